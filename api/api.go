@@ -30,6 +30,9 @@ type CemInterface interface {
 
 	// Add a use case implementation
 	AddUseCase(usecase UseCaseInterface)
+
+	// Return the added use case implementations
+	UseCases() []UseCaseInterface
 }
 
 // Implemented by each Use Case
@@ -54,6 +57,76 @@ type UseCaseInterface interface {
 	IsUseCaseSupported(remoteEntity spineapi.EntityRemoteInterface) (bool, error)
 }
 
+// Implemented by Use Cases providing data on an EV entity
+//
+// Used by the EVSE side use case implementations, as the EV entity
+// only exists while an EV is connected
+type EVUseCaseInterface interface {
+	UseCaseInterface
+
+	// add the features and the use case to a newly connected EV entity,
+	// before the entity is announced to remote devices
+	AddEVFeatures(entity spineapi.EntityLocalInterface)
+}
+
+// Implemented by approval policies for incoming limit writes
+//
+// Used by the LPC and LPP server use case implementations
+type ApprovalPolicyInterface interface {
+	// return the decision for an incoming limit write
+	Decide(request LimitApprovalRequest) ApprovalDecision
+}
+
+// Implemented by authorization policies for connected EVs
+//
+// Used by the EV charging authorization
+type AuthorizationPolicyInterface interface {
+	// return the decision for a connected EV
+	Decide(request AuthorizationRequest) AuthorizationDecision
+}
+
+// Implemented by persistent storages
+//
+// Used by the LPC and LPP server use case implementations to persist their configuration
+type StoreInterface interface {
+	// load the value stored for a key
+	//
+	// parameters:
+	//   - key: the key the value is stored for
+	//   - value: pointer to the value to load the data into
+	//
+	// possible errors:
+	//   - ErrDataNotAvailable if no value is stored for the key
+	//   - and others
+	Load(key string, value any) error
+
+	// store a value for a key
+	//
+	// parameters:
+	//   - key: the key the value is stored for
+	//   - value: the value to store, has to be serializable to JSON
+	Save(key string, value any) error
+}
+
+// Implemented by registries of device quirks
+//
+// Used by use case implementations to decide on workarounds for devices
+// deviating from the specifications
+type QuirkRegistryInterface interface {
+	// returns if a quirk applies to a remote device
+	//
+	// parameters:
+	//   - data: the manufacturer data of the remote device
+	//   - quirk: the quirk to check
+	HasQuirk(data ManufacturerData, quirk QuirkType) bool
+
+	// returns if a quirk is registered for any device
+	//
+	// used for local data that is shared by all remote devices,
+	// and can therefor not depend on a specific device
+	HasQuirkForAnyDevice(quirk QuirkType) bool
+}
+
 type ManufacturerData struct {
 	DeviceName                     string `json:"deviceName,omitempty"`
 	DeviceCode                     string `json:"deviceCode,omitempty"`
@@ -18,6 +18,17 @@ const (
 	EVChargeStateTypeFinished  EVChargeStateType = "finished"
 )
 
+// Defines the states of a Controllable System in the LPC and LPP use cases
+type ControllableSystemStateType string
+
+const (
+	ControllableSystemStateTypeInit                ControllableSystemStateType = "init"
+	ControllableSystemStateTypeUnlimitedControlled ControllableSystemStateType = "unlimitedControlled"
+	ControllableSystemStateTypeLimited             ControllableSystemStateType = "limited"
+	ControllableSystemStateTypeFailsafe            ControllableSystemStateType = "failsafe"
+	ControllableSystemStateTypeUnlimitedAutonomous ControllableSystemStateType = "unlimitedAutonomous"
+)
+
 // Defines a phase specific limit data set
 type LoadLimitsPhase struct {
 	Phase        model.ElectricalConnectionPhaseNameType // the phase
@@ -34,6 +45,100 @@ type LoadLimit struct {
 	Value        float64       // the limit in A
 }
 
+// Contains the configuration of a LPC or LPP Controllable System that has to survive a restart
+type ControllableSystemConfiguration struct {
+	Limit                      *LoadLimit     `json:"limit,omitempty"`                      // the current limit
+	LimitEndTime               time.Time      `json:"limitEndTime,omitempty"`               // the end time of the current limit, zero if it has no duration
+	FailsafeLimit              *float64       `json:"failsafeLimit,omitempty"`              // the failsafe limit in W
+	FailsafeLimitChangeable    bool           `json:"failsafeLimitChangeable,omitempty"`    // if the failsafe limit can be changed by the client service
+	FailsafeDurationMinimum    *time.Duration `json:"failsafeDurationMinimum,omitempty"`    // the minimum duration of the failsafe state
+	FailsafeDurationChangeable bool           `json:"failsafeDurationChangeable,omitempty"` // if the failsafe duration can be changed by the client service
+	ContractualNominalMax      *float64       `json:"contractualNominalMax,omitempty"`      // the contractual nominal max power in W
+}
+
+type ApprovalResultType string
+
+const (
+	// the policy does not decide, the decision is left to the next policy
+	// or to the application if there is none
+	ApprovalResultTypeNone ApprovalResultType = "none"
+
+	// the incoming limit is approved
+	ApprovalResultTypeApprove ApprovalResultType = "approve"
+
+	// the incoming limit is denied
+	ApprovalResultTypeDeny ApprovalResultType = "deny"
+
+	// the application has to approve or deny the incoming limit
+	ApprovalResultTypeManual ApprovalResultType = "manual"
+)
+
+// Contains the decision of an approval policy
+type ApprovalDecision struct {
+	Result ApprovalResultType // the result of the decision
+	Reason string             // the reason for the decision, reported to the remote service if the limit is denied
+}
+
+// Contains details about an incoming limit write that needs to be approved or denied
+type LimitApprovalRequest struct {
+	Ski                   string                // the SKI of the remote device writing the limit
+	MsgCounter            model.MsgCounterType  // the message counter of the incoming write message
+	UseCase               model.UseCaseNameType // the use case the limit is written for
+	Limit                 LoadLimit             // the limit in W
+	ContractualNominalMax float64               // the contractual nominal max power in W of the use case, 0 if not available
+}
+
+type AuthorizationResultType string
+
+const (
+	// the policy does not decide, the decision is left to the next policy,
+	// the EV is denied if there is none
+	AuthorizationResultTypeNone AuthorizationResultType = "none"
+
+	// the EV is authorized to charge
+	AuthorizationResultTypeAuthorize AuthorizationResultType = "authorize"
+
+	// the EV is not authorized to charge
+	AuthorizationResultTypeDeny AuthorizationResultType = "deny"
+)
+
+// Contains the decision of an authorization policy
+type AuthorizationDecision struct {
+	Result AuthorizationResultType // the result of the decision
+	Reason string                  // the reason for the decision
+}
+
+// Contains details about a connected EV that needs to be authorized or denied
+type AuthorizationRequest struct {
+	Ski             string               // the SKI of the EVSE the EV is connected to
+	Identifications []IdentificationItem // the identifications of the EV, empty if the EV provided none
+}
+
+type QuirkType string
+
+const (
+	// the device provides a DeviceDiagnosis server on multiple entities,
+	// the heartbeat has to be subscribed on the entity binding to the
+	// load control server
+	QuirkTypeHeartbeatOnBindingEntity QuirkType = "heartbeatOnBindingEntity"
+
+	// the device requires a MeasurementId in the load control limit descriptions,
+	// even if there is no measurement for the limit
+	QuirkTypeLimitDescriptionMeasurementId QuirkType = "limitDescriptionMeasurementId"
+)
+
+// Identifies the devices a set of quirks applies to
+//
+// The values are compared case insensitive with the manufacturer data
+// of the remote device, empty values match all devices
+type QuirkProfile struct {
+	Name       string      // unique name of the profile
+	VendorName string      // contained in the vendor name
+	BrandName  string      // contained in the brand name
+	DeviceCode string      // equal to the device code
+	Quirks     []QuirkType // the quirks of the matching devices
+}
+
 // identification
 type IdentificationItem struct {
 	// the identification value
@@ -155,3 +260,5 @@ type DurationSlotValue struct {
 type EventType string
 
 var ErrNoCompatibleEntity = errors.New("entity is not an compatible entity")
+
+var ErrUseCaseAdded = errors.New("not possible after the use case was added")
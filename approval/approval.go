@@ -0,0 +1,119 @@
+package approval
+
+import (
+	"math"
+	"slices"
+
+	"github.com/enbility/cemd/api"
+)
+
+// Approves all incoming limits
+type AutoApprovePolicy struct{}
+
+var _ api.ApprovalPolicyInterface = (*AutoApprovePolicy)(nil)
+
+func NewAutoApprovePolicy() *AutoApprovePolicy {
+	return &AutoApprovePolicy{}
+}
+
+func (p *AutoApprovePolicy) Decide(request api.LimitApprovalRequest) api.ApprovalDecision {
+	return api.ApprovalDecision{Result: api.ApprovalResultTypeApprove}
+}
+
+// Leaves the decision to the application
+//
+// The use case reports a WriteApprovalRequired event and the application
+// has to invoke the use cases ApproveOrDeny method
+type ManualPolicy struct{}
+
+var _ api.ApprovalPolicyInterface = (*ManualPolicy)(nil)
+
+func NewManualPolicy() *ManualPolicy {
+	return &ManualPolicy{}
+}
+
+func (p *ManualPolicy) Decide(request api.LimitApprovalRequest) api.ApprovalDecision {
+	return api.ApprovalDecision{Result: api.ApprovalResultTypeManual}
+}
+
+// Denies active limits above the contractual nominal max power
+//
+// Does not decide if the limit is within the contractual nominal max power,
+// is not active or the contractual nominal max power is not available
+type NominalMaxPolicy struct{}
+
+var _ api.ApprovalPolicyInterface = (*NominalMaxPolicy)(nil)
+
+func NewNominalMaxPolicy() *NominalMaxPolicy {
+	return &NominalMaxPolicy{}
+}
+
+func (p *NominalMaxPolicy) Decide(request api.LimitApprovalRequest) api.ApprovalDecision {
+	// production values may be provided as negative numbers
+	if request.Limit.IsActive &&
+		request.ContractualNominalMax != 0 &&
+		math.Abs(request.Limit.Value) > math.Abs(request.ContractualNominalMax) {
+		return api.ApprovalDecision{
+			Result: api.ApprovalResultTypeDeny,
+			Reason: "limit exceeds the contractual nominal max power",
+		}
+	}
+
+	return api.ApprovalDecision{Result: api.ApprovalResultTypeNone}
+}
+
+// Denies limits from remote devices whose SKI is not in the allow-list
+//
+// Does not decide for remote devices in the allow-list
+type SKIAllowListPolicy struct {
+	skis []string
+}
+
+var _ api.ApprovalPolicyInterface = (*SKIAllowListPolicy)(nil)
+
+func NewSKIAllowListPolicy(skis ...string) *SKIAllowListPolicy {
+	return &SKIAllowListPolicy{
+		skis: skis,
+	}
+}
+
+func (p *SKIAllowListPolicy) Decide(request api.LimitApprovalRequest) api.ApprovalDecision {
+	if !slices.Contains(p.skis, request.Ski) {
+		return api.ApprovalDecision{
+			Result: api.ApprovalResultTypeDeny,
+			Reason: "remote device is not allowed to set limits",
+		}
+	}
+
+	return api.ApprovalDecision{Result: api.ApprovalResultTypeNone}
+}
+
+// Asks each policy in the given order, the first decision wins
+//
+// Does not decide if none of the policies decides
+type ChainPolicy struct {
+	policies []api.ApprovalPolicyInterface
+}
+
+var _ api.ApprovalPolicyInterface = (*ChainPolicy)(nil)
+
+func NewChainPolicy(policies ...api.ApprovalPolicyInterface) *ChainPolicy {
+	return &ChainPolicy{
+		policies: policies,
+	}
+}
+
+func (p *ChainPolicy) Decide(request api.LimitApprovalRequest) api.ApprovalDecision {
+	for _, policy := range p.policies {
+		if policy == nil {
+			continue
+		}
+
+		decision := policy.Decide(request)
+		if decision.Result != api.ApprovalResultTypeNone && decision.Result != "" {
+			return decision
+		}
+	}
+
+	return api.ApprovalDecision{Result: api.ApprovalResultTypeNone}
+}
@@ -0,0 +1,105 @@
+package approval
+
+import (
+	"testing"
+
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestApprovalSuite(t *testing.T) {
+	suite.Run(t, new(ApprovalSuite))
+}
+
+type ApprovalSuite struct {
+	suite.Suite
+
+	request api.LimitApprovalRequest
+}
+
+func (s *ApprovalSuite) BeforeTest(suiteName, testName string) {
+	s.request = api.LimitApprovalRequest{
+		Ski:        "testski",
+		MsgCounter: 500,
+		Limit: api.LoadLimit{
+			IsActive: true,
+			Value:    4200,
+		},
+		ContractualNominalMax: 11000,
+	}
+}
+
+func (s *ApprovalSuite) Test_AutoApprovePolicy() {
+	decision := NewAutoApprovePolicy().Decide(s.request)
+	assert.Equal(s.T(), api.ApprovalResultTypeApprove, decision.Result)
+}
+
+func (s *ApprovalSuite) Test_ManualPolicy() {
+	decision := NewManualPolicy().Decide(s.request)
+	assert.Equal(s.T(), api.ApprovalResultTypeManual, decision.Result)
+}
+
+func (s *ApprovalSuite) Test_NominalMaxPolicy() {
+	sut := NewNominalMaxPolicy()
+
+	decision := sut.Decide(s.request)
+	assert.Equal(s.T(), api.ApprovalResultTypeNone, decision.Result)
+
+	s.request.Limit.Value = 12000
+	decision = sut.Decide(s.request)
+	assert.Equal(s.T(), api.ApprovalResultTypeDeny, decision.Result)
+	assert.NotEqual(s.T(), "", decision.Reason)
+
+	s.request.Limit.IsActive = false
+	decision = sut.Decide(s.request)
+	assert.Equal(s.T(), api.ApprovalResultTypeNone, decision.Result)
+
+	s.request.Limit.IsActive = true
+	s.request.ContractualNominalMax = 0
+	decision = sut.Decide(s.request)
+	assert.Equal(s.T(), api.ApprovalResultTypeNone, decision.Result)
+
+	s.request.Limit.Value = -8000
+	s.request.ContractualNominalMax = -7000
+	decision = sut.Decide(s.request)
+	assert.Equal(s.T(), api.ApprovalResultTypeDeny, decision.Result)
+}
+
+func (s *ApprovalSuite) Test_SKIAllowListPolicy() {
+	sut := NewSKIAllowListPolicy()
+	decision := sut.Decide(s.request)
+	assert.Equal(s.T(), api.ApprovalResultTypeDeny, decision.Result)
+	assert.NotEqual(s.T(), "", decision.Reason)
+
+	sut = NewSKIAllowListPolicy("otherski", "testski")
+	decision = sut.Decide(s.request)
+	assert.Equal(s.T(), api.ApprovalResultTypeNone, decision.Result)
+}
+
+func (s *ApprovalSuite) Test_ChainPolicy() {
+	sut := NewChainPolicy()
+	decision := sut.Decide(s.request)
+	assert.Equal(s.T(), api.ApprovalResultTypeNone, decision.Result)
+
+	sut = NewChainPolicy(nil, NewSKIAllowListPolicy("testski"), NewNominalMaxPolicy())
+	decision = sut.Decide(s.request)
+	assert.Equal(s.T(), api.ApprovalResultTypeNone, decision.Result)
+
+	mockPolicy := mocks.NewApprovalPolicyInterface(s.T())
+	mockPolicy.EXPECT().Decide(mock.Anything).Return(api.ApprovalDecision{Result: api.ApprovalResultTypeApprove}).Once()
+
+	sut = NewChainPolicy(NewSKIAllowListPolicy("testski"), NewNominalMaxPolicy(), mockPolicy, NewManualPolicy())
+	decision = sut.Decide(s.request)
+	assert.Equal(s.T(), api.ApprovalResultTypeApprove, decision.Result)
+
+	s.request.Limit.Value = 12000
+	decision = sut.Decide(s.request)
+	assert.Equal(s.T(), api.ApprovalResultTypeDeny, decision.Result)
+
+	s.request.Ski = "otherski"
+	decision = sut.Decide(s.request)
+	assert.Equal(s.T(), api.ApprovalResultTypeDeny, decision.Result)
+}
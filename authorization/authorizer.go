@@ -0,0 +1,343 @@
+// Package authorization authorizes EVs to charge based on their identifications
+//
+// When an EV is connected, the Authorizer holds it at 0 A via OPEV and asks the
+// policy with the identifications of the EV reported via EVCC, e.g. MAC
+// addresses or EVCC IDs. If no identifications are reported within the
+// identification timeout, the policy decides without identifications.
+//
+// Authorized EVs are released by writing inactive limits, denied EVs and EVs no
+// policy decided for are held at 0 A until they are disconnected. If the limits
+// of a held EV are changed, e.g. by another controller, the EV is held again.
+// The results are reported via the EvAuthorized and EvDenied events.
+package authorization
+
+import (
+	"sync"
+	"time"
+
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/cem"
+	"github.com/enbility/cemd/ucevcc"
+	"github.com/enbility/cemd/ucopev"
+	"github.com/enbility/cemd/util"
+	"github.com/enbility/ship-go/logging"
+	spineapi "github.com/enbility/spine-go/api"
+)
+
+// Authorizes the connected EVs
+type Authorizer struct {
+	evcc ucevcc.UCEVCCInterface
+	opev ucopev.UCOPEVInterface
+
+	policy  api.AuthorizationPolicyInterface
+	eventCB api.EntityEventCallback
+	config  Config
+
+	mux sync.Mutex
+	// the connected EVs by entity
+	evs map[spineapi.EntityRemoteInterface]*ev
+}
+
+// a connected EV
+type ev struct {
+	ski    string
+	device spineapi.DeviceRemoteInterface
+	entity spineapi.EntityRemoteInterface
+
+	// the decision, AuthorizationResultTypeNone until the policy decided
+	decision api.AuthorizationDecision
+
+	// if the policy is currently asked for a decision
+	deciding bool
+
+	// if the limits for the decision are written
+	written bool
+
+	// if the identification timeout passed
+	timedOut bool
+	timer    *time.Timer
+}
+
+// create a new authorizer
+//
+// parameters:
+//   - evcc: the use case reporting the connected EVs and their identifications
+//   - opev: the use case limiting the currents of the EVs
+//   - policy: decides if an EV is authorized
+//   - eventCB: receives the EvAuthorized and EvDenied events, may be nil
+//   - config: the configuration
+//
+// the events of the use cases have to be passed to EntityEventCB and DeviceEventCB
+func NewAuthorizer(
+	evcc ucevcc.UCEVCCInterface,
+	opev ucopev.UCOPEVInterface,
+	policy api.AuthorizationPolicyInterface,
+	eventCB api.EntityEventCallback,
+	config Config,
+) *Authorizer {
+	if config.IdentificationTimeout <= 0 {
+		config.IdentificationTimeout = defaultIdentificationTimeout
+	}
+
+	return &Authorizer{
+		evcc:    evcc,
+		opev:    opev,
+		policy:  policy,
+		eventCB: eventCB,
+		config:  config,
+		evs:     make(map[spineapi.EntityRemoteInterface]*ev),
+	}
+}
+
+// returns the decision for a connected EV
+//
+// returns false if the EV is unknown or no decision was made yet
+func (a *Authorizer) Decision(entity spineapi.EntityRemoteInterface) (api.AuthorizationDecision, bool) {
+	a.mux.Lock()
+	defer a.mux.Unlock()
+
+	item, ok := a.evs[entity]
+	if !ok || item.decision.Result == api.AuthorizationResultTypeNone {
+		return api.AuthorizationDecision{}, false
+	}
+
+	return item.decision, true
+}
+
+// returns if a connected EV is authorized to charge
+func (a *Authorizer) Authorized(entity spineapi.EntityRemoteInterface) bool {
+	decision, ok := a.Decision(entity)
+
+	return ok && decision.Result == api.AuthorizationResultTypeAuthorize
+}
+
+// track the EVs, hold them and decide once their identifications are known
+//
+// has the signature of api.EntityEventCallback
+func (a *Authorizer) EntityEventCB(ski string, device spineapi.DeviceRemoteInterface, entity spineapi.EntityRemoteInterface, event api.EventType) {
+	if entity == nil {
+		return
+	}
+
+	a.mux.Lock()
+
+	var item *ev
+
+	switch event {
+	case ucevcc.EvConnected, ucevcc.DataUpdateIdentifications, ucopev.DataUpdateCurrentLimits:
+		item = a.track(ski, device, entity)
+	case ucopev.DataUpdateLimit:
+		if tracked, ok := a.evs[entity]; ok {
+			tracked.checkHeld(a.opev)
+			item = tracked
+		}
+	case ucevcc.EvDisconnected:
+		if tracked, ok := a.evs[entity]; ok {
+			a.remove(tracked)
+		}
+	}
+
+	a.mux.Unlock()
+
+	if item != nil {
+		a.update(item)
+	}
+}
+
+// forget the EVs of disconnected devices
+//
+// has the signature of api.DeviceEventCallback
+func (a *Authorizer) DeviceEventCB(ski string, device spineapi.DeviceRemoteInterface, event api.EventType) {
+	if event != cem.DeviceDisconnected {
+		return
+	}
+
+	a.mux.Lock()
+	defer a.mux.Unlock()
+
+	for _, item := range a.evs {
+		if item.ski == ski {
+			a.remove(item)
+		}
+	}
+}
+
+// returns the tracked EV, starts tracking unknown EVs
+func (a *Authorizer) track(ski string, device spineapi.DeviceRemoteInterface, entity spineapi.EntityRemoteInterface) *ev {
+	if item, ok := a.evs[entity]; ok {
+		return item
+	}
+
+	item := &ev{
+		ski:      ski,
+		device:   device,
+		entity:   entity,
+		decision: api.AuthorizationDecision{Result: api.AuthorizationResultTypeNone},
+	}
+	item.timer = time.AfterFunc(a.config.IdentificationTimeout, func() {
+		a.identificationTimedOut(item)
+	})
+	a.evs[entity] = item
+
+	return item
+}
+
+func (a *Authorizer) remove(item *ev) {
+	item.timer.Stop()
+	delete(a.evs, item.entity)
+}
+
+// no identifications were reported within the timeout
+func (a *Authorizer) identificationTimedOut(item *ev) {
+	a.mux.Lock()
+	tracked := a.evs[item.entity] == item
+	if tracked {
+		item.timedOut = true
+	}
+	a.mux.Unlock()
+
+	if tracked {
+		a.update(item)
+	}
+}
+
+// decide for the EV if possible and write its limits
+//
+// the policy is asked without holding the lock, as it may take a while,
+// the EvAuthorized or EvDenied event is published if a decision was made
+func (a *Authorizer) update(item *ev) {
+	a.mux.Lock()
+
+	// the EV was removed in the meantime
+	if a.evs[item.entity] != item {
+		a.mux.Unlock()
+		return
+	}
+
+	request, ok := a.request(item)
+	if !ok {
+		a.write(item)
+		a.mux.Unlock()
+		return
+	}
+	item.deciding = true
+
+	a.mux.Unlock()
+
+	decision := a.decide(request)
+
+	a.mux.Lock()
+
+	item.deciding = false
+	if a.evs[item.entity] != item {
+		a.mux.Unlock()
+		return
+	}
+
+	item.decision = decision
+	item.written = false
+	item.timer.Stop()
+	a.write(item)
+
+	a.mux.Unlock()
+
+	result := EvDenied
+	if decision.Result == api.AuthorizationResultTypeAuthorize {
+		result = EvAuthorized
+	}
+	a.publish(item.ski, item.device, item.entity, result)
+}
+
+// returns the request for the policy, if the EV is to be decided on
+//
+// EVs are decided on once their identifications are known or the identification timeout passed
+func (a *Authorizer) request(item *ev) (api.AuthorizationRequest, bool) {
+	if item.decision.Result != api.AuthorizationResultTypeNone || item.deciding {
+		return api.AuthorizationRequest{}, false
+	}
+
+	identifications, err := a.evcc.Identifications(item.entity)
+	if (err != nil || len(identifications) == 0) && !item.timedOut {
+		return api.AuthorizationRequest{}, false
+	}
+
+	return api.AuthorizationRequest{
+		Ski:             item.ski,
+		Identifications: identifications,
+	}, true
+}
+
+// returns the decision of the policy, denies if the policy does not decide
+func (a *Authorizer) decide(request api.AuthorizationRequest) api.AuthorizationDecision {
+	decision := a.policy.Decide(request)
+
+	if decision.Result == api.AuthorizationResultTypeNone || decision.Result == "" {
+		return api.AuthorizationDecision{
+			Result: api.AuthorizationResultTypeDeny,
+			Reason: "no policy authorized the EV",
+		}
+	}
+
+	return decision
+}
+
+// write the limits for the decision, if not written yet
+//
+// authorized EVs get inactive limits at their maximum current,
+// all other EVs active limits of 0 A
+func (a *Authorizer) write(item *ev) {
+	if item.written {
+		return
+	}
+
+	_, maximum, _, err := a.opev.CurrentLimits(item.entity)
+	if err != nil || len(maximum) == 0 {
+		return
+	}
+
+	authorized := item.decision.Result == api.AuthorizationResultTypeAuthorize
+
+	var limits []api.LoadLimitsPhase
+	for phase := 0; phase < min(len(maximum), len(util.PhaseNameMapping)); phase++ {
+		limit := api.LoadLimitsPhase{
+			Phase:    util.PhaseNameMapping[phase],
+			IsActive: !authorized,
+		}
+		if authorized {
+			limit.Value = maximum[phase]
+		}
+		limits = append(limits, limit)
+	}
+
+	if _, err := a.opev.WriteLoadControlLimits(item.entity, limits); err != nil {
+		logging.Log().Error("authorization", item.ski, err)
+		return
+	}
+
+	item.written = true
+}
+
+func (a *Authorizer) publish(ski string, device spineapi.DeviceRemoteInterface, entity spineapi.EntityRemoteInterface, event api.EventType) {
+	if a.eventCB != nil {
+		a.eventCB(ski, device, entity, event)
+	}
+}
+
+// check if a held EV is still limited to 0 A, otherwise its limits are written again
+func (item *ev) checkHeld(opev ucopev.UCOPEVInterface) {
+	if item.decision.Result == api.AuthorizationResultTypeAuthorize || !item.written {
+		return
+	}
+
+	limits, err := opev.LoadControlLimits(item.entity)
+	if err != nil {
+		return
+	}
+
+	for _, limit := range limits {
+		if !limit.IsActive || limit.Value > 0 {
+			item.written = false
+			return
+		}
+	}
+}
@@ -0,0 +1,210 @@
+package authorization
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/cem"
+	"github.com/enbility/cemd/loopback"
+	"github.com/enbility/cemd/mocks"
+	"github.com/enbility/cemd/ucevcc"
+	"github.com/enbility/cemd/ucopev"
+	spinemocks "github.com/enbility/spine-go/mocks"
+	"github.com/enbility/spine-go/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestAuthorizerSuite(t *testing.T) {
+	suite.Run(t, new(AuthorizerSuite))
+}
+
+type AuthorizerSuite struct {
+	suite.Suite
+
+	evcc *mocks.UCEVCCInterface
+	opev *mocks.UCOPEVInterface
+
+	ev *spinemocks.EntityRemoteInterface
+
+	events *loopback.EventRecorder
+	sut    *Authorizer
+}
+
+func (s *AuthorizerSuite) BeforeTest(suiteName, testName string) {
+	s.evcc = mocks.NewUCEVCCInterface(s.T())
+	s.opev = mocks.NewUCOPEVInterface(s.T())
+
+	s.ev = spinemocks.NewEntityRemoteInterface(s.T())
+
+	s.events = loopback.NewEventRecorder()
+	s.sut = NewAuthorizer(s.evcc, s.opev, NewAllowListPolicy("00:11:22:33:44:55"), s.events.EntityEventCB, Config{})
+}
+
+// returns the limits of an EV with the same value on all 3 phases
+func limits(isActive bool, value float64) []api.LoadLimitsPhase {
+	var result []api.LoadLimitsPhase
+	for _, phase := range []model.ElectricalConnectionPhaseNameType{"a", "b", "c"} {
+		result = append(result, api.LoadLimitsPhase{Phase: phase, IsActive: isActive, Value: value})
+	}
+	return result
+}
+
+func identifications(value string) []api.IdentificationItem {
+	return []api.IdentificationItem{{Value: value, ValueType: model.IdentificationTypeTypeEui48}}
+}
+
+func (s *AuthorizerSuite) Test_Authorized() {
+	s.opev.EXPECT().CurrentLimits(s.ev).Return([]float64{6, 6, 6}, []float64{16, 16, 16}, []float64{0, 0, 0}, nil)
+
+	// the EV is held until its identifications are known
+	s.evcc.EXPECT().Identifications(s.ev).Return(nil, errors.New("not available")).Once()
+	s.opev.EXPECT().WriteLoadControlLimits(s.ev, limits(true, 0)).Return(nil, nil).Once()
+	s.sut.EntityEventCB("evse", nil, s.ev, ucevcc.EvConnected)
+
+	_, ok := s.sut.Decision(s.ev)
+	assert.False(s.T(), ok)
+	assert.False(s.T(), s.sut.Authorized(s.ev))
+
+	// the limits are released once the EV is authorized
+	s.evcc.EXPECT().Identifications(s.ev).Return(identifications("001122334455"), nil).Once()
+	s.opev.EXPECT().WriteLoadControlLimits(s.ev, limits(false, 16)).Return(nil, nil).Once()
+	s.sut.EntityEventCB("evse", nil, s.ev, ucevcc.DataUpdateIdentifications)
+
+	assert.True(s.T(), s.sut.Authorized(s.ev))
+	assert.True(s.T(), s.events.Received("evse", EvAuthorized))
+
+	// the limits of authorized EVs are not checked
+	s.sut.EntityEventCB("evse", nil, s.ev, ucopev.DataUpdateLimit)
+	s.sut.EntityEventCB("evse", nil, s.ev, ucopev.DataUpdateCurrentLimits)
+
+	s.sut.EntityEventCB("evse", nil, s.ev, ucevcc.EvDisconnected)
+	assert.False(s.T(), s.sut.Authorized(s.ev))
+}
+
+func (s *AuthorizerSuite) Test_Denied() {
+	s.sut = NewAuthorizer(s.evcc, s.opev, NewDenyListPolicy("00-11-22-33-44-55"), s.events.EntityEventCB, Config{})
+
+	// the limits are written once the current limits of the EV are known
+	s.evcc.EXPECT().Identifications(s.ev).Return(identifications("001122334455"), nil).Once()
+	s.opev.EXPECT().CurrentLimits(s.ev).Return(nil, nil, nil, errors.New("not available")).Once()
+	s.sut.EntityEventCB("evse", nil, s.ev, ucevcc.EvConnected)
+
+	decision, ok := s.sut.Decision(s.ev)
+	assert.True(s.T(), ok)
+	assert.Equal(s.T(), api.AuthorizationResultTypeDeny, decision.Result)
+	assert.True(s.T(), s.events.Received("evse", EvDenied))
+
+	s.opev.EXPECT().CurrentLimits(s.ev).Return([]float64{6, 6, 6}, []float64{16, 16, 16}, []float64{0, 0, 0}, nil)
+	s.opev.EXPECT().WriteLoadControlLimits(s.ev, limits(true, 0)).Return(nil, nil).Once()
+	s.sut.EntityEventCB("evse", nil, s.ev, ucopev.DataUpdateCurrentLimits)
+
+	// the EV is held again if its limits are changed
+	s.opev.EXPECT().LoadControlLimits(s.ev).Return(limits(true, 16), nil).Once()
+	s.opev.EXPECT().WriteLoadControlLimits(s.ev, limits(true, 0)).Return(nil, errors.New("failed")).Once()
+	s.sut.EntityEventCB("evse", nil, s.ev, ucopev.DataUpdateLimit)
+
+	// failed writes are repeated with the next event
+	s.opev.EXPECT().WriteLoadControlLimits(s.ev, limits(true, 0)).Return(nil, nil).Once()
+	s.sut.EntityEventCB("evse", nil, s.ev, ucopev.DataUpdateLimit)
+
+	s.opev.EXPECT().LoadControlLimits(s.ev).Return(limits(true, 0), nil).Once()
+	s.sut.EntityEventCB("evse", nil, s.ev, ucopev.DataUpdateLimit)
+
+	// the EVs of disconnected devices are forgotten
+	s.sut.DeviceEventCB("other", nil, cem.DeviceDisconnected)
+	s.sut.DeviceEventCB("evse", nil, cem.DeviceConnected)
+	_, ok = s.sut.Decision(s.ev)
+	assert.True(s.T(), ok)
+
+	s.sut.DeviceEventCB("evse", nil, cem.DeviceDisconnected)
+	_, ok = s.sut.Decision(s.ev)
+	assert.False(s.T(), ok)
+}
+
+func (s *AuthorizerSuite) Test_Undecided() {
+	s.opev.EXPECT().CurrentLimits(s.ev).Return([]float64{6, 6, 6}, []float64{16, 16, 16}, []float64{0, 0, 0}, nil)
+	s.opev.EXPECT().WriteLoadControlLimits(s.ev, limits(true, 0)).Return(nil, nil).Once()
+
+	s.evcc.EXPECT().Identifications(s.ev).Return(identifications("aabbccddeeff"), nil).Once()
+	s.sut.EntityEventCB("evse", nil, s.ev, ucevcc.EvConnected)
+
+	decision, ok := s.sut.Decision(s.ev)
+	assert.True(s.T(), ok)
+	assert.Equal(s.T(), api.AuthorizationDecision{
+		Result: api.AuthorizationResultTypeDeny,
+		Reason: "no policy authorized the EV",
+	}, decision)
+}
+
+func (s *AuthorizerSuite) Test_IdentificationTimeout() {
+	var requests []api.AuthorizationRequest
+	policy := NewCallbackPolicy(func(request api.AuthorizationRequest) api.AuthorizationDecision {
+		requests = append(requests, request)
+		return api.AuthorizationDecision{Result: api.AuthorizationResultTypeAuthorize}
+	})
+	s.sut = NewAuthorizer(s.evcc, s.opev, policy, s.events.EntityEventCB, Config{IdentificationTimeout: time.Millisecond * 50})
+
+	s.opev.EXPECT().CurrentLimits(s.ev).Return([]float64{6, 6, 6}, []float64{16, 16, 16}, []float64{0, 0, 0}, nil)
+	s.opev.EXPECT().WriteLoadControlLimits(s.ev, limits(true, 0)).Return(nil, nil).Once()
+	s.evcc.EXPECT().Identifications(s.ev).Return(nil, errors.New("not available"))
+	s.sut.EntityEventCB("evse", nil, s.ev, ucevcc.EvConnected)
+
+	// the policy decides without identifications
+	s.opev.EXPECT().WriteLoadControlLimits(s.ev, limits(false, 16)).Return(nil, nil).Once()
+	_, ok := s.events.WaitFor("evse", EvAuthorized, time.Second)
+	assert.True(s.T(), ok)
+
+	assert.True(s.T(), s.sut.Authorized(s.ev))
+	assert.Equal(s.T(), []api.AuthorizationRequest{{Ski: "evse"}}, requests)
+}
+
+func (s *AuthorizerSuite) Test_SlowPolicy() {
+	asked := make(chan struct{})
+	decided := make(chan struct{})
+	policy := NewCallbackPolicy(func(request api.AuthorizationRequest) api.AuthorizationDecision {
+		close(asked)
+		<-decided
+		return api.AuthorizationDecision{Result: api.AuthorizationResultTypeAuthorize}
+	})
+	s.sut = NewAuthorizer(s.evcc, s.opev, policy, s.events.EntityEventCB, Config{})
+
+	s.evcc.EXPECT().Identifications(s.ev).Return(identifications("001122334455"), nil).Once()
+
+	done := make(chan struct{})
+	go func() {
+		s.sut.EntityEventCB("evse", nil, s.ev, ucevcc.EvConnected)
+		close(done)
+	}()
+	<-asked
+
+	// the authorizer is not blocked while the policy decides
+	disconnected := make(chan struct{})
+	go func() {
+		_, ok := s.sut.Decision(s.ev)
+		assert.False(s.T(), ok)
+
+		s.sut.EntityEventCB("evse", nil, s.ev, ucevcc.EvDisconnected)
+		close(disconnected)
+	}()
+
+	select {
+	case <-disconnected:
+		close(decided)
+	case <-time.After(time.Second):
+		close(decided)
+		s.T().Fatal("the authorizer is blocked while the policy decides")
+	}
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		s.T().Fatal("the event callback did not return")
+	}
+
+	// the decision for the disconnected EV is discarded
+	_, ok := s.sut.Decision(s.ev)
+	assert.False(s.T(), ok)
+	assert.False(s.T(), s.events.Received("evse", EvAuthorized))
+}
@@ -0,0 +1,151 @@
+package authorization
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/enbility/cemd/api"
+)
+
+// Authorizes all EVs
+type AuthorizeAllPolicy struct{}
+
+var _ api.AuthorizationPolicyInterface = (*AuthorizeAllPolicy)(nil)
+
+func NewAuthorizeAllPolicy() *AuthorizeAllPolicy {
+	return &AuthorizeAllPolicy{}
+}
+
+func (p *AuthorizeAllPolicy) Decide(request api.AuthorizationRequest) api.AuthorizationDecision {
+	return api.AuthorizationDecision{Result: api.AuthorizationResultTypeAuthorize}
+}
+
+// Authorizes EVs with an identification in the allow-list
+//
+// Does not decide for other EVs
+type AllowListPolicy struct {
+	values []string
+}
+
+var _ api.AuthorizationPolicyInterface = (*AllowListPolicy)(nil)
+
+// parameters:
+//   - values: the identifications, e.g. MAC addresses or EVCC IDs, compared
+//     case insensitive and ignoring ":" and "-" separators
+func NewAllowListPolicy(values ...string) *AllowListPolicy {
+	return &AllowListPolicy{
+		values: normalizeAll(values),
+	}
+}
+
+func (p *AllowListPolicy) Decide(request api.AuthorizationRequest) api.AuthorizationDecision {
+	if contains(p.values, request.Identifications) {
+		return api.AuthorizationDecision{
+			Result: api.AuthorizationResultTypeAuthorize,
+			Reason: "EV is in the allow-list",
+		}
+	}
+
+	return api.AuthorizationDecision{Result: api.AuthorizationResultTypeNone}
+}
+
+// Denies EVs with an identification in the deny-list
+//
+// Does not decide for other EVs
+type DenyListPolicy struct {
+	values []string
+}
+
+var _ api.AuthorizationPolicyInterface = (*DenyListPolicy)(nil)
+
+// parameters:
+//   - values: the identifications, e.g. MAC addresses or EVCC IDs, compared
+//     case insensitive and ignoring ":" and "-" separators
+func NewDenyListPolicy(values ...string) *DenyListPolicy {
+	return &DenyListPolicy{
+		values: normalizeAll(values),
+	}
+}
+
+func (p *DenyListPolicy) Decide(request api.AuthorizationRequest) api.AuthorizationDecision {
+	if contains(p.values, request.Identifications) {
+		return api.AuthorizationDecision{
+			Result: api.AuthorizationResultTypeDeny,
+			Reason: "EV is in the deny-list",
+		}
+	}
+
+	return api.AuthorizationDecision{Result: api.AuthorizationResultTypeNone}
+}
+
+// Leaves the decision to a function of the application, e.g. to check an external list
+type CallbackPolicy struct {
+	decide func(request api.AuthorizationRequest) api.AuthorizationDecision
+}
+
+var _ api.AuthorizationPolicyInterface = (*CallbackPolicy)(nil)
+
+func NewCallbackPolicy(decide func(request api.AuthorizationRequest) api.AuthorizationDecision) *CallbackPolicy {
+	return &CallbackPolicy{
+		decide: decide,
+	}
+}
+
+func (p *CallbackPolicy) Decide(request api.AuthorizationRequest) api.AuthorizationDecision {
+	return p.decide(request)
+}
+
+// Asks each policy in the given order, the first decision wins
+//
+// Does not decide if none of the policies decides
+type ChainPolicy struct {
+	policies []api.AuthorizationPolicyInterface
+}
+
+var _ api.AuthorizationPolicyInterface = (*ChainPolicy)(nil)
+
+func NewChainPolicy(policies ...api.AuthorizationPolicyInterface) *ChainPolicy {
+	return &ChainPolicy{
+		policies: policies,
+	}
+}
+
+func (p *ChainPolicy) Decide(request api.AuthorizationRequest) api.AuthorizationDecision {
+	for _, policy := range p.policies {
+		if policy == nil {
+			continue
+		}
+
+		decision := policy.Decide(request)
+		if decision.Result != api.AuthorizationResultTypeNone && decision.Result != "" {
+			return decision
+		}
+	}
+
+	return api.AuthorizationDecision{Result: api.AuthorizationResultTypeNone}
+}
+
+// returns if one of the identifications is in the normalized values
+func contains(values []string, identifications []api.IdentificationItem) bool {
+	for _, item := range identifications {
+		if slices.Contains(values, normalize(item.Value)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func normalizeAll(values []string) []string {
+	var result []string
+	for _, value := range values {
+		result = append(result, normalize(value))
+	}
+
+	return result
+}
+
+// returns the identification in lower case without separators
+func normalize(value string) string {
+	return strings.ToLower(strings.NewReplacer(":", "", "-", "").Replace(value))
+}
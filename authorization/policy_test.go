@@ -0,0 +1,73 @@
+package authorization
+
+import (
+	"testing"
+
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestPolicySuite(t *testing.T) {
+	suite.Run(t, new(PolicySuite))
+}
+
+type PolicySuite struct {
+	suite.Suite
+}
+
+func request(values ...string) api.AuthorizationRequest {
+	result := api.AuthorizationRequest{Ski: "evse"}
+	for _, value := range values {
+		result.Identifications = append(result.Identifications, api.IdentificationItem{Value: value})
+	}
+	return result
+}
+
+func (s *PolicySuite) Test_AuthorizeAllPolicy() {
+	sut := NewAuthorizeAllPolicy()
+
+	assert.Equal(s.T(), api.AuthorizationResultTypeAuthorize, sut.Decide(request()).Result)
+}
+
+func (s *PolicySuite) Test_AllowListPolicy() {
+	sut := NewAllowListPolicy("00:11:22:33:44:55", "EVCCID")
+
+	assert.Equal(s.T(), api.AuthorizationResultTypeAuthorize, sut.Decide(request("001122334455")).Result)
+	assert.Equal(s.T(), api.AuthorizationResultTypeAuthorize, sut.Decide(request("other", "evccid")).Result)
+	assert.Equal(s.T(), api.AuthorizationResultTypeNone, sut.Decide(request("other")).Result)
+	assert.Equal(s.T(), api.AuthorizationResultTypeNone, sut.Decide(request()).Result)
+}
+
+func (s *PolicySuite) Test_DenyListPolicy() {
+	sut := NewDenyListPolicy("00-11-22-33-44-55")
+
+	decision := sut.Decide(request("00:11:22:33:44:55"))
+	assert.Equal(s.T(), api.AuthorizationResultTypeDeny, decision.Result)
+	assert.NotEmpty(s.T(), decision.Reason)
+
+	assert.Equal(s.T(), api.AuthorizationResultTypeNone, sut.Decide(request("other")).Result)
+}
+
+func (s *PolicySuite) Test_ChainPolicy() {
+	undecided := mocks.NewAuthorizationPolicyInterface(s.T())
+	undecided.EXPECT().Decide(request("other")).Return(api.AuthorizationDecision{}).Once()
+
+	sut := NewChainPolicy(nil, undecided, NewDenyListPolicy("denied"), NewAuthorizeAllPolicy())
+
+	assert.Equal(s.T(), api.AuthorizationResultTypeAuthorize, sut.Decide(request("other")).Result)
+	assert.Equal(s.T(), api.AuthorizationResultTypeDeny, NewChainPolicy(NewDenyListPolicy("denied")).Decide(request("denied")).Result)
+	assert.Equal(s.T(), api.AuthorizationResultTypeNone, NewChainPolicy().Decide(request()).Result)
+}
+
+func (s *PolicySuite) Test_CallbackPolicy() {
+	sut := NewCallbackPolicy(func(request api.AuthorizationRequest) api.AuthorizationDecision {
+		if request.Ski == "evse" {
+			return api.AuthorizationDecision{Result: api.AuthorizationResultTypeDeny, Reason: "maintenance"}
+		}
+		return api.AuthorizationDecision{Result: api.AuthorizationResultTypeNone}
+	})
+
+	assert.Equal(s.T(), api.AuthorizationDecision{Result: api.AuthorizationResultTypeDeny, Reason: "maintenance"}, sut.Decide(request()))
+}
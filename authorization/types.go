@@ -0,0 +1,31 @@
+package authorization
+
+import (
+	"time"
+
+	"github.com/enbility/cemd/api"
+)
+
+// the identification timeout used if no timeout is configured
+const defaultIdentificationTimeout = time.Minute
+
+const (
+	// An EV was authorized to charge, its limits are released
+	//
+	// Use `Decision` to get the reason
+	EvAuthorized api.EventType = "authorization-EvAuthorized"
+
+	// An EV was denied to charge, it is held at 0 A
+	//
+	// Use `Decision` to get the reason
+	EvDenied api.EventType = "authorization-EvDenied"
+)
+
+// Configuration of the authorizer
+type Config struct {
+	// the duration to wait for the identifications of a connected EV, 1 minute if 0
+	//
+	// once it passes, the policy decides without identifications, as EVs
+	// charging via IEC 61851 may not provide any
+	IdentificationTimeout time.Duration
+}
@@ -5,6 +5,7 @@ import (
 	eebusapi "github.com/enbility/eebus-go/api"
 	"github.com/enbility/eebus-go/service"
 	"github.com/enbility/ship-go/logging"
+	spineapi "github.com/enbility/spine-go/api"
 	"github.com/enbility/spine-go/model"
 	"github.com/enbility/spine-go/spine"
 )
@@ -25,8 +26,16 @@ func NewCEM(
 	serviceHandler eebusapi.ServiceReaderInterface,
 	eventCB api.DeviceEventCallback,
 	log logging.LoggingInterface) *Cem {
+	return NewCEMWithService(service.NewService(serviceDescription, serviceHandler), eventCB, log)
+}
+
+// Create a CEM using the given EEBUS service, e.g. one recording the SPINE traffic
+func NewCEMWithService(
+	service eebusapi.ServiceInterface,
+	eventCB api.DeviceEventCallback,
+	log logging.LoggingInterface) *Cem {
 	cem := &Cem{
-		Service:  service.NewService(serviceDescription, serviceHandler),
+		Service:  service,
 		Currency: model.CurrencyTypeEur,
 		eventCB:  eventCB,
 	}
@@ -50,9 +59,19 @@ func (h *Cem) Start() {
 	h.Service.Start()
 }
 
-// Shutdown the EEBUS servic
+// Shutdown the EEBUS service
+//
+// The CEM and its use cases no longer receive SPINE events afterwards,
+// so a new instance can be set up in the same process
 func (h *Cem) Shutdown() {
 	h.Service.Shutdown()
+
+	for _, usecase := range h.usecases {
+		if handler, ok := usecase.(spineapi.EventHandlerInterface); ok {
+			_ = spine.Events.Unsubscribe(handler)
+		}
+	}
+	_ = spine.Events.Unsubscribe(h)
 }
 
 // Add a use case implementation
@@ -62,3 +81,8 @@ func (h *Cem) AddUseCase(usecase api.UseCaseInterface) {
 	usecase.AddFeatures()
 	usecase.AddUseCase()
 }
+
+// Return the added use case implementations
+func (h *Cem) UseCases() []api.UseCaseInterface {
+	return h.usecases
+}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/enbility/cemd/daemon"
+)
+
+// main app
+func main() {
+	configPath := flag.String("config", "cemd.yaml", "The filepath of the configuration file")
+
+	flag.Parse()
+
+	config, err := daemon.LoadConfig(*configPath)
+	if err != nil {
+		fmt.Println("Error loading configuration:", err)
+		os.Exit(1)
+	}
+
+	log := daemon.NewLogger(os.Stdout, config.LogLevel)
+
+	cemd, err := start(config, log)
+	if err != nil {
+		log.Error("Error starting cemd:", err)
+		os.Exit(1)
+	}
+
+	sig := make(chan os.Signal, 1)
+	signal.Notify(sig, os.Interrupt, syscall.SIGTERM, syscall.SIGHUP)
+
+	for s := range sig {
+		if s != syscall.SIGHUP {
+			break
+		}
+
+		config, err := daemon.LoadConfig(*configPath)
+		if err != nil {
+			log.Error("Error reloading configuration, keeping the current one:", err)
+			continue
+		}
+
+		err = cemd.Reload(config)
+		if err == nil {
+			log.Info("Configuration reloaded")
+			continue
+		}
+
+		if !errors.Is(err, daemon.ErrRestartRequired) {
+			log.Error("Error reloading configuration:", err)
+			continue
+		}
+
+		log.Info("Restarting with the changed configuration")
+		cemd.Shutdown()
+
+		log.SetLevel(config.LogLevel)
+		if cemd, err = start(config, log); err != nil {
+			log.Error("Error restarting cemd:", err)
+			os.Exit(1)
+		}
+	}
+
+	// Clean exit to make sure mdns shutdown is invoked
+	cemd.Shutdown()
+}
+
+func start(config *daemon.Config, log *daemon.Logger) (*daemon.Daemon, error) {
+	cemd, err := daemon.NewDaemon(config, log)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := cemd.Start(); err != nil {
+		cemd.Shutdown()
+		return nil, err
+	}
+
+	return cemd, nil
+}
@@ -0,0 +1,56 @@
+package daemon
+
+import (
+	"crypto/ecdsa"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/enbility/ship-go/cert"
+)
+
+// load the certificate from the configured files
+//
+// if neither file exists, a new certificate is created and stored in these files
+func loadCertificate(config CertificateConfig, vendor VendorConfig) (tls.Certificate, error) {
+	certificate, err := tls.LoadX509KeyPair(config.Cert, config.Key)
+	if err == nil {
+		return certificate, nil
+	}
+
+	if fileExists(config.Cert) || fileExists(config.Key) {
+		return tls.Certificate{}, fmt.Errorf("loading certificate: %w", err)
+	}
+
+	certificate, err = cert.CreateCertificate(vendor.Brand, vendor.Brand, "DE", vendor.Model+"-"+vendor.Serial)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+
+	pemdata := pem.EncodeToMemory(&pem.Block{
+		Type:  "CERTIFICATE",
+		Bytes: certificate.Certificate[0],
+	})
+	if err := os.WriteFile(config.Cert, pemdata, 0600); err != nil {
+		return tls.Certificate{}, err
+	}
+
+	b, err := x509.MarshalECPrivateKey(certificate.PrivateKey.(*ecdsa.PrivateKey))
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	pemdata = pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: b})
+	if err := os.WriteFile(config.Key, pemdata, 0600); err != nil {
+		return tls.Certificate{}, err
+	}
+
+	return certificate, nil
+}
+
+func fileExists(path string) bool {
+	_, err := os.Stat(path)
+	return !errors.Is(err, os.ErrNotExist)
+}
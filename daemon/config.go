@@ -0,0 +1,345 @@
+package daemon
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"time"
+
+	"github.com/enbility/spine-go/model"
+	"gopkg.in/yaml.v3"
+)
+
+// the approval policies for incoming limits of the LPC and LPP server use cases
+const (
+	ApprovalAuto       = "auto"       // approve all limits
+	ApprovalNominalMax = "nominalMax" // deny active limits above the contractual nominal max, approve all others
+	ApprovalManual     = "manual"     // limits are approved or denied after the approval timeout
+)
+
+// Configuration of the daemon, read from a YAML file
+type Config struct {
+	Certificate CertificateConfig `yaml:"certificate"`
+
+	// the port of the EEBUS service
+	Port int `yaml:"port"`
+
+	// the network interfaces the EEBUS connections are limited to, all if empty
+	Interfaces []string `yaml:"interfaces"`
+
+	Vendor VendorConfig `yaml:"vendor"`
+
+	// the nominal voltage of the installation
+	Voltage float64 `yaml:"voltage"`
+
+	// the interval in which heartbeats are expected
+	HeartbeatTimeout time.Duration `yaml:"heartbeatTimeout"`
+
+	// the SKIs of the remote devices which are trusted
+	TrustedSkis []string `yaml:"trustedSkis"`
+
+	// one of trace, debug, info, error
+	LogLevel string `yaml:"logLevel"`
+
+	// the file the SPINE traffic is recorded to, disabled if empty
+	Record string `yaml:"record"`
+
+	// the file the configuration of the LPC and LPP server use cases is stored in, disabled if empty
+	Store string `yaml:"store"`
+
+	// the listen address of the HTTP API, e.g. "localhost:8080", disabled if empty
+	HTTP string `yaml:"http"`
+
+	// the listen address of the gRPC API, e.g. "localhost:50051", disabled if empty
+	GRPC string `yaml:"grpc"`
+
+	MQTT MQTTConfig `yaml:"mqtt"`
+
+	UseCases UseCasesConfig `yaml:"usecases"`
+}
+
+type CertificateConfig struct {
+	// the path of the certificate file
+	Cert string `yaml:"cert"`
+
+	// the path of the private key file
+	Key string `yaml:"key"`
+}
+
+// the identity of the local device
+type VendorConfig struct {
+	Code       string               `yaml:"code"`
+	Brand      string               `yaml:"brand"`
+	Model      string               `yaml:"model"`
+	Serial     string               `yaml:"serial"`
+	DeviceType model.DeviceTypeType `yaml:"deviceType"`
+}
+
+// the use cases provided by the daemon
+type UseCasesConfig struct {
+	CEVC   UseCaseConfig `yaml:"cevc"`
+	EVCC   UseCaseConfig `yaml:"evcc"`
+	EVCEM  UseCaseConfig `yaml:"evcem"`
+	EVSECC UseCaseConfig `yaml:"evsecc"`
+	EVSOC  UseCaseConfig `yaml:"evsoc"`
+	LPC    UseCaseConfig `yaml:"lpc"`
+	LPP    UseCaseConfig `yaml:"lpp"`
+	MGCP   UseCaseConfig `yaml:"mgcp"`
+	MPC    UseCaseConfig `yaml:"mpc"`
+	OPEV   UseCaseConfig `yaml:"opev"`
+	OSCEV  UseCaseConfig `yaml:"oscev"`
+	VABD   UseCaseConfig `yaml:"vabd"`
+	VAPD   UseCaseConfig `yaml:"vapd"`
+
+	LPCServer  LimitServerConfig `yaml:"lpcServer"`
+	LPPServer  LimitServerConfig `yaml:"lppServer"`
+	MGCPServer UseCaseConfig     `yaml:"mgcpServer"`
+	MPCServer  UseCaseConfig     `yaml:"mpcServer"`
+	VABDServer UseCaseConfig     `yaml:"vabdServer"`
+	VAPDServer VAPDServerConfig  `yaml:"vapdServer"`
+}
+
+// the MQTT bridge, disabled if no broker is configured
+type MQTTConfig struct {
+	// the broker URL, e.g. "tcp://localhost:1883"
+	Broker   string `yaml:"broker"`
+	ClientID string `yaml:"clientId"`
+	Username string `yaml:"username"`
+	Password string `yaml:"password"`
+
+	// the quality of service of the published messages and subscriptions
+	QoS byte `yaml:"qos"`
+
+	// if the published values are retained by the broker
+	Retain bool `yaml:"retain"`
+
+	// the topic templates, the mqttbridge defaults if empty
+	StateTopic   string `yaml:"stateTopic"`
+	CommandTopic string `yaml:"commandTopic"`
+
+	// the use cases whose values are published, the mqttbridge defaults if empty
+	UseCases []string `yaml:"usecases"`
+
+	// if the remote entities are announced to Home Assistant
+	Discovery bool `yaml:"discovery"`
+
+	// the Home Assistant discovery prefix, the hadiscovery default if empty
+	DiscoveryPrefix string `yaml:"discoveryPrefix"`
+}
+
+// returns if both configurations are identical
+func (c MQTTConfig) equal(other MQTTConfig) bool {
+	return c.Broker == other.Broker &&
+		c.ClientID == other.ClientID &&
+		c.Username == other.Username &&
+		c.Password == other.Password &&
+		c.QoS == other.QoS &&
+		c.Retain == other.Retain &&
+		c.StateTopic == other.StateTopic &&
+		c.CommandTopic == other.CommandTopic &&
+		slices.Equal(c.UseCases, other.UseCases) &&
+		c.Discovery == other.Discovery &&
+		c.DiscoveryPrefix == other.DiscoveryPrefix
+}
+
+type UseCaseConfig struct {
+	Enabled bool `yaml:"enabled"`
+}
+
+// the initial values of the LPC and LPP server use cases
+//
+// the values of the production limit are negative
+type LimitServerConfig struct {
+	Enabled bool `yaml:"enabled"`
+
+	// the limit provided until an Energy Guard writes one
+	Limit float64 `yaml:"limit"`
+
+	// if the limit is active
+	LimitActive bool `yaml:"limitActive"`
+
+	ContractualNominalMax float64       `yaml:"contractualNominalMax"`
+	FailsafeLimit         float64       `yaml:"failsafeLimit"`
+	FailsafeDuration      time.Duration `yaml:"failsafeDuration"`
+
+	// one of auto, nominalMax, manual
+	Approval string `yaml:"approval"`
+
+	// the maximum duration a limit remains pending, the use case default if 0
+	ApprovalTimeout time.Duration `yaml:"approvalTimeout"`
+
+	// if pending limits are approved after the approval timeout
+	ApproveOnTimeout bool `yaml:"approveOnTimeout"`
+}
+
+type VAPDServerConfig struct {
+	Enabled bool `yaml:"enabled"`
+
+	// the nominal peak power of the PV system
+	PowerNominalPeak float64 `yaml:"powerNominalPeak"`
+}
+
+// returns a configuration containing the default values
+func DefaultConfig() *Config {
+	return &Config{
+		Certificate: CertificateConfig{
+			Cert: "cert.crt",
+			Key:  "cert.key",
+		},
+		Port: 4815,
+		Vendor: VendorConfig{
+			DeviceType: model.DeviceTypeTypeEnergyManagementSystem,
+		},
+		Voltage:          230,
+		HeartbeatTimeout: time.Second * 4,
+		LogLevel:         "info",
+	}
+}
+
+// read the configuration from a YAML file
+//
+// values not provided in the file keep their defaults
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	config := DefaultConfig()
+	if err := yaml.Unmarshal(data, config); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
+	return config, nil
+}
+
+// check the configuration for missing or invalid values
+func (c *Config) Validate() error {
+	var errs []error
+
+	if c.Certificate.Cert == "" || c.Certificate.Key == "" {
+		errs = append(errs, errors.New("certificate and key paths are required"))
+	}
+
+	if c.Port <= 0 || c.Port > 65535 {
+		errs = append(errs, fmt.Errorf("port %d is invalid", c.Port))
+	}
+
+	if c.Vendor.Code == "" {
+		errs = append(errs, errors.New("vendor code is required"))
+	}
+	if c.Vendor.Brand == "" {
+		errs = append(errs, errors.New("vendor brand is required"))
+	}
+	if c.Vendor.Model == "" {
+		errs = append(errs, errors.New("vendor model is required"))
+	}
+	if c.Vendor.Serial == "" {
+		errs = append(errs, errors.New("vendor serial is required"))
+	}
+	if c.Vendor.DeviceType == "" {
+		errs = append(errs, errors.New("vendor deviceType is required"))
+	}
+
+	if c.Voltage <= 0 {
+		errs = append(errs, errors.New("voltage has to be greater than 0"))
+	}
+
+	if c.HeartbeatTimeout <= 0 {
+		errs = append(errs, errors.New("heartbeatTimeout has to be greater than 0"))
+	}
+
+	if c.MQTT.QoS > 2 {
+		errs = append(errs, errors.New("mqtt qos has to be 0, 1 or 2"))
+	}
+
+	if _, ok := logLevels[c.LogLevel]; !ok {
+		errs = append(errs, fmt.Errorf("logLevel %s is invalid", c.LogLevel))
+	}
+
+	for name, server := range map[string]LimitServerConfig{
+		"lpcServer": c.UseCases.LPCServer,
+		"lppServer": c.UseCases.LPPServer,
+	} {
+		if !server.Enabled {
+			continue
+		}
+
+		switch server.Approval {
+		case "", ApprovalAuto, ApprovalNominalMax, ApprovalManual:
+		default:
+			errs = append(errs, fmt.Errorf("%s approval %s is invalid", name, server.Approval))
+		}
+
+		if server.ApprovalTimeout < 0 {
+			errs = append(errs, fmt.Errorf("%s approvalTimeout must not be negative", name))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// returns if switching from this to the other configuration requires
+// the EEBUS service to be restarted
+//
+// only the trusted SKIs, the log level and the use case values can be changed
+// on a running service
+func (c *Config) restartRequired(other *Config) bool {
+	if c.Certificate != other.Certificate ||
+		c.Port != other.Port ||
+		!slices.Equal(c.Interfaces, other.Interfaces) ||
+		c.Vendor != other.Vendor ||
+		c.Voltage != other.Voltage ||
+		c.HeartbeatTimeout != other.HeartbeatTimeout ||
+		c.Record != other.Record ||
+		c.Store != other.Store ||
+		c.HTTP != other.HTTP ||
+		c.GRPC != other.GRPC ||
+		!c.MQTT.equal(other.MQTT) {
+		return true
+	}
+
+	// the approval timeouts can only be set while setting up the use cases
+	if !c.UseCases.LPCServer.equalTimeout(other.UseCases.LPCServer) ||
+		!c.UseCases.LPPServer.equalTimeout(other.UseCases.LPPServer) {
+		return true
+	}
+
+	return c.UseCases.enabled() != other.UseCases.enabled()
+}
+
+// returns if the approval timeout settings are equal
+func (c LimitServerConfig) equalTimeout(other LimitServerConfig) bool {
+	return c.ApprovalTimeout == other.ApprovalTimeout &&
+		c.ApproveOnTimeout == other.ApproveOnTimeout
+}
+
+// the enabled use cases in a comparable form
+func (c UseCasesConfig) enabled() [19]bool {
+	return [19]bool{
+		c.CEVC.Enabled,
+		c.EVCC.Enabled,
+		c.EVCEM.Enabled,
+		c.EVSECC.Enabled,
+		c.EVSOC.Enabled,
+		c.LPC.Enabled,
+		c.LPP.Enabled,
+		c.MGCP.Enabled,
+		c.MPC.Enabled,
+		c.OPEV.Enabled,
+		c.OSCEV.Enabled,
+		c.VABD.Enabled,
+		c.VAPD.Enabled,
+		c.LPCServer.Enabled,
+		c.LPPServer.Enabled,
+		c.MGCPServer.Enabled,
+		c.MPCServer.Enabled,
+		c.VABDServer.Enabled,
+		c.VAPDServer.Enabled,
+	}
+}
@@ -0,0 +1,140 @@
+package daemon
+
+import (
+	"testing"
+	"time"
+
+	"github.com/enbility/spine-go/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestConfigSuite(t *testing.T) {
+	suite.Run(t, new(ConfigSuite))
+}
+
+type ConfigSuite struct {
+	suite.Suite
+}
+
+func (s *ConfigSuite) Test_LoadConfig() {
+	config, err := LoadConfig("testdata/missing.yaml")
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), config)
+
+	config, err = LoadConfig("testdata/invalid.yaml")
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), config)
+	assert.ErrorContains(s.T(), err, "port 0 is invalid")
+	assert.ErrorContains(s.T(), err, "vendor brand is required")
+	assert.ErrorContains(s.T(), err, "logLevel verbose is invalid")
+	assert.ErrorContains(s.T(), err, "lpcServer approval sometimes is invalid")
+
+	config, err = LoadConfig("testdata/cemd.yaml")
+	assert.Nil(s.T(), err)
+	if !assert.NotNil(s.T(), config) {
+		return
+	}
+
+	assert.Equal(s.T(), "test.crt", config.Certificate.Cert)
+	assert.Equal(s.T(), 4816, config.Port)
+	assert.Equal(s.T(), []string{"eth0"}, config.Interfaces)
+	assert.Equal(s.T(), "Daemon", config.Vendor.Model)
+	assert.Equal(s.T(), model.DeviceTypeTypeEnergyManagementSystem, config.Vendor.DeviceType)
+	assert.Equal(s.T(), 230.0, config.Voltage)
+	assert.Equal(s.T(), time.Second*10, config.HeartbeatTimeout)
+	assert.Equal(s.T(), []string{"test"}, config.TrustedSkis)
+	assert.Equal(s.T(), "debug", config.LogLevel)
+
+	assert.True(s.T(), config.UseCases.MGCP.Enabled)
+	assert.False(s.T(), config.UseCases.LPC.Enabled)
+	assert.True(s.T(), config.UseCases.LPPServer.Enabled)
+
+	lpc := config.UseCases.LPCServer
+	assert.True(s.T(), lpc.Enabled)
+	assert.Equal(s.T(), 4200.0, lpc.Limit)
+	assert.True(s.T(), lpc.LimitActive)
+	assert.Equal(s.T(), 22000.0, lpc.ContractualNominalMax)
+	assert.Equal(s.T(), 4300.0, lpc.FailsafeLimit)
+	assert.Equal(s.T(), time.Hour*2, lpc.FailsafeDuration)
+	assert.Equal(s.T(), ApprovalNominalMax, lpc.Approval)
+	assert.Equal(s.T(), time.Second*5, lpc.ApprovalTimeout)
+
+	assert.Equal(s.T(), 10000.0, config.UseCases.VAPDServer.PowerNominalPeak)
+}
+
+func (s *ConfigSuite) Test_Validate() {
+	config := DefaultConfig()
+	assert.NotNil(s.T(), config.Validate())
+
+	config.Vendor.Code = "Test"
+	config.Vendor.Brand = "Test"
+	config.Vendor.Model = "Daemon"
+	config.Vendor.Serial = "1234"
+	assert.Nil(s.T(), config.Validate())
+
+	config.HeartbeatTimeout = 0
+	assert.NotNil(s.T(), config.Validate())
+	config.HeartbeatTimeout = time.Second
+
+	config.MQTT.QoS = 3
+	assert.NotNil(s.T(), config.Validate())
+	config.MQTT.QoS = 1
+
+	// the values of disabled use cases are ignored
+	config.UseCases.LPPServer.ApprovalTimeout = -time.Second
+	assert.Nil(s.T(), config.Validate())
+
+	config.UseCases.LPPServer.Enabled = true
+	assert.NotNil(s.T(), config.Validate())
+}
+
+func (s *ConfigSuite) Test_RestartRequired() {
+	config, err := LoadConfig("testdata/cemd.yaml")
+	assert.Nil(s.T(), err)
+
+	other, err := LoadConfig("testdata/cemd.yaml")
+	assert.Nil(s.T(), err)
+	assert.False(s.T(), config.restartRequired(other))
+
+	other.TrustedSkis = []string{"other"}
+	other.LogLevel = "trace"
+	other.UseCases.LPCServer.Limit = 1000
+	other.UseCases.LPCServer.Approval = ApprovalManual
+	assert.False(s.T(), config.restartRequired(other))
+
+	other.UseCases.LPC.Enabled = true
+	assert.True(s.T(), config.restartRequired(other))
+	other.UseCases.LPC.Enabled = false
+
+	other.Interfaces = nil
+	assert.True(s.T(), config.restartRequired(other))
+	other.Interfaces = []string{"eth0"}
+
+	other.Vendor.Serial = "5678"
+	assert.True(s.T(), config.restartRequired(other))
+	other.Vendor.Serial = "1234"
+
+	other.HTTP = "localhost:8080"
+	assert.True(s.T(), config.restartRequired(other))
+	other.HTTP = ""
+
+	other.GRPC = "localhost:50051"
+	assert.True(s.T(), config.restartRequired(other))
+	other.GRPC = ""
+
+	other.MQTT.UseCases = []string{"mgcp"}
+	assert.True(s.T(), config.restartRequired(other))
+	other.MQTT.UseCases = nil
+
+	other.MQTT.Discovery = true
+	assert.True(s.T(), config.restartRequired(other))
+	other.MQTT.Discovery = false
+
+	other.UseCases.LPPServer.ApprovalTimeout = time.Minute
+	assert.True(s.T(), config.restartRequired(other))
+	other.UseCases.LPPServer.ApprovalTimeout = 0
+
+	other.UseCases.LPCServer.ApproveOnTimeout = true
+	assert.True(s.T(), config.restartRequired(other))
+}
@@ -0,0 +1,584 @@
+package daemon
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"slices"
+	"sync"
+
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/approval"
+	"github.com/enbility/cemd/cem"
+	"github.com/enbility/cemd/eventstream"
+	"github.com/enbility/cemd/grpcapi"
+	"github.com/enbility/cemd/hadiscovery"
+	"github.com/enbility/cemd/metrics"
+	"github.com/enbility/cemd/mqttbridge"
+	"github.com/enbility/cemd/recording"
+	"github.com/enbility/cemd/restapi"
+	"github.com/enbility/cemd/store"
+	"github.com/enbility/cemd/uccevc"
+	"github.com/enbility/cemd/ucevcc"
+	"github.com/enbility/cemd/ucevcem"
+	"github.com/enbility/cemd/ucevsecc"
+	"github.com/enbility/cemd/ucevsoc"
+	"github.com/enbility/cemd/uclpc"
+	"github.com/enbility/cemd/uclpcserver"
+	"github.com/enbility/cemd/uclpp"
+	"github.com/enbility/cemd/uclppserver"
+	"github.com/enbility/cemd/ucmgcp"
+	"github.com/enbility/cemd/ucmgcpserver"
+	"github.com/enbility/cemd/ucmpc"
+	"github.com/enbility/cemd/ucmpcserver"
+	"github.com/enbility/cemd/ucopev"
+	"github.com/enbility/cemd/ucoscev"
+	"github.com/enbility/cemd/ucvabd"
+	"github.com/enbility/cemd/ucvabdserver"
+	"github.com/enbility/cemd/ucvapd"
+	"github.com/enbility/cemd/ucvapdserver"
+	eebusapi "github.com/enbility/eebus-go/api"
+	eebusservice "github.com/enbility/eebus-go/service"
+	shipapi "github.com/enbility/ship-go/api"
+	"github.com/enbility/ship-go/mdns"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"google.golang.org/grpc"
+)
+
+// the configuration can not be applied to the running service
+var ErrRestartRequired = errors.New("configuration change requires a restart")
+
+// Runs a CEM providing the use cases and values of a configuration
+type Daemon struct {
+	mux sync.Mutex
+
+	config *Config
+	log    *Logger
+
+	cem        *cem.Cem
+	store      *store.FileStore
+	recordFile *os.File
+	httpServer *http.Server
+	stream     *eventstream.Stream
+	grpcServer *grpc.Server
+	grpcAPI    *grpcapi.Server
+	metrics    *metrics.Exporter
+	mqttClient *mqttbridge.PahoClient
+	bridge     *mqttbridge.Bridge
+	discovery  *hadiscovery.Discovery
+
+	lpcServer  *uclpcserver.UCLPCServer
+	lppServer  *uclppserver.UCLPPServer
+	vapdServer *ucvapdserver.UCVAPDServer
+}
+
+var _ eebusapi.ServiceReaderInterface = (*Daemon)(nil)
+
+// create a daemon for a validated configuration
+//
+// parameters:
+//   - config: the configuration
+//   - log: the logger used for all log messages
+func NewDaemon(config *Config, log *Logger) (*Daemon, error) {
+	d := &Daemon{
+		config: config,
+		log:    log,
+	}
+
+	certificate, err := loadCertificate(config.Certificate, config.Vendor)
+	if err != nil {
+		return nil, err
+	}
+
+	entityTypes := []model.EntityTypeType{model.EntityTypeTypeCEM}
+	if config.UseCases.VAPDServer.Enabled {
+		entityTypes = append(entityTypes, model.EntityTypeTypePVSystem)
+	}
+	if config.UseCases.VABDServer.Enabled {
+		entityTypes = append(entityTypes, model.EntityTypeTypeElectricityStorageSystem)
+	}
+
+	configuration, err := eebusapi.NewConfiguration(
+		config.Vendor.Code,
+		config.Vendor.Brand,
+		config.Vendor.Model,
+		config.Vendor.Serial,
+		config.Vendor.DeviceType,
+		entityTypes,
+		config.Port,
+		certificate,
+		config.Voltage,
+		config.HeartbeatTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	configuration.SetMdnsProviderSelection(mdns.MdnsProviderSelectionGoZeroConfOnly)
+
+	if len(config.Interfaces) > 0 {
+		configuration.SetInterfaces(config.Interfaces)
+	}
+
+	var service eebusapi.ServiceInterface = eebusservice.NewService(configuration, d)
+	if config.Record != "" {
+		d.recordFile, err = os.Create(config.Record)
+		if err != nil {
+			return nil, fmt.Errorf("creating recording file: %w", err)
+		}
+
+		service = recording.NewService(configuration, d, recording.NewRecorder(d.recordFile))
+	}
+
+	if config.Store != "" {
+		d.store = store.NewFileStore(config.Store)
+	}
+
+	d.cem = cem.NewCEMWithService(service, d.deviceEventCB, log)
+	d.stream = eventstream.NewStream(d.cem)
+	d.metrics = metrics.NewExporter(d.cem)
+	d.grpcAPI = grpcapi.NewServer(d.cem)
+	d.grpcAPI.SetWriteCallback(d.metrics.WriteCB)
+
+	if config.MQTT.Broker != "" {
+		options := mqtt.NewClientOptions().
+			AddBroker(config.MQTT.Broker).
+			SetClientID(config.MQTT.ClientID).
+			SetUsername(config.MQTT.Username).
+			SetPassword(config.MQTT.Password)
+		d.mqttClient = mqttbridge.NewPahoClient(options, config.MQTT.QoS, config.MQTT.Retain)
+
+		d.bridge, err = mqttbridge.NewBridge(d.cem, d.mqttClient, mqttbridge.Config{
+			StateTopic:   config.MQTT.StateTopic,
+			CommandTopic: config.MQTT.CommandTopic,
+			UseCases:     config.MQTT.UseCases,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("mqtt: %w", err)
+		}
+		d.bridge.SetWriteCallback(d.metrics.WriteCB)
+
+		if config.MQTT.Discovery {
+			d.discovery = hadiscovery.NewDiscovery(d.cem, d.mqttClient, d.bridge, hadiscovery.Config{
+				Prefix: config.MQTT.DiscoveryPrefix,
+			})
+		}
+	}
+
+	return d, nil
+}
+
+// the CEM run by the daemon
+func (d *Daemon) Cem() *cem.Cem {
+	return d.cem
+}
+
+// Set up the use cases and start the EEBUS service, the HTTP and gRPC APIs and the MQTT bridge
+func (d *Daemon) Start() error {
+	if err := d.setup(); err != nil {
+		return err
+	}
+
+	if err := d.startHTTP(); err != nil {
+		return err
+	}
+
+	if err := d.startGRPC(); err != nil {
+		return err
+	}
+
+	if d.bridge != nil {
+		if err := d.bridge.Start(); err != nil {
+			return err
+		}
+		d.mqttClient.Connect()
+	}
+
+	d.cem.Start()
+
+	return nil
+}
+
+// start the HTTP API, if configured
+func (d *Daemon) startHTTP() error {
+	if d.config.HTTP == "" {
+		return nil
+	}
+
+	listener, err := net.Listen("tcp", d.config.HTTP)
+	if err != nil {
+		return fmt.Errorf("starting HTTP API: %w", err)
+	}
+
+	d.httpServer = &http.Server{
+		Handler: d.httpHandler(),
+	}
+
+	go func() {
+		if err := d.httpServer.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			d.log.Errorf("HTTP API: %s", err)
+		}
+	}()
+
+	d.log.Infof("HTTP API listening on %s", listener.Addr())
+
+	return nil
+}
+
+// start the gRPC API, if configured
+func (d *Daemon) startGRPC() error {
+	if d.config.GRPC == "" {
+		return nil
+	}
+
+	listener, err := net.Listen("tcp", d.config.GRPC)
+	if err != nil {
+		return fmt.Errorf("starting gRPC API: %w", err)
+	}
+
+	d.grpcServer = grpc.NewServer()
+	d.grpcAPI.Register(d.grpcServer)
+
+	go func() {
+		if err := d.grpcServer.Serve(listener); err != nil {
+			d.log.Errorf("gRPC API: %s", err)
+		}
+	}()
+
+	d.log.Infof("gRPC API listening on %s", listener.Addr())
+
+	return nil
+}
+
+// the handler serving all HTTP endpoints
+func (d *Daemon) httpHandler() http.Handler {
+	mux := http.NewServeMux()
+
+	rest := restapi.NewServer(d.cem)
+	rest.SetWriteCallback(d.metrics.WriteCB)
+	mux.Handle("/devices", rest)
+	mux.Handle("/devices/", rest)
+	mux.Handle("/events", d.stream)
+	mux.Handle("/metrics", d.metrics.Handler())
+
+	return mux
+}
+
+func (d *Daemon) setup() error {
+	if err := d.cem.Setup(); err != nil {
+		return err
+	}
+
+	d.addUseCases()
+
+	if d.discovery != nil {
+		d.discovery.AddFeatures()
+	}
+
+	d.mux.Lock()
+	defer d.mux.Unlock()
+
+	d.applyApprovalPolicies()
+	d.applyInitialValues()
+
+	for _, ski := range d.config.TrustedSkis {
+		d.cem.Service.RegisterRemoteSKI(ski)
+	}
+
+	return nil
+}
+
+// apply a changed configuration to the running daemon
+//
+// only the trusted SKIs, the log level and the approval policies are applied,
+// the initial use case values are only applied on startup
+//
+// returns ErrRestartRequired if the configuration contains changes
+// which can only be applied by creating a new daemon, nothing is applied then
+func (d *Daemon) Reload(config *Config) error {
+	d.mux.Lock()
+	defer d.mux.Unlock()
+
+	if d.config.restartRequired(config) {
+		return ErrRestartRequired
+	}
+
+	for _, ski := range d.config.TrustedSkis {
+		if !slices.Contains(config.TrustedSkis, ski) {
+			d.cem.Service.UnregisterRemoteSKI(ski)
+		}
+	}
+	for _, ski := range config.TrustedSkis {
+		if !slices.Contains(d.config.TrustedSkis, ski) {
+			d.cem.Service.RegisterRemoteSKI(ski)
+		}
+	}
+
+	d.log.SetLevel(config.LogLevel)
+
+	d.config = config
+	d.applyApprovalPolicies()
+
+	return nil
+}
+
+// stop the EEBUS service, the HTTP and gRPC APIs and the MQTT bridge and release all resources
+func (d *Daemon) Shutdown() {
+	if d.bridge != nil {
+		d.bridge.Stop()
+		d.mqttClient.Disconnect()
+	}
+
+	if d.httpServer != nil {
+		_ = d.httpServer.Close()
+	}
+	d.stream.Close()
+
+	// the event streams are ended first, so the gRPC server does not wait for them
+	d.grpcAPI.Close()
+	if d.grpcServer != nil {
+		d.grpcServer.GracefulStop()
+	}
+
+	d.cem.Shutdown()
+
+	if d.recordFile != nil {
+		_ = d.recordFile.Close()
+	}
+}
+
+func (d *Daemon) addUseCases() {
+	usecases := d.config.UseCases
+	service := d.cem.Service
+
+	list := []struct {
+		enabled bool
+		create  func() api.UseCaseInterface
+	}{
+		{usecases.CEVC.Enabled, func() api.UseCaseInterface {
+			return uccevc.NewUCCEVC(service, d.entityEventCB)
+		}},
+		{usecases.EVCC.Enabled, func() api.UseCaseInterface {
+			return ucevcc.NewUCEVCC(service, d.entityEventCB)
+		}},
+		{usecases.EVCEM.Enabled, func() api.UseCaseInterface {
+			return ucevcem.NewUCEVCEM(service, d.entityEventCB)
+		}},
+		{usecases.EVSECC.Enabled, func() api.UseCaseInterface {
+			return ucevsecc.NewUCEVSECC(service, d.entityEventCB)
+		}},
+		{usecases.EVSOC.Enabled, func() api.UseCaseInterface {
+			return ucevsoc.NewUCEVSOC(service, d.entityEventCB)
+		}},
+		{usecases.LPC.Enabled, func() api.UseCaseInterface {
+			return uclpc.NewUCLPC(service, d.entityEventCB)
+		}},
+		{usecases.LPP.Enabled, func() api.UseCaseInterface {
+			return uclpp.NewUCLPP(service, d.entityEventCB)
+		}},
+		{usecases.MGCP.Enabled, func() api.UseCaseInterface {
+			return ucmgcp.NewUCMGCP(service, d.entityEventCB)
+		}},
+		{usecases.MPC.Enabled, func() api.UseCaseInterface {
+			return ucmpc.NewUCMPC(service, d.entityEventCB)
+		}},
+		{usecases.OPEV.Enabled, func() api.UseCaseInterface {
+			return ucopev.NewUCOPEV(service, d.entityEventCB)
+		}},
+		{usecases.OSCEV.Enabled, func() api.UseCaseInterface {
+			return ucoscev.NewUCOSCEV(service, d.entityEventCB)
+		}},
+		{usecases.VABD.Enabled, func() api.UseCaseInterface {
+			return ucvabd.NewUCVABD(service, d.entityEventCB)
+		}},
+		{usecases.VAPD.Enabled, func() api.UseCaseInterface {
+			return ucvapd.NewUCVAPD(service, d.entityEventCB)
+		}},
+		{usecases.MGCPServer.Enabled, func() api.UseCaseInterface {
+			return ucmgcpserver.NewUCMGCP(service, d.entityEventCB)
+		}},
+		{usecases.MPCServer.Enabled, func() api.UseCaseInterface {
+			return ucmpcserver.NewUCMPC(service, d.entityEventCB)
+		}},
+		{usecases.VABDServer.Enabled, func() api.UseCaseInterface {
+			return ucvabdserver.NewUCVABD(service, d.entityEventCB)
+		}},
+	}
+
+	for _, item := range list {
+		if item.enabled {
+			d.cem.AddUseCase(item.create())
+		}
+	}
+
+	// the store and the approval timeout are required before the use case is added,
+	// as the features restore their values and set the write approval timeout
+	if usecases.LPCServer.Enabled {
+		d.lpcServer = uclpcserver.NewUCLPC(service, d.entityEventCB)
+		if d.store != nil {
+			d.lpcServer.SetStore(d.store)
+		}
+		if config := usecases.LPCServer; config.ApprovalTimeout > 0 {
+			d.logError(d.lpcServer.SetApprovalTimeout(config.ApprovalTimeout, config.ApproveOnTimeout))
+		}
+		d.cem.AddUseCase(d.lpcServer)
+	}
+
+	if usecases.LPPServer.Enabled {
+		d.lppServer = uclppserver.NewUCLPP(service, d.entityEventCB)
+		if d.store != nil {
+			d.lppServer.SetStore(d.store)
+		}
+		if config := usecases.LPPServer; config.ApprovalTimeout > 0 {
+			d.logError(d.lppServer.SetApprovalTimeout(config.ApprovalTimeout, config.ApproveOnTimeout))
+		}
+		d.cem.AddUseCase(d.lppServer)
+	}
+
+	if usecases.VAPDServer.Enabled {
+		d.vapdServer = ucvapdserver.NewUCVAPD(service, d.entityEventCB)
+		d.cem.AddUseCase(d.vapdServer)
+	}
+}
+
+// apply the configured approval policies of the limit server use cases
+func (d *Daemon) applyApprovalPolicies() {
+	usecases := d.config.UseCases
+
+	if d.lpcServer != nil {
+		config := usecases.LPCServer
+
+		d.lpcServer.SetApprovalPolicy(approvalPolicy(config.Approval))
+	}
+
+	if d.lppServer != nil {
+		config := usecases.LPPServer
+
+		d.lppServer.SetApprovalPolicy(approvalPolicy(config.Approval))
+	}
+}
+
+// apply the configured initial use case values on startup
+//
+// the limits and failsafe and contractual values are only applied if nothing
+// was restored from the store, as they may have been changed by the Energy Guard
+func (d *Daemon) applyInitialValues() {
+	usecases := d.config.UseCases
+
+	if d.lpcServer != nil {
+		config := usecases.LPCServer
+
+		if !d.restored(d.lpcServer) {
+			d.logError(d.lpcServer.SetConsumptionLimit(api.LoadLimit{
+				IsChangeable: true,
+				IsActive:     config.LimitActive,
+				Value:        config.Limit,
+			}))
+			d.logError(d.lpcServer.SetContractualConsumptionNominalMax(config.ContractualNominalMax))
+			d.logError(d.lpcServer.SetFailsafeConsumptionActivePowerLimit(config.FailsafeLimit, true))
+			d.logError(d.lpcServer.SetFailsafeDurationMinimum(config.FailsafeDuration, true))
+		}
+	}
+
+	if d.lppServer != nil {
+		config := usecases.LPPServer
+
+		if !d.restored(d.lppServer) {
+			d.logError(d.lppServer.SetProductionLimit(api.LoadLimit{
+				IsChangeable: true,
+				IsActive:     config.LimitActive,
+				Value:        config.Limit,
+			}))
+			d.logError(d.lppServer.SetContractualProductionNominalMax(config.ContractualNominalMax))
+			d.logError(d.lppServer.SetFailsafeProductionActivePowerLimit(config.FailsafeLimit, true))
+			d.logError(d.lppServer.SetFailsafeDurationMinimum(config.FailsafeDuration, true))
+		}
+	}
+
+	if d.vapdServer != nil {
+		d.logError(d.vapdServer.SetPowerNominalPeak(usecases.VAPDServer.PowerNominalPeak))
+	}
+}
+
+// returns if the configuration of a use case was restored from the store
+func (d *Daemon) restored(usecase api.UseCaseInterface) bool {
+	if d.store == nil {
+		return false
+	}
+
+	var config api.ControllableSystemConfiguration
+	return d.store.Load(string(usecase.UseCaseName()), &config) == nil
+}
+
+func (d *Daemon) logError(err error) {
+	if err != nil {
+		d.log.Error(err)
+	}
+}
+
+// returns the approval policy for a configured name
+func approvalPolicy(name string) api.ApprovalPolicyInterface {
+	switch name {
+	case ApprovalNominalMax:
+		return approval.NewChainPolicy(approval.NewNominalMaxPolicy(), approval.NewAutoApprovePolicy())
+	case ApprovalManual:
+		return approval.NewManualPolicy()
+	default:
+		return approval.NewAutoApprovePolicy()
+	}
+}
+
+// Callbacks
+
+func (d *Daemon) deviceEventCB(ski string, device spineapi.DeviceRemoteInterface, event api.EventType) {
+	d.log.Debug("Device event:", ski, event)
+
+	d.stream.DeviceEventCB(ski, device, event)
+	d.grpcAPI.DeviceEventCB(ski, device, event)
+
+	if d.discovery != nil {
+		d.discovery.DeviceEventCB(ski, device, event)
+	}
+}
+
+func (d *Daemon) entityEventCB(ski string, device spineapi.DeviceRemoteInterface, entity spineapi.EntityRemoteInterface, event api.EventType) {
+	d.log.Debug("Entity event:", ski, event)
+
+	d.stream.EntityEventCB(ski, device, entity, event)
+	d.grpcAPI.EntityEventCB(ski, device, entity, event)
+
+	// the entities are announced before their values are published
+	if d.discovery != nil {
+		d.discovery.EntityEventCB(ski, device, entity, event)
+	}
+
+	if d.bridge != nil {
+		d.bridge.EntityEventCB(ski, device, entity, event)
+	}
+}
+
+// eebusapi.ServiceReaderInterface
+
+func (d *Daemon) RemoteSKIConnected(service eebusapi.ServiceInterface, ski string) {
+	d.log.Info("Remote SKI connected:", ski)
+}
+
+func (d *Daemon) RemoteSKIDisconnected(service eebusapi.ServiceInterface, ski string) {
+	d.log.Info("Remote SKI disconnected:", ski)
+}
+
+func (d *Daemon) VisibleRemoteServicesUpdated(service eebusapi.ServiceInterface, entries []shipapi.RemoteService) {
+}
+
+func (d *Daemon) ServiceShipIDUpdate(ski string, shipdID string) {}
+
+func (d *Daemon) ServicePairingDetailUpdate(ski string, detail *shipapi.ConnectionStateDetail) {
+	if detail == nil {
+		return
+	}
+
+	d.log.Debug("Pairing state of", ski, "changed to", detail.State())
+}
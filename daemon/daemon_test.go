@@ -0,0 +1,275 @@
+package daemon
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestDaemonSuite(t *testing.T) {
+	suite.Run(t, new(DaemonSuite))
+}
+
+type DaemonSuite struct {
+	suite.Suite
+
+	config *Config
+	output *bytes.Buffer
+	log    *Logger
+	sut    *Daemon
+}
+
+func (s *DaemonSuite) BeforeTest(suiteName, testName string) {
+	var err error
+	s.config, err = LoadConfig("testdata/cemd.yaml")
+	assert.Nil(s.T(), err)
+
+	dir := s.T().TempDir()
+	s.config.Certificate.Cert = filepath.Join(dir, "test.crt")
+	s.config.Certificate.Key = filepath.Join(dir, "test.key")
+	s.config.Interfaces = nil
+	s.config.Store = filepath.Join(dir, "store.json")
+
+	s.output = &bytes.Buffer{}
+	s.log = NewLogger(s.output, s.config.LogLevel)
+
+	s.sut, err = NewDaemon(s.config, s.log)
+	assert.Nil(s.T(), err)
+	assert.NotNil(s.T(), s.sut)
+
+	err = s.sut.setup()
+	assert.Nil(s.T(), err)
+}
+
+func (s *DaemonSuite) AfterTest(suiteName, testName string) {
+	s.sut.Shutdown()
+}
+
+func (s *DaemonSuite) Test_Setup() {
+	// the certificate is created if it does not exist
+	assert.FileExists(s.T(), s.config.Certificate.Cert)
+	assert.FileExists(s.T(), s.config.Certificate.Key)
+
+	localDevice := s.sut.Cem().Service.LocalDevice()
+	assert.NotNil(s.T(), localDevice.EntityForType(model.EntityTypeTypeCEM))
+	assert.NotNil(s.T(), localDevice.EntityForType(model.EntityTypeTypePVSystem))
+	assert.Nil(s.T(), localDevice.EntityForType(model.EntityTypeTypeElectricityStorageSystem))
+
+	assert.NotNil(s.T(), s.sut.lpcServer)
+	assert.NotNil(s.T(), s.sut.lppServer)
+	assert.NotNil(s.T(), s.sut.vapdServer)
+
+	limit, err := s.sut.lpcServer.ConsumptionLimit()
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 4200.0, limit.Value)
+	assert.True(s.T(), limit.IsActive)
+
+	value, err := s.sut.lpcServer.ContractualConsumptionNominalMax()
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 22000.0, value)
+
+	value, _, err = s.sut.lpcServer.FailsafeConsumptionActivePowerLimit()
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 4300.0, value)
+
+	duration, _, err := s.sut.lpcServer.FailsafeDurationMinimum()
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), time.Hour*2, duration)
+
+	// both limit server use cases provide their values
+	limit, err = s.sut.lppServer.ProductionLimit()
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 0.0, limit.Value)
+
+	value, err = s.sut.lppServer.ContractualProductionNominalMax()
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), -7000.0, value)
+}
+
+func (s *DaemonSuite) Test_Reload() {
+	config := *s.config
+	config.TrustedSkis = []string{"other"}
+	config.LogLevel = "info"
+	config.UseCases.LPCServer.Limit = 1000
+	config.UseCases.LPCServer.LimitActive = false
+	config.UseCases.LPCServer.FailsafeLimit = 2000
+
+	// a limit written by the Energy Guard
+	err := s.sut.lpcServer.SetConsumptionLimit(api.LoadLimit{
+		IsChangeable: true,
+		IsActive:     true,
+		Value:        3000,
+	})
+	assert.Nil(s.T(), err)
+
+	err = s.sut.Reload(&config)
+	assert.Nil(s.T(), err)
+
+	// the initial values are not applied again
+	limit, err := s.sut.lpcServer.ConsumptionLimit()
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 3000.0, limit.Value)
+	assert.True(s.T(), limit.IsActive)
+
+	value, _, err := s.sut.lpcServer.FailsafeConsumptionActivePowerLimit()
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 4300.0, value)
+
+	s.output.Reset()
+	s.log.Debug("not logged")
+	assert.Equal(s.T(), 0, s.output.Len())
+
+	restart := config
+	restart.Port = 4817
+	err = s.sut.Reload(&restart)
+	assert.Equal(s.T(), ErrRestartRequired, err)
+	assert.Equal(s.T(), 4816, s.sut.config.Port)
+}
+
+func (s *DaemonSuite) Test_Store() {
+	err := s.sut.lpcServer.SetConsumptionLimit(api.LoadLimit{
+		IsChangeable: true,
+		IsActive:     true,
+		Value:        1000,
+	})
+	assert.Nil(s.T(), err)
+
+	s.sut.Shutdown()
+
+	// the stored values take precedence on startup
+	s.sut, err = NewDaemon(s.config, s.log)
+	assert.Nil(s.T(), err)
+
+	err = s.sut.setup()
+	assert.Nil(s.T(), err)
+
+	limit, err := s.sut.lpcServer.ConsumptionLimit()
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 1000.0, limit.Value)
+}
+
+func (s *DaemonSuite) Test_InvalidCertificate() {
+	config := *s.config
+	config.Certificate.Key = filepath.Join(s.T().TempDir(), "invalid.key")
+	err := os.WriteFile(config.Certificate.Key, []byte("invalid"), 0600)
+	assert.Nil(s.T(), err)
+
+	// an existing certificate is never replaced
+	daemon, err := NewDaemon(&config, s.log)
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), daemon)
+
+	data, err := os.ReadFile(config.Certificate.Key)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "invalid", string(data))
+}
+
+func (s *DaemonSuite) Test_HTTP() {
+	recorder := httptest.NewRecorder()
+	s.sut.httpHandler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/devices", nil))
+	assert.Equal(s.T(), http.StatusOK, recorder.Code)
+	assert.Equal(s.T(), "[]\n", recorder.Body.String())
+
+	// the event stream requires a WebSocket upgrade
+	recorder = httptest.NewRecorder()
+	s.sut.httpHandler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/events", nil))
+	assert.Equal(s.T(), http.StatusBadRequest, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	s.sut.httpHandler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	assert.Equal(s.T(), http.StatusOK, recorder.Code)
+	assert.Contains(s.T(), recorder.Body.String(), "cemd_connected_devices 0")
+
+	// the HTTP API is disabled by default
+	err := s.sut.startHTTP()
+	assert.Nil(s.T(), err)
+	assert.Nil(s.T(), s.sut.httpServer)
+
+	s.sut.config.HTTP = "127.0.0.1:0"
+	err = s.sut.startHTTP()
+	assert.Nil(s.T(), err)
+	assert.NotNil(s.T(), s.sut.httpServer)
+
+	s.sut.config.HTTP = "invalid"
+	err = s.sut.startHTTP()
+	assert.NotNil(s.T(), err)
+}
+
+func (s *DaemonSuite) Test_GRPC() {
+	// the gRPC API is disabled by default
+	err := s.sut.startGRPC()
+	assert.Nil(s.T(), err)
+	assert.Nil(s.T(), s.sut.grpcServer)
+
+	s.sut.config.GRPC = "127.0.0.1:0"
+	err = s.sut.startGRPC()
+	assert.Nil(s.T(), err)
+	if assert.NotNil(s.T(), s.sut.grpcServer) {
+		assert.Contains(s.T(), s.sut.grpcServer.GetServiceInfo(), "cemd.v1.DeviceService")
+		assert.Contains(s.T(), s.sut.grpcServer.GetServiceInfo(), "cemd.v1.LPCService")
+	}
+
+	s.sut.config.GRPC = "invalid"
+	err = s.sut.startGRPC()
+	assert.NotNil(s.T(), err)
+}
+
+func (s *DaemonSuite) Test_MQTT() {
+	// the MQTT bridge is disabled by default
+	assert.Nil(s.T(), s.sut.bridge)
+
+	config := *s.config
+	config.MQTT.Broker = "tcp://127.0.0.1:1883"
+
+	daemon, err := NewDaemon(&config, s.log)
+	assert.Nil(s.T(), err)
+	assert.NotNil(s.T(), daemon.bridge)
+	assert.NotNil(s.T(), daemon.mqttClient)
+	assert.Nil(s.T(), daemon.discovery)
+
+	err = daemon.setup()
+	assert.Nil(s.T(), err)
+	daemon.Shutdown()
+
+	config.MQTT.Discovery = true
+	daemon, err = NewDaemon(&config, s.log)
+	assert.Nil(s.T(), err)
+	assert.NotNil(s.T(), daemon.discovery)
+
+	config.MQTT.StateTopic = "cemd/{ski}"
+	daemon, err = NewDaemon(&config, s.log)
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), daemon)
+}
+
+func (s *DaemonSuite) Test_Logger() {
+	output := &bytes.Buffer{}
+	log := NewLogger(output, "unknown")
+
+	log.Debug("debug")
+	log.Tracef("%s", "trace")
+	assert.Equal(s.T(), 0, output.Len())
+
+	log.Infof("%s %d", "info", 1)
+	log.Error("error", 2)
+	lines := strings.Split(strings.TrimSpace(output.String()), "\n")
+	if assert.Equal(s.T(), 2, len(lines)) {
+		assert.True(s.T(), strings.HasSuffix(lines[0], "INFO  info 1"))
+		assert.True(s.T(), strings.HasSuffix(lines[1], "ERROR error 2"))
+	}
+
+	output.Reset()
+	log.SetLevel("trace")
+	log.Trace("trace")
+	assert.True(s.T(), strings.HasSuffix(output.String(), "TRACE trace\n"))
+}
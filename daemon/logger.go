@@ -0,0 +1,113 @@
+package daemon
+
+import (
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/enbility/ship-go/logging"
+)
+
+const (
+	levelTrace = iota
+	levelDebug
+	levelInfo
+	levelError
+)
+
+var logLevels = map[string]int{
+	"trace": levelTrace,
+	"debug": levelDebug,
+	"info":  levelInfo,
+	"error": levelError,
+}
+
+// Writes log messages with a timestamp, omitting messages below the log level
+type Logger struct {
+	mux    sync.Mutex
+	writer io.Writer
+	level  int
+}
+
+var _ logging.LoggingInterface = (*Logger)(nil)
+
+// parameters:
+//   - writer: the destination of the log messages
+//   - level: one of trace, debug, info, error
+func NewLogger(writer io.Writer, level string) *Logger {
+	l := &Logger{
+		writer: writer,
+	}
+	l.SetLevel(level)
+
+	return l
+}
+
+// change the log level, unknown levels are treated as info
+func (l *Logger) SetLevel(level string) {
+	value, ok := logLevels[level]
+	if !ok {
+		value = levelInfo
+	}
+
+	l.mux.Lock()
+	defer l.mux.Unlock()
+
+	l.level = value
+}
+
+func (l *Logger) Trace(args ...interface{}) {
+	l.print(levelTrace, "TRACE", args...)
+}
+
+func (l *Logger) Tracef(format string, args ...interface{}) {
+	l.printFormat(levelTrace, "TRACE", format, args...)
+}
+
+func (l *Logger) Debug(args ...interface{}) {
+	l.print(levelDebug, "DEBUG", args...)
+}
+
+func (l *Logger) Debugf(format string, args ...interface{}) {
+	l.printFormat(levelDebug, "DEBUG", format, args...)
+}
+
+func (l *Logger) Info(args ...interface{}) {
+	l.print(levelInfo, "INFO ", args...)
+}
+
+func (l *Logger) Infof(format string, args ...interface{}) {
+	l.printFormat(levelInfo, "INFO ", format, args...)
+}
+
+func (l *Logger) Error(args ...interface{}) {
+	l.print(levelError, "ERROR", args...)
+}
+
+func (l *Logger) Errorf(format string, args ...interface{}) {
+	l.printFormat(levelError, "ERROR", format, args...)
+}
+
+func (l *Logger) currentTimestamp() string {
+	return time.Now().Format("2006-01-02 15:04:05")
+}
+
+func (l *Logger) print(level int, msgType string, args ...interface{}) {
+	l.write(level, msgType, fmt.Sprintln(args...))
+}
+
+func (l *Logger) printFormat(level int, msgType, format string, args ...interface{}) {
+	l.write(level, msgType, fmt.Sprintf(format, args...)+"\n")
+}
+
+func (l *Logger) write(level int, msgType, value string) {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+
+	if level < l.level {
+		return
+	}
+
+	fmt.Fprintf(l.writer, "%s %s %s", l.currentTimestamp(), msgType, value)
+}
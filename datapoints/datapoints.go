@@ -0,0 +1,233 @@
+// Package datapoints provides generic access to the values and write operations
+// of the client use case implementations
+//
+// It is used to expose the use cases by name, e.g. via network APIs, without
+// handling each use case interface separately.
+package datapoints
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/enbility/cemd/api"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+)
+
+// the data provided for a write operation is invalid
+var ErrInvalidData = errors.New("invalid data")
+
+// the entity address is not in the format of EntityAddress
+var ErrInvalidAddress = errors.New("invalid entity address")
+
+// A value a use case provides for a remote entity
+type DataPoint struct {
+	// the name of the value, e.g. "power"
+	Name string
+
+	// the events reporting updates of the value, empty if there are none
+	Events []api.EventType
+
+	// read the current value
+	//
+	// possible errors are the ones of the use case getter
+	Read func(entity spineapi.EntityRemoteInterface) (any, error)
+}
+
+// A write operation a use case provides for a remote entity
+type Operation struct {
+	// the name of the operation, e.g. "consumptionLimit"
+	Name string
+
+	// write the JSON encoded data, the format is the one of the use case write method
+	//
+	// return values:
+	//   - the message counter of the sent message, nil if the use case does not provide it
+	//
+	// possible errors:
+	//   - ErrInvalidData if the data could not be decoded
+	//   - and the ones of the use case write method
+	Write func(entity spineapi.EntityRemoteInterface, data json.RawMessage) (*model.MsgCounterType, error)
+}
+
+// Callback invoked after a write operation was called
+//
+// parameters:
+//   - entity: the remote entity written to
+//   - usecase: the short name of the use case, e.g. "lpc"
+//   - operation: the name of the write operation, e.g. "consumptionLimit"
+//   - msgCounter: the message counter of the sent message, nil if none was sent or it is not provided
+//   - err: the error of the write operation, nil if the message was sent
+type WriteCallback func(entity spineapi.EntityRemoteInterface, usecase, operation string, msgCounter *model.MsgCounterType, err error)
+
+// The values and write operations of a use case implementation
+type UseCase struct {
+	// the short name of the use case, e.g. "mgcp"
+	Name string
+
+	UseCase api.UseCaseInterface
+
+	DataPoints []DataPoint
+	Operations []Operation
+}
+
+// returns the data point with the provided name
+func (u UseCase) DataPoint(name string) (DataPoint, bool) {
+	index := slices.IndexFunc(u.DataPoints, func(item DataPoint) bool {
+		return item.Name == name
+	})
+	if index < 0 {
+		return DataPoint{}, false
+	}
+
+	return u.DataPoints[index], true
+}
+
+// returns the write operation with the provided name
+func (u UseCase) Operation(name string) (Operation, bool) {
+	index := slices.IndexFunc(u.Operations, func(item Operation) bool {
+		return item.Name == name
+	})
+	if index < 0 {
+		return Operation{}, false
+	}
+
+	return u.Operations[index], true
+}
+
+// returns the data points whose value is updated with the event
+func (u UseCase) DataPointsForEvent(event api.EventType) []DataPoint {
+	var result []DataPoint
+
+	for _, item := range u.DataPoints {
+		if slices.Contains(item.Events, event) {
+			result = append(result, item)
+		}
+	}
+
+	return result
+}
+
+// returns the description of a use case implementation
+//
+// returns false for use cases without data points, e.g. the server use cases
+func ForUseCase(usecase api.UseCaseInterface) (UseCase, bool) {
+	for _, item := range useCaseDescriptions {
+		if item.useCaseName != usecase.UseCaseName() {
+			continue
+		}
+
+		if result, ok := item.describe(usecase); ok {
+			return result, true
+		}
+	}
+
+	return UseCase{}, false
+}
+
+// returns the descriptions of all supported use case implementations in the provided order
+func ForUseCases(usecases []api.UseCaseInterface) []UseCase {
+	var result []UseCase
+
+	for _, usecase := range usecases {
+		if item, ok := ForUseCase(usecase); ok {
+			result = append(result, item)
+		}
+	}
+
+	return result
+}
+
+// returns the address of an entity as entity ids joined with dots, e.g. "1.1"
+func EntityAddress(entity spineapi.EntityRemoteInterface) string {
+	var ids []string
+
+	if address := entity.Address(); address != nil {
+		for _, id := range address.Entity {
+			ids = append(ids, strconv.FormatUint(uint64(id), 10))
+		}
+	}
+
+	return strings.Join(ids, ".")
+}
+
+// returns the short name of the use case sending the event, e.g. "mgcp" for "ucmgcp-DataUpdatePower"
+//
+// returns an empty string for events of the CEM
+func UseCaseNameForEvent(event api.EventType) string {
+	prefix, _, found := strings.Cut(string(event), "-")
+	if !found || !strings.HasPrefix(prefix, "uc") {
+		return ""
+	}
+
+	return strings.TrimPrefix(prefix, "uc")
+}
+
+// returns the entity ids of an address in the format of EntityAddress
+//
+// possible errors:
+//   - ErrInvalidAddress if the address is invalid
+func ParseEntityAddress(address string) ([]model.AddressEntityType, error) {
+	var ids []model.AddressEntityType
+
+	for _, item := range strings.Split(address, ".") {
+		id, err := strconv.ParseUint(item, 10, 32)
+		if err != nil {
+			return nil, ErrInvalidAddress
+		}
+		ids = append(ids, model.AddressEntityType(id))
+	}
+
+	return ids, nil
+}
+
+// returns the read function for a getter
+func read[T any](getter func(spineapi.EntityRemoteInterface) (T, error)) func(spineapi.EntityRemoteInterface) (any, error) {
+	return func(entity spineapi.EntityRemoteInterface) (any, error) {
+		value, err := getter(entity)
+		if err != nil {
+			return nil, err
+		}
+
+		return value, nil
+	}
+}
+
+// returns the write function for a write method returning a message counter
+func write[T any](
+	writer func(spineapi.EntityRemoteInterface, T) (*model.MsgCounterType, error),
+) func(spineapi.EntityRemoteInterface, json.RawMessage) (*model.MsgCounterType, error) {
+	return func(entity spineapi.EntityRemoteInterface, data json.RawMessage) (*model.MsgCounterType, error) {
+		var value T
+		if err := decode(data, &value); err != nil {
+			return nil, err
+		}
+
+		return writer(entity, value)
+	}
+}
+
+// returns the write function for a write method without a message counter
+func writeOnly[T any](
+	writer func(spineapi.EntityRemoteInterface, T) error,
+) func(spineapi.EntityRemoteInterface, json.RawMessage) (*model.MsgCounterType, error) {
+	return write(func(entity spineapi.EntityRemoteInterface, value T) (*model.MsgCounterType, error) {
+		return nil, writer(entity, value)
+	})
+}
+
+// decode JSON data, unknown fields are not allowed
+func decode(data json.RawMessage, value any) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(value); err != nil {
+		return errors.Join(ErrInvalidData, err)
+	}
+
+	return nil
+}
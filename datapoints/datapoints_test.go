@@ -0,0 +1,110 @@
+package datapoints
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/mocks"
+	"github.com/enbility/cemd/ucmgcp"
+	"github.com/enbility/cemd/ucopev"
+	eebusapi "github.com/enbility/eebus-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestDataPointsSuite(t *testing.T) {
+	suite.Run(t, new(DataPointsSuite))
+}
+
+type DataPointsSuite struct {
+	suite.Suite
+}
+
+func (s *DataPointsSuite) Test_ForUseCase() {
+	mgcp := mocks.NewUCMGCPInterface(s.T())
+	mgcp.EXPECT().UseCaseName().Return(model.UseCaseNameTypeMonitoringOfGridConnectionPoint)
+	mgcp.EXPECT().Power(mock.Anything).Return(1000, nil)
+	mgcp.EXPECT().Frequency(mock.Anything).Return(0, eebusapi.ErrDataNotAvailable)
+
+	sut, ok := ForUseCase(mgcp)
+	assert.True(s.T(), ok)
+	assert.Equal(s.T(), "mgcp", sut.Name)
+	assert.Equal(s.T(), mgcp, sut.UseCase)
+	assert.Equal(s.T(), 0, len(sut.Operations))
+
+	dataPoint, ok := sut.DataPoint("power")
+	assert.True(s.T(), ok)
+	value, err := dataPoint.Read(nil)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 1000.0, value)
+
+	dataPoint, ok = sut.DataPoint("frequency")
+	assert.True(s.T(), ok)
+	value, err = dataPoint.Read(nil)
+	assert.Equal(s.T(), eebusapi.ErrDataNotAvailable, err)
+	assert.Nil(s.T(), value)
+
+	_, ok = sut.DataPoint("unknown")
+	assert.False(s.T(), ok)
+
+	dataPoints := sut.DataPointsForEvent(ucmgcp.DataUpdatePower)
+	if assert.Equal(s.T(), 1, len(dataPoints)) {
+		assert.Equal(s.T(), "power", dataPoints[0].Name)
+	}
+
+	// server use cases are not supported
+	server := mocks.NewUCMGCPServerInterface(s.T())
+	server.EXPECT().UseCaseName().Return(model.UseCaseNameTypeMonitoringOfGridConnectionPoint)
+
+	_, ok = ForUseCase(server)
+	assert.False(s.T(), ok)
+
+	result := ForUseCases([]api.UseCaseInterface{server, mgcp})
+	if assert.Equal(s.T(), 1, len(result)) {
+		assert.Equal(s.T(), "mgcp", result[0].Name)
+	}
+}
+
+func (s *DataPointsSuite) Test_Operation() {
+	// the OPEV and OSCEV interfaces are identical, the name decides
+	opev := mocks.NewUCOPEVInterface(s.T())
+	opev.EXPECT().UseCaseName().Return(model.UseCaseNameTypeOverloadProtectionByEVChargingCurrentCurtailment)
+
+	sut, ok := ForUseCase(opev)
+	assert.True(s.T(), ok)
+	assert.Equal(s.T(), "opev", sut.Name)
+
+	limits := []api.LoadLimitsPhase{{Phase: model.ElectricalConnectionPhaseNameTypeA, IsActive: true, Value: 16}}
+	opev.EXPECT().WriteLoadControlLimits(mock.Anything, limits).Return(util.Ptr(model.MsgCounterType(1)), nil)
+
+	operation, ok := sut.Operation("loadControlLimits")
+	assert.True(s.T(), ok)
+
+	data, err := json.Marshal(limits)
+	assert.Nil(s.T(), err)
+	msgCounter, err := operation.Write(nil, data)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), util.Ptr(model.MsgCounterType(1)), msgCounter)
+
+	msgCounter, err = operation.Write(nil, json.RawMessage(`[{"Unknown": 1}]`))
+	assert.True(s.T(), errors.Is(err, ErrInvalidData))
+	assert.Nil(s.T(), msgCounter)
+
+	_, ok = sut.Operation("unknown")
+	assert.False(s.T(), ok)
+
+	currentLimits, ok := sut.DataPoint("currentLimits")
+	assert.True(s.T(), ok)
+	assert.Equal(s.T(), []api.EventType{ucopev.DataUpdateCurrentLimits}, currentLimits.Events)
+}
+
+func (s *DataPointsSuite) Test_UseCaseNameForEvent() {
+	assert.Equal(s.T(), "mgcp", UseCaseNameForEvent(ucmgcp.DataUpdatePower))
+	assert.Equal(s.T(), "lpcserver", UseCaseNameForEvent("uclpcserver-WriteApprovalRequired"))
+	assert.Equal(s.T(), "", UseCaseNameForEvent("deviceConnected"))
+}
@@ -0,0 +1,25 @@
+package datapoints
+
+import "github.com/enbility/spine-go/model"
+
+// the values of getters returning multiple values
+
+// the charging power limits of an EV
+type ChargingPowerLimits struct {
+	Minimum float64 // the minimum charging power in W
+	Maximum float64 // the maximum charging power in W
+	Standby float64 // the standby power in W
+}
+
+// the per phase current limits of an EV
+type CurrentLimits struct {
+	Minimum []float64 // the minimum current per phase in A
+	Maximum []float64 // the maximum current per phase in A
+	Default []float64 // the default current per phase in A
+}
+
+// the operating state of an EVSE
+type OperatingState struct {
+	State         model.DeviceDiagnosisOperatingStateType
+	LastErrorCode string
+}
@@ -0,0 +1,329 @@
+package datapoints
+
+import (
+	"time"
+
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/uccevc"
+	"github.com/enbility/cemd/ucevcc"
+	"github.com/enbility/cemd/ucevcem"
+	"github.com/enbility/cemd/ucevsecc"
+	"github.com/enbility/cemd/ucevsoc"
+	"github.com/enbility/cemd/uclpc"
+	"github.com/enbility/cemd/uclpp"
+	"github.com/enbility/cemd/ucmgcp"
+	"github.com/enbility/cemd/ucmpc"
+	"github.com/enbility/cemd/ucopev"
+	"github.com/enbility/cemd/ucoscev"
+	"github.com/enbility/cemd/ucvabd"
+	"github.com/enbility/cemd/ucvapd"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+)
+
+// the use case name is checked first, as the interfaces of some use cases are identical
+var useCaseDescriptions = []struct {
+	useCaseName model.UseCaseNameType
+	describe    func(usecase api.UseCaseInterface) (UseCase, bool)
+}{
+	{model.UseCaseNameTypeCoordinatedEVCharging, describeCEVC},
+	{model.UseCaseNameTypeEVCommissioningAndConfiguration, describeEVCC},
+	{model.UseCaseNameTypeMeasurementOfElectricityDuringEVCharging, describeEVCEM},
+	{model.UseCaseNameTypeEVSECommissioningAndConfiguration, describeEVSECC},
+	{model.UseCaseNameTypeEVStateOfCharge, describeEVSOC},
+	{model.UseCaseNameTypeLimitationOfPowerConsumption, describeLPC},
+	{model.UseCaseNameTypeLimitationOfPowerProduction, describeLPP},
+	{model.UseCaseNameTypeMonitoringOfGridConnectionPoint, describeMGCP},
+	{model.UseCaseNameTypeMonitoringOfPowerConsumption, describeMPC},
+	{model.UseCaseNameTypeOverloadProtectionByEVChargingCurrentCurtailment, describeOPEV},
+	{model.UseCaseNameTypeOptimizationOfSelfConsumptionDuringEVCharging, describeOSCEV},
+	{model.UseCaseNameTypeVisualizationOfAggregatedBatteryData, describeVABD},
+	{model.UseCaseNameTypeVisualizationOfAggregatedPhotovoltaicData, describeVAPD},
+}
+
+func events(events ...api.EventType) []api.EventType {
+	return events
+}
+
+func describeCEVC(usecase api.UseCaseInterface) (UseCase, bool) {
+	uc, ok := usecase.(uccevc.UCCEVCInterface)
+	if !ok {
+		return UseCase{}, false
+	}
+
+	return UseCase{
+		Name:    "cevc",
+		UseCase: usecase,
+		DataPoints: []DataPoint{
+			{"chargeStrategy", events(uccevc.DataUpdateEnergyDemand), func(entity spineapi.EntityRemoteInterface) (any, error) {
+				return uc.ChargeStrategy(entity), nil
+			}},
+			{"energyDemand", events(uccevc.DataUpdateEnergyDemand), read(uc.EnergyDemand)},
+			{"timeSlotConstraints", events(uccevc.DataUpdateTimeSlotConstraints), read(uc.TimeSlotConstraints)},
+			{"incentiveConstraints", nil, read(uc.IncentiveConstraints)},
+			{"chargePlanConstraints", events(uccevc.DataUpdateChargePlanConstraints), read(uc.ChargePlanConstraints)},
+			{"chargePlan", events(uccevc.DataUpdateChargePlan), read(uc.ChargePlan)},
+		},
+		Operations: []Operation{
+			{"powerLimits", writeOnly(uc.WritePowerLimits)},
+			{"incentiveTableDescriptions", writeOnly(uc.WriteIncentiveTableDescriptions)},
+			{"incentives", writeOnly(uc.WriteIncentives)},
+		},
+	}, true
+}
+
+func describeEVCC(usecase api.UseCaseInterface) (UseCase, bool) {
+	uc, ok := usecase.(ucevcc.UCEVCCInterface)
+	if !ok {
+		return UseCase{}, false
+	}
+
+	return UseCase{
+		Name:    "evcc",
+		UseCase: usecase,
+		DataPoints: []DataPoint{
+			{"evConnected", events(ucevcc.EvConnected, ucevcc.EvDisconnected), func(entity spineapi.EntityRemoteInterface) (any, error) {
+				return uc.EVConnected(entity), nil
+			}},
+			{"chargeState", events(ucevcc.DataUpdateChargeState), read(uc.ChargeState)},
+			{"communicationStandard", events(ucevcc.DataUpdateCommunicationStandard), read(uc.CommunicationStandard)},
+			{"asymmetricChargingSupport", events(ucevcc.DataUpdateAsymmetricChargingSupport), read(uc.AsymmetricChargingSupport)},
+			{"identifications", events(ucevcc.DataUpdateIdentifications), read(uc.Identifications)},
+			{"manufacturerData", events(ucevcc.DataUpdateManufacturerData), read(uc.ManufacturerData)},
+			{"chargingPowerLimits", events(ucevcc.DataUpdateCurrentLimits), func(entity spineapi.EntityRemoteInterface) (any, error) {
+				minimum, maximum, standby, err := uc.ChargingPowerLimits(entity)
+				if err != nil {
+					return nil, err
+				}
+
+				return ChargingPowerLimits{Minimum: minimum, Maximum: maximum, Standby: standby}, nil
+			}},
+			{"isInSleepMode", events(ucevcc.DataUpdateIsInSleepMode), read(uc.IsInSleepMode)},
+		},
+	}, true
+}
+
+func describeEVCEM(usecase api.UseCaseInterface) (UseCase, bool) {
+	uc, ok := usecase.(ucevcem.UCEVCEMInterface)
+	if !ok {
+		return UseCase{}, false
+	}
+
+	return UseCase{
+		Name:    "evcem",
+		UseCase: usecase,
+		DataPoints: []DataPoint{
+			{"phasesConnected", events(ucevcem.DataUpdatePhasesConnected), read(uc.PhasesConnected)},
+			{"currentPerPhase", events(ucevcem.DataUpdateCurrentPerPhase), read(uc.CurrentPerPhase)},
+			{"powerPerPhase", events(ucevcem.DataUpdatePowerPerPhase), read(uc.PowerPerPhase)},
+			{"energyCharged", events(ucevcem.DataUpdateEnergyCharged), read(uc.EnergyCharged)},
+		},
+	}, true
+}
+
+func describeEVSECC(usecase api.UseCaseInterface) (UseCase, bool) {
+	uc, ok := usecase.(ucevsecc.UCEVSECCInterface)
+	if !ok {
+		return UseCase{}, false
+	}
+
+	return UseCase{
+		Name:    "evsecc",
+		UseCase: usecase,
+		DataPoints: []DataPoint{
+			{"manufacturerData", events(ucevsecc.DataUpdateManufacturerData), read(uc.ManufacturerData)},
+			{"operatingState", events(ucevsecc.DataUpdateOperatingState), func(entity spineapi.EntityRemoteInterface) (any, error) {
+				state, lastErrorCode, err := uc.OperatingState(entity)
+				if err != nil {
+					return nil, err
+				}
+
+				return OperatingState{State: state, LastErrorCode: lastErrorCode}, nil
+			}},
+		},
+	}, true
+}
+
+func describeEVSOC(usecase api.UseCaseInterface) (UseCase, bool) {
+	uc, ok := usecase.(ucevsoc.UCEVSOCInterface)
+	if !ok {
+		return UseCase{}, false
+	}
+
+	return UseCase{
+		Name:    "evsoc",
+		UseCase: usecase,
+		DataPoints: []DataPoint{
+			{"stateOfCharge", events(ucevsoc.DataUpdateStateOfCharge), read(uc.StateOfCharge)},
+		},
+	}, true
+}
+
+func describeLPC(usecase api.UseCaseInterface) (UseCase, bool) {
+	uc, ok := usecase.(uclpc.UCLPCInterface)
+	if !ok {
+		return UseCase{}, false
+	}
+
+	return UseCase{
+		Name:    "lpc",
+		UseCase: usecase,
+		DataPoints: []DataPoint{
+			{"consumptionLimit", events(uclpc.DataUpdateLimit), read(uc.ConsumptionLimit)},
+			{"failsafeConsumptionActivePowerLimit", events(uclpc.DataUpdateFailsafeConsumptionActivePowerLimit), read(uc.FailsafeConsumptionActivePowerLimit)},
+			{"failsafeDurationMinimum", events(uclpc.DataUpdateFailsafeDurationMinimum), read(uc.FailsafeDurationMinimum)},
+			{"powerConsumptionNominalMax", nil, read(uc.PowerConsumptionNominalMax)},
+		},
+		Operations: []Operation{
+			{"consumptionLimit", write(uc.WriteConsumptionLimit)},
+			{"failsafeConsumptionActivePowerLimit", write(uc.WriteFailsafeConsumptionActivePowerLimit)},
+			{"failsafeDurationMinimum", write[time.Duration](uc.WriteFailsafeDurationMinimum)},
+		},
+	}, true
+}
+
+func describeLPP(usecase api.UseCaseInterface) (UseCase, bool) {
+	uc, ok := usecase.(uclpp.UCLPPInterface)
+	if !ok {
+		return UseCase{}, false
+	}
+
+	return UseCase{
+		Name:    "lpp",
+		UseCase: usecase,
+		DataPoints: []DataPoint{
+			{"productionLimit", events(uclpp.DataUpdateLimit), read(uc.ProductionLimit)},
+			{"failsafeProductionActivePowerLimit", events(uclpp.DataUpdateFailsafeProductionActivePowerLimit), read(uc.FailsafeProductionActivePowerLimit)},
+			{"failsafeDurationMinimum", events(uclpp.DataUpdateFailsafeDurationMinimum), read(uc.FailsafeDurationMinimum)},
+			{"powerProductionNominalMax", nil, read(uc.PowerProductionNominalMax)},
+		},
+		Operations: []Operation{
+			{"productionLimit", write(uc.WriteProductionLimit)},
+			{"failsafeProductionActivePowerLimit", write(uc.WriteFailsafeProductionActivePowerLimit)},
+			{"failsafeDurationMinimum", write[time.Duration](uc.WriteFailsafeDurationMinimum)},
+		},
+	}, true
+}
+
+func describeMGCP(usecase api.UseCaseInterface) (UseCase, bool) {
+	uc, ok := usecase.(ucmgcp.UCMGCPInterface)
+	if !ok {
+		return UseCase{}, false
+	}
+
+	return UseCase{
+		Name:    "mgcp",
+		UseCase: usecase,
+		DataPoints: []DataPoint{
+			{"powerLimitationFactor", events(ucmgcp.DataUpdatePowerLimitationFactor), read(uc.PowerLimitationFactor)},
+			{"power", events(ucmgcp.DataUpdatePower), read(uc.Power)},
+			{"energyFeedIn", events(ucmgcp.DataUpdateEnergyFeedIn), read(uc.EnergyFeedIn)},
+			{"energyConsumed", events(ucmgcp.DataUpdateEnergyConsumed), read(uc.EnergyConsumed)},
+			{"currentPerPhase", events(ucmgcp.DataUpdateCurrentPerPhase), read(uc.CurrentPerPhase)},
+			{"voltagePerPhase", events(ucmgcp.DataUpdateVoltagePerPhase), read(uc.VoltagePerPhase)},
+			{"frequency", events(ucmgcp.DataUpdateFrequency), read(uc.Frequency)},
+		},
+	}, true
+}
+
+func describeMPC(usecase api.UseCaseInterface) (UseCase, bool) {
+	uc, ok := usecase.(ucmpc.UCMCPInterface)
+	if !ok {
+		return UseCase{}, false
+	}
+
+	return UseCase{
+		Name:    "mpc",
+		UseCase: usecase,
+		DataPoints: []DataPoint{
+			{"power", events(ucmpc.DataUpdatePower), read(uc.Power)},
+			{"powerPerPhase", events(ucmpc.DataUpdatePowerPerPhase), read(uc.PowerPerPhase)},
+			{"energyConsumed", events(ucmpc.DataUpdateEnergyConsumed), read(uc.EnergyConsumed)},
+			{"energyProduced", events(ucmpc.DataUpdateEnergyProduced), read(uc.EnergyProduced)},
+			{"currentPerPhase", events(ucmpc.DataUpdateCurrentsPerPhase), read(uc.CurrentPerPhase)},
+			{"voltagePerPhase", events(ucmpc.DataUpdateVoltagePerPhase), read(uc.VoltagePerPhase)},
+			{"frequency", events(ucmpc.DataUpdateFrequency), read(uc.Frequency)},
+		},
+	}, true
+}
+
+// the interface of the OPEV and OSCEV use cases
+type evLimitsInterface interface {
+	CurrentLimits(entity spineapi.EntityRemoteInterface) ([]float64, []float64, []float64, error)
+	LoadControlLimits(entity spineapi.EntityRemoteInterface) ([]api.LoadLimitsPhase, error)
+	WriteLoadControlLimits(entity spineapi.EntityRemoteInterface, limits []api.LoadLimitsPhase) (*model.MsgCounterType, error)
+}
+
+func describeEVLimits(name string, uc evLimitsInterface, usecase api.UseCaseInterface, currentLimits, limit api.EventType) UseCase {
+	return UseCase{
+		Name:    name,
+		UseCase: usecase,
+		DataPoints: []DataPoint{
+			{"currentLimits", events(currentLimits), func(entity spineapi.EntityRemoteInterface) (any, error) {
+				minimum, maximum, standard, err := uc.CurrentLimits(entity)
+				if err != nil {
+					return nil, err
+				}
+
+				return CurrentLimits{Minimum: minimum, Maximum: maximum, Default: standard}, nil
+			}},
+			{"loadControlLimits", events(limit), read(uc.LoadControlLimits)},
+		},
+		Operations: []Operation{
+			{"loadControlLimits", write(uc.WriteLoadControlLimits)},
+		},
+	}
+}
+
+func describeOPEV(usecase api.UseCaseInterface) (UseCase, bool) {
+	uc, ok := usecase.(ucopev.UCOPEVInterface)
+	if !ok {
+		return UseCase{}, false
+	}
+
+	return describeEVLimits("opev", uc, usecase, ucopev.DataUpdateCurrentLimits, ucopev.DataUpdateLimit), true
+}
+
+func describeOSCEV(usecase api.UseCaseInterface) (UseCase, bool) {
+	uc, ok := usecase.(ucoscev.UCOSCEVInterface)
+	if !ok {
+		return UseCase{}, false
+	}
+
+	return describeEVLimits("oscev", uc, usecase, ucoscev.DataUpdateCurrentLimits, ucoscev.DataUpdateLimit), true
+}
+
+func describeVABD(usecase api.UseCaseInterface) (UseCase, bool) {
+	uc, ok := usecase.(ucvabd.UCVABDInterface)
+	if !ok {
+		return UseCase{}, false
+	}
+
+	return UseCase{
+		Name:    "vabd",
+		UseCase: usecase,
+		DataPoints: []DataPoint{
+			{"power", events(ucvabd.DataUpdatePower), read(uc.Power)},
+			{"energyCharged", events(ucvabd.DataUpdateEnergyCharged), read(uc.EnergyCharged)},
+			{"energyDischarged", events(ucvabd.DataUpdateEnergyDischarged), read(uc.EnergyDischarged)},
+			{"stateOfCharge", events(ucvabd.DataUpdateStateOfCharge), read(uc.StateOfCharge)},
+		},
+	}, true
+}
+
+func describeVAPD(usecase api.UseCaseInterface) (UseCase, bool) {
+	uc, ok := usecase.(ucvapd.UCVAPDInterface)
+	if !ok {
+		return UseCase{}, false
+	}
+
+	return UseCase{
+		Name:    "vapd",
+		UseCase: usecase,
+		DataPoints: []DataPoint{
+			{"power", events(ucvapd.DataUpdatePower), read(uc.Power)},
+			{"powerNominalPeak", events(ucvapd.DataUpdatePowerNominalPeak), read(uc.PowerNominalPeak)},
+			{"pvYieldTotal", events(ucvapd.DataUpdatePVYieldTotal), read(uc.PVYieldTotal)},
+		},
+	}, true
+}
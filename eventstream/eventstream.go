@@ -0,0 +1,225 @@
+// Package eventstream streams the events of a CEM and its use cases via WebSocket
+//
+// Each event is sent as a JSON encoded Message, containing the current values
+// of the data points the event updates. Clients can filter the events with
+// the query parameters of the WebSocket request, each can be repeated or
+// contain comma separated values:
+//
+//	ski:     the SKI of the remote device
+//	usecase: the short name of the use case, e.g. "mgcp" or "lpcserver"
+//	entity:  the entity address, e.g. "1.1"
+package eventstream
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/cem"
+	"github.com/enbility/cemd/datapoints"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/gorilla/websocket"
+)
+
+const (
+	// the number of messages buffered for each client, slower clients are disconnected
+	clientBufferSize = 64
+
+	// the maximum duration for writing a message to a client
+	writeTimeout = time.Second * 10
+)
+
+// Streams the events of a CEM to WebSocket clients
+type Stream struct {
+	cem *cem.Cem
+
+	upgrader websocket.Upgrader
+
+	mux     sync.Mutex
+	clients map[*client]struct{}
+}
+
+// create a new stream for the events of the CEM
+//
+// the events have to be passed to EntityEventCB and DeviceEventCB,
+// usually by calling them from the callbacks provided to the CEM and its use cases
+func NewStream(c *cem.Cem) *Stream {
+	return &Stream{
+		cem:     c,
+		clients: make(map[*client]struct{}),
+	}
+}
+
+var _ http.Handler = (*Stream)(nil)
+
+// upgrade the request to a WebSocket connection receiving the events
+func (s *Stream) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	conn, err := s.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// the upgrader already replied with an error
+		return
+	}
+
+	c := &client{
+		conn:     conn,
+		filter:   filterFromQuery(r),
+		messages: make(chan Message, clientBufferSize),
+		done:     make(chan struct{}),
+	}
+
+	s.mux.Lock()
+	s.clients[c] = struct{}{}
+	s.mux.Unlock()
+
+	go c.writeLoop()
+	go func() {
+		c.readLoop()
+		s.remove(c)
+	}()
+}
+
+// disconnect all clients
+func (s *Stream) Close() {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	for c := range s.clients {
+		delete(s.clients, c)
+		c.close()
+	}
+}
+
+// handle an event of a use case
+//
+// has the signature of api.EntityEventCallback
+func (s *Stream) EntityEventCB(ski string, device spineapi.DeviceRemoteInterface, entity spineapi.EntityRemoteInterface, event api.EventType) {
+	if !s.hasClients() {
+		return
+	}
+
+	message := Message{
+		Ski:     ski,
+		UseCase: datapoints.UseCaseNameForEvent(event),
+		Event:   event,
+	}
+
+	if entity != nil {
+		message.Entity = datapoints.EntityAddress(entity)
+		message.Values = s.values(entity, event)
+	}
+
+	s.send(message)
+}
+
+// handle an event of the CEM
+//
+// has the signature of api.DeviceEventCallback
+func (s *Stream) DeviceEventCB(ski string, device spineapi.DeviceRemoteInterface, event api.EventType) {
+	if !s.hasClients() {
+		return
+	}
+
+	s.send(Message{
+		Ski:   ski,
+		Event: event,
+	})
+}
+
+func (s *Stream) hasClients() bool {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	return len(s.clients) > 0
+}
+
+// return the current values of the data points updated by the event
+func (s *Stream) values(entity spineapi.EntityRemoteInterface, event api.EventType) map[string]any {
+	var result map[string]any
+
+	for _, usecase := range datapoints.ForUseCases(s.cem.UseCases()) {
+		for _, dataPoint := range usecase.DataPointsForEvent(event) {
+			value, err := dataPoint.Read(entity)
+			if err != nil {
+				continue
+			}
+
+			if result == nil {
+				result = make(map[string]any)
+			}
+			result[dataPoint.Name] = value
+		}
+	}
+
+	return result
+}
+
+// send the message to all clients with a matching filter
+func (s *Stream) send(message Message) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	for c := range s.clients {
+		if !c.filter.matches(message) {
+			continue
+		}
+
+		select {
+		case c.messages <- message:
+		default:
+			// the client does not keep up
+			delete(s.clients, c)
+			c.close()
+		}
+	}
+}
+
+func (s *Stream) remove(c *client) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	delete(s.clients, c)
+	c.close()
+}
+
+// a connected WebSocket client
+type client struct {
+	conn   *websocket.Conn
+	filter Filter
+
+	messages  chan Message
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// write the messages until the client is closed
+func (c *client) writeLoop() {
+	for {
+		select {
+		case <-c.done:
+			return
+		case message := <-c.messages:
+			_ = c.conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+			if err := c.conn.WriteJSON(message); err != nil {
+				c.close()
+				return
+			}
+		}
+	}
+}
+
+// read until the connection is closed, incoming messages are ignored
+func (c *client) readLoop() {
+	for {
+		if _, _, err := c.conn.NextReader(); err != nil {
+			return
+		}
+	}
+}
+
+func (c *client) close() {
+	c.closeOnce.Do(func() {
+		close(c.done)
+		_ = c.conn.Close()
+	})
+}
@@ -0,0 +1,169 @@
+package eventstream
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/cem"
+	"github.com/enbility/cemd/datapoints"
+	"github.com/enbility/cemd/loopback"
+	"github.com/enbility/cemd/ucmgcp"
+	"github.com/enbility/cemd/ucmgcpserver"
+	"github.com/enbility/spine-go/model"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestStreamSuite(t *testing.T) {
+	suite.Run(t, new(StreamSuite))
+}
+
+type StreamSuite struct {
+	suite.Suite
+
+	network *loopback.Network
+
+	cem        *loopback.Device
+	smgw       *loopback.Device
+	mgcpServer *ucmgcpserver.UCMGCPServer
+
+	sut    *Stream
+	server *httptest.Server
+}
+
+func (s *StreamSuite) BeforeTest(suiteName, testName string) {
+	s.network = loopback.NewNetwork()
+
+	var err error
+	s.cem, err = s.network.NewCEM("cem", nil)
+	assert.Nil(s.T(), err)
+
+	s.sut = NewStream(s.cem.Cem)
+	s.cem.AddUseCase(ucmgcp.NewUCMGCP(s.cem.Service(), s.sut.EntityEventCB))
+
+	s.smgw, err = s.network.NewCEM("smgw", nil)
+	assert.Nil(s.T(), err)
+
+	s.mgcpServer = ucmgcpserver.NewUCMGCP(s.smgw.Service(), loopback.NewEventRecorder().EntityEventCB)
+	s.smgw.AddUseCase(s.mgcpServer)
+
+	s.server = httptest.NewServer(s.sut)
+}
+
+func (s *StreamSuite) AfterTest(suiteName, testName string) {
+	s.sut.Close()
+	s.server.Close()
+	s.network.Close()
+}
+
+// connect a client with the query parameters
+func (s *StreamSuite) dial(query string) *websocket.Conn {
+	address := "ws" + strings.TrimPrefix(s.server.URL, "http") + "/?" + query
+	conn, response, err := websocket.DefaultDialer.Dial(address, nil)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), http.StatusSwitchingProtocols, response.StatusCode)
+
+	// the client is registered asynchronously after the upgrade
+	assert.Eventually(s.T(), func() bool {
+		s.sut.mux.Lock()
+		defer s.sut.mux.Unlock()
+
+		for c := range s.sut.clients {
+			if c.conn.RemoteAddr().String() == conn.LocalAddr().String() {
+				return true
+			}
+		}
+		return false
+	}, time.Second, time.Millisecond)
+
+	return conn
+}
+
+// read the next message with the event
+func (s *StreamSuite) read(conn *websocket.Conn, event api.EventType) (Message, bool) {
+	for {
+		_ = conn.SetReadDeadline(time.Now().Add(time.Second * 5))
+
+		var message Message
+		if err := conn.ReadJSON(&message); err != nil {
+			return Message{}, false
+		}
+
+		if message.Event == event {
+			return message, true
+		}
+	}
+}
+
+func (s *StreamSuite) Test_Events() {
+	conn := s.dial("")
+	defer conn.Close()
+
+	s.sut.DeviceEventCB(s.smgw.SKI(), nil, cem.DeviceConnected)
+
+	message, ok := s.read(conn, cem.DeviceConnected)
+	assert.True(s.T(), ok)
+	assert.Equal(s.T(), Message{Ski: s.smgw.SKI(), Event: cem.DeviceConnected}, message)
+
+	_, err := s.network.Connect(s.cem, s.smgw)
+	assert.Nil(s.T(), err)
+
+	err = s.network.WaitIdle(time.Second * 5)
+	assert.Nil(s.T(), err)
+
+	err = s.mgcpServer.SetPower(-1000)
+	assert.Nil(s.T(), err)
+
+	message, ok = s.read(conn, ucmgcp.DataUpdatePower)
+	assert.True(s.T(), ok)
+	assert.Equal(s.T(), s.smgw.SKI(), message.Ski)
+	assert.Equal(s.T(), "mgcp", message.UseCase)
+	assert.Equal(s.T(), datapoints.EntityAddress(s.cem.RemoteEntity(s.smgw, model.EntityTypeTypeCEM)), message.Entity)
+	assert.Equal(s.T(), map[string]any{"power": -1000.0}, message.Values)
+}
+
+func (s *StreamSuite) Test_Filter() {
+	_, err := s.network.Connect(s.cem, s.smgw)
+	assert.Nil(s.T(), err)
+
+	err = s.network.WaitIdle(time.Second * 5)
+	assert.Nil(s.T(), err)
+
+	other := s.dial(url.Values{"usecase": {"lpc,evcc"}}.Encode())
+	defer other.Close()
+
+	conn := s.dial(url.Values{"usecase": {"mgcp"}, "ski": {s.smgw.SKI()}}.Encode())
+	defer conn.Close()
+
+	err = s.mgcpServer.SetPower(-1000)
+	assert.Nil(s.T(), err)
+
+	_, ok := s.read(conn, ucmgcp.DataUpdatePower)
+	assert.True(s.T(), ok)
+
+	// the filtered client receives nothing until it is disconnected
+	s.sut.Close()
+	_, ok = s.read(other, ucmgcp.DataUpdatePower)
+	assert.False(s.T(), ok)
+}
+
+func (s *StreamSuite) Test_FilterMatches() {
+	message := Message{Ski: "ski", Entity: "1", UseCase: "mgcp"}
+
+	assert.True(s.T(), Filter{}.matches(message))
+	assert.True(s.T(), Filter{Skis: []string{"other", "ski"}, Entities: []string{"1"}}.matches(message))
+	assert.False(s.T(), Filter{UseCases: []string{"lpc"}}.matches(message))
+	assert.False(s.T(), Filter{Entities: []string{"1.1"}}.matches(message))
+
+	request := httptest.NewRequest(http.MethodGet, "/?usecase=mgcp,+lpc&usecase=evcc&entity=1", nil)
+	assert.Equal(s.T(), Filter{
+		UseCases: []string{"mgcp", "lpc", "evcc"},
+		Entities: []string{"1"},
+	}, filterFromQuery(request))
+}
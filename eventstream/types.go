@@ -0,0 +1,65 @@
+package eventstream
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/enbility/cemd/api"
+)
+
+// An event sent to the clients
+type Message struct {
+	Ski     string        `json:"ski"`
+	Entity  string        `json:"entity,omitempty"`  // the entity address, e.g. "1.1", empty for events of the CEM
+	UseCase string        `json:"useCase,omitempty"` // the short name of the use case, e.g. "mgcp", empty for events of the CEM
+	Event   api.EventType `json:"event"`
+
+	// the current values of the data points updated by the event, by data point name
+	//
+	// values which are not available are omitted
+	Values map[string]any `json:"values,omitempty"`
+}
+
+// The events a client receives, empty lists match all events
+type Filter struct {
+	Skis     []string
+	UseCases []string
+	Entities []string
+}
+
+// returns the filter from the query parameters of a request
+func filterFromQuery(r *http.Request) Filter {
+	query := r.URL.Query()
+
+	return Filter{
+		Skis:     queryValues(query["ski"]),
+		UseCases: queryValues(query["usecase"]),
+		Entities: queryValues(query["entity"]),
+	}
+}
+
+// returns the values of a query parameter, splitting comma separated values
+func queryValues(values []string) []string {
+	var result []string
+
+	for _, value := range values {
+		for _, item := range strings.Split(value, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				result = append(result, item)
+			}
+		}
+	}
+
+	return result
+}
+
+func (f Filter) matches(message Message) bool {
+	return matches(f.Skis, message.Ski) &&
+		matches(f.UseCases, message.UseCase) &&
+		matches(f.Entities, message.Entity)
+}
+
+func matches(values []string, value string) bool {
+	return len(values) == 0 || slices.Contains(values, value)
+}
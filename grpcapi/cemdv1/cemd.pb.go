@@ -0,0 +1,3574 @@
+// gRPC API of a CEM
+//
+// The API exposes the connected devices and their entities, the values and
+// write operations of the client use cases added to the CEM, and the events
+// of the CEM and its use cases.
+//
+// Remote entities are addressed by the SKI of the remote device and the
+// entity ids joined with dots, e.g. "1.1". The use cases are named by their
+// short names, e.g. "lpc" or "mgcp".
+//
+// Errors are reported with the gRPC status codes:
+//   - INVALID_ARGUMENT: the entity address or the data of a write is invalid
+//   - NOT_FOUND: the device, entity or the requested data is not available
+//   - FAILED_PRECONDITION: the use case is not supported by the entity
+//   - UNIMPLEMENTED: the use case is not added to the CEM
+//   - UNAVAILABLE: the remote device is disconnected
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.33.0
+// 	protoc        (unknown)
+// source: cemd/v1/cemd.proto
+
+package cemdv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ListDevicesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListDevicesRequest) Reset() {
+	*x = ListDevicesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cemd_v1_cemd_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDevicesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDevicesRequest) ProtoMessage() {}
+
+func (x *ListDevicesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cemd_v1_cemd_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDevicesRequest.ProtoReflect.Descriptor instead.
+func (*ListDevicesRequest) Descriptor() ([]byte, []int) {
+	return file_cemd_v1_cemd_proto_rawDescGZIP(), []int{0}
+}
+
+type ListDevicesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Devices []*Device `protobuf:"bytes,1,rep,name=devices,proto3" json:"devices,omitempty"`
+}
+
+func (x *ListDevicesResponse) Reset() {
+	*x = ListDevicesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cemd_v1_cemd_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDevicesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDevicesResponse) ProtoMessage() {}
+
+func (x *ListDevicesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cemd_v1_cemd_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDevicesResponse.ProtoReflect.Descriptor instead.
+func (*ListDevicesResponse) Descriptor() ([]byte, []int) {
+	return file_cemd_v1_cemd_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListDevicesResponse) GetDevices() []*Device {
+	if x != nil {
+		return x.Devices
+	}
+	return nil
+}
+
+type GetDeviceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ski string `protobuf:"bytes,1,opt,name=ski,proto3" json:"ski,omitempty"`
+}
+
+func (x *GetDeviceRequest) Reset() {
+	*x = GetDeviceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cemd_v1_cemd_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDeviceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeviceRequest) ProtoMessage() {}
+
+func (x *GetDeviceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cemd_v1_cemd_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeviceRequest.ProtoReflect.Descriptor instead.
+func (*GetDeviceRequest) Descriptor() ([]byte, []int) {
+	return file_cemd_v1_cemd_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetDeviceRequest) GetSki() string {
+	if x != nil {
+		return x.Ski
+	}
+	return ""
+}
+
+// a connected remote device
+type Device struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ski        string    `protobuf:"bytes,1,opt,name=ski,proto3" json:"ski,omitempty"`
+	Address    string    `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	DeviceType string    `protobuf:"bytes,3,opt,name=device_type,json=deviceType,proto3" json:"device_type,omitempty"`
+	Entities   []*Entity `protobuf:"bytes,4,rep,name=entities,proto3" json:"entities,omitempty"`
+}
+
+func (x *Device) Reset() {
+	*x = Device{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cemd_v1_cemd_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Device) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Device) ProtoMessage() {}
+
+func (x *Device) ProtoReflect() protoreflect.Message {
+	mi := &file_cemd_v1_cemd_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Device.ProtoReflect.Descriptor instead.
+func (*Device) Descriptor() ([]byte, []int) {
+	return file_cemd_v1_cemd_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Device) GetSki() string {
+	if x != nil {
+		return x.Ski
+	}
+	return ""
+}
+
+func (x *Device) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Device) GetDeviceType() string {
+	if x != nil {
+		return x.DeviceType
+	}
+	return ""
+}
+
+func (x *Device) GetEntities() []*Entity {
+	if x != nil {
+		return x.Entities
+	}
+	return nil
+}
+
+// an entity of a remote device
+type Entity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the entity ids joined with dots, e.g. "1.1"
+	Address    string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	EntityType string `protobuf:"bytes,2,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`
+	// the short names of the supported use cases, e.g. "mgcp"
+	UseCases []string `protobuf:"bytes,3,rep,name=use_cases,json=useCases,proto3" json:"use_cases,omitempty"`
+}
+
+func (x *Entity) Reset() {
+	*x = Entity{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cemd_v1_cemd_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Entity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Entity) ProtoMessage() {}
+
+func (x *Entity) ProtoReflect() protoreflect.Message {
+	mi := &file_cemd_v1_cemd_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Entity.ProtoReflect.Descriptor instead.
+func (*Entity) Descriptor() ([]byte, []int) {
+	return file_cemd_v1_cemd_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Entity) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Entity) GetEntityType() string {
+	if x != nil {
+		return x.EntityType
+	}
+	return ""
+}
+
+func (x *Entity) GetUseCases() []string {
+	if x != nil {
+		return x.UseCases
+	}
+	return nil
+}
+
+// filters the streamed events, empty lists match all events
+type StreamEventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Skis     []string `protobuf:"bytes,1,rep,name=skis,proto3" json:"skis,omitempty"`
+	UseCases []string `protobuf:"bytes,2,rep,name=use_cases,json=useCases,proto3" json:"use_cases,omitempty"`
+}
+
+func (x *StreamEventsRequest) Reset() {
+	*x = StreamEventsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cemd_v1_cemd_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamEventsRequest) ProtoMessage() {}
+
+func (x *StreamEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cemd_v1_cemd_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamEventsRequest.ProtoReflect.Descriptor instead.
+func (*StreamEventsRequest) Descriptor() ([]byte, []int) {
+	return file_cemd_v1_cemd_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *StreamEventsRequest) GetSkis() []string {
+	if x != nil {
+		return x.Skis
+	}
+	return nil
+}
+
+func (x *StreamEventsRequest) GetUseCases() []string {
+	if x != nil {
+		return x.UseCases
+	}
+	return nil
+}
+
+type Event struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ski string `protobuf:"bytes,1,opt,name=ski,proto3" json:"ski,omitempty"`
+	// the entity address, empty for device events
+	Entity string `protobuf:"bytes,2,opt,name=entity,proto3" json:"entity,omitempty"`
+	// the short name of the use case, empty for device events
+	UseCase string `protobuf:"bytes,3,opt,name=use_case,json=useCase,proto3" json:"use_case,omitempty"`
+	// the event name, e.g. "ucmgcp-DataUpdatePower" or "deviceConnected"
+	Event string `protobuf:"bytes,4,opt,name=event,proto3" json:"event,omitempty"`
+}
+
+func (x *Event) Reset() {
+	*x = Event{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cemd_v1_cemd_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event) ProtoMessage() {}
+
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_cemd_v1_cemd_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_cemd_v1_cemd_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Event) GetSki() string {
+	if x != nil {
+		return x.Ski
+	}
+	return ""
+}
+
+func (x *Event) GetEntity() string {
+	if x != nil {
+		return x.Entity
+	}
+	return ""
+}
+
+func (x *Event) GetUseCase() string {
+	if x != nil {
+		return x.UseCase
+	}
+	return ""
+}
+
+func (x *Event) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+// identifies a remote entity
+type EntityRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ski string `protobuf:"bytes,1,opt,name=ski,proto3" json:"ski,omitempty"`
+	// the entity ids joined with dots, e.g. "1.1"
+	Entity string `protobuf:"bytes,2,opt,name=entity,proto3" json:"entity,omitempty"`
+}
+
+func (x *EntityRequest) Reset() {
+	*x = EntityRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cemd_v1_cemd_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EntityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EntityRequest) ProtoMessage() {}
+
+func (x *EntityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cemd_v1_cemd_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EntityRequest.ProtoReflect.Descriptor instead.
+func (*EntityRequest) Descriptor() ([]byte, []int) {
+	return file_cemd_v1_cemd_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *EntityRequest) GetSki() string {
+	if x != nil {
+		return x.Ski
+	}
+	return ""
+}
+
+func (x *EntityRequest) GetEntity() string {
+	if x != nil {
+		return x.Entity
+	}
+	return ""
+}
+
+// values in the order of the phases a, b and c
+type PhaseValues struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Values []float64 `protobuf:"fixed64,1,rep,packed,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *PhaseValues) Reset() {
+	*x = PhaseValues{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cemd_v1_cemd_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PhaseValues) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PhaseValues) ProtoMessage() {}
+
+func (x *PhaseValues) ProtoReflect() protoreflect.Message {
+	mi := &file_cemd_v1_cemd_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PhaseValues.ProtoReflect.Descriptor instead.
+func (*PhaseValues) Descriptor() ([]byte, []int) {
+	return file_cemd_v1_cemd_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PhaseValues) GetValues() []float64 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type WriteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the message counter of the sent message, if the use case provides it
+	MsgCounter *uint64 `protobuf:"varint,1,opt,name=msg_counter,json=msgCounter,proto3,oneof" json:"msg_counter,omitempty"`
+}
+
+func (x *WriteResponse) Reset() {
+	*x = WriteResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cemd_v1_cemd_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WriteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WriteResponse) ProtoMessage() {}
+
+func (x *WriteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cemd_v1_cemd_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WriteResponse.ProtoReflect.Descriptor instead.
+func (*WriteResponse) Descriptor() ([]byte, []int) {
+	return file_cemd_v1_cemd_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *WriteResponse) GetMsgCounter() uint64 {
+	if x != nil && x.MsgCounter != nil {
+		return *x.MsgCounter
+	}
+	return 0
+}
+
+type WriteDoubleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ski    string  `protobuf:"bytes,1,opt,name=ski,proto3" json:"ski,omitempty"`
+	Entity string  `protobuf:"bytes,2,opt,name=entity,proto3" json:"entity,omitempty"`
+	Value  float64 `protobuf:"fixed64,3,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *WriteDoubleRequest) Reset() {
+	*x = WriteDoubleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cemd_v1_cemd_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WriteDoubleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WriteDoubleRequest) ProtoMessage() {}
+
+func (x *WriteDoubleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cemd_v1_cemd_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WriteDoubleRequest.ProtoReflect.Descriptor instead.
+func (*WriteDoubleRequest) Descriptor() ([]byte, []int) {
+	return file_cemd_v1_cemd_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *WriteDoubleRequest) GetSki() string {
+	if x != nil {
+		return x.Ski
+	}
+	return ""
+}
+
+func (x *WriteDoubleRequest) GetEntity() string {
+	if x != nil {
+		return x.Entity
+	}
+	return ""
+}
+
+func (x *WriteDoubleRequest) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type WriteDurationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ski    string               `protobuf:"bytes,1,opt,name=ski,proto3" json:"ski,omitempty"`
+	Entity string               `protobuf:"bytes,2,opt,name=entity,proto3" json:"entity,omitempty"`
+	Value  *durationpb.Duration `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *WriteDurationRequest) Reset() {
+	*x = WriteDurationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cemd_v1_cemd_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WriteDurationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WriteDurationRequest) ProtoMessage() {}
+
+func (x *WriteDurationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cemd_v1_cemd_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WriteDurationRequest.ProtoReflect.Descriptor instead.
+func (*WriteDurationRequest) Descriptor() ([]byte, []int) {
+	return file_cemd_v1_cemd_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *WriteDurationRequest) GetSki() string {
+	if x != nil {
+		return x.Ski
+	}
+	return ""
+}
+
+func (x *WriteDurationRequest) GetEntity() string {
+	if x != nil {
+		return x.Entity
+	}
+	return ""
+}
+
+func (x *WriteDurationRequest) GetValue() *durationpb.Duration {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type ManufacturerData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeviceName                     string `protobuf:"bytes,1,opt,name=device_name,json=deviceName,proto3" json:"device_name,omitempty"`
+	DeviceCode                     string `protobuf:"bytes,2,opt,name=device_code,json=deviceCode,proto3" json:"device_code,omitempty"`
+	SerialNumber                   string `protobuf:"bytes,3,opt,name=serial_number,json=serialNumber,proto3" json:"serial_number,omitempty"`
+	SoftwareRevision               string `protobuf:"bytes,4,opt,name=software_revision,json=softwareRevision,proto3" json:"software_revision,omitempty"`
+	HardwareRevision               string `protobuf:"bytes,5,opt,name=hardware_revision,json=hardwareRevision,proto3" json:"hardware_revision,omitempty"`
+	VendorName                     string `protobuf:"bytes,6,opt,name=vendor_name,json=vendorName,proto3" json:"vendor_name,omitempty"`
+	VendorCode                     string `protobuf:"bytes,7,opt,name=vendor_code,json=vendorCode,proto3" json:"vendor_code,omitempty"`
+	BrandName                      string `protobuf:"bytes,8,opt,name=brand_name,json=brandName,proto3" json:"brand_name,omitempty"`
+	PowerSource                    string `protobuf:"bytes,9,opt,name=power_source,json=powerSource,proto3" json:"power_source,omitempty"`
+	ManufacturerNodeIdentification string `protobuf:"bytes,10,opt,name=manufacturer_node_identification,json=manufacturerNodeIdentification,proto3" json:"manufacturer_node_identification,omitempty"`
+	ManufacturerLabel              string `protobuf:"bytes,11,opt,name=manufacturer_label,json=manufacturerLabel,proto3" json:"manufacturer_label,omitempty"`
+	ManufacturerDescription        string `protobuf:"bytes,12,opt,name=manufacturer_description,json=manufacturerDescription,proto3" json:"manufacturer_description,omitempty"`
+}
+
+func (x *ManufacturerData) Reset() {
+	*x = ManufacturerData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cemd_v1_cemd_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ManufacturerData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ManufacturerData) ProtoMessage() {}
+
+func (x *ManufacturerData) ProtoReflect() protoreflect.Message {
+	mi := &file_cemd_v1_cemd_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ManufacturerData.ProtoReflect.Descriptor instead.
+func (*ManufacturerData) Descriptor() ([]byte, []int) {
+	return file_cemd_v1_cemd_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ManufacturerData) GetDeviceName() string {
+	if x != nil {
+		return x.DeviceName
+	}
+	return ""
+}
+
+func (x *ManufacturerData) GetDeviceCode() string {
+	if x != nil {
+		return x.DeviceCode
+	}
+	return ""
+}
+
+func (x *ManufacturerData) GetSerialNumber() string {
+	if x != nil {
+		return x.SerialNumber
+	}
+	return ""
+}
+
+func (x *ManufacturerData) GetSoftwareRevision() string {
+	if x != nil {
+		return x.SoftwareRevision
+	}
+	return ""
+}
+
+func (x *ManufacturerData) GetHardwareRevision() string {
+	if x != nil {
+		return x.HardwareRevision
+	}
+	return ""
+}
+
+func (x *ManufacturerData) GetVendorName() string {
+	if x != nil {
+		return x.VendorName
+	}
+	return ""
+}
+
+func (x *ManufacturerData) GetVendorCode() string {
+	if x != nil {
+		return x.VendorCode
+	}
+	return ""
+}
+
+func (x *ManufacturerData) GetBrandName() string {
+	if x != nil {
+		return x.BrandName
+	}
+	return ""
+}
+
+func (x *ManufacturerData) GetPowerSource() string {
+	if x != nil {
+		return x.PowerSource
+	}
+	return ""
+}
+
+func (x *ManufacturerData) GetManufacturerNodeIdentification() string {
+	if x != nil {
+		return x.ManufacturerNodeIdentification
+	}
+	return ""
+}
+
+func (x *ManufacturerData) GetManufacturerLabel() string {
+	if x != nil {
+		return x.ManufacturerLabel
+	}
+	return ""
+}
+
+func (x *ManufacturerData) GetManufacturerDescription() string {
+	if x != nil {
+		return x.ManufacturerDescription
+	}
+	return ""
+}
+
+type LoadLimit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Duration *durationpb.Duration `protobuf:"bytes,1,opt,name=duration,proto3" json:"duration,omitempty"`
+	// ignored when writing data
+	IsChangeable bool `protobuf:"varint,2,opt,name=is_changeable,json=isChangeable,proto3" json:"is_changeable,omitempty"`
+	IsActive     bool `protobuf:"varint,3,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	// the limit in W
+	Value float64 `protobuf:"fixed64,4,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *LoadLimit) Reset() {
+	*x = LoadLimit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cemd_v1_cemd_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoadLimit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoadLimit) ProtoMessage() {}
+
+func (x *LoadLimit) ProtoReflect() protoreflect.Message {
+	mi := &file_cemd_v1_cemd_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoadLimit.ProtoReflect.Descriptor instead.
+func (*LoadLimit) Descriptor() ([]byte, []int) {
+	return file_cemd_v1_cemd_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *LoadLimit) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *LoadLimit) GetIsChangeable() bool {
+	if x != nil {
+		return x.IsChangeable
+	}
+	return false
+}
+
+func (x *LoadLimit) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *LoadLimit) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type WriteLoadLimitRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ski    string     `protobuf:"bytes,1,opt,name=ski,proto3" json:"ski,omitempty"`
+	Entity string     `protobuf:"bytes,2,opt,name=entity,proto3" json:"entity,omitempty"`
+	Limit  *LoadLimit `protobuf:"bytes,3,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *WriteLoadLimitRequest) Reset() {
+	*x = WriteLoadLimitRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cemd_v1_cemd_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WriteLoadLimitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WriteLoadLimitRequest) ProtoMessage() {}
+
+func (x *WriteLoadLimitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cemd_v1_cemd_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WriteLoadLimitRequest.ProtoReflect.Descriptor instead.
+func (*WriteLoadLimitRequest) Descriptor() ([]byte, []int) {
+	return file_cemd_v1_cemd_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *WriteLoadLimitRequest) GetSki() string {
+	if x != nil {
+		return x.Ski
+	}
+	return ""
+}
+
+func (x *WriteLoadLimitRequest) GetEntity() string {
+	if x != nil {
+		return x.Entity
+	}
+	return ""
+}
+
+func (x *WriteLoadLimitRequest) GetLimit() *LoadLimit {
+	if x != nil {
+		return x.Limit
+	}
+	return nil
+}
+
+type Identification struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	// the identification type, e.g. "eui64"
+	ValueType string `protobuf:"bytes,2,opt,name=value_type,json=valueType,proto3" json:"value_type,omitempty"`
+}
+
+func (x *Identification) Reset() {
+	*x = Identification{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cemd_v1_cemd_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Identification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Identification) ProtoMessage() {}
+
+func (x *Identification) ProtoReflect() protoreflect.Message {
+	mi := &file_cemd_v1_cemd_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Identification.ProtoReflect.Descriptor instead.
+func (*Identification) Descriptor() ([]byte, []int) {
+	return file_cemd_v1_cemd_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Identification) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *Identification) GetValueType() string {
+	if x != nil {
+		return x.ValueType
+	}
+	return ""
+}
+
+type Identifications struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Identifications []*Identification `protobuf:"bytes,1,rep,name=identifications,proto3" json:"identifications,omitempty"`
+}
+
+func (x *Identifications) Reset() {
+	*x = Identifications{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cemd_v1_cemd_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Identifications) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Identifications) ProtoMessage() {}
+
+func (x *Identifications) ProtoReflect() protoreflect.Message {
+	mi := &file_cemd_v1_cemd_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Identifications.ProtoReflect.Descriptor instead.
+func (*Identifications) Descriptor() ([]byte, []int) {
+	return file_cemd_v1_cemd_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Identifications) GetIdentifications() []*Identification {
+	if x != nil {
+		return x.Identifications
+	}
+	return nil
+}
+
+type ChargingPowerLimits struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Minimum float64 `protobuf:"fixed64,1,opt,name=minimum,proto3" json:"minimum,omitempty"`
+	Maximum float64 `protobuf:"fixed64,2,opt,name=maximum,proto3" json:"maximum,omitempty"`
+	Standby float64 `protobuf:"fixed64,3,opt,name=standby,proto3" json:"standby,omitempty"`
+}
+
+func (x *ChargingPowerLimits) Reset() {
+	*x = ChargingPowerLimits{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cemd_v1_cemd_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChargingPowerLimits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChargingPowerLimits) ProtoMessage() {}
+
+func (x *ChargingPowerLimits) ProtoReflect() protoreflect.Message {
+	mi := &file_cemd_v1_cemd_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChargingPowerLimits.ProtoReflect.Descriptor instead.
+func (*ChargingPowerLimits) Descriptor() ([]byte, []int) {
+	return file_cemd_v1_cemd_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ChargingPowerLimits) GetMinimum() float64 {
+	if x != nil {
+		return x.Minimum
+	}
+	return 0
+}
+
+func (x *ChargingPowerLimits) GetMaximum() float64 {
+	if x != nil {
+		return x.Maximum
+	}
+	return 0
+}
+
+func (x *ChargingPowerLimits) GetStandby() float64 {
+	if x != nil {
+		return x.Standby
+	}
+	return 0
+}
+
+type OperatingState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the operating state, e.g. "normalOperation" or "failure"
+	State         string `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	LastErrorCode string `protobuf:"bytes,2,opt,name=last_error_code,json=lastErrorCode,proto3" json:"last_error_code,omitempty"`
+}
+
+func (x *OperatingState) Reset() {
+	*x = OperatingState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cemd_v1_cemd_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OperatingState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperatingState) ProtoMessage() {}
+
+func (x *OperatingState) ProtoReflect() protoreflect.Message {
+	mi := &file_cemd_v1_cemd_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperatingState.ProtoReflect.Descriptor instead.
+func (*OperatingState) Descriptor() ([]byte, []int) {
+	return file_cemd_v1_cemd_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *OperatingState) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *OperatingState) GetLastErrorCode() string {
+	if x != nil {
+		return x.LastErrorCode
+	}
+	return ""
+}
+
+type CurrentLimits struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the values in the order of the phases a, b and c
+	Minimum []float64 `protobuf:"fixed64,1,rep,packed,name=minimum,proto3" json:"minimum,omitempty"`
+	Maximum []float64 `protobuf:"fixed64,2,rep,packed,name=maximum,proto3" json:"maximum,omitempty"`
+	Default []float64 `protobuf:"fixed64,3,rep,packed,name=default,proto3" json:"default,omitempty"`
+}
+
+func (x *CurrentLimits) Reset() {
+	*x = CurrentLimits{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cemd_v1_cemd_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CurrentLimits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurrentLimits) ProtoMessage() {}
+
+func (x *CurrentLimits) ProtoReflect() protoreflect.Message {
+	mi := &file_cemd_v1_cemd_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CurrentLimits.ProtoReflect.Descriptor instead.
+func (*CurrentLimits) Descriptor() ([]byte, []int) {
+	return file_cemd_v1_cemd_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CurrentLimits) GetMinimum() []float64 {
+	if x != nil {
+		return x.Minimum
+	}
+	return nil
+}
+
+func (x *CurrentLimits) GetMaximum() []float64 {
+	if x != nil {
+		return x.Maximum
+	}
+	return nil
+}
+
+func (x *CurrentLimits) GetDefault() []float64 {
+	if x != nil {
+		return x.Default
+	}
+	return nil
+}
+
+type LoadLimitsPhase struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the phase, "a", "b" or "c"
+	Phase string `protobuf:"bytes,1,opt,name=phase,proto3" json:"phase,omitempty"`
+	// ignored when writing data
+	IsChangeable bool `protobuf:"varint,2,opt,name=is_changeable,json=isChangeable,proto3" json:"is_changeable,omitempty"`
+	IsActive     bool `protobuf:"varint,3,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	// the limit in A
+	Value float64 `protobuf:"fixed64,4,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *LoadLimitsPhase) Reset() {
+	*x = LoadLimitsPhase{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cemd_v1_cemd_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoadLimitsPhase) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoadLimitsPhase) ProtoMessage() {}
+
+func (x *LoadLimitsPhase) ProtoReflect() protoreflect.Message {
+	mi := &file_cemd_v1_cemd_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoadLimitsPhase.ProtoReflect.Descriptor instead.
+func (*LoadLimitsPhase) Descriptor() ([]byte, []int) {
+	return file_cemd_v1_cemd_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *LoadLimitsPhase) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+func (x *LoadLimitsPhase) GetIsChangeable() bool {
+	if x != nil {
+		return x.IsChangeable
+	}
+	return false
+}
+
+func (x *LoadLimitsPhase) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *LoadLimitsPhase) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type LoadLimitsPhases struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Limits []*LoadLimitsPhase `protobuf:"bytes,1,rep,name=limits,proto3" json:"limits,omitempty"`
+}
+
+func (x *LoadLimitsPhases) Reset() {
+	*x = LoadLimitsPhases{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cemd_v1_cemd_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoadLimitsPhases) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoadLimitsPhases) ProtoMessage() {}
+
+func (x *LoadLimitsPhases) ProtoReflect() protoreflect.Message {
+	mi := &file_cemd_v1_cemd_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoadLimitsPhases.ProtoReflect.Descriptor instead.
+func (*LoadLimitsPhases) Descriptor() ([]byte, []int) {
+	return file_cemd_v1_cemd_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *LoadLimitsPhases) GetLimits() []*LoadLimitsPhase {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+type WriteLoadLimitsPhasesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ski    string             `protobuf:"bytes,1,opt,name=ski,proto3" json:"ski,omitempty"`
+	Entity string             `protobuf:"bytes,2,opt,name=entity,proto3" json:"entity,omitempty"`
+	Limits []*LoadLimitsPhase `protobuf:"bytes,3,rep,name=limits,proto3" json:"limits,omitempty"`
+}
+
+func (x *WriteLoadLimitsPhasesRequest) Reset() {
+	*x = WriteLoadLimitsPhasesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cemd_v1_cemd_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WriteLoadLimitsPhasesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WriteLoadLimitsPhasesRequest) ProtoMessage() {}
+
+func (x *WriteLoadLimitsPhasesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cemd_v1_cemd_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WriteLoadLimitsPhasesRequest.ProtoReflect.Descriptor instead.
+func (*WriteLoadLimitsPhasesRequest) Descriptor() ([]byte, []int) {
+	return file_cemd_v1_cemd_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *WriteLoadLimitsPhasesRequest) GetSki() string {
+	if x != nil {
+		return x.Ski
+	}
+	return ""
+}
+
+func (x *WriteLoadLimitsPhasesRequest) GetEntity() string {
+	if x != nil {
+		return x.Entity
+	}
+	return ""
+}
+
+func (x *WriteLoadLimitsPhasesRequest) GetLimits() []*LoadLimitsPhase {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+// the demand of the EV, see api.Demand
+type Demand struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MinDemand          float64 `protobuf:"fixed64,1,opt,name=min_demand,json=minDemand,proto3" json:"min_demand,omitempty"`
+	OptDemand          float64 `protobuf:"fixed64,2,opt,name=opt_demand,json=optDemand,proto3" json:"opt_demand,omitempty"`
+	MaxDemand          float64 `protobuf:"fixed64,3,opt,name=max_demand,json=maxDemand,proto3" json:"max_demand,omitempty"`
+	DurationUntilStart float64 `protobuf:"fixed64,4,opt,name=duration_until_start,json=durationUntilStart,proto3" json:"duration_until_start,omitempty"`
+	DurationUntilEnd   float64 `protobuf:"fixed64,5,opt,name=duration_until_end,json=durationUntilEnd,proto3" json:"duration_until_end,omitempty"`
+}
+
+func (x *Demand) Reset() {
+	*x = Demand{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cemd_v1_cemd_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Demand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Demand) ProtoMessage() {}
+
+func (x *Demand) ProtoReflect() protoreflect.Message {
+	mi := &file_cemd_v1_cemd_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Demand.ProtoReflect.Descriptor instead.
+func (*Demand) Descriptor() ([]byte, []int) {
+	return file_cemd_v1_cemd_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *Demand) GetMinDemand() float64 {
+	if x != nil {
+		return x.MinDemand
+	}
+	return 0
+}
+
+func (x *Demand) GetOptDemand() float64 {
+	if x != nil {
+		return x.OptDemand
+	}
+	return 0
+}
+
+func (x *Demand) GetMaxDemand() float64 {
+	if x != nil {
+		return x.MaxDemand
+	}
+	return 0
+}
+
+func (x *Demand) GetDurationUntilStart() float64 {
+	if x != nil {
+		return x.DurationUntilStart
+	}
+	return 0
+}
+
+func (x *Demand) GetDurationUntilEnd() float64 {
+	if x != nil {
+		return x.DurationUntilEnd
+	}
+	return 0
+}
+
+type TimeSlotConstraints struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MinSlots             uint32               `protobuf:"varint,1,opt,name=min_slots,json=minSlots,proto3" json:"min_slots,omitempty"`
+	MaxSlots             uint32               `protobuf:"varint,2,opt,name=max_slots,json=maxSlots,proto3" json:"max_slots,omitempty"`
+	MinSlotDuration      *durationpb.Duration `protobuf:"bytes,3,opt,name=min_slot_duration,json=minSlotDuration,proto3" json:"min_slot_duration,omitempty"`
+	MaxSlotDuration      *durationpb.Duration `protobuf:"bytes,4,opt,name=max_slot_duration,json=maxSlotDuration,proto3" json:"max_slot_duration,omitempty"`
+	SlotDurationStepSize *durationpb.Duration `protobuf:"bytes,5,opt,name=slot_duration_step_size,json=slotDurationStepSize,proto3" json:"slot_duration_step_size,omitempty"`
+}
+
+func (x *TimeSlotConstraints) Reset() {
+	*x = TimeSlotConstraints{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cemd_v1_cemd_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TimeSlotConstraints) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeSlotConstraints) ProtoMessage() {}
+
+func (x *TimeSlotConstraints) ProtoReflect() protoreflect.Message {
+	mi := &file_cemd_v1_cemd_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeSlotConstraints.ProtoReflect.Descriptor instead.
+func (*TimeSlotConstraints) Descriptor() ([]byte, []int) {
+	return file_cemd_v1_cemd_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *TimeSlotConstraints) GetMinSlots() uint32 {
+	if x != nil {
+		return x.MinSlots
+	}
+	return 0
+}
+
+func (x *TimeSlotConstraints) GetMaxSlots() uint32 {
+	if x != nil {
+		return x.MaxSlots
+	}
+	return 0
+}
+
+func (x *TimeSlotConstraints) GetMinSlotDuration() *durationpb.Duration {
+	if x != nil {
+		return x.MinSlotDuration
+	}
+	return nil
+}
+
+func (x *TimeSlotConstraints) GetMaxSlotDuration() *durationpb.Duration {
+	if x != nil {
+		return x.MaxSlotDuration
+	}
+	return nil
+}
+
+func (x *TimeSlotConstraints) GetSlotDurationStepSize() *durationpb.Duration {
+	if x != nil {
+		return x.SlotDurationStepSize
+	}
+	return nil
+}
+
+type IncentiveSlotConstraints struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MinSlots uint32 `protobuf:"varint,1,opt,name=min_slots,json=minSlots,proto3" json:"min_slots,omitempty"`
+	MaxSlots uint32 `protobuf:"varint,2,opt,name=max_slots,json=maxSlots,proto3" json:"max_slots,omitempty"`
+}
+
+func (x *IncentiveSlotConstraints) Reset() {
+	*x = IncentiveSlotConstraints{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cemd_v1_cemd_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IncentiveSlotConstraints) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IncentiveSlotConstraints) ProtoMessage() {}
+
+func (x *IncentiveSlotConstraints) ProtoReflect() protoreflect.Message {
+	mi := &file_cemd_v1_cemd_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IncentiveSlotConstraints.ProtoReflect.Descriptor instead.
+func (*IncentiveSlotConstraints) Descriptor() ([]byte, []int) {
+	return file_cemd_v1_cemd_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *IncentiveSlotConstraints) GetMinSlots() uint32 {
+	if x != nil {
+		return x.MinSlots
+	}
+	return 0
+}
+
+func (x *IncentiveSlotConstraints) GetMaxSlots() uint32 {
+	if x != nil {
+		return x.MaxSlots
+	}
+	return 0
+}
+
+type DurationSlotValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Duration *durationpb.Duration `protobuf:"bytes,1,opt,name=duration,proto3" json:"duration,omitempty"`
+	Value    float64              `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *DurationSlotValue) Reset() {
+	*x = DurationSlotValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cemd_v1_cemd_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DurationSlotValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DurationSlotValue) ProtoMessage() {}
+
+func (x *DurationSlotValue) ProtoReflect() protoreflect.Message {
+	mi := &file_cemd_v1_cemd_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DurationSlotValue.ProtoReflect.Descriptor instead.
+func (*DurationSlotValue) Descriptor() ([]byte, []int) {
+	return file_cemd_v1_cemd_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *DurationSlotValue) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *DurationSlotValue) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type DurationSlotValues struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slots []*DurationSlotValue `protobuf:"bytes,1,rep,name=slots,proto3" json:"slots,omitempty"`
+}
+
+func (x *DurationSlotValues) Reset() {
+	*x = DurationSlotValues{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cemd_v1_cemd_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DurationSlotValues) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DurationSlotValues) ProtoMessage() {}
+
+func (x *DurationSlotValues) ProtoReflect() protoreflect.Message {
+	mi := &file_cemd_v1_cemd_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DurationSlotValues.ProtoReflect.Descriptor instead.
+func (*DurationSlotValues) Descriptor() ([]byte, []int) {
+	return file_cemd_v1_cemd_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *DurationSlotValues) GetSlots() []*DurationSlotValue {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+type WriteDurationSlotValuesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ski    string               `protobuf:"bytes,1,opt,name=ski,proto3" json:"ski,omitempty"`
+	Entity string               `protobuf:"bytes,2,opt,name=entity,proto3" json:"entity,omitempty"`
+	Slots  []*DurationSlotValue `protobuf:"bytes,3,rep,name=slots,proto3" json:"slots,omitempty"`
+}
+
+func (x *WriteDurationSlotValuesRequest) Reset() {
+	*x = WriteDurationSlotValuesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cemd_v1_cemd_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WriteDurationSlotValuesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WriteDurationSlotValuesRequest) ProtoMessage() {}
+
+func (x *WriteDurationSlotValuesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cemd_v1_cemd_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WriteDurationSlotValuesRequest.ProtoReflect.Descriptor instead.
+func (*WriteDurationSlotValuesRequest) Descriptor() ([]byte, []int) {
+	return file_cemd_v1_cemd_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *WriteDurationSlotValuesRequest) GetSki() string {
+	if x != nil {
+		return x.Ski
+	}
+	return ""
+}
+
+func (x *WriteDurationSlotValuesRequest) GetEntity() string {
+	if x != nil {
+		return x.Entity
+	}
+	return ""
+}
+
+func (x *WriteDurationSlotValuesRequest) GetSlots() []*DurationSlotValue {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+type TierBoundaryDescription struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Unit string `protobuf:"bytes,3,opt,name=unit,proto3" json:"unit,omitempty"`
+}
+
+func (x *TierBoundaryDescription) Reset() {
+	*x = TierBoundaryDescription{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cemd_v1_cemd_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TierBoundaryDescription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TierBoundaryDescription) ProtoMessage() {}
+
+func (x *TierBoundaryDescription) ProtoReflect() protoreflect.Message {
+	mi := &file_cemd_v1_cemd_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TierBoundaryDescription.ProtoReflect.Descriptor instead.
+func (*TierBoundaryDescription) Descriptor() ([]byte, []int) {
+	return file_cemd_v1_cemd_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *TierBoundaryDescription) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TierBoundaryDescription) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *TierBoundaryDescription) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+type IncentiveDescription struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type     string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Currency string `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+}
+
+func (x *IncentiveDescription) Reset() {
+	*x = IncentiveDescription{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cemd_v1_cemd_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IncentiveDescription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IncentiveDescription) ProtoMessage() {}
+
+func (x *IncentiveDescription) ProtoReflect() protoreflect.Message {
+	mi := &file_cemd_v1_cemd_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IncentiveDescription.ProtoReflect.Descriptor instead.
+func (*IncentiveDescription) Descriptor() ([]byte, []int) {
+	return file_cemd_v1_cemd_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *IncentiveDescription) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *IncentiveDescription) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *IncentiveDescription) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type IncentiveTableDescriptionTier struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         uint32                     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type       string                     `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Boundaries []*TierBoundaryDescription `protobuf:"bytes,3,rep,name=boundaries,proto3" json:"boundaries,omitempty"`
+	Incentives []*IncentiveDescription    `protobuf:"bytes,4,rep,name=incentives,proto3" json:"incentives,omitempty"`
+}
+
+func (x *IncentiveTableDescriptionTier) Reset() {
+	*x = IncentiveTableDescriptionTier{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cemd_v1_cemd_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IncentiveTableDescriptionTier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IncentiveTableDescriptionTier) ProtoMessage() {}
+
+func (x *IncentiveTableDescriptionTier) ProtoReflect() protoreflect.Message {
+	mi := &file_cemd_v1_cemd_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IncentiveTableDescriptionTier.ProtoReflect.Descriptor instead.
+func (*IncentiveTableDescriptionTier) Descriptor() ([]byte, []int) {
+	return file_cemd_v1_cemd_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *IncentiveTableDescriptionTier) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *IncentiveTableDescriptionTier) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *IncentiveTableDescriptionTier) GetBoundaries() []*TierBoundaryDescription {
+	if x != nil {
+		return x.Boundaries
+	}
+	return nil
+}
+
+func (x *IncentiveTableDescriptionTier) GetIncentives() []*IncentiveDescription {
+	if x != nil {
+		return x.Incentives
+	}
+	return nil
+}
+
+type IncentiveTariffDescription struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tiers []*IncentiveTableDescriptionTier `protobuf:"bytes,1,rep,name=tiers,proto3" json:"tiers,omitempty"`
+}
+
+func (x *IncentiveTariffDescription) Reset() {
+	*x = IncentiveTariffDescription{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cemd_v1_cemd_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IncentiveTariffDescription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IncentiveTariffDescription) ProtoMessage() {}
+
+func (x *IncentiveTariffDescription) ProtoReflect() protoreflect.Message {
+	mi := &file_cemd_v1_cemd_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IncentiveTariffDescription.ProtoReflect.Descriptor instead.
+func (*IncentiveTariffDescription) Descriptor() ([]byte, []int) {
+	return file_cemd_v1_cemd_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *IncentiveTariffDescription) GetTiers() []*IncentiveTableDescriptionTier {
+	if x != nil {
+		return x.Tiers
+	}
+	return nil
+}
+
+type WriteIncentiveTableDescriptionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ski          string                        `protobuf:"bytes,1,opt,name=ski,proto3" json:"ski,omitempty"`
+	Entity       string                        `protobuf:"bytes,2,opt,name=entity,proto3" json:"entity,omitempty"`
+	Descriptions []*IncentiveTariffDescription `protobuf:"bytes,3,rep,name=descriptions,proto3" json:"descriptions,omitempty"`
+}
+
+func (x *WriteIncentiveTableDescriptionsRequest) Reset() {
+	*x = WriteIncentiveTableDescriptionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cemd_v1_cemd_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WriteIncentiveTableDescriptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WriteIncentiveTableDescriptionsRequest) ProtoMessage() {}
+
+func (x *WriteIncentiveTableDescriptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cemd_v1_cemd_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WriteIncentiveTableDescriptionsRequest.ProtoReflect.Descriptor instead.
+func (*WriteIncentiveTableDescriptionsRequest) Descriptor() ([]byte, []int) {
+	return file_cemd_v1_cemd_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *WriteIncentiveTableDescriptionsRequest) GetSki() string {
+	if x != nil {
+		return x.Ski
+	}
+	return ""
+}
+
+func (x *WriteIncentiveTableDescriptionsRequest) GetEntity() string {
+	if x != nil {
+		return x.Entity
+	}
+	return ""
+}
+
+func (x *WriteIncentiveTableDescriptionsRequest) GetDescriptions() []*IncentiveTariffDescription {
+	if x != nil {
+		return x.Descriptions
+	}
+	return nil
+}
+
+type ChargePlanSlotValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Start    *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	End      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
+	Value    float64                `protobuf:"fixed64,3,opt,name=value,proto3" json:"value,omitempty"`
+	MinValue float64                `protobuf:"fixed64,4,opt,name=min_value,json=minValue,proto3" json:"min_value,omitempty"`
+	MaxValue float64                `protobuf:"fixed64,5,opt,name=max_value,json=maxValue,proto3" json:"max_value,omitempty"`
+}
+
+func (x *ChargePlanSlotValue) Reset() {
+	*x = ChargePlanSlotValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cemd_v1_cemd_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChargePlanSlotValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChargePlanSlotValue) ProtoMessage() {}
+
+func (x *ChargePlanSlotValue) ProtoReflect() protoreflect.Message {
+	mi := &file_cemd_v1_cemd_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChargePlanSlotValue.ProtoReflect.Descriptor instead.
+func (*ChargePlanSlotValue) Descriptor() ([]byte, []int) {
+	return file_cemd_v1_cemd_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ChargePlanSlotValue) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *ChargePlanSlotValue) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+func (x *ChargePlanSlotValue) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *ChargePlanSlotValue) GetMinValue() float64 {
+	if x != nil {
+		return x.MinValue
+	}
+	return 0
+}
+
+func (x *ChargePlanSlotValue) GetMaxValue() float64 {
+	if x != nil {
+		return x.MaxValue
+	}
+	return 0
+}
+
+type ChargePlan struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slots []*ChargePlanSlotValue `protobuf:"bytes,1,rep,name=slots,proto3" json:"slots,omitempty"`
+}
+
+func (x *ChargePlan) Reset() {
+	*x = ChargePlan{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cemd_v1_cemd_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChargePlan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChargePlan) ProtoMessage() {}
+
+func (x *ChargePlan) ProtoReflect() protoreflect.Message {
+	mi := &file_cemd_v1_cemd_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChargePlan.ProtoReflect.Descriptor instead.
+func (*ChargePlan) Descriptor() ([]byte, []int) {
+	return file_cemd_v1_cemd_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ChargePlan) GetSlots() []*ChargePlanSlotValue {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+var File_cemd_v1_cemd_proto protoreflect.FileDescriptor
+
+var file_cemd_v1_cemd_proto_rawDesc = []byte{
+	0x0a, 0x12, 0x63, 0x65, 0x6d, 0x64, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x65, 0x6d, 0x64, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x63, 0x65, 0x6d, 0x64, 0x2e, 0x76, 0x31, 0x1a, 0x1e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
+	0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x14,
+	0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x40, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x07, 0x64,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63,
+	0x65, 0x6d, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x07, 0x64,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x22, 0x24, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x44, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x6b,
+	0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x6b, 0x69, 0x22, 0x82, 0x01, 0x0a,
+	0x06, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x6b, 0x69, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x6b, 0x69, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x2b, 0x0a, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x65, 0x6d, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65,
+	0x73, 0x22, 0x60, 0x0a, 0x06, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x5f, 0x63, 0x61,
+	0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x43, 0x61,
+	0x73, 0x65, 0x73, 0x22, 0x46, 0x0a, 0x13, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6b,
+	0x69, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6b, 0x69, 0x73, 0x12, 0x1b,
+	0x0a, 0x09, 0x75, 0x73, 0x65, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x43, 0x61, 0x73, 0x65, 0x73, 0x22, 0x62, 0x0a, 0x05, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x6b, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x73, 0x6b, 0x69, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x19,
+	0x0a, 0x08, 0x75, 0x73, 0x65, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x75, 0x73, 0x65, 0x43, 0x61, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22,
+	0x39, 0x0a, 0x0d, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x10, 0x0a, 0x03, 0x73, 0x6b, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73,
+	0x6b, 0x69, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0x25, 0x0a, 0x0b, 0x50, 0x68,
+	0x61, 0x73, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x01, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x22, 0x45, 0x0a, 0x0d, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x24, 0x0a, 0x0b, 0x6d, 0x73, 0x67, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x65, 0x72, 0x88, 0x01, 0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x6d, 0x73, 0x67,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x22, 0x54, 0x0a, 0x12, 0x57, 0x72, 0x69, 0x74,
+	0x65, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10,
+	0x0a, 0x03, 0x73, 0x6b, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x6b, 0x69,
+	0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x71,
+	0x0a, 0x14, 0x57, 0x72, 0x69, 0x74, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x6b, 0x69, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x6b, 0x69, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x12, 0x2f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x22, 0x8b, 0x04, 0x0a, 0x10, 0x4d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72,
+	0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x72, 0x69,
+	0x61, 0x6c, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x2b, 0x0a,
+	0x11, 0x73, 0x6f, 0x66, 0x74, 0x77, 0x61, 0x72, 0x65, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x73, 0x6f, 0x66, 0x74, 0x77, 0x61,
+	0x72, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x11, 0x68, 0x61,
+	0x72, 0x64, 0x77, 0x61, 0x72, 0x65, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x68, 0x61, 0x72, 0x64, 0x77, 0x61, 0x72, 0x65, 0x52,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x76, 0x65, 0x6e, 0x64, 0x6f,
+	0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x76, 0x65,
+	0x6e, 0x64, 0x6f, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x76, 0x65, 0x6e, 0x64,
+	0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x76,
+	0x65, 0x6e, 0x64, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x72, 0x61,
+	0x6e, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62,
+	0x72, 0x61, 0x6e, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x6f, 0x77, 0x65,
+	0x72, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x70, 0x6f, 0x77, 0x65, 0x72, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x48, 0x0a, 0x20, 0x6d,
+	0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72, 0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x64, 0x65,
+	0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1e, 0x6d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75,
+	0x72, 0x65, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x12, 0x6d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63,
+	0x74, 0x75, 0x72, 0x65, 0x72, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x11, 0x6d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72, 0x65, 0x72, 0x4c,
+	0x61, 0x62, 0x65, 0x6c, 0x12, 0x39, 0x0a, 0x18, 0x6d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74,
+	0x75, 0x72, 0x65, 0x72, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x6d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74,
+	0x75, 0x72, 0x65, 0x72, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x9a, 0x01, 0x0a, 0x09, 0x4c, 0x6f, 0x61, 0x64, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x35, 0x0a,
+	0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x73, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x73, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73,
+	0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x6b, 0x0a, 0x15,
+	0x57, 0x72, 0x69, 0x74, 0x65, 0x4c, 0x6f, 0x61, 0x64, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x6b, 0x69, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x73, 0x6b, 0x69, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12,
+	0x28, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x63, 0x65, 0x6d, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x45, 0x0a, 0x0e, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x22, 0x54, 0x0a, 0x0f, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x41, 0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63,
+	0x65, 0x6d, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x63, 0x0a, 0x13, 0x43, 0x68, 0x61, 0x72, 0x67, 0x69,
+	0x6e, 0x67, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07,
+	0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x69, 0x6d,
+	0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75,
+	0x6d, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x62, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x07, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x62, 0x79, 0x22, 0x4e, 0x0a, 0x0e, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x61,
+	0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x5d, 0x0a, 0x0d, 0x43,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x03, 0x28, 0x01, 0x52, 0x07, 0x6d,
+	0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75,
+	0x6d, 0x18, 0x02, 0x20, 0x03, 0x28, 0x01, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d,
+	0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x01, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x22, 0x7f, 0x0a, 0x0f, 0x4c, 0x6f,
+	0x61, 0x64, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x50, 0x68, 0x61, 0x73, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68,
+	0x61, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x73, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x61, 0x62, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x73, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x41,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x44, 0x0a, 0x10, 0x4c,
+	0x6f, 0x61, 0x64, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x50, 0x68, 0x61, 0x73, 0x65, 0x73, 0x12,
+	0x30, 0x0a, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x63, 0x65, 0x6d, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x73, 0x50, 0x68, 0x61, 0x73, 0x65, 0x52, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x73, 0x22, 0x7a, 0x0a, 0x1c, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4c, 0x6f, 0x61, 0x64, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x73, 0x50, 0x68, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x6b, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x73, 0x6b, 0x69, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x30, 0x0a, 0x06, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x65,
+	0x6d, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73,
+	0x50, 0x68, 0x61, 0x73, 0x65, 0x52, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x22, 0xc5, 0x01,
+	0x0a, 0x06, 0x44, 0x65, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x5f,
+	0x64, 0x65, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6d, 0x69,
+	0x6e, 0x44, 0x65, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x70, 0x74, 0x5f, 0x64,
+	0x65, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6f, 0x70, 0x74,
+	0x44, 0x65, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x65,
+	0x6d, 0x61, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x44,
+	0x65, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x12, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x6e, 0x74,
+	0x69, 0x6c, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x64, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x10, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x6e, 0x74,
+	0x69, 0x6c, 0x45, 0x6e, 0x64, 0x22, 0xaf, 0x02, 0x0a, 0x13, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x6c,
+	0x6f, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x1b, 0x0a,
+	0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x08, 0x6d, 0x69, 0x6e, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61,
+	0x78, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6d,
+	0x61, 0x78, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x12, 0x45, 0x0a, 0x11, 0x6d, 0x69, 0x6e, 0x5f, 0x73,
+	0x6c, 0x6f, 0x74, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x6d,
+	0x69, 0x6e, 0x53, 0x6c, 0x6f, 0x74, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x45,
+	0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x53, 0x6c, 0x6f, 0x74, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x50, 0x0a, 0x17, 0x73, 0x6c, 0x6f, 0x74, 0x5f, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x14, 0x73, 0x6c, 0x6f, 0x74, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x65, 0x70, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x54, 0x0a, 0x18, 0x49, 0x6e, 0x63, 0x65, 0x6e,
+	0x74, 0x69, 0x76, 0x65, 0x53, 0x6c, 0x6f, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69,
+	0x6e, 0x74, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x53, 0x6c, 0x6f, 0x74, 0x73,
+	0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x22, 0x60, 0x0a,
+	0x11, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x6c, 0x6f, 0x74, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22,
+	0x46, 0x0a, 0x12, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x6c, 0x6f, 0x74, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x65, 0x6d, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x6c, 0x6f, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x22, 0x7c, 0x0a, 0x1e, 0x57, 0x72, 0x69, 0x74, 0x65,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x6c, 0x6f, 0x74, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x6b, 0x69,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x6b, 0x69, 0x12, 0x16, 0x0a, 0x06, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x65, 0x6d, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x6c, 0x6f, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05,
+	0x73, 0x6c, 0x6f, 0x74, 0x73, 0x22, 0x51, 0x0a, 0x17, 0x54, 0x69, 0x65, 0x72, 0x42, 0x6f, 0x75,
+	0x6e, 0x64, 0x61, 0x72, 0x79, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x22, 0x56, 0x0a, 0x14, 0x49, 0x6e, 0x63, 0x65,
+	0x6e, 0x74, 0x69, 0x76, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x22, 0xc4, 0x01, 0x0a, 0x1d, 0x49, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x76, 0x65, 0x54, 0x61,
+	0x62, 0x6c, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69,
+	0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x40, 0x0a, 0x0a, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x61,
+	0x72, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x65, 0x6d,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x65, 0x72, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72,
+	0x79, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x62, 0x6f,
+	0x75, 0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x3d, 0x0a, 0x0a, 0x69, 0x6e, 0x63, 0x65,
+	0x6e, 0x74, 0x69, 0x76, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63,
+	0x65, 0x6d, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x76, 0x65,
+	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x69, 0x6e, 0x63,
+	0x65, 0x6e, 0x74, 0x69, 0x76, 0x65, 0x73, 0x22, 0x5a, 0x0a, 0x1a, 0x49, 0x6e, 0x63, 0x65, 0x6e,
+	0x74, 0x69, 0x76, 0x65, 0x54, 0x61, 0x72, 0x69, 0x66, 0x66, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3c, 0x0a, 0x05, 0x74, 0x69, 0x65, 0x72, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x65, 0x6d, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x49,
+	0x6e, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x76, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x65, 0x72, 0x52, 0x05, 0x74, 0x69,
+	0x65, 0x72, 0x73, 0x22, 0x9b, 0x01, 0x0a, 0x26, 0x57, 0x72, 0x69, 0x74, 0x65, 0x49, 0x6e, 0x63,
+	0x65, 0x6e, 0x74, 0x69, 0x76, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10,
+	0x0a, 0x03, 0x73, 0x6b, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x6b, 0x69,
+	0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x47, 0x0a, 0x0c, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23,
+	0x2e, 0x63, 0x65, 0x6d, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x69,
+	0x76, 0x65, 0x54, 0x61, 0x72, 0x69, 0x66, 0x66, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x22, 0xc5, 0x01, 0x0a, 0x13, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x50, 0x6c, 0x61, 0x6e,
+	0x53, 0x6c, 0x6f, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x2c, 0x0a, 0x03, 0x65,
+	0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x6d, 0x61, 0x78, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x08, 0x6d, 0x61, 0x78, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x40, 0x0a, 0x0a, 0x43, 0x68, 0x61,
+	0x72, 0x67, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x32, 0x0a, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x65, 0x6d, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x53, 0x6c, 0x6f, 0x74, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x32, 0x92, 0x01, 0x0a, 0x0d,
+	0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x48, 0x0a,
+	0x0b, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x1b, 0x2e, 0x63,
+	0x65, 0x6d, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x63, 0x65, 0x6d, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x44, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x19, 0x2e, 0x63, 0x65, 0x6d, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x0f, 0x2e, 0x63, 0x65, 0x6d, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x32, 0x4e, 0x0a, 0x0c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x3e, 0x0a, 0x0c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x12, 0x1c, 0x2e, 0x63, 0x65, 0x6d, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e,
+	0x2e, 0x63, 0x65, 0x6d, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01,
+	0x32, 0xe0, 0x04, 0x0a, 0x0a, 0x4c, 0x50, 0x43, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x41, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x2e, 0x63, 0x65, 0x6d, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12,
+	0x2e, 0x63, 0x65, 0x6d, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x12, 0x4f, 0x0a, 0x15, 0x57, 0x72, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x75,
+	0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1e, 0x2e, 0x63, 0x65,
+	0x6d, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4c, 0x6f, 0x61, 0x64, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x63, 0x65,
+	0x6d, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x26, 0x47, 0x65, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x73, 0x61,
+	0x66, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x2e,
+	0x63, 0x65, 0x6d, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x5f, 0x0a, 0x28, 0x57, 0x72, 0x69, 0x74, 0x65, 0x46, 0x61, 0x69, 0x6c,
+	0x73, 0x61, 0x66, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x41,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12,
+	0x1b, 0x2e, 0x63, 0x65, 0x6d, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x44,
+	0x6f, 0x75, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x63,
+	0x65, 0x6d, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x73,
+	0x61, 0x66, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x69, 0x6e, 0x69, 0x6d,
+	0x75, 0x6d, 0x12, 0x16, 0x2e, 0x63, 0x65, 0x6d, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x55, 0x0a, 0x1c, 0x57, 0x72, 0x69, 0x74, 0x65, 0x46, 0x61,
+	0x69, 0x6c, 0x73, 0x61, 0x66, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x69,
+	0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x12, 0x1d, 0x2e, 0x63, 0x65, 0x6d, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x57, 0x72, 0x69, 0x74, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x63, 0x65, 0x6d, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x57,
+	0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x1d,
+	0x47, 0x65, 0x74, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x4d, 0x61, 0x78, 0x12, 0x16, 0x2e,
+	0x63, 0x65, 0x6d, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x32, 0xdb, 0x04, 0x0a, 0x0a, 0x4c, 0x50, 0x50, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x40, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x2e, 0x63, 0x65, 0x6d, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x12, 0x2e, 0x63, 0x65, 0x6d, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x12, 0x4e, 0x0a, 0x14, 0x57, 0x72, 0x69, 0x74, 0x65, 0x50, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1e, 0x2e, 0x63,
+	0x65, 0x6d, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4c, 0x6f, 0x61, 0x64,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x63,
+	0x65, 0x6d, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5d, 0x0a, 0x25, 0x47, 0x65, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x73,
+	0x61, 0x66, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x2e,
+	0x63, 0x65, 0x6d, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x5e, 0x0a, 0x27, 0x57, 0x72, 0x69, 0x74, 0x65, 0x46, 0x61, 0x69, 0x6c,
+	0x73, 0x61, 0x66, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1b,
+	0x2e, 0x63, 0x65, 0x6d, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x44, 0x6f,
+	0x75, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x63, 0x65,
+	0x6d, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x73, 0x61,
+	0x66, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x69, 0x6e, 0x69, 0x6d, 0x75,
+	0x6d, 0x12, 0x16, 0x2e, 0x63, 0x65, 0x6d, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x55, 0x0a, 0x1c, 0x57, 0x72, 0x69, 0x74, 0x65, 0x46, 0x61, 0x69,
+	0x6c, 0x73, 0x61, 0x66, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x69, 0x6e,
+	0x69, 0x6d, 0x75, 0x6d, 0x12, 0x1d, 0x2e, 0x63, 0x65, 0x6d, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x57,
+	0x72, 0x69, 0x74, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x63, 0x65, 0x6d, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x72,
+	0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x1c, 0x47,
+	0x65, 0x74, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x4e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x4d, 0x61, 0x78, 0x12, 0x16, 0x2e, 0x63, 0x65,
+	0x6d, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x32, 0x83, 0x04, 0x0a, 0x0b, 0x4d, 0x47, 0x43, 0x50, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x50, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x2e,
+	0x63, 0x65, 0x6d, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x40, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x12,
+	0x16, 0x2e, 0x63, 0x65, 0x6d, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x47, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x65, 0x72,
+	0x67, 0x79, 0x46, 0x65, 0x65, 0x64, 0x49, 0x6e, 0x12, 0x16, 0x2e, 0x63, 0x65, 0x6d, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x49,
+	0x0a, 0x11, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x43, 0x6f, 0x6e, 0x73, 0x75,
+	0x6d, 0x65, 0x64, 0x12, 0x16, 0x2e, 0x63, 0x65, 0x6d, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f,
+	0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x42, 0x0a, 0x12, 0x47, 0x65, 0x74,
+	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x65, 0x72, 0x50, 0x68, 0x61, 0x73, 0x65, 0x12,
+	0x16, 0x2e, 0x63, 0x65, 0x6d, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x63, 0x65, 0x6d, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x68, 0x61, 0x73, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x42, 0x0a,
+	0x12, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x74, 0x61, 0x67, 0x65, 0x50, 0x65, 0x72, 0x50, 0x68,
+	0x61, 0x73, 0x65, 0x12, 0x16, 0x2e, 0x63, 0x65, 0x6d, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x63, 0x65,
+	0x6d, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x68, 0x61, 0x73, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x12, 0x44, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
+	0x79, 0x12, 0x16, 0x2e, 0x63, 0x65, 0x6d, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62,
+	0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x32, 0xf4, 0x03, 0x0a, 0x0a, 0x4d, 0x50, 0x43, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x40, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x77,
+	0x65, 0x72, 0x12, 0x16, 0x2e, 0x63, 0x65, 0x6d, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75,
+	0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x40, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50,
+	0x6f, 0x77, 0x65, 0x72, 0x50, 0x65, 0x72, 0x50, 0x68, 0x61, 0x73, 0x65, 0x12, 0x16, 0x2e, 0x63,
+	0x65, 0x6d, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x63, 0x65, 0x6d, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x68, 0x61, 0x73, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x49, 0x0a, 0x11, 0x47, 0x65,
+	0x74, 0x45, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x12,
+	0x16, 0x2e, 0x63, 0x65, 0x6d, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x49, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x65, 0x72,
+	0x67, 0x79, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x64, 0x12, 0x16, 0x2e, 0x63, 0x65, 0x6d,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x42, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x65,
+	0x72, 0x50, 0x68, 0x61, 0x73, 0x65, 0x12, 0x16, 0x2e, 0x63, 0x65, 0x6d, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14,
+	0x2e, 0x63, 0x65, 0x6d, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x68, 0x61, 0x73, 0x65, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x12, 0x42, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x74, 0x61,
+	0x67, 0x65, 0x50, 0x65, 0x72, 0x50, 0x68, 0x61, 0x73, 0x65, 0x12, 0x16, 0x2e, 0x63, 0x65, 0x6d,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x14, 0x2e, 0x63, 0x65, 0x6d, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x68, 0x61,
+	0x73, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x46,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x16, 0x2e, 0x63, 0x65, 0x6d, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x32, 0xeb,
+	0x04, 0x0a, 0x0b, 0x45, 0x56, 0x43, 0x43, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x46,
+	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x16, 0x2e, 0x63, 0x65, 0x6d, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x44, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x45, 0x56, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x16, 0x2e, 0x63, 0x65, 0x6d, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x50, 0x0a, 0x18,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x72, 0x64, 0x12, 0x16, 0x2e, 0x63, 0x65, 0x6d, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x52,
+	0x0a, 0x1c, 0x47, 0x65, 0x74, 0x41, 0x73, 0x79, 0x6d, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x43,
+	0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x16,
+	0x2e, 0x63, 0x65, 0x6d, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x46, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x2e, 0x63, 0x65, 0x6d, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x18, 0x2e, 0x63, 0x65, 0x6d, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x48, 0x0a, 0x13, 0x47, 0x65,
+	0x74, 0x4d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72, 0x65, 0x72, 0x44, 0x61, 0x74,
+	0x61, 0x12, 0x16, 0x2e, 0x63, 0x65, 0x6d, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x63, 0x65, 0x6d, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72, 0x65, 0x72,
+	0x44, 0x61, 0x74, 0x61, 0x12, 0x4e, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x72, 0x67,
+	0x69, 0x6e, 0x67, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x16,
+	0x2e, 0x63, 0x65, 0x6d, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x63, 0x65, 0x6d, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x73, 0x12, 0x46, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x49, 0x73, 0x49, 0x6e, 0x53,
+	0x6c, 0x65, 0x65, 0x70, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x2e, 0x63, 0x65, 0x6d, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x32, 0xaa, 0x02, 0x0a,
+	0x0c, 0x45, 0x56, 0x43, 0x45, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4a, 0x0a,
+	0x12, 0x47, 0x65, 0x74, 0x50, 0x68, 0x61, 0x73, 0x65, 0x73, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x12, 0x16, 0x2e, 0x63, 0x65, 0x6d, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49,
+	0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x42, 0x0a, 0x12, 0x47, 0x65, 0x74,
+	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x65, 0x72, 0x50, 0x68, 0x61, 0x73, 0x65, 0x12,
+	0x16, 0x2e, 0x63, 0x65, 0x6d, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x63, 0x65, 0x6d, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x68, 0x61, 0x73, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x40, 0x0a,
+	0x10, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x50, 0x65, 0x72, 0x50, 0x68, 0x61, 0x73,
+	0x65, 0x12, 0x16, 0x2e, 0x63, 0x65, 0x6d, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x63, 0x65, 0x6d, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x68, 0x61, 0x73, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12,
+	0x48, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x43, 0x68, 0x61, 0x72,
+	0x67, 0x65, 0x64, 0x12, 0x16, 0x2e, 0x63, 0x65, 0x6d, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f,
+	0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x32, 0x9f, 0x01, 0x0a, 0x0d, 0x45, 0x56,
+	0x53, 0x45, 0x43, 0x43, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x48, 0x0a, 0x13, 0x47,
+	0x65, 0x74, 0x4d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72, 0x65, 0x72, 0x44, 0x61,
+	0x74, 0x61, 0x12, 0x16, 0x2e, 0x63, 0x65, 0x6d, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x63, 0x65, 0x6d,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72, 0x65,
+	0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x44, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16, 0x2e, 0x63, 0x65, 0x6d,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x17, 0x2e, 0x63, 0x65, 0x6d, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x65, 0x32, 0x58, 0x0a, 0x0c, 0x45,
+	0x56, 0x53, 0x4f, 0x43, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x48, 0x0a, 0x10, 0x47,
+	0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x12,
+	0x16, 0x2e, 0x63, 0x65, 0x6d, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x32, 0xf5, 0x01, 0x0a, 0x0b, 0x4f, 0x50, 0x45, 0x56, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x42, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x16, 0x2e, 0x63, 0x65, 0x6d, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x16, 0x2e, 0x63, 0x65, 0x6d, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x49, 0x0a, 0x14, 0x47, 0x65, 0x74,
+	0x4c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x73, 0x12, 0x16, 0x2e, 0x63, 0x65, 0x6d, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x63, 0x65, 0x6d, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x50, 0x68,
+	0x61, 0x73, 0x65, 0x73, 0x12, 0x57, 0x0a, 0x16, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4c, 0x6f, 0x61,
+	0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x25,
+	0x2e, 0x63, 0x65, 0x6d, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4c, 0x6f,
+	0x61, 0x64, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x50, 0x68, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x63, 0x65, 0x6d, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xf6, 0x01,
+	0x0a, 0x0c, 0x4f, 0x53, 0x43, 0x45, 0x56, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x42,
+	0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x73, 0x12, 0x16, 0x2e, 0x63, 0x65, 0x6d, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x63, 0x65, 0x6d,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x73, 0x12, 0x49, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x16, 0x2e, 0x63, 0x65, 0x6d,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x19, 0x2e, 0x63, 0x65, 0x6d, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x61,
+	0x64, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x50, 0x68, 0x61, 0x73, 0x65, 0x73, 0x12, 0x57, 0x0a,
+	0x16, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x25, 0x2e, 0x63, 0x65, 0x6d, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4c, 0x6f, 0x61, 0x64, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x73, 0x50, 0x68, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x63, 0x65, 0x6d, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xde, 0x05, 0x0a, 0x0b, 0x43, 0x45, 0x56, 0x43, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x49, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61,
+	0x72, 0x67, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x16, 0x2e, 0x63, 0x65,
+	0x6d, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x3a, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x44, 0x65,
+	0x6d, 0x61, 0x6e, 0x64, 0x12, 0x16, 0x2e, 0x63, 0x65, 0x6d, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x45,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x63,
+	0x65, 0x6d, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x4e, 0x0a,
+	0x16, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x6c, 0x6f, 0x74, 0x43, 0x6f, 0x6e, 0x73,
+	0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x2e, 0x63, 0x65, 0x6d, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1c, 0x2e, 0x63, 0x65, 0x6d, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x6c,
+	0x6f, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x53, 0x0a,
+	0x10, 0x57, 0x72, 0x69, 0x74, 0x65, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x73, 0x12, 0x27, 0x2e, 0x63, 0x65, 0x6d, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x72, 0x69, 0x74,
+	0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x6c, 0x6f, 0x74, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x63, 0x65, 0x6d,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x54, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x69,
+	0x76, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x2e,
+	0x63, 0x65, 0x6d, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x63, 0x65, 0x6d, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x49, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x76, 0x65, 0x53, 0x6c, 0x6f, 0x74, 0x43, 0x6f, 0x6e,
+	0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x6a, 0x0a, 0x1f, 0x57, 0x72, 0x69, 0x74,
+	0x65, 0x49, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x76, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x44,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2f, 0x2e, 0x63, 0x65,
+	0x6d, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x49, 0x6e, 0x63, 0x65, 0x6e,
+	0x74, 0x69, 0x76, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x63,
+	0x65, 0x6d, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x0f, 0x57, 0x72, 0x69, 0x74, 0x65, 0x49, 0x6e, 0x63,
+	0x65, 0x6e, 0x74, 0x69, 0x76, 0x65, 0x73, 0x12, 0x27, 0x2e, 0x63, 0x65, 0x6d, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x6c, 0x6f, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x16, 0x2e, 0x63, 0x65, 0x6d, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x43,
+	0x68, 0x61, 0x72, 0x67, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61,
+	0x69, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x2e, 0x63, 0x65, 0x6d, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x45,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63,
+	0x65, 0x6d, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x6c, 0x6f, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x3c, 0x0a, 0x0d, 0x47, 0x65, 0x74,
+	0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x16, 0x2e, 0x63, 0x65, 0x6d,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x13, 0x2e, 0x63, 0x65, 0x6d, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61,
+	0x72, 0x67, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x32, 0xb0, 0x02, 0x0a, 0x0b, 0x56, 0x41, 0x42, 0x44,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x40, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x50, 0x6f,
+	0x77, 0x65, 0x72, 0x12, 0x16, 0x2e, 0x63, 0x65, 0x6d, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f,
+	0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x48, 0x0a, 0x10, 0x47, 0x65, 0x74,
+	0x45, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x64, 0x12, 0x16, 0x2e,
+	0x63, 0x65, 0x6d, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x4b, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x65, 0x72, 0x67, 0x79,
+	0x44, 0x69, 0x73, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x64, 0x12, 0x16, 0x2e, 0x63, 0x65, 0x6d,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x48, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x43, 0x68,
+	0x61, 0x72, 0x67, 0x65, 0x12, 0x16, 0x2e, 0x63, 0x65, 0x6d, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x45,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
+	0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x32, 0xe5, 0x01, 0x0a, 0x0b, 0x56,
+	0x41, 0x50, 0x44, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x40, 0x0a, 0x08, 0x47, 0x65,
+	0x74, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x16, 0x2e, 0x63, 0x65, 0x6d, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x4b, 0x0a, 0x13,
+	0x47, 0x65, 0x74, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x4e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x50,
+	0x65, 0x61, 0x6b, 0x12, 0x16, 0x2e, 0x63, 0x65, 0x6d, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f,
+	0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x47, 0x0a, 0x0f, 0x47, 0x65, 0x74,
+	0x50, 0x56, 0x59, 0x69, 0x65, 0x6c, 0x64, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x16, 0x2e, 0x63,
+	0x65, 0x6d, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x42, 0x30, 0x5a, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x65, 0x6e, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x2f, 0x63, 0x65, 0x6d, 0x64, 0x2f, 0x67,
+	0x72, 0x70, 0x63, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x65, 0x6d, 0x64, 0x76, 0x31, 0x3b, 0x63, 0x65,
+	0x6d, 0x64, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_cemd_v1_cemd_proto_rawDescOnce sync.Once
+	file_cemd_v1_cemd_proto_rawDescData = file_cemd_v1_cemd_proto_rawDesc
+)
+
+func file_cemd_v1_cemd_proto_rawDescGZIP() []byte {
+	file_cemd_v1_cemd_proto_rawDescOnce.Do(func() {
+		file_cemd_v1_cemd_proto_rawDescData = protoimpl.X.CompressGZIP(file_cemd_v1_cemd_proto_rawDescData)
+	})
+	return file_cemd_v1_cemd_proto_rawDescData
+}
+
+var file_cemd_v1_cemd_proto_msgTypes = make([]protoimpl.MessageInfo, 36)
+var file_cemd_v1_cemd_proto_goTypes = []interface{}{
+	(*ListDevicesRequest)(nil),                     // 0: cemd.v1.ListDevicesRequest
+	(*ListDevicesResponse)(nil),                    // 1: cemd.v1.ListDevicesResponse
+	(*GetDeviceRequest)(nil),                       // 2: cemd.v1.GetDeviceRequest
+	(*Device)(nil),                                 // 3: cemd.v1.Device
+	(*Entity)(nil),                                 // 4: cemd.v1.Entity
+	(*StreamEventsRequest)(nil),                    // 5: cemd.v1.StreamEventsRequest
+	(*Event)(nil),                                  // 6: cemd.v1.Event
+	(*EntityRequest)(nil),                          // 7: cemd.v1.EntityRequest
+	(*PhaseValues)(nil),                            // 8: cemd.v1.PhaseValues
+	(*WriteResponse)(nil),                          // 9: cemd.v1.WriteResponse
+	(*WriteDoubleRequest)(nil),                     // 10: cemd.v1.WriteDoubleRequest
+	(*WriteDurationRequest)(nil),                   // 11: cemd.v1.WriteDurationRequest
+	(*ManufacturerData)(nil),                       // 12: cemd.v1.ManufacturerData
+	(*LoadLimit)(nil),                              // 13: cemd.v1.LoadLimit
+	(*WriteLoadLimitRequest)(nil),                  // 14: cemd.v1.WriteLoadLimitRequest
+	(*Identification)(nil),                         // 15: cemd.v1.Identification
+	(*Identifications)(nil),                        // 16: cemd.v1.Identifications
+	(*ChargingPowerLimits)(nil),                    // 17: cemd.v1.ChargingPowerLimits
+	(*OperatingState)(nil),                         // 18: cemd.v1.OperatingState
+	(*CurrentLimits)(nil),                          // 19: cemd.v1.CurrentLimits
+	(*LoadLimitsPhase)(nil),                        // 20: cemd.v1.LoadLimitsPhase
+	(*LoadLimitsPhases)(nil),                       // 21: cemd.v1.LoadLimitsPhases
+	(*WriteLoadLimitsPhasesRequest)(nil),           // 22: cemd.v1.WriteLoadLimitsPhasesRequest
+	(*Demand)(nil),                                 // 23: cemd.v1.Demand
+	(*TimeSlotConstraints)(nil),                    // 24: cemd.v1.TimeSlotConstraints
+	(*IncentiveSlotConstraints)(nil),               // 25: cemd.v1.IncentiveSlotConstraints
+	(*DurationSlotValue)(nil),                      // 26: cemd.v1.DurationSlotValue
+	(*DurationSlotValues)(nil),                     // 27: cemd.v1.DurationSlotValues
+	(*WriteDurationSlotValuesRequest)(nil),         // 28: cemd.v1.WriteDurationSlotValuesRequest
+	(*TierBoundaryDescription)(nil),                // 29: cemd.v1.TierBoundaryDescription
+	(*IncentiveDescription)(nil),                   // 30: cemd.v1.IncentiveDescription
+	(*IncentiveTableDescriptionTier)(nil),          // 31: cemd.v1.IncentiveTableDescriptionTier
+	(*IncentiveTariffDescription)(nil),             // 32: cemd.v1.IncentiveTariffDescription
+	(*WriteIncentiveTableDescriptionsRequest)(nil), // 33: cemd.v1.WriteIncentiveTableDescriptionsRequest
+	(*ChargePlanSlotValue)(nil),                    // 34: cemd.v1.ChargePlanSlotValue
+	(*ChargePlan)(nil),                             // 35: cemd.v1.ChargePlan
+	(*durationpb.Duration)(nil),                    // 36: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil),                  // 37: google.protobuf.Timestamp
+	(*wrapperspb.DoubleValue)(nil),                 // 38: google.protobuf.DoubleValue
+	(*wrapperspb.StringValue)(nil),                 // 39: google.protobuf.StringValue
+	(*wrapperspb.BoolValue)(nil),                   // 40: google.protobuf.BoolValue
+	(*wrapperspb.UInt32Value)(nil),                 // 41: google.protobuf.UInt32Value
+}
+var file_cemd_v1_cemd_proto_depIdxs = []int32{
+	3,  // 0: cemd.v1.ListDevicesResponse.devices:type_name -> cemd.v1.Device
+	4,  // 1: cemd.v1.Device.entities:type_name -> cemd.v1.Entity
+	36, // 2: cemd.v1.WriteDurationRequest.value:type_name -> google.protobuf.Duration
+	36, // 3: cemd.v1.LoadLimit.duration:type_name -> google.protobuf.Duration
+	13, // 4: cemd.v1.WriteLoadLimitRequest.limit:type_name -> cemd.v1.LoadLimit
+	15, // 5: cemd.v1.Identifications.identifications:type_name -> cemd.v1.Identification
+	20, // 6: cemd.v1.LoadLimitsPhases.limits:type_name -> cemd.v1.LoadLimitsPhase
+	20, // 7: cemd.v1.WriteLoadLimitsPhasesRequest.limits:type_name -> cemd.v1.LoadLimitsPhase
+	36, // 8: cemd.v1.TimeSlotConstraints.min_slot_duration:type_name -> google.protobuf.Duration
+	36, // 9: cemd.v1.TimeSlotConstraints.max_slot_duration:type_name -> google.protobuf.Duration
+	36, // 10: cemd.v1.TimeSlotConstraints.slot_duration_step_size:type_name -> google.protobuf.Duration
+	36, // 11: cemd.v1.DurationSlotValue.duration:type_name -> google.protobuf.Duration
+	26, // 12: cemd.v1.DurationSlotValues.slots:type_name -> cemd.v1.DurationSlotValue
+	26, // 13: cemd.v1.WriteDurationSlotValuesRequest.slots:type_name -> cemd.v1.DurationSlotValue
+	29, // 14: cemd.v1.IncentiveTableDescriptionTier.boundaries:type_name -> cemd.v1.TierBoundaryDescription
+	30, // 15: cemd.v1.IncentiveTableDescriptionTier.incentives:type_name -> cemd.v1.IncentiveDescription
+	31, // 16: cemd.v1.IncentiveTariffDescription.tiers:type_name -> cemd.v1.IncentiveTableDescriptionTier
+	32, // 17: cemd.v1.WriteIncentiveTableDescriptionsRequest.descriptions:type_name -> cemd.v1.IncentiveTariffDescription
+	37, // 18: cemd.v1.ChargePlanSlotValue.start:type_name -> google.protobuf.Timestamp
+	37, // 19: cemd.v1.ChargePlanSlotValue.end:type_name -> google.protobuf.Timestamp
+	34, // 20: cemd.v1.ChargePlan.slots:type_name -> cemd.v1.ChargePlanSlotValue
+	0,  // 21: cemd.v1.DeviceService.ListDevices:input_type -> cemd.v1.ListDevicesRequest
+	2,  // 22: cemd.v1.DeviceService.GetDevice:input_type -> cemd.v1.GetDeviceRequest
+	5,  // 23: cemd.v1.EventService.StreamEvents:input_type -> cemd.v1.StreamEventsRequest
+	7,  // 24: cemd.v1.LPCService.GetConsumptionLimit:input_type -> cemd.v1.EntityRequest
+	14, // 25: cemd.v1.LPCService.WriteConsumptionLimit:input_type -> cemd.v1.WriteLoadLimitRequest
+	7,  // 26: cemd.v1.LPCService.GetFailsafeConsumptionActivePowerLimit:input_type -> cemd.v1.EntityRequest
+	10, // 27: cemd.v1.LPCService.WriteFailsafeConsumptionActivePowerLimit:input_type -> cemd.v1.WriteDoubleRequest
+	7,  // 28: cemd.v1.LPCService.GetFailsafeDurationMinimum:input_type -> cemd.v1.EntityRequest
+	11, // 29: cemd.v1.LPCService.WriteFailsafeDurationMinimum:input_type -> cemd.v1.WriteDurationRequest
+	7,  // 30: cemd.v1.LPCService.GetPowerConsumptionNominalMax:input_type -> cemd.v1.EntityRequest
+	7,  // 31: cemd.v1.LPPService.GetProductionLimit:input_type -> cemd.v1.EntityRequest
+	14, // 32: cemd.v1.LPPService.WriteProductionLimit:input_type -> cemd.v1.WriteLoadLimitRequest
+	7,  // 33: cemd.v1.LPPService.GetFailsafeProductionActivePowerLimit:input_type -> cemd.v1.EntityRequest
+	10, // 34: cemd.v1.LPPService.WriteFailsafeProductionActivePowerLimit:input_type -> cemd.v1.WriteDoubleRequest
+	7,  // 35: cemd.v1.LPPService.GetFailsafeDurationMinimum:input_type -> cemd.v1.EntityRequest
+	11, // 36: cemd.v1.LPPService.WriteFailsafeDurationMinimum:input_type -> cemd.v1.WriteDurationRequest
+	7,  // 37: cemd.v1.LPPService.GetPowerProductionNominalMax:input_type -> cemd.v1.EntityRequest
+	7,  // 38: cemd.v1.MGCPService.GetPowerLimitationFactor:input_type -> cemd.v1.EntityRequest
+	7,  // 39: cemd.v1.MGCPService.GetPower:input_type -> cemd.v1.EntityRequest
+	7,  // 40: cemd.v1.MGCPService.GetEnergyFeedIn:input_type -> cemd.v1.EntityRequest
+	7,  // 41: cemd.v1.MGCPService.GetEnergyConsumed:input_type -> cemd.v1.EntityRequest
+	7,  // 42: cemd.v1.MGCPService.GetCurrentPerPhase:input_type -> cemd.v1.EntityRequest
+	7,  // 43: cemd.v1.MGCPService.GetVoltagePerPhase:input_type -> cemd.v1.EntityRequest
+	7,  // 44: cemd.v1.MGCPService.GetFrequency:input_type -> cemd.v1.EntityRequest
+	7,  // 45: cemd.v1.MPCService.GetPower:input_type -> cemd.v1.EntityRequest
+	7,  // 46: cemd.v1.MPCService.GetPowerPerPhase:input_type -> cemd.v1.EntityRequest
+	7,  // 47: cemd.v1.MPCService.GetEnergyConsumed:input_type -> cemd.v1.EntityRequest
+	7,  // 48: cemd.v1.MPCService.GetEnergyProduced:input_type -> cemd.v1.EntityRequest
+	7,  // 49: cemd.v1.MPCService.GetCurrentPerPhase:input_type -> cemd.v1.EntityRequest
+	7,  // 50: cemd.v1.MPCService.GetVoltagePerPhase:input_type -> cemd.v1.EntityRequest
+	7,  // 51: cemd.v1.MPCService.GetFrequency:input_type -> cemd.v1.EntityRequest
+	7,  // 52: cemd.v1.EVCCService.GetChargeState:input_type -> cemd.v1.EntityRequest
+	7,  // 53: cemd.v1.EVCCService.GetEVConnected:input_type -> cemd.v1.EntityRequest
+	7,  // 54: cemd.v1.EVCCService.GetCommunicationStandard:input_type -> cemd.v1.EntityRequest
+	7,  // 55: cemd.v1.EVCCService.GetAsymmetricChargingSupport:input_type -> cemd.v1.EntityRequest
+	7,  // 56: cemd.v1.EVCCService.GetIdentifications:input_type -> cemd.v1.EntityRequest
+	7,  // 57: cemd.v1.EVCCService.GetManufacturerData:input_type -> cemd.v1.EntityRequest
+	7,  // 58: cemd.v1.EVCCService.GetChargingPowerLimits:input_type -> cemd.v1.EntityRequest
+	7,  // 59: cemd.v1.EVCCService.GetIsInSleepMode:input_type -> cemd.v1.EntityRequest
+	7,  // 60: cemd.v1.EVCEMService.GetPhasesConnected:input_type -> cemd.v1.EntityRequest
+	7,  // 61: cemd.v1.EVCEMService.GetCurrentPerPhase:input_type -> cemd.v1.EntityRequest
+	7,  // 62: cemd.v1.EVCEMService.GetPowerPerPhase:input_type -> cemd.v1.EntityRequest
+	7,  // 63: cemd.v1.EVCEMService.GetEnergyCharged:input_type -> cemd.v1.EntityRequest
+	7,  // 64: cemd.v1.EVSECCService.GetManufacturerData:input_type -> cemd.v1.EntityRequest
+	7,  // 65: cemd.v1.EVSECCService.GetOperatingState:input_type -> cemd.v1.EntityRequest
+	7,  // 66: cemd.v1.EVSOCService.GetStateOfCharge:input_type -> cemd.v1.EntityRequest
+	7,  // 67: cemd.v1.OPEVService.GetCurrentLimits:input_type -> cemd.v1.EntityRequest
+	7,  // 68: cemd.v1.OPEVService.GetLoadControlLimits:input_type -> cemd.v1.EntityRequest
+	22, // 69: cemd.v1.OPEVService.WriteLoadControlLimits:input_type -> cemd.v1.WriteLoadLimitsPhasesRequest
+	7,  // 70: cemd.v1.OSCEVService.GetCurrentLimits:input_type -> cemd.v1.EntityRequest
+	7,  // 71: cemd.v1.OSCEVService.GetLoadControlLimits:input_type -> cemd.v1.EntityRequest
+	22, // 72: cemd.v1.OSCEVService.WriteLoadControlLimits:input_type -> cemd.v1.WriteLoadLimitsPhasesRequest
+	7,  // 73: cemd.v1.CEVCService.GetChargeStrategy:input_type -> cemd.v1.EntityRequest
+	7,  // 74: cemd.v1.CEVCService.GetEnergyDemand:input_type -> cemd.v1.EntityRequest
+	7,  // 75: cemd.v1.CEVCService.GetTimeSlotConstraints:input_type -> cemd.v1.EntityRequest
+	28, // 76: cemd.v1.CEVCService.WritePowerLimits:input_type -> cemd.v1.WriteDurationSlotValuesRequest
+	7,  // 77: cemd.v1.CEVCService.GetIncentiveConstraints:input_type -> cemd.v1.EntityRequest
+	33, // 78: cemd.v1.CEVCService.WriteIncentiveTableDescriptions:input_type -> cemd.v1.WriteIncentiveTableDescriptionsRequest
+	28, // 79: cemd.v1.CEVCService.WriteIncentives:input_type -> cemd.v1.WriteDurationSlotValuesRequest
+	7,  // 80: cemd.v1.CEVCService.GetChargePlanConstraints:input_type -> cemd.v1.EntityRequest
+	7,  // 81: cemd.v1.CEVCService.GetChargePlan:input_type -> cemd.v1.EntityRequest
+	7,  // 82: cemd.v1.VABDService.GetPower:input_type -> cemd.v1.EntityRequest
+	7,  // 83: cemd.v1.VABDService.GetEnergyCharged:input_type -> cemd.v1.EntityRequest
+	7,  // 84: cemd.v1.VABDService.GetEnergyDischarged:input_type -> cemd.v1.EntityRequest
+	7,  // 85: cemd.v1.VABDService.GetStateOfCharge:input_type -> cemd.v1.EntityRequest
+	7,  // 86: cemd.v1.VAPDService.GetPower:input_type -> cemd.v1.EntityRequest
+	7,  // 87: cemd.v1.VAPDService.GetPowerNominalPeak:input_type -> cemd.v1.EntityRequest
+	7,  // 88: cemd.v1.VAPDService.GetPVYieldTotal:input_type -> cemd.v1.EntityRequest
+	1,  // 89: cemd.v1.DeviceService.ListDevices:output_type -> cemd.v1.ListDevicesResponse
+	3,  // 90: cemd.v1.DeviceService.GetDevice:output_type -> cemd.v1.Device
+	6,  // 91: cemd.v1.EventService.StreamEvents:output_type -> cemd.v1.Event
+	13, // 92: cemd.v1.LPCService.GetConsumptionLimit:output_type -> cemd.v1.LoadLimit
+	9,  // 93: cemd.v1.LPCService.WriteConsumptionLimit:output_type -> cemd.v1.WriteResponse
+	38, // 94: cemd.v1.LPCService.GetFailsafeConsumptionActivePowerLimit:output_type -> google.protobuf.DoubleValue
+	9,  // 95: cemd.v1.LPCService.WriteFailsafeConsumptionActivePowerLimit:output_type -> cemd.v1.WriteResponse
+	36, // 96: cemd.v1.LPCService.GetFailsafeDurationMinimum:output_type -> google.protobuf.Duration
+	9,  // 97: cemd.v1.LPCService.WriteFailsafeDurationMinimum:output_type -> cemd.v1.WriteResponse
+	38, // 98: cemd.v1.LPCService.GetPowerConsumptionNominalMax:output_type -> google.protobuf.DoubleValue
+	13, // 99: cemd.v1.LPPService.GetProductionLimit:output_type -> cemd.v1.LoadLimit
+	9,  // 100: cemd.v1.LPPService.WriteProductionLimit:output_type -> cemd.v1.WriteResponse
+	38, // 101: cemd.v1.LPPService.GetFailsafeProductionActivePowerLimit:output_type -> google.protobuf.DoubleValue
+	9,  // 102: cemd.v1.LPPService.WriteFailsafeProductionActivePowerLimit:output_type -> cemd.v1.WriteResponse
+	36, // 103: cemd.v1.LPPService.GetFailsafeDurationMinimum:output_type -> google.protobuf.Duration
+	9,  // 104: cemd.v1.LPPService.WriteFailsafeDurationMinimum:output_type -> cemd.v1.WriteResponse
+	38, // 105: cemd.v1.LPPService.GetPowerProductionNominalMax:output_type -> google.protobuf.DoubleValue
+	38, // 106: cemd.v1.MGCPService.GetPowerLimitationFactor:output_type -> google.protobuf.DoubleValue
+	38, // 107: cemd.v1.MGCPService.GetPower:output_type -> google.protobuf.DoubleValue
+	38, // 108: cemd.v1.MGCPService.GetEnergyFeedIn:output_type -> google.protobuf.DoubleValue
+	38, // 109: cemd.v1.MGCPService.GetEnergyConsumed:output_type -> google.protobuf.DoubleValue
+	8,  // 110: cemd.v1.MGCPService.GetCurrentPerPhase:output_type -> cemd.v1.PhaseValues
+	8,  // 111: cemd.v1.MGCPService.GetVoltagePerPhase:output_type -> cemd.v1.PhaseValues
+	38, // 112: cemd.v1.MGCPService.GetFrequency:output_type -> google.protobuf.DoubleValue
+	38, // 113: cemd.v1.MPCService.GetPower:output_type -> google.protobuf.DoubleValue
+	8,  // 114: cemd.v1.MPCService.GetPowerPerPhase:output_type -> cemd.v1.PhaseValues
+	38, // 115: cemd.v1.MPCService.GetEnergyConsumed:output_type -> google.protobuf.DoubleValue
+	38, // 116: cemd.v1.MPCService.GetEnergyProduced:output_type -> google.protobuf.DoubleValue
+	8,  // 117: cemd.v1.MPCService.GetCurrentPerPhase:output_type -> cemd.v1.PhaseValues
+	8,  // 118: cemd.v1.MPCService.GetVoltagePerPhase:output_type -> cemd.v1.PhaseValues
+	38, // 119: cemd.v1.MPCService.GetFrequency:output_type -> google.protobuf.DoubleValue
+	39, // 120: cemd.v1.EVCCService.GetChargeState:output_type -> google.protobuf.StringValue
+	40, // 121: cemd.v1.EVCCService.GetEVConnected:output_type -> google.protobuf.BoolValue
+	39, // 122: cemd.v1.EVCCService.GetCommunicationStandard:output_type -> google.protobuf.StringValue
+	40, // 123: cemd.v1.EVCCService.GetAsymmetricChargingSupport:output_type -> google.protobuf.BoolValue
+	16, // 124: cemd.v1.EVCCService.GetIdentifications:output_type -> cemd.v1.Identifications
+	12, // 125: cemd.v1.EVCCService.GetManufacturerData:output_type -> cemd.v1.ManufacturerData
+	17, // 126: cemd.v1.EVCCService.GetChargingPowerLimits:output_type -> cemd.v1.ChargingPowerLimits
+	40, // 127: cemd.v1.EVCCService.GetIsInSleepMode:output_type -> google.protobuf.BoolValue
+	41, // 128: cemd.v1.EVCEMService.GetPhasesConnected:output_type -> google.protobuf.UInt32Value
+	8,  // 129: cemd.v1.EVCEMService.GetCurrentPerPhase:output_type -> cemd.v1.PhaseValues
+	8,  // 130: cemd.v1.EVCEMService.GetPowerPerPhase:output_type -> cemd.v1.PhaseValues
+	38, // 131: cemd.v1.EVCEMService.GetEnergyCharged:output_type -> google.protobuf.DoubleValue
+	12, // 132: cemd.v1.EVSECCService.GetManufacturerData:output_type -> cemd.v1.ManufacturerData
+	18, // 133: cemd.v1.EVSECCService.GetOperatingState:output_type -> cemd.v1.OperatingState
+	38, // 134: cemd.v1.EVSOCService.GetStateOfCharge:output_type -> google.protobuf.DoubleValue
+	19, // 135: cemd.v1.OPEVService.GetCurrentLimits:output_type -> cemd.v1.CurrentLimits
+	21, // 136: cemd.v1.OPEVService.GetLoadControlLimits:output_type -> cemd.v1.LoadLimitsPhases
+	9,  // 137: cemd.v1.OPEVService.WriteLoadControlLimits:output_type -> cemd.v1.WriteResponse
+	19, // 138: cemd.v1.OSCEVService.GetCurrentLimits:output_type -> cemd.v1.CurrentLimits
+	21, // 139: cemd.v1.OSCEVService.GetLoadControlLimits:output_type -> cemd.v1.LoadLimitsPhases
+	9,  // 140: cemd.v1.OSCEVService.WriteLoadControlLimits:output_type -> cemd.v1.WriteResponse
+	39, // 141: cemd.v1.CEVCService.GetChargeStrategy:output_type -> google.protobuf.StringValue
+	23, // 142: cemd.v1.CEVCService.GetEnergyDemand:output_type -> cemd.v1.Demand
+	24, // 143: cemd.v1.CEVCService.GetTimeSlotConstraints:output_type -> cemd.v1.TimeSlotConstraints
+	9,  // 144: cemd.v1.CEVCService.WritePowerLimits:output_type -> cemd.v1.WriteResponse
+	25, // 145: cemd.v1.CEVCService.GetIncentiveConstraints:output_type -> cemd.v1.IncentiveSlotConstraints
+	9,  // 146: cemd.v1.CEVCService.WriteIncentiveTableDescriptions:output_type -> cemd.v1.WriteResponse
+	9,  // 147: cemd.v1.CEVCService.WriteIncentives:output_type -> cemd.v1.WriteResponse
+	27, // 148: cemd.v1.CEVCService.GetChargePlanConstraints:output_type -> cemd.v1.DurationSlotValues
+	35, // 149: cemd.v1.CEVCService.GetChargePlan:output_type -> cemd.v1.ChargePlan
+	38, // 150: cemd.v1.VABDService.GetPower:output_type -> google.protobuf.DoubleValue
+	38, // 151: cemd.v1.VABDService.GetEnergyCharged:output_type -> google.protobuf.DoubleValue
+	38, // 152: cemd.v1.VABDService.GetEnergyDischarged:output_type -> google.protobuf.DoubleValue
+	38, // 153: cemd.v1.VABDService.GetStateOfCharge:output_type -> google.protobuf.DoubleValue
+	38, // 154: cemd.v1.VAPDService.GetPower:output_type -> google.protobuf.DoubleValue
+	38, // 155: cemd.v1.VAPDService.GetPowerNominalPeak:output_type -> google.protobuf.DoubleValue
+	38, // 156: cemd.v1.VAPDService.GetPVYieldTotal:output_type -> google.protobuf.DoubleValue
+	89, // [89:157] is the sub-list for method output_type
+	21, // [21:89] is the sub-list for method input_type
+	21, // [21:21] is the sub-list for extension type_name
+	21, // [21:21] is the sub-list for extension extendee
+	0,  // [0:21] is the sub-list for field type_name
+}
+
+func init() { file_cemd_v1_cemd_proto_init() }
+func file_cemd_v1_cemd_proto_init() {
+	if File_cemd_v1_cemd_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_cemd_v1_cemd_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDevicesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cemd_v1_cemd_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDevicesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cemd_v1_cemd_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDeviceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cemd_v1_cemd_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Device); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cemd_v1_cemd_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Entity); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cemd_v1_cemd_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamEventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cemd_v1_cemd_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Event); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cemd_v1_cemd_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EntityRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cemd_v1_cemd_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PhaseValues); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cemd_v1_cemd_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WriteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cemd_v1_cemd_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WriteDoubleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cemd_v1_cemd_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WriteDurationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cemd_v1_cemd_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ManufacturerData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cemd_v1_cemd_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoadLimit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cemd_v1_cemd_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WriteLoadLimitRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cemd_v1_cemd_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Identification); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cemd_v1_cemd_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Identifications); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cemd_v1_cemd_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChargingPowerLimits); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cemd_v1_cemd_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OperatingState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cemd_v1_cemd_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CurrentLimits); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cemd_v1_cemd_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoadLimitsPhase); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cemd_v1_cemd_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoadLimitsPhases); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cemd_v1_cemd_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WriteLoadLimitsPhasesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cemd_v1_cemd_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Demand); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cemd_v1_cemd_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TimeSlotConstraints); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cemd_v1_cemd_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IncentiveSlotConstraints); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cemd_v1_cemd_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DurationSlotValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cemd_v1_cemd_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DurationSlotValues); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cemd_v1_cemd_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WriteDurationSlotValuesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cemd_v1_cemd_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TierBoundaryDescription); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cemd_v1_cemd_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IncentiveDescription); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cemd_v1_cemd_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IncentiveTableDescriptionTier); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cemd_v1_cemd_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IncentiveTariffDescription); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cemd_v1_cemd_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WriteIncentiveTableDescriptionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cemd_v1_cemd_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChargePlanSlotValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cemd_v1_cemd_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChargePlan); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_cemd_v1_cemd_proto_msgTypes[9].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_cemd_v1_cemd_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   36,
+			NumExtensions: 0,
+			NumServices:   15,
+		},
+		GoTypes:           file_cemd_v1_cemd_proto_goTypes,
+		DependencyIndexes: file_cemd_v1_cemd_proto_depIdxs,
+		MessageInfos:      file_cemd_v1_cemd_proto_msgTypes,
+	}.Build()
+	File_cemd_v1_cemd_proto = out.File
+	file_cemd_v1_cemd_proto_rawDesc = nil
+	file_cemd_v1_cemd_proto_goTypes = nil
+	file_cemd_v1_cemd_proto_depIdxs = nil
+}
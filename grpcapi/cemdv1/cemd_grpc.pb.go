@@ -0,0 +1,3390 @@
+// gRPC API of a CEM
+//
+// The API exposes the connected devices and their entities, the values and
+// write operations of the client use cases added to the CEM, and the events
+// of the CEM and its use cases.
+//
+// Remote entities are addressed by the SKI of the remote device and the
+// entity ids joined with dots, e.g. "1.1". The use cases are named by their
+// short names, e.g. "lpc" or "mgcp".
+//
+// Errors are reported with the gRPC status codes:
+//   - INVALID_ARGUMENT: the entity address or the data of a write is invalid
+//   - NOT_FOUND: the device, entity or the requested data is not available
+//   - FAILED_PRECONDITION: the use case is not supported by the entity
+//   - UNIMPLEMENTED: the use case is not added to the CEM
+//   - UNAVAILABLE: the remote device is disconnected
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: cemd/v1/cemd.proto
+
+package cemdv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	DeviceService_ListDevices_FullMethodName = "/cemd.v1.DeviceService/ListDevices"
+	DeviceService_GetDevice_FullMethodName   = "/cemd.v1.DeviceService/GetDevice"
+)
+
+// DeviceServiceClient is the client API for DeviceService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type DeviceServiceClient interface {
+	// list the connected remote devices
+	ListDevices(ctx context.Context, in *ListDevicesRequest, opts ...grpc.CallOption) (*ListDevicesResponse, error)
+	// get a connected remote device
+	GetDevice(ctx context.Context, in *GetDeviceRequest, opts ...grpc.CallOption) (*Device, error)
+}
+
+type deviceServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDeviceServiceClient(cc grpc.ClientConnInterface) DeviceServiceClient {
+	return &deviceServiceClient{cc}
+}
+
+func (c *deviceServiceClient) ListDevices(ctx context.Context, in *ListDevicesRequest, opts ...grpc.CallOption) (*ListDevicesResponse, error) {
+	out := new(ListDevicesResponse)
+	err := c.cc.Invoke(ctx, DeviceService_ListDevices_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceServiceClient) GetDevice(ctx context.Context, in *GetDeviceRequest, opts ...grpc.CallOption) (*Device, error) {
+	out := new(Device)
+	err := c.cc.Invoke(ctx, DeviceService_GetDevice_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DeviceServiceServer is the server API for DeviceService service.
+// All implementations must embed UnimplementedDeviceServiceServer
+// for forward compatibility
+type DeviceServiceServer interface {
+	// list the connected remote devices
+	ListDevices(context.Context, *ListDevicesRequest) (*ListDevicesResponse, error)
+	// get a connected remote device
+	GetDevice(context.Context, *GetDeviceRequest) (*Device, error)
+	mustEmbedUnimplementedDeviceServiceServer()
+}
+
+// UnimplementedDeviceServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedDeviceServiceServer struct {
+}
+
+func (UnimplementedDeviceServiceServer) ListDevices(context.Context, *ListDevicesRequest) (*ListDevicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDevices not implemented")
+}
+func (UnimplementedDeviceServiceServer) GetDevice(context.Context, *GetDeviceRequest) (*Device, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDevice not implemented")
+}
+func (UnimplementedDeviceServiceServer) mustEmbedUnimplementedDeviceServiceServer() {}
+
+// UnsafeDeviceServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DeviceServiceServer will
+// result in compilation errors.
+type UnsafeDeviceServiceServer interface {
+	mustEmbedUnimplementedDeviceServiceServer()
+}
+
+func RegisterDeviceServiceServer(s grpc.ServiceRegistrar, srv DeviceServiceServer) {
+	s.RegisterService(&DeviceService_ServiceDesc, srv)
+}
+
+func _DeviceService_ListDevices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDevicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceServiceServer).ListDevices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeviceService_ListDevices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceServiceServer).ListDevices(ctx, req.(*ListDevicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceService_GetDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceServiceServer).GetDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeviceService_GetDevice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceServiceServer).GetDevice(ctx, req.(*GetDeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DeviceService_ServiceDesc is the grpc.ServiceDesc for DeviceService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DeviceService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cemd.v1.DeviceService",
+	HandlerType: (*DeviceServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListDevices",
+			Handler:    _DeviceService_ListDevices_Handler,
+		},
+		{
+			MethodName: "GetDevice",
+			Handler:    _DeviceService_GetDevice_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cemd/v1/cemd.proto",
+}
+
+const (
+	EventService_StreamEvents_FullMethodName = "/cemd.v1.EventService/StreamEvents"
+)
+
+// EventServiceClient is the client API for EventService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type EventServiceClient interface {
+	// stream the events of the CEM and its use cases
+	//
+	// the current values can be read via the use case services
+	StreamEvents(ctx context.Context, in *StreamEventsRequest, opts ...grpc.CallOption) (EventService_StreamEventsClient, error)
+}
+
+type eventServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEventServiceClient(cc grpc.ClientConnInterface) EventServiceClient {
+	return &eventServiceClient{cc}
+}
+
+func (c *eventServiceClient) StreamEvents(ctx context.Context, in *StreamEventsRequest, opts ...grpc.CallOption) (EventService_StreamEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &EventService_ServiceDesc.Streams[0], EventService_StreamEvents_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &eventServiceStreamEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type EventService_StreamEventsClient interface {
+	Recv() (*Event, error)
+	grpc.ClientStream
+}
+
+type eventServiceStreamEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *eventServiceStreamEventsClient) Recv() (*Event, error) {
+	m := new(Event)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// EventServiceServer is the server API for EventService service.
+// All implementations must embed UnimplementedEventServiceServer
+// for forward compatibility
+type EventServiceServer interface {
+	// stream the events of the CEM and its use cases
+	//
+	// the current values can be read via the use case services
+	StreamEvents(*StreamEventsRequest, EventService_StreamEventsServer) error
+	mustEmbedUnimplementedEventServiceServer()
+}
+
+// UnimplementedEventServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedEventServiceServer struct {
+}
+
+func (UnimplementedEventServiceServer) StreamEvents(*StreamEventsRequest, EventService_StreamEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamEvents not implemented")
+}
+func (UnimplementedEventServiceServer) mustEmbedUnimplementedEventServiceServer() {}
+
+// UnsafeEventServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to EventServiceServer will
+// result in compilation errors.
+type UnsafeEventServiceServer interface {
+	mustEmbedUnimplementedEventServiceServer()
+}
+
+func RegisterEventServiceServer(s grpc.ServiceRegistrar, srv EventServiceServer) {
+	s.RegisterService(&EventService_ServiceDesc, srv)
+}
+
+func _EventService_StreamEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(EventServiceServer).StreamEvents(m, &eventServiceStreamEventsServer{stream})
+}
+
+type EventService_StreamEventsServer interface {
+	Send(*Event) error
+	grpc.ServerStream
+}
+
+type eventServiceStreamEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *eventServiceStreamEventsServer) Send(m *Event) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// EventService_ServiceDesc is the grpc.ServiceDesc for EventService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var EventService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cemd.v1.EventService",
+	HandlerType: (*EventServiceServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamEvents",
+			Handler:       _EventService_StreamEvents_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "cemd/v1/cemd.proto",
+}
+
+const (
+	LPCService_GetConsumptionLimit_FullMethodName                      = "/cemd.v1.LPCService/GetConsumptionLimit"
+	LPCService_WriteConsumptionLimit_FullMethodName                    = "/cemd.v1.LPCService/WriteConsumptionLimit"
+	LPCService_GetFailsafeConsumptionActivePowerLimit_FullMethodName   = "/cemd.v1.LPCService/GetFailsafeConsumptionActivePowerLimit"
+	LPCService_WriteFailsafeConsumptionActivePowerLimit_FullMethodName = "/cemd.v1.LPCService/WriteFailsafeConsumptionActivePowerLimit"
+	LPCService_GetFailsafeDurationMinimum_FullMethodName               = "/cemd.v1.LPCService/GetFailsafeDurationMinimum"
+	LPCService_WriteFailsafeDurationMinimum_FullMethodName             = "/cemd.v1.LPCService/WriteFailsafeDurationMinimum"
+	LPCService_GetPowerConsumptionNominalMax_FullMethodName            = "/cemd.v1.LPCService/GetPowerConsumptionNominalMax"
+)
+
+// LPCServiceClient is the client API for LPCService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type LPCServiceClient interface {
+	GetConsumptionLimit(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*LoadLimit, error)
+	WriteConsumptionLimit(ctx context.Context, in *WriteLoadLimitRequest, opts ...grpc.CallOption) (*WriteResponse, error)
+	GetFailsafeConsumptionActivePowerLimit(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*wrapperspb.DoubleValue, error)
+	WriteFailsafeConsumptionActivePowerLimit(ctx context.Context, in *WriteDoubleRequest, opts ...grpc.CallOption) (*WriteResponse, error)
+	GetFailsafeDurationMinimum(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*durationpb.Duration, error)
+	WriteFailsafeDurationMinimum(ctx context.Context, in *WriteDurationRequest, opts ...grpc.CallOption) (*WriteResponse, error)
+	GetPowerConsumptionNominalMax(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*wrapperspb.DoubleValue, error)
+}
+
+type lPCServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewLPCServiceClient(cc grpc.ClientConnInterface) LPCServiceClient {
+	return &lPCServiceClient{cc}
+}
+
+func (c *lPCServiceClient) GetConsumptionLimit(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*LoadLimit, error) {
+	out := new(LoadLimit)
+	err := c.cc.Invoke(ctx, LPCService_GetConsumptionLimit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lPCServiceClient) WriteConsumptionLimit(ctx context.Context, in *WriteLoadLimitRequest, opts ...grpc.CallOption) (*WriteResponse, error) {
+	out := new(WriteResponse)
+	err := c.cc.Invoke(ctx, LPCService_WriteConsumptionLimit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lPCServiceClient) GetFailsafeConsumptionActivePowerLimit(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*wrapperspb.DoubleValue, error) {
+	out := new(wrapperspb.DoubleValue)
+	err := c.cc.Invoke(ctx, LPCService_GetFailsafeConsumptionActivePowerLimit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lPCServiceClient) WriteFailsafeConsumptionActivePowerLimit(ctx context.Context, in *WriteDoubleRequest, opts ...grpc.CallOption) (*WriteResponse, error) {
+	out := new(WriteResponse)
+	err := c.cc.Invoke(ctx, LPCService_WriteFailsafeConsumptionActivePowerLimit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lPCServiceClient) GetFailsafeDurationMinimum(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*durationpb.Duration, error) {
+	out := new(durationpb.Duration)
+	err := c.cc.Invoke(ctx, LPCService_GetFailsafeDurationMinimum_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lPCServiceClient) WriteFailsafeDurationMinimum(ctx context.Context, in *WriteDurationRequest, opts ...grpc.CallOption) (*WriteResponse, error) {
+	out := new(WriteResponse)
+	err := c.cc.Invoke(ctx, LPCService_WriteFailsafeDurationMinimum_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lPCServiceClient) GetPowerConsumptionNominalMax(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*wrapperspb.DoubleValue, error) {
+	out := new(wrapperspb.DoubleValue)
+	err := c.cc.Invoke(ctx, LPCService_GetPowerConsumptionNominalMax_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LPCServiceServer is the server API for LPCService service.
+// All implementations must embed UnimplementedLPCServiceServer
+// for forward compatibility
+type LPCServiceServer interface {
+	GetConsumptionLimit(context.Context, *EntityRequest) (*LoadLimit, error)
+	WriteConsumptionLimit(context.Context, *WriteLoadLimitRequest) (*WriteResponse, error)
+	GetFailsafeConsumptionActivePowerLimit(context.Context, *EntityRequest) (*wrapperspb.DoubleValue, error)
+	WriteFailsafeConsumptionActivePowerLimit(context.Context, *WriteDoubleRequest) (*WriteResponse, error)
+	GetFailsafeDurationMinimum(context.Context, *EntityRequest) (*durationpb.Duration, error)
+	WriteFailsafeDurationMinimum(context.Context, *WriteDurationRequest) (*WriteResponse, error)
+	GetPowerConsumptionNominalMax(context.Context, *EntityRequest) (*wrapperspb.DoubleValue, error)
+	mustEmbedUnimplementedLPCServiceServer()
+}
+
+// UnimplementedLPCServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedLPCServiceServer struct {
+}
+
+func (UnimplementedLPCServiceServer) GetConsumptionLimit(context.Context, *EntityRequest) (*LoadLimit, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConsumptionLimit not implemented")
+}
+func (UnimplementedLPCServiceServer) WriteConsumptionLimit(context.Context, *WriteLoadLimitRequest) (*WriteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WriteConsumptionLimit not implemented")
+}
+func (UnimplementedLPCServiceServer) GetFailsafeConsumptionActivePowerLimit(context.Context, *EntityRequest) (*wrapperspb.DoubleValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFailsafeConsumptionActivePowerLimit not implemented")
+}
+func (UnimplementedLPCServiceServer) WriteFailsafeConsumptionActivePowerLimit(context.Context, *WriteDoubleRequest) (*WriteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WriteFailsafeConsumptionActivePowerLimit not implemented")
+}
+func (UnimplementedLPCServiceServer) GetFailsafeDurationMinimum(context.Context, *EntityRequest) (*durationpb.Duration, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFailsafeDurationMinimum not implemented")
+}
+func (UnimplementedLPCServiceServer) WriteFailsafeDurationMinimum(context.Context, *WriteDurationRequest) (*WriteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WriteFailsafeDurationMinimum not implemented")
+}
+func (UnimplementedLPCServiceServer) GetPowerConsumptionNominalMax(context.Context, *EntityRequest) (*wrapperspb.DoubleValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPowerConsumptionNominalMax not implemented")
+}
+func (UnimplementedLPCServiceServer) mustEmbedUnimplementedLPCServiceServer() {}
+
+// UnsafeLPCServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LPCServiceServer will
+// result in compilation errors.
+type UnsafeLPCServiceServer interface {
+	mustEmbedUnimplementedLPCServiceServer()
+}
+
+func RegisterLPCServiceServer(s grpc.ServiceRegistrar, srv LPCServiceServer) {
+	s.RegisterService(&LPCService_ServiceDesc, srv)
+}
+
+func _LPCService_GetConsumptionLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EntityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LPCServiceServer).GetConsumptionLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LPCService_GetConsumptionLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LPCServiceServer).GetConsumptionLimit(ctx, req.(*EntityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LPCService_WriteConsumptionLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WriteLoadLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LPCServiceServer).WriteConsumptionLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LPCService_WriteConsumptionLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LPCServiceServer).WriteConsumptionLimit(ctx, req.(*WriteLoadLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LPCService_GetFailsafeConsumptionActivePowerLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EntityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LPCServiceServer).GetFailsafeConsumptionActivePowerLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LPCService_GetFailsafeConsumptionActivePowerLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LPCServiceServer).GetFailsafeConsumptionActivePowerLimit(ctx, req.(*EntityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LPCService_WriteFailsafeConsumptionActivePowerLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WriteDoubleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LPCServiceServer).WriteFailsafeConsumptionActivePowerLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LPCService_WriteFailsafeConsumptionActivePowerLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LPCServiceServer).WriteFailsafeConsumptionActivePowerLimit(ctx, req.(*WriteDoubleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LPCService_GetFailsafeDurationMinimum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EntityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LPCServiceServer).GetFailsafeDurationMinimum(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LPCService_GetFailsafeDurationMinimum_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LPCServiceServer).GetFailsafeDurationMinimum(ctx, req.(*EntityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LPCService_WriteFailsafeDurationMinimum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WriteDurationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LPCServiceServer).WriteFailsafeDurationMinimum(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LPCService_WriteFailsafeDurationMinimum_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LPCServiceServer).WriteFailsafeDurationMinimum(ctx, req.(*WriteDurationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LPCService_GetPowerConsumptionNominalMax_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EntityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LPCServiceServer).GetPowerConsumptionNominalMax(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LPCService_GetPowerConsumptionNominalMax_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LPCServiceServer).GetPowerConsumptionNominalMax(ctx, req.(*EntityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// LPCService_ServiceDesc is the grpc.ServiceDesc for LPCService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var LPCService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cemd.v1.LPCService",
+	HandlerType: (*LPCServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetConsumptionLimit",
+			Handler:    _LPCService_GetConsumptionLimit_Handler,
+		},
+		{
+			MethodName: "WriteConsumptionLimit",
+			Handler:    _LPCService_WriteConsumptionLimit_Handler,
+		},
+		{
+			MethodName: "GetFailsafeConsumptionActivePowerLimit",
+			Handler:    _LPCService_GetFailsafeConsumptionActivePowerLimit_Handler,
+		},
+		{
+			MethodName: "WriteFailsafeConsumptionActivePowerLimit",
+			Handler:    _LPCService_WriteFailsafeConsumptionActivePowerLimit_Handler,
+		},
+		{
+			MethodName: "GetFailsafeDurationMinimum",
+			Handler:    _LPCService_GetFailsafeDurationMinimum_Handler,
+		},
+		{
+			MethodName: "WriteFailsafeDurationMinimum",
+			Handler:    _LPCService_WriteFailsafeDurationMinimum_Handler,
+		},
+		{
+			MethodName: "GetPowerConsumptionNominalMax",
+			Handler:    _LPCService_GetPowerConsumptionNominalMax_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cemd/v1/cemd.proto",
+}
+
+const (
+	LPPService_GetProductionLimit_FullMethodName                      = "/cemd.v1.LPPService/GetProductionLimit"
+	LPPService_WriteProductionLimit_FullMethodName                    = "/cemd.v1.LPPService/WriteProductionLimit"
+	LPPService_GetFailsafeProductionActivePowerLimit_FullMethodName   = "/cemd.v1.LPPService/GetFailsafeProductionActivePowerLimit"
+	LPPService_WriteFailsafeProductionActivePowerLimit_FullMethodName = "/cemd.v1.LPPService/WriteFailsafeProductionActivePowerLimit"
+	LPPService_GetFailsafeDurationMinimum_FullMethodName              = "/cemd.v1.LPPService/GetFailsafeDurationMinimum"
+	LPPService_WriteFailsafeDurationMinimum_FullMethodName            = "/cemd.v1.LPPService/WriteFailsafeDurationMinimum"
+	LPPService_GetPowerProductionNominalMax_FullMethodName            = "/cemd.v1.LPPService/GetPowerProductionNominalMax"
+)
+
+// LPPServiceClient is the client API for LPPService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type LPPServiceClient interface {
+	GetProductionLimit(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*LoadLimit, error)
+	WriteProductionLimit(ctx context.Context, in *WriteLoadLimitRequest, opts ...grpc.CallOption) (*WriteResponse, error)
+	GetFailsafeProductionActivePowerLimit(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*wrapperspb.DoubleValue, error)
+	WriteFailsafeProductionActivePowerLimit(ctx context.Context, in *WriteDoubleRequest, opts ...grpc.CallOption) (*WriteResponse, error)
+	GetFailsafeDurationMinimum(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*durationpb.Duration, error)
+	WriteFailsafeDurationMinimum(ctx context.Context, in *WriteDurationRequest, opts ...grpc.CallOption) (*WriteResponse, error)
+	GetPowerProductionNominalMax(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*wrapperspb.DoubleValue, error)
+}
+
+type lPPServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewLPPServiceClient(cc grpc.ClientConnInterface) LPPServiceClient {
+	return &lPPServiceClient{cc}
+}
+
+func (c *lPPServiceClient) GetProductionLimit(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*LoadLimit, error) {
+	out := new(LoadLimit)
+	err := c.cc.Invoke(ctx, LPPService_GetProductionLimit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lPPServiceClient) WriteProductionLimit(ctx context.Context, in *WriteLoadLimitRequest, opts ...grpc.CallOption) (*WriteResponse, error) {
+	out := new(WriteResponse)
+	err := c.cc.Invoke(ctx, LPPService_WriteProductionLimit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lPPServiceClient) GetFailsafeProductionActivePowerLimit(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*wrapperspb.DoubleValue, error) {
+	out := new(wrapperspb.DoubleValue)
+	err := c.cc.Invoke(ctx, LPPService_GetFailsafeProductionActivePowerLimit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lPPServiceClient) WriteFailsafeProductionActivePowerLimit(ctx context.Context, in *WriteDoubleRequest, opts ...grpc.CallOption) (*WriteResponse, error) {
+	out := new(WriteResponse)
+	err := c.cc.Invoke(ctx, LPPService_WriteFailsafeProductionActivePowerLimit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lPPServiceClient) GetFailsafeDurationMinimum(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*durationpb.Duration, error) {
+	out := new(durationpb.Duration)
+	err := c.cc.Invoke(ctx, LPPService_GetFailsafeDurationMinimum_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lPPServiceClient) WriteFailsafeDurationMinimum(ctx context.Context, in *WriteDurationRequest, opts ...grpc.CallOption) (*WriteResponse, error) {
+	out := new(WriteResponse)
+	err := c.cc.Invoke(ctx, LPPService_WriteFailsafeDurationMinimum_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lPPServiceClient) GetPowerProductionNominalMax(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*wrapperspb.DoubleValue, error) {
+	out := new(wrapperspb.DoubleValue)
+	err := c.cc.Invoke(ctx, LPPService_GetPowerProductionNominalMax_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LPPServiceServer is the server API for LPPService service.
+// All implementations must embed UnimplementedLPPServiceServer
+// for forward compatibility
+type LPPServiceServer interface {
+	GetProductionLimit(context.Context, *EntityRequest) (*LoadLimit, error)
+	WriteProductionLimit(context.Context, *WriteLoadLimitRequest) (*WriteResponse, error)
+	GetFailsafeProductionActivePowerLimit(context.Context, *EntityRequest) (*wrapperspb.DoubleValue, error)
+	WriteFailsafeProductionActivePowerLimit(context.Context, *WriteDoubleRequest) (*WriteResponse, error)
+	GetFailsafeDurationMinimum(context.Context, *EntityRequest) (*durationpb.Duration, error)
+	WriteFailsafeDurationMinimum(context.Context, *WriteDurationRequest) (*WriteResponse, error)
+	GetPowerProductionNominalMax(context.Context, *EntityRequest) (*wrapperspb.DoubleValue, error)
+	mustEmbedUnimplementedLPPServiceServer()
+}
+
+// UnimplementedLPPServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedLPPServiceServer struct {
+}
+
+func (UnimplementedLPPServiceServer) GetProductionLimit(context.Context, *EntityRequest) (*LoadLimit, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProductionLimit not implemented")
+}
+func (UnimplementedLPPServiceServer) WriteProductionLimit(context.Context, *WriteLoadLimitRequest) (*WriteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WriteProductionLimit not implemented")
+}
+func (UnimplementedLPPServiceServer) GetFailsafeProductionActivePowerLimit(context.Context, *EntityRequest) (*wrapperspb.DoubleValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFailsafeProductionActivePowerLimit not implemented")
+}
+func (UnimplementedLPPServiceServer) WriteFailsafeProductionActivePowerLimit(context.Context, *WriteDoubleRequest) (*WriteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WriteFailsafeProductionActivePowerLimit not implemented")
+}
+func (UnimplementedLPPServiceServer) GetFailsafeDurationMinimum(context.Context, *EntityRequest) (*durationpb.Duration, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFailsafeDurationMinimum not implemented")
+}
+func (UnimplementedLPPServiceServer) WriteFailsafeDurationMinimum(context.Context, *WriteDurationRequest) (*WriteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WriteFailsafeDurationMinimum not implemented")
+}
+func (UnimplementedLPPServiceServer) GetPowerProductionNominalMax(context.Context, *EntityRequest) (*wrapperspb.DoubleValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPowerProductionNominalMax not implemented")
+}
+func (UnimplementedLPPServiceServer) mustEmbedUnimplementedLPPServiceServer() {}
+
+// UnsafeLPPServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LPPServiceServer will
+// result in compilation errors.
+type UnsafeLPPServiceServer interface {
+	mustEmbedUnimplementedLPPServiceServer()
+}
+
+func RegisterLPPServiceServer(s grpc.ServiceRegistrar, srv LPPServiceServer) {
+	s.RegisterService(&LPPService_ServiceDesc, srv)
+}
+
+func _LPPService_GetProductionLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EntityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LPPServiceServer).GetProductionLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LPPService_GetProductionLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LPPServiceServer).GetProductionLimit(ctx, req.(*EntityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LPPService_WriteProductionLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WriteLoadLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LPPServiceServer).WriteProductionLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LPPService_WriteProductionLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LPPServiceServer).WriteProductionLimit(ctx, req.(*WriteLoadLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LPPService_GetFailsafeProductionActivePowerLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EntityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LPPServiceServer).GetFailsafeProductionActivePowerLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LPPService_GetFailsafeProductionActivePowerLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LPPServiceServer).GetFailsafeProductionActivePowerLimit(ctx, req.(*EntityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LPPService_WriteFailsafeProductionActivePowerLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WriteDoubleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LPPServiceServer).WriteFailsafeProductionActivePowerLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LPPService_WriteFailsafeProductionActivePowerLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LPPServiceServer).WriteFailsafeProductionActivePowerLimit(ctx, req.(*WriteDoubleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LPPService_GetFailsafeDurationMinimum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EntityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LPPServiceServer).GetFailsafeDurationMinimum(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LPPService_GetFailsafeDurationMinimum_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LPPServiceServer).GetFailsafeDurationMinimum(ctx, req.(*EntityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LPPService_WriteFailsafeDurationMinimum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WriteDurationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LPPServiceServer).WriteFailsafeDurationMinimum(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LPPService_WriteFailsafeDurationMinimum_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LPPServiceServer).WriteFailsafeDurationMinimum(ctx, req.(*WriteDurationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LPPService_GetPowerProductionNominalMax_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EntityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LPPServiceServer).GetPowerProductionNominalMax(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LPPService_GetPowerProductionNominalMax_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LPPServiceServer).GetPowerProductionNominalMax(ctx, req.(*EntityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// LPPService_ServiceDesc is the grpc.ServiceDesc for LPPService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var LPPService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cemd.v1.LPPService",
+	HandlerType: (*LPPServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetProductionLimit",
+			Handler:    _LPPService_GetProductionLimit_Handler,
+		},
+		{
+			MethodName: "WriteProductionLimit",
+			Handler:    _LPPService_WriteProductionLimit_Handler,
+		},
+		{
+			MethodName: "GetFailsafeProductionActivePowerLimit",
+			Handler:    _LPPService_GetFailsafeProductionActivePowerLimit_Handler,
+		},
+		{
+			MethodName: "WriteFailsafeProductionActivePowerLimit",
+			Handler:    _LPPService_WriteFailsafeProductionActivePowerLimit_Handler,
+		},
+		{
+			MethodName: "GetFailsafeDurationMinimum",
+			Handler:    _LPPService_GetFailsafeDurationMinimum_Handler,
+		},
+		{
+			MethodName: "WriteFailsafeDurationMinimum",
+			Handler:    _LPPService_WriteFailsafeDurationMinimum_Handler,
+		},
+		{
+			MethodName: "GetPowerProductionNominalMax",
+			Handler:    _LPPService_GetPowerProductionNominalMax_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cemd/v1/cemd.proto",
+}
+
+const (
+	MGCPService_GetPowerLimitationFactor_FullMethodName = "/cemd.v1.MGCPService/GetPowerLimitationFactor"
+	MGCPService_GetPower_FullMethodName                 = "/cemd.v1.MGCPService/GetPower"
+	MGCPService_GetEnergyFeedIn_FullMethodName          = "/cemd.v1.MGCPService/GetEnergyFeedIn"
+	MGCPService_GetEnergyConsumed_FullMethodName        = "/cemd.v1.MGCPService/GetEnergyConsumed"
+	MGCPService_GetCurrentPerPhase_FullMethodName       = "/cemd.v1.MGCPService/GetCurrentPerPhase"
+	MGCPService_GetVoltagePerPhase_FullMethodName       = "/cemd.v1.MGCPService/GetVoltagePerPhase"
+	MGCPService_GetFrequency_FullMethodName             = "/cemd.v1.MGCPService/GetFrequency"
+)
+
+// MGCPServiceClient is the client API for MGCPService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MGCPServiceClient interface {
+	GetPowerLimitationFactor(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*wrapperspb.DoubleValue, error)
+	GetPower(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*wrapperspb.DoubleValue, error)
+	GetEnergyFeedIn(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*wrapperspb.DoubleValue, error)
+	GetEnergyConsumed(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*wrapperspb.DoubleValue, error)
+	GetCurrentPerPhase(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*PhaseValues, error)
+	GetVoltagePerPhase(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*PhaseValues, error)
+	GetFrequency(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*wrapperspb.DoubleValue, error)
+}
+
+type mGCPServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMGCPServiceClient(cc grpc.ClientConnInterface) MGCPServiceClient {
+	return &mGCPServiceClient{cc}
+}
+
+func (c *mGCPServiceClient) GetPowerLimitationFactor(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*wrapperspb.DoubleValue, error) {
+	out := new(wrapperspb.DoubleValue)
+	err := c.cc.Invoke(ctx, MGCPService_GetPowerLimitationFactor_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mGCPServiceClient) GetPower(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*wrapperspb.DoubleValue, error) {
+	out := new(wrapperspb.DoubleValue)
+	err := c.cc.Invoke(ctx, MGCPService_GetPower_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mGCPServiceClient) GetEnergyFeedIn(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*wrapperspb.DoubleValue, error) {
+	out := new(wrapperspb.DoubleValue)
+	err := c.cc.Invoke(ctx, MGCPService_GetEnergyFeedIn_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mGCPServiceClient) GetEnergyConsumed(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*wrapperspb.DoubleValue, error) {
+	out := new(wrapperspb.DoubleValue)
+	err := c.cc.Invoke(ctx, MGCPService_GetEnergyConsumed_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mGCPServiceClient) GetCurrentPerPhase(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*PhaseValues, error) {
+	out := new(PhaseValues)
+	err := c.cc.Invoke(ctx, MGCPService_GetCurrentPerPhase_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mGCPServiceClient) GetVoltagePerPhase(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*PhaseValues, error) {
+	out := new(PhaseValues)
+	err := c.cc.Invoke(ctx, MGCPService_GetVoltagePerPhase_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mGCPServiceClient) GetFrequency(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*wrapperspb.DoubleValue, error) {
+	out := new(wrapperspb.DoubleValue)
+	err := c.cc.Invoke(ctx, MGCPService_GetFrequency_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MGCPServiceServer is the server API for MGCPService service.
+// All implementations must embed UnimplementedMGCPServiceServer
+// for forward compatibility
+type MGCPServiceServer interface {
+	GetPowerLimitationFactor(context.Context, *EntityRequest) (*wrapperspb.DoubleValue, error)
+	GetPower(context.Context, *EntityRequest) (*wrapperspb.DoubleValue, error)
+	GetEnergyFeedIn(context.Context, *EntityRequest) (*wrapperspb.DoubleValue, error)
+	GetEnergyConsumed(context.Context, *EntityRequest) (*wrapperspb.DoubleValue, error)
+	GetCurrentPerPhase(context.Context, *EntityRequest) (*PhaseValues, error)
+	GetVoltagePerPhase(context.Context, *EntityRequest) (*PhaseValues, error)
+	GetFrequency(context.Context, *EntityRequest) (*wrapperspb.DoubleValue, error)
+	mustEmbedUnimplementedMGCPServiceServer()
+}
+
+// UnimplementedMGCPServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedMGCPServiceServer struct {
+}
+
+func (UnimplementedMGCPServiceServer) GetPowerLimitationFactor(context.Context, *EntityRequest) (*wrapperspb.DoubleValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPowerLimitationFactor not implemented")
+}
+func (UnimplementedMGCPServiceServer) GetPower(context.Context, *EntityRequest) (*wrapperspb.DoubleValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPower not implemented")
+}
+func (UnimplementedMGCPServiceServer) GetEnergyFeedIn(context.Context, *EntityRequest) (*wrapperspb.DoubleValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEnergyFeedIn not implemented")
+}
+func (UnimplementedMGCPServiceServer) GetEnergyConsumed(context.Context, *EntityRequest) (*wrapperspb.DoubleValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEnergyConsumed not implemented")
+}
+func (UnimplementedMGCPServiceServer) GetCurrentPerPhase(context.Context, *EntityRequest) (*PhaseValues, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCurrentPerPhase not implemented")
+}
+func (UnimplementedMGCPServiceServer) GetVoltagePerPhase(context.Context, *EntityRequest) (*PhaseValues, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVoltagePerPhase not implemented")
+}
+func (UnimplementedMGCPServiceServer) GetFrequency(context.Context, *EntityRequest) (*wrapperspb.DoubleValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFrequency not implemented")
+}
+func (UnimplementedMGCPServiceServer) mustEmbedUnimplementedMGCPServiceServer() {}
+
+// UnsafeMGCPServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MGCPServiceServer will
+// result in compilation errors.
+type UnsafeMGCPServiceServer interface {
+	mustEmbedUnimplementedMGCPServiceServer()
+}
+
+func RegisterMGCPServiceServer(s grpc.ServiceRegistrar, srv MGCPServiceServer) {
+	s.RegisterService(&MGCPService_ServiceDesc, srv)
+}
+
+func _MGCPService_GetPowerLimitationFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EntityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MGCPServiceServer).GetPowerLimitationFactor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MGCPService_GetPowerLimitationFactor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MGCPServiceServer).GetPowerLimitationFactor(ctx, req.(*EntityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MGCPService_GetPower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EntityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MGCPServiceServer).GetPower(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MGCPService_GetPower_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MGCPServiceServer).GetPower(ctx, req.(*EntityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MGCPService_GetEnergyFeedIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EntityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MGCPServiceServer).GetEnergyFeedIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MGCPService_GetEnergyFeedIn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MGCPServiceServer).GetEnergyFeedIn(ctx, req.(*EntityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MGCPService_GetEnergyConsumed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EntityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MGCPServiceServer).GetEnergyConsumed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MGCPService_GetEnergyConsumed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MGCPServiceServer).GetEnergyConsumed(ctx, req.(*EntityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MGCPService_GetCurrentPerPhase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EntityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MGCPServiceServer).GetCurrentPerPhase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MGCPService_GetCurrentPerPhase_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MGCPServiceServer).GetCurrentPerPhase(ctx, req.(*EntityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MGCPService_GetVoltagePerPhase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EntityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MGCPServiceServer).GetVoltagePerPhase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MGCPService_GetVoltagePerPhase_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MGCPServiceServer).GetVoltagePerPhase(ctx, req.(*EntityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MGCPService_GetFrequency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EntityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MGCPServiceServer).GetFrequency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MGCPService_GetFrequency_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MGCPServiceServer).GetFrequency(ctx, req.(*EntityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MGCPService_ServiceDesc is the grpc.ServiceDesc for MGCPService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MGCPService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cemd.v1.MGCPService",
+	HandlerType: (*MGCPServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetPowerLimitationFactor",
+			Handler:    _MGCPService_GetPowerLimitationFactor_Handler,
+		},
+		{
+			MethodName: "GetPower",
+			Handler:    _MGCPService_GetPower_Handler,
+		},
+		{
+			MethodName: "GetEnergyFeedIn",
+			Handler:    _MGCPService_GetEnergyFeedIn_Handler,
+		},
+		{
+			MethodName: "GetEnergyConsumed",
+			Handler:    _MGCPService_GetEnergyConsumed_Handler,
+		},
+		{
+			MethodName: "GetCurrentPerPhase",
+			Handler:    _MGCPService_GetCurrentPerPhase_Handler,
+		},
+		{
+			MethodName: "GetVoltagePerPhase",
+			Handler:    _MGCPService_GetVoltagePerPhase_Handler,
+		},
+		{
+			MethodName: "GetFrequency",
+			Handler:    _MGCPService_GetFrequency_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cemd/v1/cemd.proto",
+}
+
+const (
+	MPCService_GetPower_FullMethodName           = "/cemd.v1.MPCService/GetPower"
+	MPCService_GetPowerPerPhase_FullMethodName   = "/cemd.v1.MPCService/GetPowerPerPhase"
+	MPCService_GetEnergyConsumed_FullMethodName  = "/cemd.v1.MPCService/GetEnergyConsumed"
+	MPCService_GetEnergyProduced_FullMethodName  = "/cemd.v1.MPCService/GetEnergyProduced"
+	MPCService_GetCurrentPerPhase_FullMethodName = "/cemd.v1.MPCService/GetCurrentPerPhase"
+	MPCService_GetVoltagePerPhase_FullMethodName = "/cemd.v1.MPCService/GetVoltagePerPhase"
+	MPCService_GetFrequency_FullMethodName       = "/cemd.v1.MPCService/GetFrequency"
+)
+
+// MPCServiceClient is the client API for MPCService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MPCServiceClient interface {
+	GetPower(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*wrapperspb.DoubleValue, error)
+	GetPowerPerPhase(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*PhaseValues, error)
+	GetEnergyConsumed(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*wrapperspb.DoubleValue, error)
+	GetEnergyProduced(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*wrapperspb.DoubleValue, error)
+	GetCurrentPerPhase(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*PhaseValues, error)
+	GetVoltagePerPhase(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*PhaseValues, error)
+	GetFrequency(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*wrapperspb.DoubleValue, error)
+}
+
+type mPCServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMPCServiceClient(cc grpc.ClientConnInterface) MPCServiceClient {
+	return &mPCServiceClient{cc}
+}
+
+func (c *mPCServiceClient) GetPower(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*wrapperspb.DoubleValue, error) {
+	out := new(wrapperspb.DoubleValue)
+	err := c.cc.Invoke(ctx, MPCService_GetPower_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mPCServiceClient) GetPowerPerPhase(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*PhaseValues, error) {
+	out := new(PhaseValues)
+	err := c.cc.Invoke(ctx, MPCService_GetPowerPerPhase_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mPCServiceClient) GetEnergyConsumed(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*wrapperspb.DoubleValue, error) {
+	out := new(wrapperspb.DoubleValue)
+	err := c.cc.Invoke(ctx, MPCService_GetEnergyConsumed_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mPCServiceClient) GetEnergyProduced(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*wrapperspb.DoubleValue, error) {
+	out := new(wrapperspb.DoubleValue)
+	err := c.cc.Invoke(ctx, MPCService_GetEnergyProduced_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mPCServiceClient) GetCurrentPerPhase(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*PhaseValues, error) {
+	out := new(PhaseValues)
+	err := c.cc.Invoke(ctx, MPCService_GetCurrentPerPhase_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mPCServiceClient) GetVoltagePerPhase(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*PhaseValues, error) {
+	out := new(PhaseValues)
+	err := c.cc.Invoke(ctx, MPCService_GetVoltagePerPhase_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mPCServiceClient) GetFrequency(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*wrapperspb.DoubleValue, error) {
+	out := new(wrapperspb.DoubleValue)
+	err := c.cc.Invoke(ctx, MPCService_GetFrequency_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MPCServiceServer is the server API for MPCService service.
+// All implementations must embed UnimplementedMPCServiceServer
+// for forward compatibility
+type MPCServiceServer interface {
+	GetPower(context.Context, *EntityRequest) (*wrapperspb.DoubleValue, error)
+	GetPowerPerPhase(context.Context, *EntityRequest) (*PhaseValues, error)
+	GetEnergyConsumed(context.Context, *EntityRequest) (*wrapperspb.DoubleValue, error)
+	GetEnergyProduced(context.Context, *EntityRequest) (*wrapperspb.DoubleValue, error)
+	GetCurrentPerPhase(context.Context, *EntityRequest) (*PhaseValues, error)
+	GetVoltagePerPhase(context.Context, *EntityRequest) (*PhaseValues, error)
+	GetFrequency(context.Context, *EntityRequest) (*wrapperspb.DoubleValue, error)
+	mustEmbedUnimplementedMPCServiceServer()
+}
+
+// UnimplementedMPCServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedMPCServiceServer struct {
+}
+
+func (UnimplementedMPCServiceServer) GetPower(context.Context, *EntityRequest) (*wrapperspb.DoubleValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPower not implemented")
+}
+func (UnimplementedMPCServiceServer) GetPowerPerPhase(context.Context, *EntityRequest) (*PhaseValues, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPowerPerPhase not implemented")
+}
+func (UnimplementedMPCServiceServer) GetEnergyConsumed(context.Context, *EntityRequest) (*wrapperspb.DoubleValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEnergyConsumed not implemented")
+}
+func (UnimplementedMPCServiceServer) GetEnergyProduced(context.Context, *EntityRequest) (*wrapperspb.DoubleValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEnergyProduced not implemented")
+}
+func (UnimplementedMPCServiceServer) GetCurrentPerPhase(context.Context, *EntityRequest) (*PhaseValues, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCurrentPerPhase not implemented")
+}
+func (UnimplementedMPCServiceServer) GetVoltagePerPhase(context.Context, *EntityRequest) (*PhaseValues, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVoltagePerPhase not implemented")
+}
+func (UnimplementedMPCServiceServer) GetFrequency(context.Context, *EntityRequest) (*wrapperspb.DoubleValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFrequency not implemented")
+}
+func (UnimplementedMPCServiceServer) mustEmbedUnimplementedMPCServiceServer() {}
+
+// UnsafeMPCServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MPCServiceServer will
+// result in compilation errors.
+type UnsafeMPCServiceServer interface {
+	mustEmbedUnimplementedMPCServiceServer()
+}
+
+func RegisterMPCServiceServer(s grpc.ServiceRegistrar, srv MPCServiceServer) {
+	s.RegisterService(&MPCService_ServiceDesc, srv)
+}
+
+func _MPCService_GetPower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EntityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MPCServiceServer).GetPower(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MPCService_GetPower_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MPCServiceServer).GetPower(ctx, req.(*EntityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MPCService_GetPowerPerPhase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EntityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MPCServiceServer).GetPowerPerPhase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MPCService_GetPowerPerPhase_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MPCServiceServer).GetPowerPerPhase(ctx, req.(*EntityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MPCService_GetEnergyConsumed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EntityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MPCServiceServer).GetEnergyConsumed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MPCService_GetEnergyConsumed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MPCServiceServer).GetEnergyConsumed(ctx, req.(*EntityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MPCService_GetEnergyProduced_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EntityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MPCServiceServer).GetEnergyProduced(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MPCService_GetEnergyProduced_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MPCServiceServer).GetEnergyProduced(ctx, req.(*EntityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MPCService_GetCurrentPerPhase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EntityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MPCServiceServer).GetCurrentPerPhase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MPCService_GetCurrentPerPhase_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MPCServiceServer).GetCurrentPerPhase(ctx, req.(*EntityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MPCService_GetVoltagePerPhase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EntityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MPCServiceServer).GetVoltagePerPhase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MPCService_GetVoltagePerPhase_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MPCServiceServer).GetVoltagePerPhase(ctx, req.(*EntityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MPCService_GetFrequency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EntityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MPCServiceServer).GetFrequency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MPCService_GetFrequency_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MPCServiceServer).GetFrequency(ctx, req.(*EntityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MPCService_ServiceDesc is the grpc.ServiceDesc for MPCService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MPCService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cemd.v1.MPCService",
+	HandlerType: (*MPCServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetPower",
+			Handler:    _MPCService_GetPower_Handler,
+		},
+		{
+			MethodName: "GetPowerPerPhase",
+			Handler:    _MPCService_GetPowerPerPhase_Handler,
+		},
+		{
+			MethodName: "GetEnergyConsumed",
+			Handler:    _MPCService_GetEnergyConsumed_Handler,
+		},
+		{
+			MethodName: "GetEnergyProduced",
+			Handler:    _MPCService_GetEnergyProduced_Handler,
+		},
+		{
+			MethodName: "GetCurrentPerPhase",
+			Handler:    _MPCService_GetCurrentPerPhase_Handler,
+		},
+		{
+			MethodName: "GetVoltagePerPhase",
+			Handler:    _MPCService_GetVoltagePerPhase_Handler,
+		},
+		{
+			MethodName: "GetFrequency",
+			Handler:    _MPCService_GetFrequency_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cemd/v1/cemd.proto",
+}
+
+const (
+	EVCCService_GetChargeState_FullMethodName               = "/cemd.v1.EVCCService/GetChargeState"
+	EVCCService_GetEVConnected_FullMethodName               = "/cemd.v1.EVCCService/GetEVConnected"
+	EVCCService_GetCommunicationStandard_FullMethodName     = "/cemd.v1.EVCCService/GetCommunicationStandard"
+	EVCCService_GetAsymmetricChargingSupport_FullMethodName = "/cemd.v1.EVCCService/GetAsymmetricChargingSupport"
+	EVCCService_GetIdentifications_FullMethodName           = "/cemd.v1.EVCCService/GetIdentifications"
+	EVCCService_GetManufacturerData_FullMethodName          = "/cemd.v1.EVCCService/GetManufacturerData"
+	EVCCService_GetChargingPowerLimits_FullMethodName       = "/cemd.v1.EVCCService/GetChargingPowerLimits"
+	EVCCService_GetIsInSleepMode_FullMethodName             = "/cemd.v1.EVCCService/GetIsInSleepMode"
+)
+
+// EVCCServiceClient is the client API for EVCCService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type EVCCServiceClient interface {
+	// the charge state, e.g. "active" or "unplugged"
+	GetChargeState(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*wrapperspb.StringValue, error)
+	GetEVConnected(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*wrapperspb.BoolValue, error)
+	GetCommunicationStandard(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*wrapperspb.StringValue, error)
+	GetAsymmetricChargingSupport(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*wrapperspb.BoolValue, error)
+	GetIdentifications(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*Identifications, error)
+	GetManufacturerData(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*ManufacturerData, error)
+	GetChargingPowerLimits(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*ChargingPowerLimits, error)
+	GetIsInSleepMode(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*wrapperspb.BoolValue, error)
+}
+
+type eVCCServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEVCCServiceClient(cc grpc.ClientConnInterface) EVCCServiceClient {
+	return &eVCCServiceClient{cc}
+}
+
+func (c *eVCCServiceClient) GetChargeState(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*wrapperspb.StringValue, error) {
+	out := new(wrapperspb.StringValue)
+	err := c.cc.Invoke(ctx, EVCCService_GetChargeState_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eVCCServiceClient) GetEVConnected(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*wrapperspb.BoolValue, error) {
+	out := new(wrapperspb.BoolValue)
+	err := c.cc.Invoke(ctx, EVCCService_GetEVConnected_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eVCCServiceClient) GetCommunicationStandard(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*wrapperspb.StringValue, error) {
+	out := new(wrapperspb.StringValue)
+	err := c.cc.Invoke(ctx, EVCCService_GetCommunicationStandard_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eVCCServiceClient) GetAsymmetricChargingSupport(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*wrapperspb.BoolValue, error) {
+	out := new(wrapperspb.BoolValue)
+	err := c.cc.Invoke(ctx, EVCCService_GetAsymmetricChargingSupport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eVCCServiceClient) GetIdentifications(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*Identifications, error) {
+	out := new(Identifications)
+	err := c.cc.Invoke(ctx, EVCCService_GetIdentifications_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eVCCServiceClient) GetManufacturerData(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*ManufacturerData, error) {
+	out := new(ManufacturerData)
+	err := c.cc.Invoke(ctx, EVCCService_GetManufacturerData_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eVCCServiceClient) GetChargingPowerLimits(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*ChargingPowerLimits, error) {
+	out := new(ChargingPowerLimits)
+	err := c.cc.Invoke(ctx, EVCCService_GetChargingPowerLimits_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eVCCServiceClient) GetIsInSleepMode(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*wrapperspb.BoolValue, error) {
+	out := new(wrapperspb.BoolValue)
+	err := c.cc.Invoke(ctx, EVCCService_GetIsInSleepMode_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EVCCServiceServer is the server API for EVCCService service.
+// All implementations must embed UnimplementedEVCCServiceServer
+// for forward compatibility
+type EVCCServiceServer interface {
+	// the charge state, e.g. "active" or "unplugged"
+	GetChargeState(context.Context, *EntityRequest) (*wrapperspb.StringValue, error)
+	GetEVConnected(context.Context, *EntityRequest) (*wrapperspb.BoolValue, error)
+	GetCommunicationStandard(context.Context, *EntityRequest) (*wrapperspb.StringValue, error)
+	GetAsymmetricChargingSupport(context.Context, *EntityRequest) (*wrapperspb.BoolValue, error)
+	GetIdentifications(context.Context, *EntityRequest) (*Identifications, error)
+	GetManufacturerData(context.Context, *EntityRequest) (*ManufacturerData, error)
+	GetChargingPowerLimits(context.Context, *EntityRequest) (*ChargingPowerLimits, error)
+	GetIsInSleepMode(context.Context, *EntityRequest) (*wrapperspb.BoolValue, error)
+	mustEmbedUnimplementedEVCCServiceServer()
+}
+
+// UnimplementedEVCCServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedEVCCServiceServer struct {
+}
+
+func (UnimplementedEVCCServiceServer) GetChargeState(context.Context, *EntityRequest) (*wrapperspb.StringValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChargeState not implemented")
+}
+func (UnimplementedEVCCServiceServer) GetEVConnected(context.Context, *EntityRequest) (*wrapperspb.BoolValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEVConnected not implemented")
+}
+func (UnimplementedEVCCServiceServer) GetCommunicationStandard(context.Context, *EntityRequest) (*wrapperspb.StringValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommunicationStandard not implemented")
+}
+func (UnimplementedEVCCServiceServer) GetAsymmetricChargingSupport(context.Context, *EntityRequest) (*wrapperspb.BoolValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAsymmetricChargingSupport not implemented")
+}
+func (UnimplementedEVCCServiceServer) GetIdentifications(context.Context, *EntityRequest) (*Identifications, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIdentifications not implemented")
+}
+func (UnimplementedEVCCServiceServer) GetManufacturerData(context.Context, *EntityRequest) (*ManufacturerData, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetManufacturerData not implemented")
+}
+func (UnimplementedEVCCServiceServer) GetChargingPowerLimits(context.Context, *EntityRequest) (*ChargingPowerLimits, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChargingPowerLimits not implemented")
+}
+func (UnimplementedEVCCServiceServer) GetIsInSleepMode(context.Context, *EntityRequest) (*wrapperspb.BoolValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIsInSleepMode not implemented")
+}
+func (UnimplementedEVCCServiceServer) mustEmbedUnimplementedEVCCServiceServer() {}
+
+// UnsafeEVCCServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to EVCCServiceServer will
+// result in compilation errors.
+type UnsafeEVCCServiceServer interface {
+	mustEmbedUnimplementedEVCCServiceServer()
+}
+
+func RegisterEVCCServiceServer(s grpc.ServiceRegistrar, srv EVCCServiceServer) {
+	s.RegisterService(&EVCCService_ServiceDesc, srv)
+}
+
+func _EVCCService_GetChargeState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EntityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EVCCServiceServer).GetChargeState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EVCCService_GetChargeState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EVCCServiceServer).GetChargeState(ctx, req.(*EntityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EVCCService_GetEVConnected_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EntityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EVCCServiceServer).GetEVConnected(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EVCCService_GetEVConnected_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EVCCServiceServer).GetEVConnected(ctx, req.(*EntityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EVCCService_GetCommunicationStandard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EntityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EVCCServiceServer).GetCommunicationStandard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EVCCService_GetCommunicationStandard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EVCCServiceServer).GetCommunicationStandard(ctx, req.(*EntityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EVCCService_GetAsymmetricChargingSupport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EntityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EVCCServiceServer).GetAsymmetricChargingSupport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EVCCService_GetAsymmetricChargingSupport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EVCCServiceServer).GetAsymmetricChargingSupport(ctx, req.(*EntityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EVCCService_GetIdentifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EntityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EVCCServiceServer).GetIdentifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EVCCService_GetIdentifications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EVCCServiceServer).GetIdentifications(ctx, req.(*EntityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EVCCService_GetManufacturerData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EntityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EVCCServiceServer).GetManufacturerData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EVCCService_GetManufacturerData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EVCCServiceServer).GetManufacturerData(ctx, req.(*EntityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EVCCService_GetChargingPowerLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EntityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EVCCServiceServer).GetChargingPowerLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EVCCService_GetChargingPowerLimits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EVCCServiceServer).GetChargingPowerLimits(ctx, req.(*EntityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EVCCService_GetIsInSleepMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EntityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EVCCServiceServer).GetIsInSleepMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EVCCService_GetIsInSleepMode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EVCCServiceServer).GetIsInSleepMode(ctx, req.(*EntityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// EVCCService_ServiceDesc is the grpc.ServiceDesc for EVCCService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var EVCCService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cemd.v1.EVCCService",
+	HandlerType: (*EVCCServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetChargeState",
+			Handler:    _EVCCService_GetChargeState_Handler,
+		},
+		{
+			MethodName: "GetEVConnected",
+			Handler:    _EVCCService_GetEVConnected_Handler,
+		},
+		{
+			MethodName: "GetCommunicationStandard",
+			Handler:    _EVCCService_GetCommunicationStandard_Handler,
+		},
+		{
+			MethodName: "GetAsymmetricChargingSupport",
+			Handler:    _EVCCService_GetAsymmetricChargingSupport_Handler,
+		},
+		{
+			MethodName: "GetIdentifications",
+			Handler:    _EVCCService_GetIdentifications_Handler,
+		},
+		{
+			MethodName: "GetManufacturerData",
+			Handler:    _EVCCService_GetManufacturerData_Handler,
+		},
+		{
+			MethodName: "GetChargingPowerLimits",
+			Handler:    _EVCCService_GetChargingPowerLimits_Handler,
+		},
+		{
+			MethodName: "GetIsInSleepMode",
+			Handler:    _EVCCService_GetIsInSleepMode_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cemd/v1/cemd.proto",
+}
+
+const (
+	EVCEMService_GetPhasesConnected_FullMethodName = "/cemd.v1.EVCEMService/GetPhasesConnected"
+	EVCEMService_GetCurrentPerPhase_FullMethodName = "/cemd.v1.EVCEMService/GetCurrentPerPhase"
+	EVCEMService_GetPowerPerPhase_FullMethodName   = "/cemd.v1.EVCEMService/GetPowerPerPhase"
+	EVCEMService_GetEnergyCharged_FullMethodName   = "/cemd.v1.EVCEMService/GetEnergyCharged"
+)
+
+// EVCEMServiceClient is the client API for EVCEMService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type EVCEMServiceClient interface {
+	GetPhasesConnected(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*wrapperspb.UInt32Value, error)
+	GetCurrentPerPhase(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*PhaseValues, error)
+	GetPowerPerPhase(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*PhaseValues, error)
+	GetEnergyCharged(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*wrapperspb.DoubleValue, error)
+}
+
+type eVCEMServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEVCEMServiceClient(cc grpc.ClientConnInterface) EVCEMServiceClient {
+	return &eVCEMServiceClient{cc}
+}
+
+func (c *eVCEMServiceClient) GetPhasesConnected(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*wrapperspb.UInt32Value, error) {
+	out := new(wrapperspb.UInt32Value)
+	err := c.cc.Invoke(ctx, EVCEMService_GetPhasesConnected_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eVCEMServiceClient) GetCurrentPerPhase(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*PhaseValues, error) {
+	out := new(PhaseValues)
+	err := c.cc.Invoke(ctx, EVCEMService_GetCurrentPerPhase_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eVCEMServiceClient) GetPowerPerPhase(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*PhaseValues, error) {
+	out := new(PhaseValues)
+	err := c.cc.Invoke(ctx, EVCEMService_GetPowerPerPhase_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eVCEMServiceClient) GetEnergyCharged(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*wrapperspb.DoubleValue, error) {
+	out := new(wrapperspb.DoubleValue)
+	err := c.cc.Invoke(ctx, EVCEMService_GetEnergyCharged_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EVCEMServiceServer is the server API for EVCEMService service.
+// All implementations must embed UnimplementedEVCEMServiceServer
+// for forward compatibility
+type EVCEMServiceServer interface {
+	GetPhasesConnected(context.Context, *EntityRequest) (*wrapperspb.UInt32Value, error)
+	GetCurrentPerPhase(context.Context, *EntityRequest) (*PhaseValues, error)
+	GetPowerPerPhase(context.Context, *EntityRequest) (*PhaseValues, error)
+	GetEnergyCharged(context.Context, *EntityRequest) (*wrapperspb.DoubleValue, error)
+	mustEmbedUnimplementedEVCEMServiceServer()
+}
+
+// UnimplementedEVCEMServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedEVCEMServiceServer struct {
+}
+
+func (UnimplementedEVCEMServiceServer) GetPhasesConnected(context.Context, *EntityRequest) (*wrapperspb.UInt32Value, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPhasesConnected not implemented")
+}
+func (UnimplementedEVCEMServiceServer) GetCurrentPerPhase(context.Context, *EntityRequest) (*PhaseValues, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCurrentPerPhase not implemented")
+}
+func (UnimplementedEVCEMServiceServer) GetPowerPerPhase(context.Context, *EntityRequest) (*PhaseValues, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPowerPerPhase not implemented")
+}
+func (UnimplementedEVCEMServiceServer) GetEnergyCharged(context.Context, *EntityRequest) (*wrapperspb.DoubleValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEnergyCharged not implemented")
+}
+func (UnimplementedEVCEMServiceServer) mustEmbedUnimplementedEVCEMServiceServer() {}
+
+// UnsafeEVCEMServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to EVCEMServiceServer will
+// result in compilation errors.
+type UnsafeEVCEMServiceServer interface {
+	mustEmbedUnimplementedEVCEMServiceServer()
+}
+
+func RegisterEVCEMServiceServer(s grpc.ServiceRegistrar, srv EVCEMServiceServer) {
+	s.RegisterService(&EVCEMService_ServiceDesc, srv)
+}
+
+func _EVCEMService_GetPhasesConnected_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EntityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EVCEMServiceServer).GetPhasesConnected(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EVCEMService_GetPhasesConnected_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EVCEMServiceServer).GetPhasesConnected(ctx, req.(*EntityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EVCEMService_GetCurrentPerPhase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EntityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EVCEMServiceServer).GetCurrentPerPhase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EVCEMService_GetCurrentPerPhase_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EVCEMServiceServer).GetCurrentPerPhase(ctx, req.(*EntityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EVCEMService_GetPowerPerPhase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EntityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EVCEMServiceServer).GetPowerPerPhase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EVCEMService_GetPowerPerPhase_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EVCEMServiceServer).GetPowerPerPhase(ctx, req.(*EntityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EVCEMService_GetEnergyCharged_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EntityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EVCEMServiceServer).GetEnergyCharged(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EVCEMService_GetEnergyCharged_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EVCEMServiceServer).GetEnergyCharged(ctx, req.(*EntityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// EVCEMService_ServiceDesc is the grpc.ServiceDesc for EVCEMService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var EVCEMService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cemd.v1.EVCEMService",
+	HandlerType: (*EVCEMServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetPhasesConnected",
+			Handler:    _EVCEMService_GetPhasesConnected_Handler,
+		},
+		{
+			MethodName: "GetCurrentPerPhase",
+			Handler:    _EVCEMService_GetCurrentPerPhase_Handler,
+		},
+		{
+			MethodName: "GetPowerPerPhase",
+			Handler:    _EVCEMService_GetPowerPerPhase_Handler,
+		},
+		{
+			MethodName: "GetEnergyCharged",
+			Handler:    _EVCEMService_GetEnergyCharged_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cemd/v1/cemd.proto",
+}
+
+const (
+	EVSECCService_GetManufacturerData_FullMethodName = "/cemd.v1.EVSECCService/GetManufacturerData"
+	EVSECCService_GetOperatingState_FullMethodName   = "/cemd.v1.EVSECCService/GetOperatingState"
+)
+
+// EVSECCServiceClient is the client API for EVSECCService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type EVSECCServiceClient interface {
+	GetManufacturerData(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*ManufacturerData, error)
+	GetOperatingState(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*OperatingState, error)
+}
+
+type eVSECCServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEVSECCServiceClient(cc grpc.ClientConnInterface) EVSECCServiceClient {
+	return &eVSECCServiceClient{cc}
+}
+
+func (c *eVSECCServiceClient) GetManufacturerData(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*ManufacturerData, error) {
+	out := new(ManufacturerData)
+	err := c.cc.Invoke(ctx, EVSECCService_GetManufacturerData_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eVSECCServiceClient) GetOperatingState(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*OperatingState, error) {
+	out := new(OperatingState)
+	err := c.cc.Invoke(ctx, EVSECCService_GetOperatingState_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EVSECCServiceServer is the server API for EVSECCService service.
+// All implementations must embed UnimplementedEVSECCServiceServer
+// for forward compatibility
+type EVSECCServiceServer interface {
+	GetManufacturerData(context.Context, *EntityRequest) (*ManufacturerData, error)
+	GetOperatingState(context.Context, *EntityRequest) (*OperatingState, error)
+	mustEmbedUnimplementedEVSECCServiceServer()
+}
+
+// UnimplementedEVSECCServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedEVSECCServiceServer struct {
+}
+
+func (UnimplementedEVSECCServiceServer) GetManufacturerData(context.Context, *EntityRequest) (*ManufacturerData, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetManufacturerData not implemented")
+}
+func (UnimplementedEVSECCServiceServer) GetOperatingState(context.Context, *EntityRequest) (*OperatingState, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOperatingState not implemented")
+}
+func (UnimplementedEVSECCServiceServer) mustEmbedUnimplementedEVSECCServiceServer() {}
+
+// UnsafeEVSECCServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to EVSECCServiceServer will
+// result in compilation errors.
+type UnsafeEVSECCServiceServer interface {
+	mustEmbedUnimplementedEVSECCServiceServer()
+}
+
+func RegisterEVSECCServiceServer(s grpc.ServiceRegistrar, srv EVSECCServiceServer) {
+	s.RegisterService(&EVSECCService_ServiceDesc, srv)
+}
+
+func _EVSECCService_GetManufacturerData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EntityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EVSECCServiceServer).GetManufacturerData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EVSECCService_GetManufacturerData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EVSECCServiceServer).GetManufacturerData(ctx, req.(*EntityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EVSECCService_GetOperatingState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EntityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EVSECCServiceServer).GetOperatingState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EVSECCService_GetOperatingState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EVSECCServiceServer).GetOperatingState(ctx, req.(*EntityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// EVSECCService_ServiceDesc is the grpc.ServiceDesc for EVSECCService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var EVSECCService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cemd.v1.EVSECCService",
+	HandlerType: (*EVSECCServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetManufacturerData",
+			Handler:    _EVSECCService_GetManufacturerData_Handler,
+		},
+		{
+			MethodName: "GetOperatingState",
+			Handler:    _EVSECCService_GetOperatingState_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cemd/v1/cemd.proto",
+}
+
+const (
+	EVSOCService_GetStateOfCharge_FullMethodName = "/cemd.v1.EVSOCService/GetStateOfCharge"
+)
+
+// EVSOCServiceClient is the client API for EVSOCService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type EVSOCServiceClient interface {
+	GetStateOfCharge(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*wrapperspb.DoubleValue, error)
+}
+
+type eVSOCServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEVSOCServiceClient(cc grpc.ClientConnInterface) EVSOCServiceClient {
+	return &eVSOCServiceClient{cc}
+}
+
+func (c *eVSOCServiceClient) GetStateOfCharge(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*wrapperspb.DoubleValue, error) {
+	out := new(wrapperspb.DoubleValue)
+	err := c.cc.Invoke(ctx, EVSOCService_GetStateOfCharge_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EVSOCServiceServer is the server API for EVSOCService service.
+// All implementations must embed UnimplementedEVSOCServiceServer
+// for forward compatibility
+type EVSOCServiceServer interface {
+	GetStateOfCharge(context.Context, *EntityRequest) (*wrapperspb.DoubleValue, error)
+	mustEmbedUnimplementedEVSOCServiceServer()
+}
+
+// UnimplementedEVSOCServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedEVSOCServiceServer struct {
+}
+
+func (UnimplementedEVSOCServiceServer) GetStateOfCharge(context.Context, *EntityRequest) (*wrapperspb.DoubleValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStateOfCharge not implemented")
+}
+func (UnimplementedEVSOCServiceServer) mustEmbedUnimplementedEVSOCServiceServer() {}
+
+// UnsafeEVSOCServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to EVSOCServiceServer will
+// result in compilation errors.
+type UnsafeEVSOCServiceServer interface {
+	mustEmbedUnimplementedEVSOCServiceServer()
+}
+
+func RegisterEVSOCServiceServer(s grpc.ServiceRegistrar, srv EVSOCServiceServer) {
+	s.RegisterService(&EVSOCService_ServiceDesc, srv)
+}
+
+func _EVSOCService_GetStateOfCharge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EntityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EVSOCServiceServer).GetStateOfCharge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EVSOCService_GetStateOfCharge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EVSOCServiceServer).GetStateOfCharge(ctx, req.(*EntityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// EVSOCService_ServiceDesc is the grpc.ServiceDesc for EVSOCService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var EVSOCService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cemd.v1.EVSOCService",
+	HandlerType: (*EVSOCServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetStateOfCharge",
+			Handler:    _EVSOCService_GetStateOfCharge_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cemd/v1/cemd.proto",
+}
+
+const (
+	OPEVService_GetCurrentLimits_FullMethodName       = "/cemd.v1.OPEVService/GetCurrentLimits"
+	OPEVService_GetLoadControlLimits_FullMethodName   = "/cemd.v1.OPEVService/GetLoadControlLimits"
+	OPEVService_WriteLoadControlLimits_FullMethodName = "/cemd.v1.OPEVService/WriteLoadControlLimits"
+)
+
+// OPEVServiceClient is the client API for OPEVService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type OPEVServiceClient interface {
+	GetCurrentLimits(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*CurrentLimits, error)
+	GetLoadControlLimits(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*LoadLimitsPhases, error)
+	WriteLoadControlLimits(ctx context.Context, in *WriteLoadLimitsPhasesRequest, opts ...grpc.CallOption) (*WriteResponse, error)
+}
+
+type oPEVServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewOPEVServiceClient(cc grpc.ClientConnInterface) OPEVServiceClient {
+	return &oPEVServiceClient{cc}
+}
+
+func (c *oPEVServiceClient) GetCurrentLimits(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*CurrentLimits, error) {
+	out := new(CurrentLimits)
+	err := c.cc.Invoke(ctx, OPEVService_GetCurrentLimits_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oPEVServiceClient) GetLoadControlLimits(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*LoadLimitsPhases, error) {
+	out := new(LoadLimitsPhases)
+	err := c.cc.Invoke(ctx, OPEVService_GetLoadControlLimits_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oPEVServiceClient) WriteLoadControlLimits(ctx context.Context, in *WriteLoadLimitsPhasesRequest, opts ...grpc.CallOption) (*WriteResponse, error) {
+	out := new(WriteResponse)
+	err := c.cc.Invoke(ctx, OPEVService_WriteLoadControlLimits_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// OPEVServiceServer is the server API for OPEVService service.
+// All implementations must embed UnimplementedOPEVServiceServer
+// for forward compatibility
+type OPEVServiceServer interface {
+	GetCurrentLimits(context.Context, *EntityRequest) (*CurrentLimits, error)
+	GetLoadControlLimits(context.Context, *EntityRequest) (*LoadLimitsPhases, error)
+	WriteLoadControlLimits(context.Context, *WriteLoadLimitsPhasesRequest) (*WriteResponse, error)
+	mustEmbedUnimplementedOPEVServiceServer()
+}
+
+// UnimplementedOPEVServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedOPEVServiceServer struct {
+}
+
+func (UnimplementedOPEVServiceServer) GetCurrentLimits(context.Context, *EntityRequest) (*CurrentLimits, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCurrentLimits not implemented")
+}
+func (UnimplementedOPEVServiceServer) GetLoadControlLimits(context.Context, *EntityRequest) (*LoadLimitsPhases, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLoadControlLimits not implemented")
+}
+func (UnimplementedOPEVServiceServer) WriteLoadControlLimits(context.Context, *WriteLoadLimitsPhasesRequest) (*WriteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WriteLoadControlLimits not implemented")
+}
+func (UnimplementedOPEVServiceServer) mustEmbedUnimplementedOPEVServiceServer() {}
+
+// UnsafeOPEVServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to OPEVServiceServer will
+// result in compilation errors.
+type UnsafeOPEVServiceServer interface {
+	mustEmbedUnimplementedOPEVServiceServer()
+}
+
+func RegisterOPEVServiceServer(s grpc.ServiceRegistrar, srv OPEVServiceServer) {
+	s.RegisterService(&OPEVService_ServiceDesc, srv)
+}
+
+func _OPEVService_GetCurrentLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EntityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OPEVServiceServer).GetCurrentLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OPEVService_GetCurrentLimits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OPEVServiceServer).GetCurrentLimits(ctx, req.(*EntityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OPEVService_GetLoadControlLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EntityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OPEVServiceServer).GetLoadControlLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OPEVService_GetLoadControlLimits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OPEVServiceServer).GetLoadControlLimits(ctx, req.(*EntityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OPEVService_WriteLoadControlLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WriteLoadLimitsPhasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OPEVServiceServer).WriteLoadControlLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OPEVService_WriteLoadControlLimits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OPEVServiceServer).WriteLoadControlLimits(ctx, req.(*WriteLoadLimitsPhasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// OPEVService_ServiceDesc is the grpc.ServiceDesc for OPEVService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var OPEVService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cemd.v1.OPEVService",
+	HandlerType: (*OPEVServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetCurrentLimits",
+			Handler:    _OPEVService_GetCurrentLimits_Handler,
+		},
+		{
+			MethodName: "GetLoadControlLimits",
+			Handler:    _OPEVService_GetLoadControlLimits_Handler,
+		},
+		{
+			MethodName: "WriteLoadControlLimits",
+			Handler:    _OPEVService_WriteLoadControlLimits_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cemd/v1/cemd.proto",
+}
+
+const (
+	OSCEVService_GetCurrentLimits_FullMethodName       = "/cemd.v1.OSCEVService/GetCurrentLimits"
+	OSCEVService_GetLoadControlLimits_FullMethodName   = "/cemd.v1.OSCEVService/GetLoadControlLimits"
+	OSCEVService_WriteLoadControlLimits_FullMethodName = "/cemd.v1.OSCEVService/WriteLoadControlLimits"
+)
+
+// OSCEVServiceClient is the client API for OSCEVService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type OSCEVServiceClient interface {
+	GetCurrentLimits(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*CurrentLimits, error)
+	GetLoadControlLimits(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*LoadLimitsPhases, error)
+	WriteLoadControlLimits(ctx context.Context, in *WriteLoadLimitsPhasesRequest, opts ...grpc.CallOption) (*WriteResponse, error)
+}
+
+type oSCEVServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewOSCEVServiceClient(cc grpc.ClientConnInterface) OSCEVServiceClient {
+	return &oSCEVServiceClient{cc}
+}
+
+func (c *oSCEVServiceClient) GetCurrentLimits(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*CurrentLimits, error) {
+	out := new(CurrentLimits)
+	err := c.cc.Invoke(ctx, OSCEVService_GetCurrentLimits_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oSCEVServiceClient) GetLoadControlLimits(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*LoadLimitsPhases, error) {
+	out := new(LoadLimitsPhases)
+	err := c.cc.Invoke(ctx, OSCEVService_GetLoadControlLimits_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oSCEVServiceClient) WriteLoadControlLimits(ctx context.Context, in *WriteLoadLimitsPhasesRequest, opts ...grpc.CallOption) (*WriteResponse, error) {
+	out := new(WriteResponse)
+	err := c.cc.Invoke(ctx, OSCEVService_WriteLoadControlLimits_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// OSCEVServiceServer is the server API for OSCEVService service.
+// All implementations must embed UnimplementedOSCEVServiceServer
+// for forward compatibility
+type OSCEVServiceServer interface {
+	GetCurrentLimits(context.Context, *EntityRequest) (*CurrentLimits, error)
+	GetLoadControlLimits(context.Context, *EntityRequest) (*LoadLimitsPhases, error)
+	WriteLoadControlLimits(context.Context, *WriteLoadLimitsPhasesRequest) (*WriteResponse, error)
+	mustEmbedUnimplementedOSCEVServiceServer()
+}
+
+// UnimplementedOSCEVServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedOSCEVServiceServer struct {
+}
+
+func (UnimplementedOSCEVServiceServer) GetCurrentLimits(context.Context, *EntityRequest) (*CurrentLimits, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCurrentLimits not implemented")
+}
+func (UnimplementedOSCEVServiceServer) GetLoadControlLimits(context.Context, *EntityRequest) (*LoadLimitsPhases, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLoadControlLimits not implemented")
+}
+func (UnimplementedOSCEVServiceServer) WriteLoadControlLimits(context.Context, *WriteLoadLimitsPhasesRequest) (*WriteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WriteLoadControlLimits not implemented")
+}
+func (UnimplementedOSCEVServiceServer) mustEmbedUnimplementedOSCEVServiceServer() {}
+
+// UnsafeOSCEVServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to OSCEVServiceServer will
+// result in compilation errors.
+type UnsafeOSCEVServiceServer interface {
+	mustEmbedUnimplementedOSCEVServiceServer()
+}
+
+func RegisterOSCEVServiceServer(s grpc.ServiceRegistrar, srv OSCEVServiceServer) {
+	s.RegisterService(&OSCEVService_ServiceDesc, srv)
+}
+
+func _OSCEVService_GetCurrentLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EntityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OSCEVServiceServer).GetCurrentLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OSCEVService_GetCurrentLimits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OSCEVServiceServer).GetCurrentLimits(ctx, req.(*EntityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OSCEVService_GetLoadControlLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EntityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OSCEVServiceServer).GetLoadControlLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OSCEVService_GetLoadControlLimits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OSCEVServiceServer).GetLoadControlLimits(ctx, req.(*EntityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OSCEVService_WriteLoadControlLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WriteLoadLimitsPhasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OSCEVServiceServer).WriteLoadControlLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OSCEVService_WriteLoadControlLimits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OSCEVServiceServer).WriteLoadControlLimits(ctx, req.(*WriteLoadLimitsPhasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// OSCEVService_ServiceDesc is the grpc.ServiceDesc for OSCEVService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var OSCEVService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cemd.v1.OSCEVService",
+	HandlerType: (*OSCEVServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetCurrentLimits",
+			Handler:    _OSCEVService_GetCurrentLimits_Handler,
+		},
+		{
+			MethodName: "GetLoadControlLimits",
+			Handler:    _OSCEVService_GetLoadControlLimits_Handler,
+		},
+		{
+			MethodName: "WriteLoadControlLimits",
+			Handler:    _OSCEVService_WriteLoadControlLimits_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cemd/v1/cemd.proto",
+}
+
+const (
+	CEVCService_GetChargeStrategy_FullMethodName               = "/cemd.v1.CEVCService/GetChargeStrategy"
+	CEVCService_GetEnergyDemand_FullMethodName                 = "/cemd.v1.CEVCService/GetEnergyDemand"
+	CEVCService_GetTimeSlotConstraints_FullMethodName          = "/cemd.v1.CEVCService/GetTimeSlotConstraints"
+	CEVCService_WritePowerLimits_FullMethodName                = "/cemd.v1.CEVCService/WritePowerLimits"
+	CEVCService_GetIncentiveConstraints_FullMethodName         = "/cemd.v1.CEVCService/GetIncentiveConstraints"
+	CEVCService_WriteIncentiveTableDescriptions_FullMethodName = "/cemd.v1.CEVCService/WriteIncentiveTableDescriptions"
+	CEVCService_WriteIncentives_FullMethodName                 = "/cemd.v1.CEVCService/WriteIncentives"
+	CEVCService_GetChargePlanConstraints_FullMethodName        = "/cemd.v1.CEVCService/GetChargePlanConstraints"
+	CEVCService_GetChargePlan_FullMethodName                   = "/cemd.v1.CEVCService/GetChargePlan"
+)
+
+// CEVCServiceClient is the client API for CEVCService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CEVCServiceClient interface {
+	// the charge strategy, e.g. "directcharging" or "timedcharging"
+	GetChargeStrategy(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*wrapperspb.StringValue, error)
+	GetEnergyDemand(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*Demand, error)
+	GetTimeSlotConstraints(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*TimeSlotConstraints, error)
+	WritePowerLimits(ctx context.Context, in *WriteDurationSlotValuesRequest, opts ...grpc.CallOption) (*WriteResponse, error)
+	GetIncentiveConstraints(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*IncentiveSlotConstraints, error)
+	WriteIncentiveTableDescriptions(ctx context.Context, in *WriteIncentiveTableDescriptionsRequest, opts ...grpc.CallOption) (*WriteResponse, error)
+	WriteIncentives(ctx context.Context, in *WriteDurationSlotValuesRequest, opts ...grpc.CallOption) (*WriteResponse, error)
+	GetChargePlanConstraints(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*DurationSlotValues, error)
+	GetChargePlan(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*ChargePlan, error)
+}
+
+type cEVCServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCEVCServiceClient(cc grpc.ClientConnInterface) CEVCServiceClient {
+	return &cEVCServiceClient{cc}
+}
+
+func (c *cEVCServiceClient) GetChargeStrategy(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*wrapperspb.StringValue, error) {
+	out := new(wrapperspb.StringValue)
+	err := c.cc.Invoke(ctx, CEVCService_GetChargeStrategy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cEVCServiceClient) GetEnergyDemand(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*Demand, error) {
+	out := new(Demand)
+	err := c.cc.Invoke(ctx, CEVCService_GetEnergyDemand_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cEVCServiceClient) GetTimeSlotConstraints(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*TimeSlotConstraints, error) {
+	out := new(TimeSlotConstraints)
+	err := c.cc.Invoke(ctx, CEVCService_GetTimeSlotConstraints_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cEVCServiceClient) WritePowerLimits(ctx context.Context, in *WriteDurationSlotValuesRequest, opts ...grpc.CallOption) (*WriteResponse, error) {
+	out := new(WriteResponse)
+	err := c.cc.Invoke(ctx, CEVCService_WritePowerLimits_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cEVCServiceClient) GetIncentiveConstraints(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*IncentiveSlotConstraints, error) {
+	out := new(IncentiveSlotConstraints)
+	err := c.cc.Invoke(ctx, CEVCService_GetIncentiveConstraints_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cEVCServiceClient) WriteIncentiveTableDescriptions(ctx context.Context, in *WriteIncentiveTableDescriptionsRequest, opts ...grpc.CallOption) (*WriteResponse, error) {
+	out := new(WriteResponse)
+	err := c.cc.Invoke(ctx, CEVCService_WriteIncentiveTableDescriptions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cEVCServiceClient) WriteIncentives(ctx context.Context, in *WriteDurationSlotValuesRequest, opts ...grpc.CallOption) (*WriteResponse, error) {
+	out := new(WriteResponse)
+	err := c.cc.Invoke(ctx, CEVCService_WriteIncentives_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cEVCServiceClient) GetChargePlanConstraints(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*DurationSlotValues, error) {
+	out := new(DurationSlotValues)
+	err := c.cc.Invoke(ctx, CEVCService_GetChargePlanConstraints_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cEVCServiceClient) GetChargePlan(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*ChargePlan, error) {
+	out := new(ChargePlan)
+	err := c.cc.Invoke(ctx, CEVCService_GetChargePlan_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CEVCServiceServer is the server API for CEVCService service.
+// All implementations must embed UnimplementedCEVCServiceServer
+// for forward compatibility
+type CEVCServiceServer interface {
+	// the charge strategy, e.g. "directcharging" or "timedcharging"
+	GetChargeStrategy(context.Context, *EntityRequest) (*wrapperspb.StringValue, error)
+	GetEnergyDemand(context.Context, *EntityRequest) (*Demand, error)
+	GetTimeSlotConstraints(context.Context, *EntityRequest) (*TimeSlotConstraints, error)
+	WritePowerLimits(context.Context, *WriteDurationSlotValuesRequest) (*WriteResponse, error)
+	GetIncentiveConstraints(context.Context, *EntityRequest) (*IncentiveSlotConstraints, error)
+	WriteIncentiveTableDescriptions(context.Context, *WriteIncentiveTableDescriptionsRequest) (*WriteResponse, error)
+	WriteIncentives(context.Context, *WriteDurationSlotValuesRequest) (*WriteResponse, error)
+	GetChargePlanConstraints(context.Context, *EntityRequest) (*DurationSlotValues, error)
+	GetChargePlan(context.Context, *EntityRequest) (*ChargePlan, error)
+	mustEmbedUnimplementedCEVCServiceServer()
+}
+
+// UnimplementedCEVCServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedCEVCServiceServer struct {
+}
+
+func (UnimplementedCEVCServiceServer) GetChargeStrategy(context.Context, *EntityRequest) (*wrapperspb.StringValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChargeStrategy not implemented")
+}
+func (UnimplementedCEVCServiceServer) GetEnergyDemand(context.Context, *EntityRequest) (*Demand, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEnergyDemand not implemented")
+}
+func (UnimplementedCEVCServiceServer) GetTimeSlotConstraints(context.Context, *EntityRequest) (*TimeSlotConstraints, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTimeSlotConstraints not implemented")
+}
+func (UnimplementedCEVCServiceServer) WritePowerLimits(context.Context, *WriteDurationSlotValuesRequest) (*WriteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WritePowerLimits not implemented")
+}
+func (UnimplementedCEVCServiceServer) GetIncentiveConstraints(context.Context, *EntityRequest) (*IncentiveSlotConstraints, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIncentiveConstraints not implemented")
+}
+func (UnimplementedCEVCServiceServer) WriteIncentiveTableDescriptions(context.Context, *WriteIncentiveTableDescriptionsRequest) (*WriteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WriteIncentiveTableDescriptions not implemented")
+}
+func (UnimplementedCEVCServiceServer) WriteIncentives(context.Context, *WriteDurationSlotValuesRequest) (*WriteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WriteIncentives not implemented")
+}
+func (UnimplementedCEVCServiceServer) GetChargePlanConstraints(context.Context, *EntityRequest) (*DurationSlotValues, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChargePlanConstraints not implemented")
+}
+func (UnimplementedCEVCServiceServer) GetChargePlan(context.Context, *EntityRequest) (*ChargePlan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChargePlan not implemented")
+}
+func (UnimplementedCEVCServiceServer) mustEmbedUnimplementedCEVCServiceServer() {}
+
+// UnsafeCEVCServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CEVCServiceServer will
+// result in compilation errors.
+type UnsafeCEVCServiceServer interface {
+	mustEmbedUnimplementedCEVCServiceServer()
+}
+
+func RegisterCEVCServiceServer(s grpc.ServiceRegistrar, srv CEVCServiceServer) {
+	s.RegisterService(&CEVCService_ServiceDesc, srv)
+}
+
+func _CEVCService_GetChargeStrategy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EntityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CEVCServiceServer).GetChargeStrategy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CEVCService_GetChargeStrategy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CEVCServiceServer).GetChargeStrategy(ctx, req.(*EntityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CEVCService_GetEnergyDemand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EntityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CEVCServiceServer).GetEnergyDemand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CEVCService_GetEnergyDemand_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CEVCServiceServer).GetEnergyDemand(ctx, req.(*EntityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CEVCService_GetTimeSlotConstraints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EntityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CEVCServiceServer).GetTimeSlotConstraints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CEVCService_GetTimeSlotConstraints_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CEVCServiceServer).GetTimeSlotConstraints(ctx, req.(*EntityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CEVCService_WritePowerLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WriteDurationSlotValuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CEVCServiceServer).WritePowerLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CEVCService_WritePowerLimits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CEVCServiceServer).WritePowerLimits(ctx, req.(*WriteDurationSlotValuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CEVCService_GetIncentiveConstraints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EntityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CEVCServiceServer).GetIncentiveConstraints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CEVCService_GetIncentiveConstraints_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CEVCServiceServer).GetIncentiveConstraints(ctx, req.(*EntityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CEVCService_WriteIncentiveTableDescriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WriteIncentiveTableDescriptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CEVCServiceServer).WriteIncentiveTableDescriptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CEVCService_WriteIncentiveTableDescriptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CEVCServiceServer).WriteIncentiveTableDescriptions(ctx, req.(*WriteIncentiveTableDescriptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CEVCService_WriteIncentives_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WriteDurationSlotValuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CEVCServiceServer).WriteIncentives(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CEVCService_WriteIncentives_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CEVCServiceServer).WriteIncentives(ctx, req.(*WriteDurationSlotValuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CEVCService_GetChargePlanConstraints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EntityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CEVCServiceServer).GetChargePlanConstraints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CEVCService_GetChargePlanConstraints_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CEVCServiceServer).GetChargePlanConstraints(ctx, req.(*EntityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CEVCService_GetChargePlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EntityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CEVCServiceServer).GetChargePlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CEVCService_GetChargePlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CEVCServiceServer).GetChargePlan(ctx, req.(*EntityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CEVCService_ServiceDesc is the grpc.ServiceDesc for CEVCService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CEVCService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cemd.v1.CEVCService",
+	HandlerType: (*CEVCServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetChargeStrategy",
+			Handler:    _CEVCService_GetChargeStrategy_Handler,
+		},
+		{
+			MethodName: "GetEnergyDemand",
+			Handler:    _CEVCService_GetEnergyDemand_Handler,
+		},
+		{
+			MethodName: "GetTimeSlotConstraints",
+			Handler:    _CEVCService_GetTimeSlotConstraints_Handler,
+		},
+		{
+			MethodName: "WritePowerLimits",
+			Handler:    _CEVCService_WritePowerLimits_Handler,
+		},
+		{
+			MethodName: "GetIncentiveConstraints",
+			Handler:    _CEVCService_GetIncentiveConstraints_Handler,
+		},
+		{
+			MethodName: "WriteIncentiveTableDescriptions",
+			Handler:    _CEVCService_WriteIncentiveTableDescriptions_Handler,
+		},
+		{
+			MethodName: "WriteIncentives",
+			Handler:    _CEVCService_WriteIncentives_Handler,
+		},
+		{
+			MethodName: "GetChargePlanConstraints",
+			Handler:    _CEVCService_GetChargePlanConstraints_Handler,
+		},
+		{
+			MethodName: "GetChargePlan",
+			Handler:    _CEVCService_GetChargePlan_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cemd/v1/cemd.proto",
+}
+
+const (
+	VABDService_GetPower_FullMethodName            = "/cemd.v1.VABDService/GetPower"
+	VABDService_GetEnergyCharged_FullMethodName    = "/cemd.v1.VABDService/GetEnergyCharged"
+	VABDService_GetEnergyDischarged_FullMethodName = "/cemd.v1.VABDService/GetEnergyDischarged"
+	VABDService_GetStateOfCharge_FullMethodName    = "/cemd.v1.VABDService/GetStateOfCharge"
+)
+
+// VABDServiceClient is the client API for VABDService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type VABDServiceClient interface {
+	GetPower(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*wrapperspb.DoubleValue, error)
+	GetEnergyCharged(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*wrapperspb.DoubleValue, error)
+	GetEnergyDischarged(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*wrapperspb.DoubleValue, error)
+	GetStateOfCharge(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*wrapperspb.DoubleValue, error)
+}
+
+type vABDServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewVABDServiceClient(cc grpc.ClientConnInterface) VABDServiceClient {
+	return &vABDServiceClient{cc}
+}
+
+func (c *vABDServiceClient) GetPower(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*wrapperspb.DoubleValue, error) {
+	out := new(wrapperspb.DoubleValue)
+	err := c.cc.Invoke(ctx, VABDService_GetPower_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vABDServiceClient) GetEnergyCharged(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*wrapperspb.DoubleValue, error) {
+	out := new(wrapperspb.DoubleValue)
+	err := c.cc.Invoke(ctx, VABDService_GetEnergyCharged_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vABDServiceClient) GetEnergyDischarged(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*wrapperspb.DoubleValue, error) {
+	out := new(wrapperspb.DoubleValue)
+	err := c.cc.Invoke(ctx, VABDService_GetEnergyDischarged_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vABDServiceClient) GetStateOfCharge(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*wrapperspb.DoubleValue, error) {
+	out := new(wrapperspb.DoubleValue)
+	err := c.cc.Invoke(ctx, VABDService_GetStateOfCharge_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// VABDServiceServer is the server API for VABDService service.
+// All implementations must embed UnimplementedVABDServiceServer
+// for forward compatibility
+type VABDServiceServer interface {
+	GetPower(context.Context, *EntityRequest) (*wrapperspb.DoubleValue, error)
+	GetEnergyCharged(context.Context, *EntityRequest) (*wrapperspb.DoubleValue, error)
+	GetEnergyDischarged(context.Context, *EntityRequest) (*wrapperspb.DoubleValue, error)
+	GetStateOfCharge(context.Context, *EntityRequest) (*wrapperspb.DoubleValue, error)
+	mustEmbedUnimplementedVABDServiceServer()
+}
+
+// UnimplementedVABDServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedVABDServiceServer struct {
+}
+
+func (UnimplementedVABDServiceServer) GetPower(context.Context, *EntityRequest) (*wrapperspb.DoubleValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPower not implemented")
+}
+func (UnimplementedVABDServiceServer) GetEnergyCharged(context.Context, *EntityRequest) (*wrapperspb.DoubleValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEnergyCharged not implemented")
+}
+func (UnimplementedVABDServiceServer) GetEnergyDischarged(context.Context, *EntityRequest) (*wrapperspb.DoubleValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEnergyDischarged not implemented")
+}
+func (UnimplementedVABDServiceServer) GetStateOfCharge(context.Context, *EntityRequest) (*wrapperspb.DoubleValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStateOfCharge not implemented")
+}
+func (UnimplementedVABDServiceServer) mustEmbedUnimplementedVABDServiceServer() {}
+
+// UnsafeVABDServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to VABDServiceServer will
+// result in compilation errors.
+type UnsafeVABDServiceServer interface {
+	mustEmbedUnimplementedVABDServiceServer()
+}
+
+func RegisterVABDServiceServer(s grpc.ServiceRegistrar, srv VABDServiceServer) {
+	s.RegisterService(&VABDService_ServiceDesc, srv)
+}
+
+func _VABDService_GetPower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EntityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VABDServiceServer).GetPower(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VABDService_GetPower_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VABDServiceServer).GetPower(ctx, req.(*EntityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VABDService_GetEnergyCharged_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EntityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VABDServiceServer).GetEnergyCharged(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VABDService_GetEnergyCharged_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VABDServiceServer).GetEnergyCharged(ctx, req.(*EntityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VABDService_GetEnergyDischarged_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EntityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VABDServiceServer).GetEnergyDischarged(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VABDService_GetEnergyDischarged_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VABDServiceServer).GetEnergyDischarged(ctx, req.(*EntityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VABDService_GetStateOfCharge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EntityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VABDServiceServer).GetStateOfCharge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VABDService_GetStateOfCharge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VABDServiceServer).GetStateOfCharge(ctx, req.(*EntityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// VABDService_ServiceDesc is the grpc.ServiceDesc for VABDService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var VABDService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cemd.v1.VABDService",
+	HandlerType: (*VABDServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetPower",
+			Handler:    _VABDService_GetPower_Handler,
+		},
+		{
+			MethodName: "GetEnergyCharged",
+			Handler:    _VABDService_GetEnergyCharged_Handler,
+		},
+		{
+			MethodName: "GetEnergyDischarged",
+			Handler:    _VABDService_GetEnergyDischarged_Handler,
+		},
+		{
+			MethodName: "GetStateOfCharge",
+			Handler:    _VABDService_GetStateOfCharge_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cemd/v1/cemd.proto",
+}
+
+const (
+	VAPDService_GetPower_FullMethodName            = "/cemd.v1.VAPDService/GetPower"
+	VAPDService_GetPowerNominalPeak_FullMethodName = "/cemd.v1.VAPDService/GetPowerNominalPeak"
+	VAPDService_GetPVYieldTotal_FullMethodName     = "/cemd.v1.VAPDService/GetPVYieldTotal"
+)
+
+// VAPDServiceClient is the client API for VAPDService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type VAPDServiceClient interface {
+	GetPower(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*wrapperspb.DoubleValue, error)
+	GetPowerNominalPeak(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*wrapperspb.DoubleValue, error)
+	GetPVYieldTotal(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*wrapperspb.DoubleValue, error)
+}
+
+type vAPDServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewVAPDServiceClient(cc grpc.ClientConnInterface) VAPDServiceClient {
+	return &vAPDServiceClient{cc}
+}
+
+func (c *vAPDServiceClient) GetPower(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*wrapperspb.DoubleValue, error) {
+	out := new(wrapperspb.DoubleValue)
+	err := c.cc.Invoke(ctx, VAPDService_GetPower_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vAPDServiceClient) GetPowerNominalPeak(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*wrapperspb.DoubleValue, error) {
+	out := new(wrapperspb.DoubleValue)
+	err := c.cc.Invoke(ctx, VAPDService_GetPowerNominalPeak_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vAPDServiceClient) GetPVYieldTotal(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*wrapperspb.DoubleValue, error) {
+	out := new(wrapperspb.DoubleValue)
+	err := c.cc.Invoke(ctx, VAPDService_GetPVYieldTotal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// VAPDServiceServer is the server API for VAPDService service.
+// All implementations must embed UnimplementedVAPDServiceServer
+// for forward compatibility
+type VAPDServiceServer interface {
+	GetPower(context.Context, *EntityRequest) (*wrapperspb.DoubleValue, error)
+	GetPowerNominalPeak(context.Context, *EntityRequest) (*wrapperspb.DoubleValue, error)
+	GetPVYieldTotal(context.Context, *EntityRequest) (*wrapperspb.DoubleValue, error)
+	mustEmbedUnimplementedVAPDServiceServer()
+}
+
+// UnimplementedVAPDServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedVAPDServiceServer struct {
+}
+
+func (UnimplementedVAPDServiceServer) GetPower(context.Context, *EntityRequest) (*wrapperspb.DoubleValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPower not implemented")
+}
+func (UnimplementedVAPDServiceServer) GetPowerNominalPeak(context.Context, *EntityRequest) (*wrapperspb.DoubleValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPowerNominalPeak not implemented")
+}
+func (UnimplementedVAPDServiceServer) GetPVYieldTotal(context.Context, *EntityRequest) (*wrapperspb.DoubleValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPVYieldTotal not implemented")
+}
+func (UnimplementedVAPDServiceServer) mustEmbedUnimplementedVAPDServiceServer() {}
+
+// UnsafeVAPDServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to VAPDServiceServer will
+// result in compilation errors.
+type UnsafeVAPDServiceServer interface {
+	mustEmbedUnimplementedVAPDServiceServer()
+}
+
+func RegisterVAPDServiceServer(s grpc.ServiceRegistrar, srv VAPDServiceServer) {
+	s.RegisterService(&VAPDService_ServiceDesc, srv)
+}
+
+func _VAPDService_GetPower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EntityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VAPDServiceServer).GetPower(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VAPDService_GetPower_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VAPDServiceServer).GetPower(ctx, req.(*EntityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VAPDService_GetPowerNominalPeak_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EntityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VAPDServiceServer).GetPowerNominalPeak(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VAPDService_GetPowerNominalPeak_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VAPDServiceServer).GetPowerNominalPeak(ctx, req.(*EntityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VAPDService_GetPVYieldTotal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EntityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VAPDServiceServer).GetPVYieldTotal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VAPDService_GetPVYieldTotal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VAPDServiceServer).GetPVYieldTotal(ctx, req.(*EntityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// VAPDService_ServiceDesc is the grpc.ServiceDesc for VAPDService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var VAPDService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cemd.v1.VAPDService",
+	HandlerType: (*VAPDServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetPower",
+			Handler:    _VAPDService_GetPower_Handler,
+		},
+		{
+			MethodName: "GetPowerNominalPeak",
+			Handler:    _VAPDService_GetPowerNominalPeak_Handler,
+		},
+		{
+			MethodName: "GetPVYieldTotal",
+			Handler:    _VAPDService_GetPVYieldTotal_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cemd/v1/cemd.proto",
+}
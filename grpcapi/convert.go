@@ -0,0 +1,260 @@
+package grpcapi
+
+import (
+	"time"
+
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/datapoints"
+	"github.com/enbility/cemd/grpcapi/cemdv1"
+	"github.com/enbility/spine-go/model"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// conversions of the use case values to the messages of the API
+
+// for values already converted by the getter
+func identity[T any](value T) T {
+	return value
+}
+
+func doubleValue(value float64) *wrapperspb.DoubleValue {
+	return wrapperspb.Double(value)
+}
+
+func boolValue(value bool) *wrapperspb.BoolValue {
+	return wrapperspb.Bool(value)
+}
+
+func uint32Value(value uint) *wrapperspb.UInt32Value {
+	return wrapperspb.UInt32(uint32(value))
+}
+
+func durationValue(value time.Duration) *durationpb.Duration {
+	return durationpb.New(value)
+}
+
+func stringValue[T ~string](value T) *wrapperspb.StringValue {
+	return wrapperspb.String(string(value))
+}
+
+func phaseValues(values []float64) *cemdv1.PhaseValues {
+	return &cemdv1.PhaseValues{Values: values}
+}
+
+func loadLimit(limit api.LoadLimit) *cemdv1.LoadLimit {
+	return &cemdv1.LoadLimit{
+		Duration:     durationpb.New(limit.Duration),
+		IsChangeable: limit.IsChangeable,
+		IsActive:     limit.IsActive,
+		Value:        limit.Value,
+	}
+}
+
+func loadLimitsPhases(limits []api.LoadLimitsPhase) *cemdv1.LoadLimitsPhases {
+	result := &cemdv1.LoadLimitsPhases{}
+
+	for _, limit := range limits {
+		result.Limits = append(result.Limits, &cemdv1.LoadLimitsPhase{
+			Phase:        string(limit.Phase),
+			IsChangeable: limit.IsChangeable,
+			IsActive:     limit.IsActive,
+			Value:        limit.Value,
+		})
+	}
+
+	return result
+}
+
+func manufacturerData(data api.ManufacturerData) *cemdv1.ManufacturerData {
+	return &cemdv1.ManufacturerData{
+		DeviceName:                     data.DeviceName,
+		DeviceCode:                     data.DeviceCode,
+		SerialNumber:                   data.SerialNumber,
+		SoftwareRevision:               data.SoftwareRevision,
+		HardwareRevision:               data.HardwareRevision,
+		VendorName:                     data.VendorName,
+		VendorCode:                     data.VendorCode,
+		BrandName:                      data.BrandName,
+		PowerSource:                    data.PowerSource,
+		ManufacturerNodeIdentification: data.ManufacturerNodeIdentification,
+		ManufacturerLabel:              data.ManufacturerLabel,
+		ManufacturerDescription:        data.ManufacturerDescription,
+	}
+}
+
+func identifications(items []api.IdentificationItem) *cemdv1.Identifications {
+	result := &cemdv1.Identifications{}
+
+	for _, item := range items {
+		result.Identifications = append(result.Identifications, &cemdv1.Identification{
+			Value:     item.Value,
+			ValueType: string(item.ValueType),
+		})
+	}
+
+	return result
+}
+
+func demand(value api.Demand) *cemdv1.Demand {
+	return &cemdv1.Demand{
+		MinDemand:          value.MinDemand,
+		OptDemand:          value.OptDemand,
+		MaxDemand:          value.MaxDemand,
+		DurationUntilStart: value.DurationUntilStart,
+		DurationUntilEnd:   value.DurationUntilEnd,
+	}
+}
+
+func timeSlotConstraints(value api.TimeSlotConstraints) *cemdv1.TimeSlotConstraints {
+	return &cemdv1.TimeSlotConstraints{
+		MinSlots:             uint32(value.MinSlots),
+		MaxSlots:             uint32(value.MaxSlots),
+		MinSlotDuration:      durationpb.New(value.MinSlotDuration),
+		MaxSlotDuration:      durationpb.New(value.MaxSlotDuration),
+		SlotDurationStepSize: durationpb.New(value.SlotDurationStepSize),
+	}
+}
+
+func incentiveSlotConstraints(value api.IncentiveSlotConstraints) *cemdv1.IncentiveSlotConstraints {
+	return &cemdv1.IncentiveSlotConstraints{
+		MinSlots: uint32(value.MinSlots),
+		MaxSlots: uint32(value.MaxSlots),
+	}
+}
+
+func durationSlotValues(slots []api.DurationSlotValue) *cemdv1.DurationSlotValues {
+	result := &cemdv1.DurationSlotValues{}
+
+	for _, slot := range slots {
+		result.Slots = append(result.Slots, &cemdv1.DurationSlotValue{
+			Duration: durationpb.New(slot.Duration),
+			Value:    slot.Value,
+		})
+	}
+
+	return result
+}
+
+func chargePlan(plan api.ChargePlan) *cemdv1.ChargePlan {
+	result := &cemdv1.ChargePlan{}
+
+	for _, slot := range plan.Slots {
+		result.Slots = append(result.Slots, &cemdv1.ChargePlanSlotValue{
+			Start:    timestamppb.New(slot.Start),
+			End:      timestamppb.New(slot.End),
+			Value:    slot.Value,
+			MinValue: slot.MinValue,
+			MaxValue: slot.MaxValue,
+		})
+	}
+
+	return result
+}
+
+// conversions of the messages of the API to the data of the use case write methods
+//
+// possible errors:
+//   - datapoints.ErrInvalidData if required data is missing
+
+func toDuration(value *durationpb.Duration) (time.Duration, error) {
+	if err := value.CheckValid(); err != nil {
+		return 0, datapoints.ErrInvalidData
+	}
+
+	return value.AsDuration(), nil
+}
+
+func toLoadLimit(limit *cemdv1.LoadLimit) (api.LoadLimit, error) {
+	if limit == nil {
+		return api.LoadLimit{}, datapoints.ErrInvalidData
+	}
+
+	result := api.LoadLimit{
+		IsChangeable: limit.GetIsChangeable(),
+		IsActive:     limit.GetIsActive(),
+		Value:        limit.GetValue(),
+	}
+
+	// the duration is optional
+	if limit.GetDuration() != nil {
+		duration, err := toDuration(limit.GetDuration())
+		if err != nil {
+			return api.LoadLimit{}, err
+		}
+		result.Duration = duration
+	}
+
+	return result, nil
+}
+
+func toLoadLimitsPhases(limits []*cemdv1.LoadLimitsPhase) []api.LoadLimitsPhase {
+	var result []api.LoadLimitsPhase
+
+	for _, limit := range limits {
+		result = append(result, api.LoadLimitsPhase{
+			Phase:        model.ElectricalConnectionPhaseNameType(limit.GetPhase()),
+			IsChangeable: limit.GetIsChangeable(),
+			IsActive:     limit.GetIsActive(),
+			Value:        limit.GetValue(),
+		})
+	}
+
+	return result
+}
+
+func toDurationSlotValues(slots []*cemdv1.DurationSlotValue) ([]api.DurationSlotValue, error) {
+	var result []api.DurationSlotValue
+
+	for _, slot := range slots {
+		duration, err := toDuration(slot.GetDuration())
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, api.DurationSlotValue{
+			Duration: duration,
+			Value:    slot.GetValue(),
+		})
+	}
+
+	return result, nil
+}
+
+func toIncentiveTariffDescriptions(descriptions []*cemdv1.IncentiveTariffDescription) []api.IncentiveTariffDescription {
+	var result []api.IncentiveTariffDescription
+
+	for _, description := range descriptions {
+		var tiers []api.IncentiveTableDescriptionTier
+
+		for _, tier := range description.GetTiers() {
+			item := api.IncentiveTableDescriptionTier{
+				Id:   uint(tier.GetId()),
+				Type: model.TierTypeType(tier.GetType()),
+			}
+
+			for _, boundary := range tier.GetBoundaries() {
+				item.Boundaries = append(item.Boundaries, api.TierBoundaryDescription{
+					Id:   uint(boundary.GetId()),
+					Type: model.TierBoundaryTypeType(boundary.GetType()),
+					Unit: model.UnitOfMeasurementType(boundary.GetUnit()),
+				})
+			}
+
+			for _, incentive := range tier.GetIncentives() {
+				item.Incentives = append(item.Incentives, api.IncentiveDescription{
+					Id:       uint(incentive.GetId()),
+					Type:     model.IncentiveTypeType(incentive.GetType()),
+					Currency: model.CurrencyType(incentive.GetCurrency()),
+				})
+			}
+
+			tiers = append(tiers, item)
+		}
+
+		result = append(result, api.IncentiveTariffDescription{Tiers: tiers})
+	}
+
+	return result
+}
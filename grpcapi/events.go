@@ -0,0 +1,143 @@
+package grpcapi
+
+import (
+	"slices"
+	"sync"
+
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/datapoints"
+	"github.com/enbility/cemd/grpcapi/cemdv1"
+	spineapi "github.com/enbility/spine-go/api"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// the number of events buffered for each stream, slower clients are disconnected
+const subscriberBufferSize = 64
+
+type eventService struct {
+	cemdv1.UnimplementedEventServiceServer
+
+	server *Server
+}
+
+// send the events matching the request until the client cancels the stream
+func (e *eventService) StreamEvents(req *cemdv1.StreamEventsRequest, stream cemdv1.EventService_StreamEventsServer) error {
+	sub := &subscriber{
+		skis:     req.GetSkis(),
+		useCases: req.GetUseCases(),
+		events:   make(chan *cemdv1.Event, subscriberBufferSize),
+		done:     make(chan struct{}),
+	}
+
+	e.server.subscribe(sub)
+	defer e.server.unsubscribe(sub)
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case <-sub.done:
+			return status.Error(codes.Unavailable, "event stream closed")
+		case event := <-sub.events:
+			if err := stream.Send(event); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// end all event streams
+func (s *Server) Close() {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	for sub := range s.subscribers {
+		delete(s.subscribers, sub)
+		sub.close()
+	}
+}
+
+// handle an event of a use case
+//
+// has the signature of api.EntityEventCallback
+func (s *Server) EntityEventCB(ski string, device spineapi.DeviceRemoteInterface, entity spineapi.EntityRemoteInterface, event api.EventType) {
+	message := &cemdv1.Event{
+		Ski:     ski,
+		UseCase: datapoints.UseCaseNameForEvent(event),
+		Event:   string(event),
+	}
+
+	if entity != nil {
+		message.Entity = datapoints.EntityAddress(entity)
+	}
+
+	s.send(message)
+}
+
+// handle an event of the CEM
+//
+// has the signature of api.DeviceEventCallback
+func (s *Server) DeviceEventCB(ski string, device spineapi.DeviceRemoteInterface, event api.EventType) {
+	s.send(&cemdv1.Event{
+		Ski:   ski,
+		Event: string(event),
+	})
+}
+
+// send the event to all subscribers with a matching filter
+func (s *Server) send(event *cemdv1.Event) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	for sub := range s.subscribers {
+		if !sub.matches(event) {
+			continue
+		}
+
+		select {
+		case sub.events <- event:
+		default:
+			// the client does not keep up
+			delete(s.subscribers, sub)
+			sub.close()
+		}
+	}
+}
+
+func (s *Server) subscribe(sub *subscriber) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	s.subscribers[sub] = struct{}{}
+}
+
+func (s *Server) unsubscribe(sub *subscriber) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	delete(s.subscribers, sub)
+	sub.close()
+}
+
+// the client of an event stream
+type subscriber struct {
+	// the filter of the events, empty lists match all events
+	skis     []string
+	useCases []string
+
+	events    chan *cemdv1.Event
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+func (s *subscriber) matches(event *cemdv1.Event) bool {
+	return (len(s.skis) == 0 || slices.Contains(s.skis, event.Ski)) &&
+		(len(s.useCases) == 0 || slices.Contains(s.useCases, event.UseCase))
+}
+
+func (s *subscriber) close() {
+	s.closeOnce.Do(func() {
+		close(s.done)
+	})
+}
@@ -0,0 +1,280 @@
+// Package grpcapi provides a gRPC API on top of a CEM
+//
+// The services are defined in proto/cemd/v1/cemd.proto, the Go code in the
+// cemdv1 package is generated from it with buf. They expose the connected
+// devices and their entities, a typed service for each client use case added
+// to the CEM, e.g. LPCService for uclpc.UCLPCInterface, and a stream of the
+// events of the CEM and its use cases.
+//
+// The entity address is the list of entity ids joined with dots, e.g. "1.1".
+package grpcapi
+
+//go:generate buf generate proto
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/cem"
+	"github.com/enbility/cemd/datapoints"
+	"github.com/enbility/cemd/grpcapi/cemdv1"
+	"github.com/enbility/cemd/util"
+	eebusapi "github.com/enbility/eebus-go/api"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	errDeviceNotFound = errors.New("device not found")
+	errEntityNotFound = errors.New("entity not found")
+)
+
+// the services of a CEM exposed via gRPC
+type Server struct {
+	cem *cem.Cem
+
+	writeCB datapoints.WriteCallback
+
+	mux         sync.Mutex
+	subscribers map[*subscriber]struct{}
+}
+
+// create a new server for the use cases added to the CEM
+//
+// use cases added to the CEM later on are exposed as well,
+// the events have to be passed to EntityEventCB and DeviceEventCB
+func NewServer(c *cem.Cem) *Server {
+	return &Server{
+		cem:         c,
+		subscribers: make(map[*subscriber]struct{}),
+	}
+}
+
+// set a callback invoked after each write operation, has to be set before serving requests
+func (s *Server) SetWriteCallback(cb datapoints.WriteCallback) {
+	s.writeCB = cb
+}
+
+// register all services with a gRPC server
+func (s *Server) Register(registrar grpc.ServiceRegistrar) {
+	cemdv1.RegisterDeviceServiceServer(registrar, &deviceService{server: s})
+	cemdv1.RegisterEventServiceServer(registrar, &eventService{server: s})
+	cemdv1.RegisterLPCServiceServer(registrar, &lpcService{server: s})
+	cemdv1.RegisterLPPServiceServer(registrar, &lppService{server: s})
+	cemdv1.RegisterMGCPServiceServer(registrar, &mgcpService{server: s})
+	cemdv1.RegisterMPCServiceServer(registrar, &mpcService{server: s})
+	cemdv1.RegisterEVCCServiceServer(registrar, &evccService{server: s})
+	cemdv1.RegisterEVCEMServiceServer(registrar, &evcemService{server: s})
+	cemdv1.RegisterEVSECCServiceServer(registrar, &evseccService{server: s})
+	cemdv1.RegisterEVSOCServiceServer(registrar, &evsocService{server: s})
+	cemdv1.RegisterOPEVServiceServer(registrar, &opevService{server: s})
+	cemdv1.RegisterOSCEVServiceServer(registrar, &oscevService{server: s})
+	cemdv1.RegisterCEVCServiceServer(registrar, &cevcService{server: s})
+	cemdv1.RegisterVABDServiceServer(registrar, &vabdService{server: s})
+	cemdv1.RegisterVAPDServiceServer(registrar, &vapdService{server: s})
+}
+
+type deviceService struct {
+	cemdv1.UnimplementedDeviceServiceServer
+
+	server *Server
+}
+
+func (d *deviceService) ListDevices(ctx context.Context, req *cemdv1.ListDevicesRequest) (*cemdv1.ListDevicesResponse, error) {
+	result := &cemdv1.ListDevicesResponse{}
+
+	for _, device := range d.server.cem.Service.LocalDevice().RemoteDevices() {
+		result.Devices = append(result.Devices, d.server.device(device))
+	}
+
+	return result, nil
+}
+
+func (d *deviceService) GetDevice(ctx context.Context, req *cemdv1.GetDeviceRequest) (*cemdv1.Device, error) {
+	device := d.server.remoteDevice(req.GetSki())
+	if device == nil {
+		return nil, statusError(errDeviceNotFound)
+	}
+
+	return d.server.device(device), nil
+}
+
+func (s *Server) device(device spineapi.DeviceRemoteInterface) *cemdv1.Device {
+	result := &cemdv1.Device{
+		Ski: device.Ski(),
+	}
+
+	if address := device.Address(); address != nil {
+		result.Address = string(*address)
+	}
+	if deviceType := device.DeviceType(); deviceType != nil {
+		result.DeviceType = string(*deviceType)
+	}
+
+	usecases := datapoints.ForUseCases(s.cem.UseCases())
+	for _, entity := range device.Entities() {
+		item := &cemdv1.Entity{
+			Address:    datapoints.EntityAddress(entity),
+			EntityType: string(entity.EntityType()),
+		}
+
+		for _, usecase := range usecases {
+			if supported, err := usecase.UseCase.IsUseCaseSupported(entity); err == nil && supported {
+				item.UseCases = append(item.UseCases, usecase.Name)
+			}
+		}
+
+		result.Entities = append(result.Entities, item)
+	}
+
+	return result
+}
+
+// return the connected remote device with the SKI
+func (s *Server) remoteDevice(ski string) spineapi.DeviceRemoteInterface {
+	if ski == "" {
+		return nil
+	}
+
+	return s.cem.Service.LocalDevice().RemoteDeviceForSki(ski)
+}
+
+// a request addressing a remote entity
+type entityRequest interface {
+	GetSki() string
+	GetEntity() string
+}
+
+// return the remote entity addressed by the request
+func (s *Server) remoteEntity(req entityRequest) (spineapi.EntityRemoteInterface, error) {
+	device := s.remoteDevice(req.GetSki())
+	if device == nil {
+		return nil, errDeviceNotFound
+	}
+
+	ids, err := datapoints.ParseEntityAddress(req.GetEntity())
+	if err != nil {
+		return nil, err
+	}
+
+	entity := device.Entity(ids)
+	if entity == nil {
+		return nil, errEntityNotFound
+	}
+
+	return entity, nil
+}
+
+// return the use case added to the CEM with the short name, e.g. "lpc"
+//
+// the name is checked as well, as the interfaces of some use cases are identical
+func useCase[U any](s *Server, name string) (U, error) {
+	for _, item := range datapoints.ForUseCases(s.cem.UseCases()) {
+		if item.Name != name {
+			continue
+		}
+
+		if usecase, ok := item.UseCase.(U); ok {
+			return usecase, nil
+		}
+	}
+
+	var result U
+	return result, status.Errorf(codes.Unimplemented, "use case %s is not available", name)
+}
+
+// read a value of a use case for the entity of the request
+func get[U, T, R any](
+	s *Server,
+	name string,
+	req entityRequest,
+	getter func(U, spineapi.EntityRemoteInterface) (T, error),
+	convert func(T) R,
+) (R, error) {
+	var result R
+
+	usecase, err := useCase[U](s, name)
+	if err != nil {
+		return result, err
+	}
+
+	entity, err := s.remoteEntity(req)
+	if err != nil {
+		return result, statusError(err)
+	}
+
+	value, err := getter(usecase, entity)
+	if err != nil {
+		return result, statusError(err)
+	}
+
+	return convert(value), nil
+}
+
+// call a write method of a use case for the entity of the request
+//
+// the operation is the name of the write operation in the datapoints package, e.g. "consumptionLimit"
+func write[U any](
+	s *Server,
+	name, operation string,
+	req entityRequest,
+	writer func(U, spineapi.EntityRemoteInterface) (*model.MsgCounterType, error),
+) (*cemdv1.WriteResponse, error) {
+	usecase, err := useCase[U](s, name)
+	if err != nil {
+		return nil, err
+	}
+
+	entity, err := s.remoteEntity(req)
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	msgCounter, err := writer(usecase, entity)
+	if s.writeCB != nil {
+		s.writeCB(entity, name, operation, msgCounter, err)
+	}
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	result := &cemdv1.WriteResponse{}
+	if msgCounter != nil {
+		result.MsgCounter = proto.Uint64(uint64(*msgCounter))
+	}
+
+	return result, nil
+}
+
+// return the gRPC status of an error
+func statusError(err error) error {
+	code := codes.Internal
+
+	switch {
+	case errors.Is(err, datapoints.ErrInvalidAddress),
+		errors.Is(err, datapoints.ErrInvalidData),
+		errors.Is(err, eebusapi.ErrMissingData):
+		code = codes.InvalidArgument
+	case errors.Is(err, errDeviceNotFound),
+		errors.Is(err, errEntityNotFound),
+		errors.Is(err, eebusapi.ErrEntityNotFound),
+		errors.Is(err, eebusapi.ErrDataNotAvailable):
+		code = codes.NotFound
+	case errors.Is(err, api.ErrNoCompatibleEntity),
+		errors.Is(err, eebusapi.ErrNotSupported),
+		errors.Is(err, eebusapi.ErrUsecCaseNotSupported),
+		errors.Is(err, eebusapi.ErrFunctionNotSupported),
+		errors.Is(err, eebusapi.ErrOperationOnFunctionNotSupported):
+		code = codes.FailedPrecondition
+	case errors.Is(err, util.ErrDeviceDisconnected):
+		code = codes.Unavailable
+	}
+
+	return status.Error(code, err.Error())
+}
@@ -0,0 +1,265 @@
+package grpcapi
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/approval"
+	"github.com/enbility/cemd/cem"
+	"github.com/enbility/cemd/datapoints"
+	"github.com/enbility/cemd/grpcapi/cemdv1"
+	"github.com/enbility/cemd/loopback"
+	"github.com/enbility/cemd/uclpc"
+	"github.com/enbility/cemd/uclpcserver"
+	"github.com/enbility/cemd/ucmgcp"
+	"github.com/enbility/cemd/ucmgcpserver"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestServerSuite(t *testing.T) {
+	suite.Run(t, new(ServerSuite))
+}
+
+type ServerSuite struct {
+	suite.Suite
+
+	network *loopback.Network
+
+	cem       *loopback.Device
+	cemEvents *loopback.EventRecorder
+
+	smgw       *loopback.Device
+	mgcpServer *ucmgcpserver.UCMGCPServer
+	lpcServer  *uclpcserver.UCLPCServer
+
+	sut    *Server
+	server *grpc.Server
+	conn   *grpc.ClientConn
+
+	// the request of the remote CEM entity
+	entity *cemdv1.EntityRequest
+}
+
+func (s *ServerSuite) BeforeTest(suiteName, testName string) {
+	s.network = loopback.NewNetwork()
+
+	var err error
+	s.cem, err = s.network.NewCEM("cem", nil)
+	assert.Nil(s.T(), err)
+
+	s.cemEvents = loopback.NewEventRecorder()
+	s.cem.AddUseCase(ucmgcp.NewUCMGCP(s.cem.Service(), s.cemEvents.EntityEventCB))
+	s.cem.AddUseCase(uclpc.NewUCLPC(s.cem.Service(), s.cemEvents.EntityEventCB))
+
+	s.smgw, err = s.network.NewCEM("smgw", nil)
+	assert.Nil(s.T(), err)
+
+	serverEvents := loopback.NewEventRecorder()
+	s.mgcpServer = ucmgcpserver.NewUCMGCP(s.smgw.Service(), serverEvents.EntityEventCB)
+	s.smgw.AddUseCase(s.mgcpServer)
+	s.lpcServer = uclpcserver.NewUCLPC(s.smgw.Service(), serverEvents.EntityEventCB)
+	s.lpcServer.SetApprovalPolicy(approval.NewAutoApprovePolicy())
+	s.smgw.AddUseCase(s.lpcServer)
+
+	err = s.lpcServer.SetConsumptionLimit(api.LoadLimit{IsChangeable: true})
+	assert.Nil(s.T(), err)
+
+	_, err = s.network.Connect(s.cem, s.smgw)
+	assert.Nil(s.T(), err)
+
+	err = s.network.WaitIdle(time.Second * 5)
+	assert.Nil(s.T(), err)
+
+	entity := s.cem.RemoteEntity(s.smgw, model.EntityTypeTypeCEM)
+	assert.NotNil(s.T(), entity)
+	s.entity = &cemdv1.EntityRequest{Ski: s.smgw.SKI(), Entity: datapoints.EntityAddress(entity)}
+
+	s.sut = NewServer(s.cem.Cem)
+
+	listener := bufconn.Listen(1 << 20)
+	s.server = grpc.NewServer()
+	s.sut.Register(s.server)
+	go func() {
+		_ = s.server.Serve(listener)
+	}()
+
+	s.conn, err = grpc.DialContext(context.Background(), "bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	assert.Nil(s.T(), err)
+}
+
+func (s *ServerSuite) AfterTest(suiteName, testName string) {
+	s.sut.Close()
+	_ = s.conn.Close()
+	s.server.Stop()
+	s.network.Close()
+}
+
+func (s *ServerSuite) Test_Devices() {
+	client := cemdv1.NewDeviceServiceClient(s.conn)
+
+	devices, err := client.ListDevices(context.Background(), &cemdv1.ListDevicesRequest{})
+	assert.Nil(s.T(), err)
+	if assert.Equal(s.T(), 1, len(devices.GetDevices())) {
+		assert.Equal(s.T(), s.smgw.SKI(), devices.GetDevices()[0].GetSki())
+		assert.Equal(s.T(), string(model.DeviceTypeTypeEnergyManagementSystem), devices.GetDevices()[0].GetDeviceType())
+	}
+
+	device, err := client.GetDevice(context.Background(), &cemdv1.GetDeviceRequest{Ski: s.smgw.SKI()})
+	assert.Nil(s.T(), err)
+
+	var found bool
+	for _, entity := range device.GetEntities() {
+		if entity.GetAddress() == s.entity.GetEntity() {
+			found = true
+			assert.Equal(s.T(), string(model.EntityTypeTypeCEM), entity.GetEntityType())
+			assert.Equal(s.T(), []string{"mgcp", "lpc"}, entity.GetUseCases())
+		}
+	}
+	assert.True(s.T(), found)
+
+	_, err = client.GetDevice(context.Background(), &cemdv1.GetDeviceRequest{Ski: "unknown"})
+	assert.Equal(s.T(), codes.NotFound, status.Code(err))
+}
+
+func (s *ServerSuite) Test_Read() {
+	err := s.mgcpServer.SetPower(-1000)
+	assert.Nil(s.T(), err)
+	err = s.mgcpServer.SetCurrentPerPhase([]float64{1, 2, 3})
+	assert.Nil(s.T(), err)
+
+	_, ok := s.cemEvents.WaitFor(s.smgw.SKI(), ucmgcp.DataUpdateCurrentPerPhase, time.Second*5)
+	assert.True(s.T(), ok)
+
+	client := cemdv1.NewMGCPServiceClient(s.conn)
+
+	power, err := client.GetPower(context.Background(), s.entity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), -1000.0, power.GetValue())
+
+	currents, err := client.GetCurrentPerPhase(context.Background(), s.entity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []float64{1, 2, 3}, currents.GetValues())
+
+	limit, err := cemdv1.NewLPCServiceClient(s.conn).GetConsumptionLimit(context.Background(), s.entity)
+	assert.Nil(s.T(), err)
+	assert.True(s.T(), limit.GetIsChangeable())
+}
+
+func (s *ServerSuite) Test_Write() {
+	var writes []string
+	s.sut.SetWriteCallback(func(entity spineapi.EntityRemoteInterface, usecase, operation string, msgCounter *model.MsgCounterType, err error) {
+		writes = append(writes, usecase+"/"+operation)
+	})
+
+	client := cemdv1.NewLPCServiceClient(s.conn)
+
+	result, err := client.WriteConsumptionLimit(context.Background(), &cemdv1.WriteLoadLimitRequest{
+		Ski:    s.entity.GetSki(),
+		Entity: s.entity.GetEntity(),
+		Limit:  &cemdv1.LoadLimit{IsActive: true, Value: 4200, Duration: durationpb.New(time.Hour)},
+	})
+	assert.Nil(s.T(), err)
+	assert.NotNil(s.T(), result.MsgCounter)
+
+	assert.Eventually(s.T(), func() bool {
+		limit, err := s.lpcServer.ConsumptionLimit()
+		return err == nil && limit.IsActive && limit.Value == 4200
+	}, time.Second*5, time.Millisecond*10)
+
+	// the limit is missing
+	_, err = client.WriteConsumptionLimit(context.Background(), &cemdv1.WriteLoadLimitRequest{
+		Ski:    s.entity.GetSki(),
+		Entity: s.entity.GetEntity(),
+	})
+	assert.Equal(s.T(), codes.InvalidArgument, status.Code(err))
+
+	assert.Equal(s.T(), []string{"lpc/consumptionLimit", "lpc/consumptionLimit"}, writes)
+}
+
+func (s *ServerSuite) Test_Errors() {
+	client := cemdv1.NewMGCPServiceClient(s.conn)
+
+	tests := []struct {
+		request *cemdv1.EntityRequest
+		code    codes.Code
+	}{
+		{&cemdv1.EntityRequest{Ski: "unknown", Entity: s.entity.GetEntity()}, codes.NotFound},
+		{&cemdv1.EntityRequest{Ski: s.entity.GetSki(), Entity: "a"}, codes.InvalidArgument},
+		{&cemdv1.EntityRequest{Ski: s.entity.GetSki(), Entity: "99"}, codes.NotFound},
+		// no value has been set
+		{s.entity, codes.NotFound},
+		// the device information entity does not support the use case
+		{&cemdv1.EntityRequest{Ski: s.entity.GetSki(), Entity: "0"}, codes.FailedPrecondition},
+	}
+
+	for _, test := range tests {
+		_, err := client.GetFrequency(context.Background(), test.request)
+		assert.Equal(s.T(), test.code, status.Code(err), test.request.String())
+	}
+
+	// the use case is not added to the CEM
+	_, err := cemdv1.NewVAPDServiceClient(s.conn).GetPower(context.Background(), s.entity)
+	assert.Equal(s.T(), codes.Unimplemented, status.Code(err))
+}
+
+func (s *ServerSuite) Test_Events() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client := cemdv1.NewEventServiceClient(s.conn)
+	stream, err := client.StreamEvents(ctx, &cemdv1.StreamEventsRequest{UseCases: []string{"mgcp"}})
+	assert.Nil(s.T(), err)
+
+	assert.Eventually(s.T(), func() bool {
+		s.sut.mux.Lock()
+		defer s.sut.mux.Unlock()
+		return len(s.sut.subscribers) == 1
+	}, time.Second*5, time.Millisecond*10)
+
+	entity := s.cem.RemoteEntity(s.smgw, model.EntityTypeTypeCEM)
+
+	// filtered out
+	s.sut.DeviceEventCB(s.smgw.SKI(), nil, cem.DeviceConnected)
+	s.sut.EntityEventCB(s.smgw.SKI(), nil, entity, uclpc.DataUpdateLimit)
+
+	s.sut.EntityEventCB(s.smgw.SKI(), nil, entity, ucmgcp.DataUpdatePower)
+
+	event, err := stream.Recv()
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), s.smgw.SKI(), event.GetSki())
+	assert.Equal(s.T(), s.entity.GetEntity(), event.GetEntity())
+	assert.Equal(s.T(), "mgcp", event.GetUseCase())
+	assert.Equal(s.T(), string(ucmgcp.DataUpdatePower), event.GetEvent())
+
+	// closing the server ends the stream
+	s.sut.Close()
+	_, err = stream.Recv()
+	assert.Equal(s.T(), codes.Unavailable, status.Code(err))
+}
+
+func (s *ServerSuite) Test_Subscriber() {
+	sub := &subscriber{skis: []string{"ski"}}
+
+	assert.True(s.T(), sub.matches(&cemdv1.Event{Ski: "ski", UseCase: "mgcp"}))
+	assert.False(s.T(), sub.matches(&cemdv1.Event{Ski: "other"}))
+
+	sub.useCases = []string{"lpc"}
+	assert.False(s.T(), sub.matches(&cemdv1.Event{Ski: "ski", UseCase: "mgcp"}))
+	assert.True(s.T(), sub.matches(&cemdv1.Event{Ski: "ski", UseCase: "lpc"}))
+}
@@ -0,0 +1,449 @@
+package grpcapi
+
+import (
+	"context"
+
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/grpcapi/cemdv1"
+	"github.com/enbility/cemd/uccevc"
+	"github.com/enbility/cemd/ucevcc"
+	"github.com/enbility/cemd/ucevcem"
+	"github.com/enbility/cemd/ucevsecc"
+	"github.com/enbility/cemd/ucevsoc"
+	"github.com/enbility/cemd/uclpc"
+	"github.com/enbility/cemd/uclpp"
+	"github.com/enbility/cemd/ucmgcp"
+	"github.com/enbility/cemd/ucmpc"
+	"github.com/enbility/cemd/ucopev"
+	"github.com/enbility/cemd/ucoscev"
+	"github.com/enbility/cemd/ucvabd"
+	"github.com/enbility/cemd/ucvapd"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// the services of the client use cases, each is named after the short name of the use case
+//
+// the write operations are reported to the write callback with the names of the datapoints package
+
+type lpcService struct {
+	cemdv1.UnimplementedLPCServiceServer
+
+	server *Server
+}
+
+func (l *lpcService) GetConsumptionLimit(ctx context.Context, req *cemdv1.EntityRequest) (*cemdv1.LoadLimit, error) {
+	return get(l.server, "lpc", req, uclpc.UCLPCInterface.ConsumptionLimit, loadLimit)
+}
+
+func (l *lpcService) WriteConsumptionLimit(ctx context.Context, req *cemdv1.WriteLoadLimitRequest) (*cemdv1.WriteResponse, error) {
+	return write(l.server, "lpc", "consumptionLimit", req, func(uc uclpc.UCLPCInterface, entity spineapi.EntityRemoteInterface) (*model.MsgCounterType, error) {
+		limit, err := toLoadLimit(req.GetLimit())
+		if err != nil {
+			return nil, err
+		}
+
+		return uc.WriteConsumptionLimit(entity, limit)
+	})
+}
+
+func (l *lpcService) GetFailsafeConsumptionActivePowerLimit(ctx context.Context, req *cemdv1.EntityRequest) (*wrapperspb.DoubleValue, error) {
+	return get(l.server, "lpc", req, uclpc.UCLPCInterface.FailsafeConsumptionActivePowerLimit, doubleValue)
+}
+
+func (l *lpcService) WriteFailsafeConsumptionActivePowerLimit(ctx context.Context, req *cemdv1.WriteDoubleRequest) (*cemdv1.WriteResponse, error) {
+	return write(l.server, "lpc", "failsafeConsumptionActivePowerLimit", req, func(uc uclpc.UCLPCInterface, entity spineapi.EntityRemoteInterface) (*model.MsgCounterType, error) {
+		return uc.WriteFailsafeConsumptionActivePowerLimit(entity, req.GetValue())
+	})
+}
+
+func (l *lpcService) GetFailsafeDurationMinimum(ctx context.Context, req *cemdv1.EntityRequest) (*durationpb.Duration, error) {
+	return get(l.server, "lpc", req, uclpc.UCLPCInterface.FailsafeDurationMinimum, durationValue)
+}
+
+func (l *lpcService) WriteFailsafeDurationMinimum(ctx context.Context, req *cemdv1.WriteDurationRequest) (*cemdv1.WriteResponse, error) {
+	return write(l.server, "lpc", "failsafeDurationMinimum", req, func(uc uclpc.UCLPCInterface, entity spineapi.EntityRemoteInterface) (*model.MsgCounterType, error) {
+		duration, err := toDuration(req.GetValue())
+		if err != nil {
+			return nil, err
+		}
+
+		return uc.WriteFailsafeDurationMinimum(entity, duration)
+	})
+}
+
+func (l *lpcService) GetPowerConsumptionNominalMax(ctx context.Context, req *cemdv1.EntityRequest) (*wrapperspb.DoubleValue, error) {
+	return get(l.server, "lpc", req, uclpc.UCLPCInterface.PowerConsumptionNominalMax, doubleValue)
+}
+
+type lppService struct {
+	cemdv1.UnimplementedLPPServiceServer
+
+	server *Server
+}
+
+func (l *lppService) GetProductionLimit(ctx context.Context, req *cemdv1.EntityRequest) (*cemdv1.LoadLimit, error) {
+	return get(l.server, "lpp", req, uclpp.UCLPPInterface.ProductionLimit, loadLimit)
+}
+
+func (l *lppService) WriteProductionLimit(ctx context.Context, req *cemdv1.WriteLoadLimitRequest) (*cemdv1.WriteResponse, error) {
+	return write(l.server, "lpp", "productionLimit", req, func(uc uclpp.UCLPPInterface, entity spineapi.EntityRemoteInterface) (*model.MsgCounterType, error) {
+		limit, err := toLoadLimit(req.GetLimit())
+		if err != nil {
+			return nil, err
+		}
+
+		return uc.WriteProductionLimit(entity, limit)
+	})
+}
+
+func (l *lppService) GetFailsafeProductionActivePowerLimit(ctx context.Context, req *cemdv1.EntityRequest) (*wrapperspb.DoubleValue, error) {
+	return get(l.server, "lpp", req, uclpp.UCLPPInterface.FailsafeProductionActivePowerLimit, doubleValue)
+}
+
+func (l *lppService) WriteFailsafeProductionActivePowerLimit(ctx context.Context, req *cemdv1.WriteDoubleRequest) (*cemdv1.WriteResponse, error) {
+	return write(l.server, "lpp", "failsafeProductionActivePowerLimit", req, func(uc uclpp.UCLPPInterface, entity spineapi.EntityRemoteInterface) (*model.MsgCounterType, error) {
+		return uc.WriteFailsafeProductionActivePowerLimit(entity, req.GetValue())
+	})
+}
+
+func (l *lppService) GetFailsafeDurationMinimum(ctx context.Context, req *cemdv1.EntityRequest) (*durationpb.Duration, error) {
+	return get(l.server, "lpp", req, uclpp.UCLPPInterface.FailsafeDurationMinimum, durationValue)
+}
+
+func (l *lppService) WriteFailsafeDurationMinimum(ctx context.Context, req *cemdv1.WriteDurationRequest) (*cemdv1.WriteResponse, error) {
+	return write(l.server, "lpp", "failsafeDurationMinimum", req, func(uc uclpp.UCLPPInterface, entity spineapi.EntityRemoteInterface) (*model.MsgCounterType, error) {
+		duration, err := toDuration(req.GetValue())
+		if err != nil {
+			return nil, err
+		}
+
+		return uc.WriteFailsafeDurationMinimum(entity, duration)
+	})
+}
+
+func (l *lppService) GetPowerProductionNominalMax(ctx context.Context, req *cemdv1.EntityRequest) (*wrapperspb.DoubleValue, error) {
+	return get(l.server, "lpp", req, uclpp.UCLPPInterface.PowerProductionNominalMax, doubleValue)
+}
+
+type mgcpService struct {
+	cemdv1.UnimplementedMGCPServiceServer
+
+	server *Server
+}
+
+func (m *mgcpService) GetPowerLimitationFactor(ctx context.Context, req *cemdv1.EntityRequest) (*wrapperspb.DoubleValue, error) {
+	return get(m.server, "mgcp", req, ucmgcp.UCMGCPInterface.PowerLimitationFactor, doubleValue)
+}
+
+func (m *mgcpService) GetPower(ctx context.Context, req *cemdv1.EntityRequest) (*wrapperspb.DoubleValue, error) {
+	return get(m.server, "mgcp", req, ucmgcp.UCMGCPInterface.Power, doubleValue)
+}
+
+func (m *mgcpService) GetEnergyFeedIn(ctx context.Context, req *cemdv1.EntityRequest) (*wrapperspb.DoubleValue, error) {
+	return get(m.server, "mgcp", req, ucmgcp.UCMGCPInterface.EnergyFeedIn, doubleValue)
+}
+
+func (m *mgcpService) GetEnergyConsumed(ctx context.Context, req *cemdv1.EntityRequest) (*wrapperspb.DoubleValue, error) {
+	return get(m.server, "mgcp", req, ucmgcp.UCMGCPInterface.EnergyConsumed, doubleValue)
+}
+
+func (m *mgcpService) GetCurrentPerPhase(ctx context.Context, req *cemdv1.EntityRequest) (*cemdv1.PhaseValues, error) {
+	return get(m.server, "mgcp", req, ucmgcp.UCMGCPInterface.CurrentPerPhase, phaseValues)
+}
+
+func (m *mgcpService) GetVoltagePerPhase(ctx context.Context, req *cemdv1.EntityRequest) (*cemdv1.PhaseValues, error) {
+	return get(m.server, "mgcp", req, ucmgcp.UCMGCPInterface.VoltagePerPhase, phaseValues)
+}
+
+func (m *mgcpService) GetFrequency(ctx context.Context, req *cemdv1.EntityRequest) (*wrapperspb.DoubleValue, error) {
+	return get(m.server, "mgcp", req, ucmgcp.UCMGCPInterface.Frequency, doubleValue)
+}
+
+type mpcService struct {
+	cemdv1.UnimplementedMPCServiceServer
+
+	server *Server
+}
+
+func (m *mpcService) GetPower(ctx context.Context, req *cemdv1.EntityRequest) (*wrapperspb.DoubleValue, error) {
+	return get(m.server, "mpc", req, ucmpc.UCMCPInterface.Power, doubleValue)
+}
+
+func (m *mpcService) GetPowerPerPhase(ctx context.Context, req *cemdv1.EntityRequest) (*cemdv1.PhaseValues, error) {
+	return get(m.server, "mpc", req, ucmpc.UCMCPInterface.PowerPerPhase, phaseValues)
+}
+
+func (m *mpcService) GetEnergyConsumed(ctx context.Context, req *cemdv1.EntityRequest) (*wrapperspb.DoubleValue, error) {
+	return get(m.server, "mpc", req, ucmpc.UCMCPInterface.EnergyConsumed, doubleValue)
+}
+
+func (m *mpcService) GetEnergyProduced(ctx context.Context, req *cemdv1.EntityRequest) (*wrapperspb.DoubleValue, error) {
+	return get(m.server, "mpc", req, ucmpc.UCMCPInterface.EnergyProduced, doubleValue)
+}
+
+func (m *mpcService) GetCurrentPerPhase(ctx context.Context, req *cemdv1.EntityRequest) (*cemdv1.PhaseValues, error) {
+	return get(m.server, "mpc", req, ucmpc.UCMCPInterface.CurrentPerPhase, phaseValues)
+}
+
+func (m *mpcService) GetVoltagePerPhase(ctx context.Context, req *cemdv1.EntityRequest) (*cemdv1.PhaseValues, error) {
+	return get(m.server, "mpc", req, ucmpc.UCMCPInterface.VoltagePerPhase, phaseValues)
+}
+
+func (m *mpcService) GetFrequency(ctx context.Context, req *cemdv1.EntityRequest) (*wrapperspb.DoubleValue, error) {
+	return get(m.server, "mpc", req, ucmpc.UCMCPInterface.Frequency, doubleValue)
+}
+
+type evccService struct {
+	cemdv1.UnimplementedEVCCServiceServer
+
+	server *Server
+}
+
+func (e *evccService) GetChargeState(ctx context.Context, req *cemdv1.EntityRequest) (*wrapperspb.StringValue, error) {
+	return get(e.server, "evcc", req, ucevcc.UCEVCCInterface.ChargeState, stringValue[api.EVChargeStateType])
+}
+
+func (e *evccService) GetEVConnected(ctx context.Context, req *cemdv1.EntityRequest) (*wrapperspb.BoolValue, error) {
+	return get(e.server, "evcc", req, func(uc ucevcc.UCEVCCInterface, entity spineapi.EntityRemoteInterface) (bool, error) {
+		return uc.EVConnected(entity), nil
+	}, boolValue)
+}
+
+func (e *evccService) GetCommunicationStandard(ctx context.Context, req *cemdv1.EntityRequest) (*wrapperspb.StringValue, error) {
+	return get(e.server, "evcc", req, ucevcc.UCEVCCInterface.CommunicationStandard, stringValue[model.DeviceConfigurationKeyValueStringType])
+}
+
+func (e *evccService) GetAsymmetricChargingSupport(ctx context.Context, req *cemdv1.EntityRequest) (*wrapperspb.BoolValue, error) {
+	return get(e.server, "evcc", req, ucevcc.UCEVCCInterface.AsymmetricChargingSupport, boolValue)
+}
+
+func (e *evccService) GetIdentifications(ctx context.Context, req *cemdv1.EntityRequest) (*cemdv1.Identifications, error) {
+	return get(e.server, "evcc", req, ucevcc.UCEVCCInterface.Identifications, identifications)
+}
+
+func (e *evccService) GetManufacturerData(ctx context.Context, req *cemdv1.EntityRequest) (*cemdv1.ManufacturerData, error) {
+	return get(e.server, "evcc", req, ucevcc.UCEVCCInterface.ManufacturerData, manufacturerData)
+}
+
+func (e *evccService) GetChargingPowerLimits(ctx context.Context, req *cemdv1.EntityRequest) (*cemdv1.ChargingPowerLimits, error) {
+	return get(e.server, "evcc", req, func(uc ucevcc.UCEVCCInterface, entity spineapi.EntityRemoteInterface) (*cemdv1.ChargingPowerLimits, error) {
+		minimum, maximum, standby, err := uc.ChargingPowerLimits(entity)
+		if err != nil {
+			return nil, err
+		}
+
+		return &cemdv1.ChargingPowerLimits{Minimum: minimum, Maximum: maximum, Standby: standby}, nil
+	}, identity[*cemdv1.ChargingPowerLimits])
+}
+
+func (e *evccService) GetIsInSleepMode(ctx context.Context, req *cemdv1.EntityRequest) (*wrapperspb.BoolValue, error) {
+	return get(e.server, "evcc", req, ucevcc.UCEVCCInterface.IsInSleepMode, boolValue)
+}
+
+type evcemService struct {
+	cemdv1.UnimplementedEVCEMServiceServer
+
+	server *Server
+}
+
+func (e *evcemService) GetPhasesConnected(ctx context.Context, req *cemdv1.EntityRequest) (*wrapperspb.UInt32Value, error) {
+	return get(e.server, "evcem", req, ucevcem.UCEVCEMInterface.PhasesConnected, uint32Value)
+}
+
+func (e *evcemService) GetCurrentPerPhase(ctx context.Context, req *cemdv1.EntityRequest) (*cemdv1.PhaseValues, error) {
+	return get(e.server, "evcem", req, ucevcem.UCEVCEMInterface.CurrentPerPhase, phaseValues)
+}
+
+func (e *evcemService) GetPowerPerPhase(ctx context.Context, req *cemdv1.EntityRequest) (*cemdv1.PhaseValues, error) {
+	return get(e.server, "evcem", req, ucevcem.UCEVCEMInterface.PowerPerPhase, phaseValues)
+}
+
+func (e *evcemService) GetEnergyCharged(ctx context.Context, req *cemdv1.EntityRequest) (*wrapperspb.DoubleValue, error) {
+	return get(e.server, "evcem", req, ucevcem.UCEVCEMInterface.EnergyCharged, doubleValue)
+}
+
+type evseccService struct {
+	cemdv1.UnimplementedEVSECCServiceServer
+
+	server *Server
+}
+
+func (e *evseccService) GetManufacturerData(ctx context.Context, req *cemdv1.EntityRequest) (*cemdv1.ManufacturerData, error) {
+	return get(e.server, "evsecc", req, ucevsecc.UCEVSECCInterface.ManufacturerData, manufacturerData)
+}
+
+func (e *evseccService) GetOperatingState(ctx context.Context, req *cemdv1.EntityRequest) (*cemdv1.OperatingState, error) {
+	return get(e.server, "evsecc", req, func(uc ucevsecc.UCEVSECCInterface, entity spineapi.EntityRemoteInterface) (*cemdv1.OperatingState, error) {
+		state, lastErrorCode, err := uc.OperatingState(entity)
+		if err != nil {
+			return nil, err
+		}
+
+		return &cemdv1.OperatingState{State: string(state), LastErrorCode: lastErrorCode}, nil
+	}, identity[*cemdv1.OperatingState])
+}
+
+type evsocService struct {
+	cemdv1.UnimplementedEVSOCServiceServer
+
+	server *Server
+}
+
+func (e *evsocService) GetStateOfCharge(ctx context.Context, req *cemdv1.EntityRequest) (*wrapperspb.DoubleValue, error) {
+	return get(e.server, "evsoc", req, ucevsoc.UCEVSOCInterface.StateOfCharge, doubleValue)
+}
+
+type opevService struct {
+	cemdv1.UnimplementedOPEVServiceServer
+
+	server *Server
+}
+
+func (o *opevService) GetCurrentLimits(ctx context.Context, req *cemdv1.EntityRequest) (*cemdv1.CurrentLimits, error) {
+	return get(o.server, "opev", req, currentLimits[ucopev.UCOPEVInterface], identity[*cemdv1.CurrentLimits])
+}
+
+func (o *opevService) GetLoadControlLimits(ctx context.Context, req *cemdv1.EntityRequest) (*cemdv1.LoadLimitsPhases, error) {
+	return get(o.server, "opev", req, ucopev.UCOPEVInterface.LoadControlLimits, loadLimitsPhases)
+}
+
+func (o *opevService) WriteLoadControlLimits(ctx context.Context, req *cemdv1.WriteLoadLimitsPhasesRequest) (*cemdv1.WriteResponse, error) {
+	return write(o.server, "opev", "loadControlLimits", req, func(uc ucopev.UCOPEVInterface, entity spineapi.EntityRemoteInterface) (*model.MsgCounterType, error) {
+		return uc.WriteLoadControlLimits(entity, toLoadLimitsPhases(req.GetLimits()))
+	})
+}
+
+type oscevService struct {
+	cemdv1.UnimplementedOSCEVServiceServer
+
+	server *Server
+}
+
+func (o *oscevService) GetCurrentLimits(ctx context.Context, req *cemdv1.EntityRequest) (*cemdv1.CurrentLimits, error) {
+	return get(o.server, "oscev", req, currentLimits[ucoscev.UCOSCEVInterface], identity[*cemdv1.CurrentLimits])
+}
+
+func (o *oscevService) GetLoadControlLimits(ctx context.Context, req *cemdv1.EntityRequest) (*cemdv1.LoadLimitsPhases, error) {
+	return get(o.server, "oscev", req, ucoscev.UCOSCEVInterface.LoadControlLimits, loadLimitsPhases)
+}
+
+func (o *oscevService) WriteLoadControlLimits(ctx context.Context, req *cemdv1.WriteLoadLimitsPhasesRequest) (*cemdv1.WriteResponse, error) {
+	return write(o.server, "oscev", "loadControlLimits", req, func(uc ucoscev.UCOSCEVInterface, entity spineapi.EntityRemoteInterface) (*model.MsgCounterType, error) {
+		return uc.WriteLoadControlLimits(entity, toLoadLimitsPhases(req.GetLimits()))
+	})
+}
+
+// the current limits of the OPEV and OSCEV use cases
+func currentLimits[U interface {
+	CurrentLimits(entity spineapi.EntityRemoteInterface) ([]float64, []float64, []float64, error)
+}](uc U, entity spineapi.EntityRemoteInterface) (*cemdv1.CurrentLimits, error) {
+	minimum, maximum, standard, err := uc.CurrentLimits(entity)
+	if err != nil {
+		return nil, err
+	}
+
+	return &cemdv1.CurrentLimits{Minimum: minimum, Maximum: maximum, Default: standard}, nil
+}
+
+type cevcService struct {
+	cemdv1.UnimplementedCEVCServiceServer
+
+	server *Server
+}
+
+func (c *cevcService) GetChargeStrategy(ctx context.Context, req *cemdv1.EntityRequest) (*wrapperspb.StringValue, error) {
+	return get(c.server, "cevc", req, func(uc uccevc.UCCEVCInterface, entity spineapi.EntityRemoteInterface) (api.EVChargeStrategyType, error) {
+		return uc.ChargeStrategy(entity), nil
+	}, stringValue[api.EVChargeStrategyType])
+}
+
+func (c *cevcService) GetEnergyDemand(ctx context.Context, req *cemdv1.EntityRequest) (*cemdv1.Demand, error) {
+	return get(c.server, "cevc", req, uccevc.UCCEVCInterface.EnergyDemand, demand)
+}
+
+func (c *cevcService) GetTimeSlotConstraints(ctx context.Context, req *cemdv1.EntityRequest) (*cemdv1.TimeSlotConstraints, error) {
+	return get(c.server, "cevc", req, uccevc.UCCEVCInterface.TimeSlotConstraints, timeSlotConstraints)
+}
+
+func (c *cevcService) WritePowerLimits(ctx context.Context, req *cemdv1.WriteDurationSlotValuesRequest) (*cemdv1.WriteResponse, error) {
+	return write(c.server, "cevc", "powerLimits", req, func(uc uccevc.UCCEVCInterface, entity spineapi.EntityRemoteInterface) (*model.MsgCounterType, error) {
+		slots, err := toDurationSlotValues(req.GetSlots())
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, uc.WritePowerLimits(entity, slots)
+	})
+}
+
+func (c *cevcService) GetIncentiveConstraints(ctx context.Context, req *cemdv1.EntityRequest) (*cemdv1.IncentiveSlotConstraints, error) {
+	return get(c.server, "cevc", req, uccevc.UCCEVCInterface.IncentiveConstraints, incentiveSlotConstraints)
+}
+
+func (c *cevcService) WriteIncentiveTableDescriptions(ctx context.Context, req *cemdv1.WriteIncentiveTableDescriptionsRequest) (*cemdv1.WriteResponse, error) {
+	return write(c.server, "cevc", "incentiveTableDescriptions", req, func(uc uccevc.UCCEVCInterface, entity spineapi.EntityRemoteInterface) (*model.MsgCounterType, error) {
+		return nil, uc.WriteIncentiveTableDescriptions(entity, toIncentiveTariffDescriptions(req.GetDescriptions()))
+	})
+}
+
+func (c *cevcService) WriteIncentives(ctx context.Context, req *cemdv1.WriteDurationSlotValuesRequest) (*cemdv1.WriteResponse, error) {
+	return write(c.server, "cevc", "incentives", req, func(uc uccevc.UCCEVCInterface, entity spineapi.EntityRemoteInterface) (*model.MsgCounterType, error) {
+		slots, err := toDurationSlotValues(req.GetSlots())
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, uc.WriteIncentives(entity, slots)
+	})
+}
+
+func (c *cevcService) GetChargePlanConstraints(ctx context.Context, req *cemdv1.EntityRequest) (*cemdv1.DurationSlotValues, error) {
+	return get(c.server, "cevc", req, uccevc.UCCEVCInterface.ChargePlanConstraints, durationSlotValues)
+}
+
+func (c *cevcService) GetChargePlan(ctx context.Context, req *cemdv1.EntityRequest) (*cemdv1.ChargePlan, error) {
+	return get(c.server, "cevc", req, uccevc.UCCEVCInterface.ChargePlan, chargePlan)
+}
+
+type vabdService struct {
+	cemdv1.UnimplementedVABDServiceServer
+
+	server *Server
+}
+
+func (v *vabdService) GetPower(ctx context.Context, req *cemdv1.EntityRequest) (*wrapperspb.DoubleValue, error) {
+	return get(v.server, "vabd", req, ucvabd.UCVABDInterface.Power, doubleValue)
+}
+
+func (v *vabdService) GetEnergyCharged(ctx context.Context, req *cemdv1.EntityRequest) (*wrapperspb.DoubleValue, error) {
+	return get(v.server, "vabd", req, ucvabd.UCVABDInterface.EnergyCharged, doubleValue)
+}
+
+func (v *vabdService) GetEnergyDischarged(ctx context.Context, req *cemdv1.EntityRequest) (*wrapperspb.DoubleValue, error) {
+	return get(v.server, "vabd", req, ucvabd.UCVABDInterface.EnergyDischarged, doubleValue)
+}
+
+func (v *vabdService) GetStateOfCharge(ctx context.Context, req *cemdv1.EntityRequest) (*wrapperspb.DoubleValue, error) {
+	return get(v.server, "vabd", req, ucvabd.UCVABDInterface.StateOfCharge, doubleValue)
+}
+
+type vapdService struct {
+	cemdv1.UnimplementedVAPDServiceServer
+
+	server *Server
+}
+
+func (v *vapdService) GetPower(ctx context.Context, req *cemdv1.EntityRequest) (*wrapperspb.DoubleValue, error) {
+	return get(v.server, "vapd", req, ucvapd.UCVAPDInterface.Power, doubleValue)
+}
+
+func (v *vapdService) GetPowerNominalPeak(ctx context.Context, req *cemdv1.EntityRequest) (*wrapperspb.DoubleValue, error) {
+	return get(v.server, "vapd", req, ucvapd.UCVAPDInterface.PowerNominalPeak, doubleValue)
+}
+
+func (v *vapdService) GetPVYieldTotal(ctx context.Context, req *cemdv1.EntityRequest) (*wrapperspb.DoubleValue, error) {
+	return get(v.server, "vapd", req, ucvapd.UCVAPDInterface.PVYieldTotal, doubleValue)
+}
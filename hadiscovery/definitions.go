@@ -0,0 +1,211 @@
+package hadiscovery
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/enbility/spine-go/model"
+)
+
+// the Home Assistant components
+const (
+	componentSensor       = "sensor"
+	componentBinarySensor = "binary_sensor"
+	componentNumber       = "number"
+)
+
+// a Home Assistant entity announced for a data point or write operation of a use case
+type entityDefinition struct {
+	useCase   string // the short name of the use case, e.g. "mgcp"
+	dataPoint string // the data point providing the state, empty if there is none
+	operation string // the write operation of a number, empty for sensors
+
+	component string
+	key       string // the unique part of the object id, e.g. "power" or "current_l1"
+	name      string
+
+	deviceClass   string
+	unit          string
+	stateClass    string
+	valueTemplate string
+
+	// the template of the write operation payload for numbers
+	commandTemplate string
+	min, max, step  float64
+}
+
+// the entities announced for the data points and write operations
+var entityDefinitions = flatten(
+	// MGCP
+	sensor("mgcp", "power", "Grid power", "power", "W", "measurement"),
+	sensor("mgcp", "energyFeedIn", "Grid energy feed-in", "energy", "Wh", "total_increasing"),
+	sensor("mgcp", "energyConsumed", "Grid energy consumed", "energy", "Wh", "total_increasing"),
+	perPhase("mgcp", "currentPerPhase", "Grid current", "current", "A"),
+	perPhase("mgcp", "voltagePerPhase", "Grid voltage", "voltage", "V"),
+	sensor("mgcp", "frequency", "Grid frequency", "frequency", "Hz", "measurement"),
+	sensor("mgcp", "powerLimitationFactor", "Power limitation factor", "", "", "measurement"),
+
+	// MPC
+	sensor("mpc", "power", "Power", "power", "W", "measurement"),
+	perPhase("mpc", "powerPerPhase", "Power", "power", "W"),
+	sensor("mpc", "energyConsumed", "Energy consumed", "energy", "Wh", "total_increasing"),
+	sensor("mpc", "energyProduced", "Energy produced", "energy", "Wh", "total_increasing"),
+	perPhase("mpc", "currentPerPhase", "Current", "current", "A"),
+	perPhase("mpc", "voltagePerPhase", "Voltage", "voltage", "V"),
+	sensor("mpc", "frequency", "Frequency", "frequency", "Hz", "measurement"),
+
+	// EV
+	[]entityDefinition{{
+		useCase:       "evcc",
+		dataPoint:     "evConnected",
+		component:     componentBinarySensor,
+		key:           "connected",
+		name:          "EV connected",
+		deviceClass:   "plug",
+		valueTemplate: "{{ 'ON' if value_json else 'OFF' }}",
+	}},
+	[]entityDefinition{{
+		useCase:       "evcc",
+		dataPoint:     "chargeState",
+		component:     componentSensor,
+		key:           "charge_state",
+		name:          "Charge state",
+		valueTemplate: "{{ value_json }}",
+	}},
+	perPhase("evcem", "currentPerPhase", "Charging current", "current", "A"),
+	perPhase("evcem", "powerPerPhase", "Charging power", "power", "W"),
+	sensor("evcem", "energyCharged", "Energy charged", "energy", "Wh", "total_increasing"),
+	sensor("evsoc", "stateOfCharge", "EV state of charge", "battery", "%", "measurement"),
+
+	// PV and battery
+	sensor("vapd", "power", "PV power", "power", "W", "measurement"),
+	sensor("vapd", "powerNominalPeak", "PV nominal peak power", "power", "W", ""),
+	sensor("vapd", "pvYieldTotal", "PV yield", "energy", "Wh", "total_increasing"),
+	sensor("vabd", "power", "Battery power", "power", "W", "measurement"),
+	sensor("vabd", "energyCharged", "Battery energy charged", "energy", "Wh", "total_increasing"),
+	sensor("vabd", "energyDischarged", "Battery energy discharged", "energy", "Wh", "total_increasing"),
+	sensor("vabd", "stateOfCharge", "Battery state of charge", "battery", "%", "measurement"),
+
+	// limits
+	[]entityDefinition{
+		{
+			useCase:         "lpc",
+			dataPoint:       "consumptionLimit",
+			operation:       "consumptionLimit",
+			component:       componentNumber,
+			key:             "consumption_limit",
+			name:            "Consumption limit",
+			deviceClass:     "power",
+			unit:            "W",
+			valueTemplate:   "{{ value_json.Value }}",
+			commandTemplate: `{"Value": {{ value }}, "IsActive": true}`,
+			min:             0,
+			max:             100000,
+			step:            1,
+		},
+		{
+			useCase:         "lpp",
+			dataPoint:       "productionLimit",
+			operation:       "productionLimit",
+			component:       componentNumber,
+			key:             "production_limit",
+			name:            "Production limit",
+			deviceClass:     "power",
+			unit:            "W",
+			valueTemplate:   "{{ value_json.Value }}",
+			commandTemplate: `{"Value": {{ value }}, "IsActive": true}`,
+			min:             -100000,
+			max:             0,
+			step:            1,
+		},
+	},
+	currentLimit("opev", "EV current limit"),
+	currentLimit("oscev", "EV self consumption current limit"),
+)
+
+func flatten(items ...[]entityDefinition) []entityDefinition {
+	var result []entityDefinition
+
+	for _, item := range items {
+		result = append(result, item...)
+	}
+
+	return result
+}
+
+func sensor(useCase, dataPoint, name, deviceClass, unit, stateClass string) []entityDefinition {
+	return []entityDefinition{{
+		useCase:     useCase,
+		dataPoint:   dataPoint,
+		component:   componentSensor,
+		key:         objectKey(dataPoint),
+		name:        name,
+		deviceClass: deviceClass,
+		unit:        unit,
+		stateClass:  stateClass,
+	}}
+}
+
+// a sensor for each phase of a data point providing a list of values
+func perPhase(useCase, dataPoint, name, deviceClass, unit string) []entityDefinition {
+	var result []entityDefinition
+
+	for i := 0; i < 3; i++ {
+		result = append(result, entityDefinition{
+			useCase:       useCase,
+			dataPoint:     dataPoint,
+			component:     componentSensor,
+			key:           fmt.Sprintf("%s_l%d", objectKey(dataPoint), i+1),
+			name:          fmt.Sprintf("%s L%d", name, i+1),
+			deviceClass:   deviceClass,
+			unit:          unit,
+			stateClass:    "measurement",
+			valueTemplate: fmt.Sprintf("{{ value_json[%d] }}", i),
+		})
+	}
+
+	return result
+}
+
+// a number setting the load control limits of all phases of an EV to the same current
+func currentLimit(useCase, name string) []entityDefinition {
+	var phases []string
+	for _, phase := range []model.ElectricalConnectionPhaseNameType{
+		model.ElectricalConnectionPhaseNameTypeA,
+		model.ElectricalConnectionPhaseNameTypeB,
+		model.ElectricalConnectionPhaseNameTypeC,
+	} {
+		phases = append(phases, fmt.Sprintf(`{"Phase": "%s", "IsActive": true, "Value": {{ value }}}`, phase))
+	}
+
+	return []entityDefinition{{
+		useCase:         useCase,
+		dataPoint:       "loadControlLimits",
+		operation:       "loadControlLimits",
+		component:       componentNumber,
+		key:             "current_limit",
+		name:            name,
+		deviceClass:     "current",
+		unit:            "A",
+		valueTemplate:   "{{ value_json[0].Value }}",
+		commandTemplate: "[" + strings.Join(phases, ", ") + "]",
+		min:             0,
+		max:             32,
+		step:            1,
+	}}
+}
+
+// returns the data point name in snake case, e.g. "energy_feed_in" for "energyFeedIn"
+func objectKey(dataPoint string) string {
+	var result strings.Builder
+
+	for _, r := range dataPoint {
+		if r >= 'A' && r <= 'Z' {
+			result.WriteByte('_')
+			r += 'a' - 'A'
+		}
+		result.WriteRune(r)
+	}
+
+	return result.String()
+}
@@ -0,0 +1,340 @@
+// Package hadiscovery announces the remote entities to Home Assistant via MQTT discovery
+//
+// Each remote entity becomes a Home Assistant device, named after the manufacturer
+// data of the remote device and the entity type. The use cases the entity supports
+// decide which sensors and numbers are announced, e.g. the grid power of MGCP,
+// the state of charge of EVSOC or the consumption limit of LPC.
+//
+// The states and commands use the topics of an mqttbridge.Bridge, only the values of
+// the use cases published by the bridge are announced as sensors.
+package hadiscovery
+
+import (
+	"encoding/json"
+	"strings"
+	"sync"
+
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/cem"
+	"github.com/enbility/cemd/datapoints"
+	"github.com/enbility/cemd/mqttbridge"
+	"github.com/enbility/cemd/util"
+	"github.com/enbility/ship-go/logging"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+)
+
+const (
+	// the default prefix of the discovery topics
+	DefaultPrefix = "homeassistant"
+
+	// the default topic the availability of a remote device is published to
+	DefaultAvailabilityTopic = "cemd/{ski}/availability"
+)
+
+// the payloads of the availability topic
+const (
+	payloadOnline  = "online"
+	payloadOffline = "offline"
+)
+
+// the prefix of all node ids, unique ids and device identifiers
+const idPrefix = "cemd"
+
+// Configuration of the discovery, empty values are replaced by the defaults
+type Config struct {
+	// the discovery prefix configured in Home Assistant
+	Prefix string
+
+	// the topic the availability of a remote device is published to,
+	// the placeholder {ski} is replaced by the SKI of the device
+	AvailabilityTopic string
+}
+
+// Announces the remote entities of a CEM to Home Assistant
+type Discovery struct {
+	cem    *cem.Cem
+	client mqttbridge.ClientInterface
+	bridge *mqttbridge.Bridge
+
+	prefix            string
+	availabilityTopic string
+
+	mux sync.Mutex
+	// the announced discovery payloads by topic, by entity address, by SKI
+	announced map[string]map[string]map[string]string
+	// the SKIs the manufacturer data was requested for
+	requested map[string]bool
+}
+
+// create a new discovery for the remote entities of the CEM
+//
+// the events have to be passed to EntityEventCB and DeviceEventCB, before
+// they are passed to the bridge, so the entities are announced before their values
+func NewDiscovery(c *cem.Cem, client mqttbridge.ClientInterface, bridge *mqttbridge.Bridge, config Config) *Discovery {
+	if config.Prefix == "" {
+		config.Prefix = DefaultPrefix
+	}
+	if config.AvailabilityTopic == "" {
+		config.AvailabilityTopic = DefaultAvailabilityTopic
+	}
+
+	return &Discovery{
+		cem:               c,
+		client:            client,
+		bridge:            bridge,
+		prefix:            config.Prefix,
+		availabilityTopic: config.AvailabilityTopic,
+		announced:         make(map[string]map[string]map[string]string),
+		requested:         make(map[string]bool),
+	}
+}
+
+// add the local DeviceClassification client, which is required to request
+// the manufacturer data of the remote devices
+//
+// has to be called after the CEM is set up
+func (d *Discovery) AddFeatures() {
+	localEntity := d.cem.Service.LocalDevice().EntityForType(model.EntityTypeTypeCEM)
+	if localEntity == nil {
+		return
+	}
+
+	_ = localEntity.GetOrAddFeature(model.FeatureTypeTypeDeviceClassification, model.RoleTypeClient)
+}
+
+// announce the entity of a use case event, if its announcement changed
+//
+// has the signature of api.EntityEventCallback
+func (d *Discovery) EntityEventCB(ski string, device spineapi.DeviceRemoteInterface, entity spineapi.EntityRemoteInterface, event api.EventType) {
+	if device == nil || entity == nil {
+		return
+	}
+
+	d.mux.Lock()
+	defer d.mux.Unlock()
+
+	payloads := d.payloads(ski, device, entity)
+
+	entities, ok := d.announced[ski]
+	if !ok {
+		entities = make(map[string]map[string]string)
+		d.announced[ski] = entities
+		d.publish(d.deviceAvailabilityTopic(ski), payloadOnline)
+	}
+
+	address := datapoints.EntityAddress(entity)
+	announced, ok := entities[address]
+	if !ok {
+		announced = make(map[string]string)
+		entities[address] = announced
+	}
+
+	for topic, payload := range payloads {
+		if announced[topic] != payload {
+			announced[topic] = payload
+			d.publish(topic, payload)
+		}
+	}
+
+	// remove the entities of use cases no longer supported
+	for topic := range announced {
+		if _, ok := payloads[topic]; !ok {
+			delete(announced, topic)
+			d.publish(topic, "")
+		}
+	}
+}
+
+// mark the entities of a disconnected device as unavailable
+//
+// has the signature of api.DeviceEventCallback
+func (d *Discovery) DeviceEventCB(ski string, device spineapi.DeviceRemoteInterface, event api.EventType) {
+	if event != cem.DeviceDisconnected {
+		return
+	}
+
+	d.mux.Lock()
+	defer d.mux.Unlock()
+
+	if _, ok := d.announced[ski]; !ok {
+		return
+	}
+
+	// the entities are announced again after reconnecting, as the device may have changed
+	delete(d.announced, ski)
+	delete(d.requested, ski)
+	d.publish(d.deviceAvailabilityTopic(ski), payloadOffline)
+}
+
+// returns the discovery payloads of an entity by topic
+func (d *Discovery) payloads(ski string, device spineapi.DeviceRemoteInterface, entity spineapi.EntityRemoteInterface) map[string]string {
+	result := make(map[string]string)
+
+	address := datapoints.EntityAddress(entity)
+	haDevice := d.device(ski, device, entity)
+
+	for _, usecase := range datapoints.ForUseCases(d.cem.UseCases()) {
+		if supported, err := usecase.UseCase.IsUseCaseSupported(entity); err != nil || !supported {
+			continue
+		}
+
+		for _, definition := range entityDefinitions {
+			if definition.useCase != usecase.Name {
+				continue
+			}
+
+			config := d.entityConfig(ski, address, definition, haDevice)
+			if config == nil {
+				continue
+			}
+
+			payload, err := json.Marshal(config)
+			if err != nil {
+				logging.Log().Error("Home Assistant discovery", err)
+				continue
+			}
+
+			objectID := entityObjectID(address) + "_" + definition.useCase + "_" + definition.key
+			topic := d.prefix + "/" + definition.component + "/" + d.nodeID(ski) + "/" + objectID + "/config"
+			result[topic] = string(payload)
+		}
+	}
+
+	return result
+}
+
+// returns the discovery payload of an entity, nil if it has no state and no command
+func (d *Discovery) entityConfig(ski, address string, definition entityDefinition, haDevice map[string]any) map[string]any {
+	config := map[string]any{
+		"name":               definition.name,
+		"unique_id":          d.nodeID(ski) + "_" + entityObjectID(address) + "_" + definition.useCase + "_" + definition.key,
+		"device":             haDevice,
+		"availability_topic": d.deviceAvailabilityTopic(ski),
+	}
+
+	if definition.dataPoint != "" && d.bridge.Publishes(definition.useCase) {
+		config["state_topic"] = d.bridge.StateTopic(ski, address, definition.useCase, definition.dataPoint)
+		if definition.valueTemplate != "" {
+			config["value_template"] = definition.valueTemplate
+		}
+	}
+
+	if definition.operation != "" {
+		config["command_topic"] = d.bridge.CommandTopic(ski, address, definition.useCase, definition.operation)
+		config["command_template"] = definition.commandTemplate
+		config["min"] = definition.min
+		config["max"] = definition.max
+		config["step"] = definition.step
+		config["mode"] = "box"
+	}
+
+	if config["state_topic"] == nil && config["command_topic"] == nil {
+		return nil
+	}
+
+	if definition.deviceClass != "" {
+		config["device_class"] = definition.deviceClass
+	}
+	if definition.unit != "" {
+		config["unit_of_measurement"] = definition.unit
+	}
+	if definition.stateClass != "" {
+		config["state_class"] = definition.stateClass
+	}
+
+	return config
+}
+
+// returns the Home Assistant device of a remote entity
+func (d *Discovery) device(ski string, device spineapi.DeviceRemoteInterface, entity spineapi.EntityRemoteInterface) map[string]any {
+	result := map[string]any{
+		"identifiers": []string{d.nodeID(ski) + "_" + entityObjectID(datapoints.EntityAddress(entity))},
+	}
+
+	name := "EEBUS " + ski
+	if len(ski) > 8 {
+		name = "EEBUS " + ski[:8]
+	}
+
+	data, err := util.DeviceManufacturerData(d.cem.Service, device)
+	if err != nil && !d.requested[ski] {
+		// the entities are announced again including the device info
+		// with the next event of the entity after the data is received
+		d.requested[ski] = true
+		if err := util.RequestDeviceManufacturerData(d.cem.Service, device); err != nil {
+			logging.Log().Debug("Home Assistant discovery", err)
+		}
+	}
+
+	switch {
+	case data.DeviceName != "":
+		name = data.DeviceName
+	case data.BrandName != "":
+		name = data.BrandName
+	}
+	result["name"] = name + " " + entityTypeName(entity.EntityType())
+
+	manufacturer := data.BrandName
+	if manufacturer == "" {
+		manufacturer = data.VendorName
+	}
+
+	for key, value := range map[string]string{
+		"manufacturer":  manufacturer,
+		"model":         data.DeviceCode,
+		"serial_number": data.SerialNumber,
+		"sw_version":    data.SoftwareRevision,
+		"hw_version":    data.HardwareRevision,
+	} {
+		if value != "" {
+			result[key] = value
+		}
+	}
+
+	return result
+}
+
+func (d *Discovery) publish(topic, payload string) {
+	if err := d.client.Publish(topic, []byte(payload)); err != nil {
+		logging.Log().Error("Home Assistant discovery", topic, err)
+	}
+}
+
+// returns the node id of a remote device, which groups its discovery topics
+func (d *Discovery) nodeID(ski string) string {
+	return idPrefix + "_" + ski
+}
+
+func (d *Discovery) deviceAvailabilityTopic(ski string) string {
+	return strings.ReplaceAll(d.availabilityTopic, mqttbridge.PlaceholderSki, ski)
+}
+
+// returns the entity address as part of an object id, e.g. "1_1" for "1.1"
+func entityObjectID(address string) string {
+	return strings.ReplaceAll(address, ".", "_")
+}
+
+// returns a readable name of an entity type
+func entityTypeName(entityType model.EntityTypeType) string {
+	switch entityType {
+	case model.EntityTypeTypeEV:
+		return "EV"
+	case model.EntityTypeTypeEVSE:
+		return "EVSE"
+	case model.EntityTypeTypeCEM:
+		return "CEM"
+	case model.EntityTypeTypeGridConnectionPointOfPremises:
+		return "Grid connection point"
+	case model.EntityTypeTypePVSystem:
+		return "PV system"
+	case model.EntityTypeTypeBatterySystem:
+		return "Battery system"
+	case model.EntityTypeTypeHeatPumpAppliance:
+		return "Heat pump"
+	case model.EntityTypeTypeElectricityStorageSystem:
+		return "Storage system"
+	}
+
+	return string(entityType)
+}
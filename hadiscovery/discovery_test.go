@@ -0,0 +1,296 @@
+package hadiscovery
+
+import (
+	"encoding/json"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/approval"
+	"github.com/enbility/cemd/cem"
+	"github.com/enbility/cemd/datapoints"
+	"github.com/enbility/cemd/loopback"
+	"github.com/enbility/cemd/mocks"
+	"github.com/enbility/cemd/mqttbridge"
+	"github.com/enbility/cemd/uclpc"
+	"github.com/enbility/cemd/uclpcserver"
+	"github.com/enbility/cemd/ucmgcp"
+	"github.com/enbility/cemd/ucmgcpserver"
+	"github.com/enbility/spine-go/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestDiscoverySuite(t *testing.T) {
+	suite.Run(t, new(DiscoverySuite))
+}
+
+// records the published messages
+type testClient struct {
+	mux       sync.Mutex
+	published map[string]string
+}
+
+func (c *testClient) Publish(topic string, payload []byte) error {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	c.published[topic] = string(payload)
+	return nil
+}
+
+func (c *testClient) Subscribe(filter string, handler func(topic string, payload []byte)) error {
+	return nil
+}
+
+func (c *testClient) Unsubscribe(filter string) error {
+	return nil
+}
+
+func (c *testClient) message(topic string) (string, bool) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	payload, ok := c.published[topic]
+	return payload, ok
+}
+
+// returns the decoded discovery payload of a topic, nil if there is none
+func (c *testClient) config(topic string) map[string]any {
+	payload, ok := c.message(topic)
+	if !ok || payload == "" {
+		return nil
+	}
+
+	var result map[string]any
+	if err := json.Unmarshal([]byte(payload), &result); err != nil {
+		return nil
+	}
+	return result
+}
+
+type DiscoverySuite struct {
+	suite.Suite
+
+	network *loopback.Network
+
+	cem        *loopback.Device
+	smgw       *loopback.Device
+	mgcpServer *ucmgcpserver.UCMGCPServer
+
+	client *testClient
+	bridge *mqttbridge.Bridge
+	sut    *Discovery
+
+	// the SKI of the smgw and the object id of its CEM entity
+	ski    string
+	entity string
+}
+
+func (s *DiscoverySuite) BeforeTest(suiteName, testName string) {
+	s.network = loopback.NewNetwork()
+
+	var err error
+	s.cem, err = s.network.NewCEM("cem", nil)
+	assert.Nil(s.T(), err)
+
+	s.client = &testClient{published: make(map[string]string)}
+	s.bridge, err = mqttbridge.NewBridge(s.cem.Cem, s.client, mqttbridge.Config{})
+	assert.Nil(s.T(), err)
+	s.sut = NewDiscovery(s.cem.Cem, s.client, s.bridge, Config{})
+	s.sut.AddFeatures()
+
+	s.cem.AddUseCase(ucmgcp.NewUCMGCP(s.cem.Service(), s.sut.EntityEventCB))
+	s.cem.AddUseCase(uclpc.NewUCLPC(s.cem.Service(), s.sut.EntityEventCB))
+
+	s.smgw, err = s.network.NewCEM("smgw", nil)
+	assert.Nil(s.T(), err)
+
+	serverEvents := loopback.NewEventRecorder()
+	s.mgcpServer = ucmgcpserver.NewUCMGCP(s.smgw.Service(), serverEvents.EntityEventCB)
+	s.smgw.AddUseCase(s.mgcpServer)
+	lpcServer := uclpcserver.NewUCLPC(s.smgw.Service(), serverEvents.EntityEventCB)
+	lpcServer.SetApprovalPolicy(approval.NewAutoApprovePolicy())
+	s.smgw.AddUseCase(lpcServer)
+
+	// the entities are announced with the first event of their use cases
+	err = lpcServer.SetConsumptionLimit(api.LoadLimit{IsChangeable: true})
+	assert.Nil(s.T(), err)
+
+	_, err = s.network.Connect(s.cem, s.smgw)
+	assert.Nil(s.T(), err)
+
+	err = s.network.WaitIdle(time.Second * 5)
+	assert.Nil(s.T(), err)
+
+	err = s.mgcpServer.SetPower(-1000)
+	assert.Nil(s.T(), err)
+
+	s.ski = s.smgw.SKI()
+	s.entity = entityObjectID(datapoints.EntityAddress(s.cem.RemoteEntity(s.smgw, model.EntityTypeTypeCEM)))
+}
+
+func (s *DiscoverySuite) AfterTest(suiteName, testName string) {
+	s.network.Close()
+}
+
+func (s *DiscoverySuite) topic(component, key string) string {
+	return "homeassistant/" + component + "/cemd_" + s.ski + "/" + s.entity + "_" + key + "/config"
+}
+
+func (s *DiscoverySuite) Test_Announce() {
+	powerTopic := s.topic(componentSensor, "mgcp_power")
+	limitTopic := s.topic(componentNumber, "lpc_consumption_limit")
+
+	assert.Eventually(s.T(), func() bool {
+		return s.client.config(powerTopic) != nil && s.client.config(limitTopic) != nil
+	}, time.Second*5, time.Millisecond*10)
+
+	payload, _ := s.client.message("cemd/" + s.ski + "/availability")
+	assert.Equal(s.T(), payloadOnline, payload)
+
+	address := strings.ReplaceAll(s.entity, "_", ".")
+
+	config := s.client.config(powerTopic)
+	assert.Equal(s.T(), "cemd/"+s.ski+"/"+address+"/mgcp/power", config["state_topic"])
+	assert.Equal(s.T(), "cemd_"+s.ski+"_"+s.entity+"_mgcp_power", config["unique_id"])
+	assert.Equal(s.T(), "W", config["unit_of_measurement"])
+	assert.Equal(s.T(), "cemd/"+s.ski+"/availability", config["availability_topic"])
+
+	// LPC values are not published by the bridge, so only the command is announced
+	config = s.client.config(limitTopic)
+	assert.Equal(s.T(), "cemd/"+s.ski+"/"+address+"/lpc/consumptionLimit/set", config["command_topic"])
+	assert.Nil(s.T(), config["state_topic"])
+
+	// the use cases of the CEM without data points are not announced
+	s.client.mux.Lock()
+	for topic := range s.client.published {
+		assert.NotContains(s.T(), topic, "_mpc_")
+	}
+	s.client.mux.Unlock()
+
+	// the device info is announced with the next event after it was received
+	err := s.network.WaitIdle(time.Second * 5)
+	assert.Nil(s.T(), err)
+	err = s.mgcpServer.SetPower(1000)
+	assert.Nil(s.T(), err)
+
+	assert.Eventually(s.T(), func() bool {
+		device, ok := s.client.config(powerTopic)["device"].(map[string]any)
+		return ok && device["manufacturer"] == "loopback"
+	}, time.Second*5, time.Millisecond*10)
+
+	device := s.client.config(powerTopic)["device"].(map[string]any)
+	assert.Equal(s.T(), "loopback CEM", device["name"])
+	assert.Equal(s.T(), "smgw", device["serial_number"])
+	assert.Equal(s.T(), []any{"cemd_" + s.ski + "_" + s.entity}, device["identifiers"])
+}
+
+func (s *DiscoverySuite) Test_Disconnect() {
+	topic := s.topic(componentSensor, "mgcp_power")
+	assert.Eventually(s.T(), func() bool {
+		return s.client.config(topic) != nil
+	}, time.Second*5, time.Millisecond*10)
+
+	s.sut.DeviceEventCB(s.ski, nil, cem.DeviceDisconnected)
+
+	payload, _ := s.client.message("cemd/" + s.ski + "/availability")
+	assert.Equal(s.T(), payloadOffline, payload)
+
+	// the entities are announced again after reconnecting
+	s.client.mux.Lock()
+	delete(s.client.published, topic)
+	s.client.mux.Unlock()
+
+	entity := s.cem.RemoteEntity(s.smgw, model.EntityTypeTypeCEM)
+	s.sut.EntityEventCB(s.ski, entity.Device(), entity, ucmgcp.DataUpdatePower)
+	assert.NotNil(s.T(), s.client.config(topic))
+
+	payload, _ = s.client.message("cemd/" + s.ski + "/availability")
+	assert.Equal(s.T(), payloadOnline, payload)
+}
+
+func (s *DiscoverySuite) Test_Config() {
+	bridge, err := mqttbridge.NewBridge(s.cem.Cem, s.client, mqttbridge.Config{
+		StateTopic: "home/{ski}/{entity}/{usecase}/{datapoint}",
+		UseCases:   []string{"lpc"},
+	})
+	assert.Nil(s.T(), err)
+
+	client := &testClient{published: make(map[string]string)}
+	sut := NewDiscovery(s.cem.Cem, client, bridge, Config{
+		Prefix:            "ha",
+		AvailabilityTopic: "home/{ski}/status",
+	})
+
+	entity := s.cem.RemoteEntity(s.smgw, model.EntityTypeTypeCEM)
+	sut.EntityEventCB(s.ski, entity.Device(), entity, ucmgcp.DataUpdatePower)
+
+	address := strings.ReplaceAll(s.entity, "_", ".")
+	config := client.config("ha/number/cemd_" + s.ski + "/" + s.entity + "_lpc_consumption_limit/config")
+	assert.Equal(s.T(), "home/"+s.ski+"/"+address+"/lpc/consumptionLimit", config["state_topic"])
+	assert.Equal(s.T(), "{{ value_json.Value }}", config["value_template"])
+	assert.Equal(s.T(), "home/"+s.ski+"/status", config["availability_topic"])
+
+	// MGCP is not published, so there is nothing to announce
+	_, ok := client.message("ha/sensor/cemd_" + s.ski + "/" + s.entity + "_mgcp_power/config")
+	assert.False(s.T(), ok)
+}
+
+func (s *DiscoverySuite) Test_Definitions() {
+	assert.Equal(s.T(), "energy_feed_in", objectKey("energyFeedIn"))
+	assert.Equal(s.T(), "EV", entityTypeName(model.EntityTypeTypeEV))
+	assert.Equal(s.T(), "Compressor", entityTypeName(model.EntityTypeTypeCompressor))
+
+	// the definitions have to refer to existing data points and operations
+	usecases := make(map[string]datapoints.UseCase)
+	for _, item := range []struct {
+		usecase interface {
+			api.UseCaseInterface
+			On(methodName string, arguments ...any) *mock.Call
+		}
+		name model.UseCaseNameType
+	}{
+		{mocks.NewUCEVCCInterface(s.T()), model.UseCaseNameTypeEVCommissioningAndConfiguration},
+		{mocks.NewUCEVCEMInterface(s.T()), model.UseCaseNameTypeMeasurementOfElectricityDuringEVCharging},
+		{mocks.NewUCEVSOCInterface(s.T()), model.UseCaseNameTypeEVStateOfCharge},
+		{mocks.NewUCLPCInterface(s.T()), model.UseCaseNameTypeLimitationOfPowerConsumption},
+		{mocks.NewUCLPPInterface(s.T()), model.UseCaseNameTypeLimitationOfPowerProduction},
+		{mocks.NewUCMGCPInterface(s.T()), model.UseCaseNameTypeMonitoringOfGridConnectionPoint},
+		{mocks.NewUCMCPInterface(s.T()), model.UseCaseNameTypeMonitoringOfPowerConsumption},
+		{mocks.NewUCOPEVInterface(s.T()), model.UseCaseNameTypeOverloadProtectionByEVChargingCurrentCurtailment},
+		{mocks.NewUCOSCEVInterface(s.T()), model.UseCaseNameTypeOptimizationOfSelfConsumptionDuringEVCharging},
+		{mocks.NewUCVABDInterface(s.T()), model.UseCaseNameTypeVisualizationOfAggregatedBatteryData},
+		{mocks.NewUCVAPDInterface(s.T()), model.UseCaseNameTypeVisualizationOfAggregatedPhotovoltaicData},
+	} {
+		item.usecase.On("UseCaseName").Return(item.name)
+
+		usecase, ok := datapoints.ForUseCase(item.usecase)
+		if assert.True(s.T(), ok, item.name) {
+			usecases[usecase.Name] = usecase
+		}
+	}
+
+	keys := make(map[string]bool)
+	for _, definition := range entityDefinitions {
+		key := definition.component + "/" + definition.useCase + "_" + definition.key
+		assert.False(s.T(), keys[key], key)
+		keys[key] = true
+
+		usecase, ok := usecases[definition.useCase]
+		if !assert.True(s.T(), ok, definition.useCase) {
+			continue
+		}
+		if definition.dataPoint != "" {
+			_, ok := usecase.DataPoint(definition.dataPoint)
+			assert.True(s.T(), ok, key)
+		}
+		if definition.operation != "" {
+			_, ok := usecase.Operation(definition.operation)
+			assert.True(s.T(), ok, key)
+		}
+	}
+}
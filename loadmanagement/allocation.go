@@ -0,0 +1,128 @@
+package loadmanagement
+
+// the current differences below are ignored when sharing out the current
+const epsilon = 1e-9
+
+// the current demand of an EV
+type demand struct {
+	// the priority of the EV, higher values are served first
+	priority int
+
+	// the number of phases the EV is connected to, starting with phase a
+	phases int
+
+	// the minimum and maximum charging current of the EV in A
+	minimum float64
+	maximum float64
+
+	// the allocated current in A for each phase of the EV
+	current float64
+
+	// if the minimum current could be allocated, otherwise the EV is paused
+	active bool
+}
+
+// share out the available current for each phase to the demands
+//
+// the demands have to be sorted by priority, highest first
+//
+// first every demand gets its minimum current in the order of the demands,
+// demands whose minimum does not fit are paused. Then the remaining current is
+// shared equally between the demands of the highest priority up to their
+// maximum current, then between the demands of the next priority and so on.
+func allocate(available []float64, demands []*demand) {
+	remaining := make([]float64, len(available))
+	copy(remaining, available)
+
+	for _, item := range demands {
+		item.current = 0
+		item.active = false
+
+		if item.phases > len(remaining) || item.maximum < item.minimum {
+			continue
+		}
+
+		if !fits(remaining, item.phases, item.minimum) {
+			continue
+		}
+
+		item.current = item.minimum
+		item.active = true
+		consume(remaining, item.phases, item.minimum)
+	}
+
+	for start := 0; start < len(demands); {
+		end := start
+		for end < len(demands) && demands[end].priority == demands[start].priority {
+			end++
+		}
+
+		share(remaining, demands[start:end])
+		start = end
+	}
+}
+
+// share the remaining current equally between the active demands up to their maximum
+func share(remaining []float64, demands []*demand) {
+	var active []*demand
+	for _, item := range demands {
+		if item.active && item.current < item.maximum {
+			active = append(active, item)
+		}
+	}
+
+	for len(active) > 0 {
+		// the number of active demands using each phase
+		users := make([]int, len(remaining))
+		for _, item := range active {
+			for phase := 0; phase < item.phases; phase++ {
+				users[phase]++
+			}
+		}
+
+		// the largest increase every active demand can get
+		step := -1.0
+		for phase, count := range users {
+			if count > 0 && (step < 0 || remaining[phase]/float64(count) < step) {
+				step = remaining[phase] / float64(count)
+			}
+		}
+		for _, item := range active {
+			if item.maximum-item.current < step {
+				step = item.maximum - item.current
+			}
+		}
+
+		for _, item := range active {
+			item.current += step
+			consume(remaining, item.phases, step)
+		}
+
+		// demands at their maximum or on an exhausted phase are done
+		var next []*demand
+		for _, item := range active {
+			if item.maximum-item.current > epsilon && fits(remaining, item.phases, epsilon) {
+				next = append(next, item)
+			}
+		}
+		active = next
+	}
+}
+
+// returns if the current is available on the first phases
+func fits(remaining []float64, phases int, current float64) bool {
+	for phase := 0; phase < phases; phase++ {
+		if remaining[phase] < current {
+			return false
+		}
+	}
+
+	return true
+}
+
+// subtract the current from the first phases
+func consume(remaining []float64, phases int, current float64) {
+	for phase := 0; phase < phases; phase++ {
+		remaining[phase] -= current
+	}
+}
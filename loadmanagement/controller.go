@@ -0,0 +1,287 @@
+// Package loadmanagement shares the current of a grid connection between several EVs
+//
+// The Controller reads the currents per phase of the grid connection point via
+// MGCP and limits the charging currents of all EVs supporting OPEV, so the fuse
+// limit of the grid connection is not exceeded.
+//
+// The current available for the EVs is the fuse limit minus the current of all
+// other consumers, which is the grid current minus the current of the EVs. The
+// current of an EV is measured via EVCEM. Without a measurement the EV is assumed
+// to draw 0 A, as EVs often draw less than their limit and assuming the limit
+// would share out more current than the fuse allows. Without EVCEM the whole
+// grid current is therefore attributed to the other consumers and the limits
+// are lower than possible, so EVCEM should be provided.
+//
+// The available current is shared out by priority. First every EV gets its
+// minimum current, EVs whose minimum does not fit are paused with a limit of 0 A.
+// Then the remaining current is shared equally between the EVs of the highest
+// priority up to their maximum current, then between the EVs of the next
+// priority and so on. Each EV gets the same limit on all of its phases.
+//
+// The limits are recalculated with every ucmgcp.DataUpdateCurrentPerPhase event.
+package loadmanagement
+
+import (
+	"math"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/cem"
+	"github.com/enbility/cemd/datapoints"
+	"github.com/enbility/cemd/ucevcc"
+	"github.com/enbility/cemd/ucevcem"
+	"github.com/enbility/cemd/ucmgcp"
+	"github.com/enbility/cemd/ucopev"
+	"github.com/enbility/cemd/util"
+	"github.com/enbility/ship-go/logging"
+	spineapi "github.com/enbility/spine-go/api"
+)
+
+// the written limits are rounded down to 1/resolution A
+const resolution = 10
+
+// Configuration of the controller
+type Config struct {
+	// the current limit of the grid connection for each phase in A
+	FuseLimit float64
+
+	// the current kept in reserve on each phase in A
+	SafetyMargin float64
+}
+
+// Shares the current of a grid connection between the EVs
+type Controller struct {
+	mgcp  ucmgcp.UCMGCPInterface
+	opev  ucopev.UCOPEVInterface
+	evcem ucevcem.UCEVCEMInterface
+
+	config Config
+
+	mux sync.Mutex
+	// the entity of the grid connection point
+	grid spineapi.EntityRemoteInterface
+	// the tracked EVs by entity
+	evs map[spineapi.EntityRemoteInterface]*ev
+	// the priorities by SKI of the remote device
+	priorities map[string]int
+}
+
+// an EV tracked by the controller
+type ev struct {
+	ski    string
+	entity spineapi.EntityRemoteInterface
+
+	// the limit last written to the EV, if written is true
+	limit   float64
+	written bool
+}
+
+// create a new controller
+//
+// parameters:
+//   - mgcp: the use case providing the currents of the grid connection point
+//   - opev: the use case limiting the currents of the EVs
+//   - evcem: the use case measuring the currents of the EVs, nil if not available
+//   - config: the configuration
+//
+// the events have to be passed to EntityEventCB and DeviceEventCB
+func NewController(mgcp ucmgcp.UCMGCPInterface, opev ucopev.UCOPEVInterface, evcem ucevcem.UCEVCEMInterface, config Config) *Controller {
+	return &Controller{
+		mgcp:       mgcp,
+		opev:       opev,
+		evcem:      evcem,
+		config:     config,
+		evs:        make(map[spineapi.EntityRemoteInterface]*ev),
+		priorities: make(map[string]int),
+	}
+}
+
+// set the priority of the EVs of a remote device, e.g. an EVSE
+//
+// EVs with higher priorities are served first, the default priority is 0.
+// The priority is applied with the next calculation of the limits.
+func (c *Controller) SetPriority(ski string, priority int) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	c.priorities[ski] = priority
+}
+
+// returns the limit last written to an EV in A
+func (c *Controller) Limit(entity spineapi.EntityRemoteInterface) (float64, bool) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	item, ok := c.evs[entity]
+	if !ok || !item.written {
+		return 0, false
+	}
+
+	return item.limit, true
+}
+
+// track the EVs and recalculate the limits with the grid currents
+//
+// has the signature of api.EntityEventCallback
+func (c *Controller) EntityEventCB(ski string, device spineapi.DeviceRemoteInterface, entity spineapi.EntityRemoteInterface, event api.EventType) {
+	if entity == nil {
+		return
+	}
+
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	switch event {
+	case ucmgcp.DataUpdateCurrentPerPhase:
+		c.grid = entity
+		c.update()
+	case ucopev.DataUpdateCurrentLimits, ucopev.DataUpdateLimit:
+		if _, ok := c.evs[entity]; ok {
+			return
+		}
+
+		c.evs[entity] = &ev{ski: ski, entity: entity}
+		c.update()
+	case ucevcc.EvDisconnected:
+		if _, ok := c.evs[entity]; !ok {
+			return
+		}
+
+		delete(c.evs, entity)
+		c.update()
+	}
+}
+
+// forget the EVs and the grid connection point of disconnected devices
+//
+// has the signature of api.DeviceEventCallback
+func (c *Controller) DeviceEventCB(ski string, device spineapi.DeviceRemoteInterface, event api.EventType) {
+	if event != cem.DeviceDisconnected {
+		return
+	}
+
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	for entity, item := range c.evs {
+		if item.ski == ski {
+			delete(c.evs, entity)
+		}
+	}
+
+	if c.grid != nil && c.grid.Device() != nil && c.grid.Device().Ski() == ski {
+		c.grid = nil
+	}
+}
+
+// calculate and write the limits of the EVs
+func (c *Controller) update() {
+	if c.grid == nil {
+		return
+	}
+
+	gridCurrents, err := c.mgcp.CurrentPerPhase(c.grid)
+	if err != nil {
+		return
+	}
+
+	available := make([]float64, len(util.PhaseNameMapping))
+	for phase := range available {
+		available[phase] = c.config.FuseLimit - c.config.SafetyMargin
+		if phase < len(gridCurrents) {
+			available[phase] -= gridCurrents[phase]
+		}
+	}
+
+	evs := c.sortedEVs()
+	demands := make(map[*ev]*demand)
+	var list []*demand
+
+	for _, item := range evs {
+		minimum, maximum, _, err := c.opev.CurrentLimits(item.entity)
+		if err != nil || len(maximum) == 0 {
+			continue
+		}
+
+		// the EV gets the same limit on all phases
+		entry := &demand{
+			priority: c.priorities[item.ski],
+			phases:   min(len(maximum), len(available)),
+			minimum:  slices.Max(minimum),
+			maximum:  slices.Min(maximum),
+		}
+		demands[item] = entry
+		list = append(list, entry)
+
+		// the current of the EV is available for the EVs
+		for phase, current := range c.evCurrents(item, entry.phases) {
+			available[phase] += current
+		}
+	}
+
+	allocate(available, list)
+
+	for _, item := range evs {
+		if entry, ok := demands[item]; ok {
+			c.write(item, math.Floor(entry.current*resolution+epsilon)/resolution, entry.phases)
+		}
+	}
+}
+
+// returns the tracked EVs in the order of their priorities, highest first
+func (c *Controller) sortedEVs() []*ev {
+	var result []*ev
+	for _, item := range c.evs {
+		result = append(result, item)
+	}
+
+	slices.SortFunc(result, func(a, b *ev) int {
+		if priorityA, priorityB := c.priorities[a.ski], c.priorities[b.ski]; priorityA != priorityB {
+			return priorityB - priorityA
+		}
+		if a.ski != b.ski {
+			return strings.Compare(a.ski, b.ski)
+		}
+		return strings.Compare(datapoints.EntityAddress(a.entity), datapoints.EntityAddress(b.entity))
+	})
+
+	return result
+}
+
+// returns the measured current of an EV on each of its phases, 0 A if not measured
+func (c *Controller) evCurrents(item *ev, phases int) []float64 {
+	if c.evcem != nil {
+		if currents, err := c.evcem.CurrentPerPhase(item.entity); err == nil {
+			return currents[:min(len(currents), phases)]
+		}
+	}
+
+	// without a measurement the current of the EV is unknown
+	return make([]float64, phases)
+}
+
+// write the limit to the EV, if it changed
+func (c *Controller) write(item *ev, limit float64, phases int) {
+	if item.written && item.limit == limit {
+		return
+	}
+
+	var limits []api.LoadLimitsPhase
+	for phase := 0; phase < phases; phase++ {
+		limits = append(limits, api.LoadLimitsPhase{
+			Phase:    util.PhaseNameMapping[phase],
+			IsActive: true,
+			Value:    limit,
+		})
+	}
+
+	if _, err := c.opev.WriteLoadControlLimits(item.entity, limits); err != nil {
+		logging.Log().Error("load management", item.ski, err)
+		return
+	}
+
+	item.limit = limit
+	item.written = true
+}
@@ -0,0 +1,258 @@
+package loadmanagement
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/cem"
+	"github.com/enbility/cemd/mocks"
+	"github.com/enbility/cemd/ucevcc"
+	"github.com/enbility/cemd/ucmgcp"
+	"github.com/enbility/cemd/ucopev"
+	spinemocks "github.com/enbility/spine-go/mocks"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestControllerSuite(t *testing.T) {
+	suite.Run(t, new(ControllerSuite))
+}
+
+type ControllerSuite struct {
+	suite.Suite
+
+	mgcp *mocks.UCMGCPInterface
+	opev *mocks.UCOPEVInterface
+
+	grid *spinemocks.EntityRemoteInterface
+	ev1  *spinemocks.EntityRemoteInterface
+	ev2  *spinemocks.EntityRemoteInterface
+
+	sut *Controller
+}
+
+func (s *ControllerSuite) BeforeTest(suiteName, testName string) {
+	s.mgcp = mocks.NewUCMGCPInterface(s.T())
+	s.opev = mocks.NewUCOPEVInterface(s.T())
+
+	gridDevice := spinemocks.NewDeviceRemoteInterface(s.T())
+	gridDevice.EXPECT().Ski().Return("smgw").Maybe()
+	s.grid = spinemocks.NewEntityRemoteInterface(s.T())
+	s.grid.EXPECT().Device().Return(gridDevice).Maybe()
+
+	s.ev1 = s.entity(1)
+	s.ev2 = s.entity(2)
+
+	s.sut = NewController(s.mgcp, s.opev, nil, Config{FuseLimit: 32})
+}
+
+func (s *ControllerSuite) entity(id uint) *spinemocks.EntityRemoteInterface {
+	entity := spinemocks.NewEntityRemoteInterface(s.T())
+	entity.EXPECT().Address().Return(&model.EntityAddressType{
+		Entity: []model.AddressEntityType{model.AddressEntityType(id), 1},
+	}).Maybe()
+	return entity
+}
+
+// returns the limits of an EV with the same value on the phases
+func limits(phases int, value float64) []api.LoadLimitsPhase {
+	var result []api.LoadLimitsPhase
+	for _, phase := range []model.ElectricalConnectionPhaseNameType{"a", "b", "c"}[:phases] {
+		result = append(result, api.LoadLimitsPhase{Phase: phase, IsActive: true, Value: value})
+	}
+	return result
+}
+
+func (s *ControllerSuite) Test_Controller() {
+	evcem := mocks.NewUCEVCEMInterface(s.T())
+	s.sut = NewController(s.mgcp, s.opev, evcem, Config{FuseLimit: 32})
+
+	s.opev.EXPECT().CurrentLimits(s.ev1).Return([]float64{6, 6, 6}, []float64{16, 16, 16}, []float64{0, 0, 0}, nil)
+	s.opev.EXPECT().CurrentLimits(s.ev2).Return([]float64{6}, []float64{32}, []float64{0}, nil)
+
+	// the limits are calculated once the grid currents are known
+	s.sut.EntityEventCB("evse1", nil, s.ev1, ucopev.DataUpdateCurrentLimits)
+	s.sut.EntityEventCB("evse2", nil, s.ev2, ucopev.DataUpdateCurrentLimits)
+	_, ok := s.sut.Limit(s.ev1)
+	assert.False(s.T(), ok)
+
+	// 10 A of other consumers on each phase, the EVs share phase a
+	evcem.EXPECT().CurrentPerPhase(s.ev1).Return([]float64{0, 0, 0}, nil).Once()
+	evcem.EXPECT().CurrentPerPhase(s.ev2).Return([]float64{0}, nil).Once()
+	s.mgcp.EXPECT().CurrentPerPhase(s.grid).Return([]float64{10, 10, 10}, nil).Once()
+	s.opev.EXPECT().WriteLoadControlLimits(s.ev1, limits(3, 11)).Return(util.Ptr(model.MsgCounterType(1)), nil).Once()
+	s.opev.EXPECT().WriteLoadControlLimits(s.ev2, limits(1, 11)).Return(util.Ptr(model.MsgCounterType(2)), nil).Once()
+	s.sut.EntityEventCB("smgw", nil, s.grid, ucmgcp.DataUpdateCurrentPerPhase)
+
+	limit, ok := s.sut.Limit(s.ev1)
+	assert.True(s.T(), ok)
+	assert.Equal(s.T(), 11.0, limit)
+
+	// the EVs draw their limits, nothing changes
+	evcem.EXPECT().CurrentPerPhase(s.ev1).Return([]float64{11, 11, 11}, nil).Once()
+	evcem.EXPECT().CurrentPerPhase(s.ev2).Return([]float64{11}, nil).Once()
+	s.mgcp.EXPECT().CurrentPerPhase(s.grid).Return([]float64{32, 21, 21}, nil).Once()
+	s.sut.EntityEventCB("smgw", nil, s.grid, ucmgcp.DataUpdateCurrentPerPhase)
+
+	// the EV with the higher priority is served first
+	s.sut.SetPriority("evse2", 1)
+	evcem.EXPECT().CurrentPerPhase(s.ev1).Return([]float64{11, 11, 11}, nil).Once()
+	evcem.EXPECT().CurrentPerPhase(s.ev2).Return([]float64{11}, nil).Once()
+	s.mgcp.EXPECT().CurrentPerPhase(s.grid).Return([]float64{32, 21, 21}, nil).Once()
+	s.opev.EXPECT().WriteLoadControlLimits(s.ev2, limits(1, 16)).Return(util.Ptr(model.MsgCounterType(3)), nil).Once()
+	s.opev.EXPECT().WriteLoadControlLimits(s.ev1, limits(3, 6)).Return(util.Ptr(model.MsgCounterType(4)), nil).Once()
+	s.sut.EntityEventCB("smgw", nil, s.grid, ucmgcp.DataUpdateCurrentPerPhase)
+
+	// the current of a disconnected EV is available after the next measurement
+	evcem.EXPECT().CurrentPerPhase(s.ev1).Return([]float64{6, 6, 6}, nil).Once()
+	s.mgcp.EXPECT().CurrentPerPhase(s.grid).Return([]float64{32, 16, 16}, nil).Once()
+	s.sut.EntityEventCB("evse2", nil, s.ev2, ucevcc.EvDisconnected)
+	_, ok = s.sut.Limit(s.ev2)
+	assert.False(s.T(), ok)
+
+	evcem.EXPECT().CurrentPerPhase(s.ev1).Return([]float64{6, 6, 6}, nil).Once()
+	s.mgcp.EXPECT().CurrentPerPhase(s.grid).Return([]float64{16, 16, 16}, nil).Once()
+	s.opev.EXPECT().WriteLoadControlLimits(s.ev1, limits(3, 16)).Return(util.Ptr(model.MsgCounterType(5)), nil).Once()
+	s.sut.EntityEventCB("smgw", nil, s.grid, ucmgcp.DataUpdateCurrentPerPhase)
+
+	// the EVs of a disconnected device are forgotten
+	s.sut.DeviceEventCB("evse1", nil, cem.DeviceDisconnected)
+	_, ok = s.sut.Limit(s.ev1)
+	assert.False(s.T(), ok)
+
+	// without a grid connection point nothing is calculated
+	s.sut.DeviceEventCB("smgw", nil, cem.DeviceDisconnected)
+	s.sut.EntityEventCB("evse2", nil, s.ev2, ucopev.DataUpdateCurrentLimits)
+}
+
+func (s *ControllerSuite) Test_Pause() {
+	s.opev.EXPECT().CurrentLimits(s.ev1).Return([]float64{6, 6, 6}, []float64{16, 16, 16}, []float64{0, 0, 0}, nil)
+
+	s.sut.EntityEventCB("evse1", nil, s.ev1, ucopev.DataUpdateLimit)
+
+	s.mgcp.EXPECT().CurrentPerPhase(s.grid).Return([]float64{28, 20, 20}, nil).Once()
+	s.opev.EXPECT().WriteLoadControlLimits(s.ev1, limits(3, 0)).Return(nil, nil).Once()
+	s.sut.EntityEventCB("smgw", nil, s.grid, ucmgcp.DataUpdateCurrentPerPhase)
+
+	// failed writes are repeated with the next calculation
+	s.mgcp.EXPECT().CurrentPerPhase(s.grid).Return([]float64{20, 20, 20}, nil).Twice()
+	s.opev.EXPECT().WriteLoadControlLimits(s.ev1, limits(3, 12)).Return(nil, errors.New("failed")).Once()
+	s.sut.EntityEventCB("smgw", nil, s.grid, ucmgcp.DataUpdateCurrentPerPhase)
+	s.opev.EXPECT().WriteLoadControlLimits(s.ev1, limits(3, 12)).Return(nil, nil).Once()
+	s.sut.EntityEventCB("smgw", nil, s.grid, ucmgcp.DataUpdateCurrentPerPhase)
+
+	// missing data is ignored
+	s.mgcp.EXPECT().CurrentPerPhase(s.grid).Return(nil, errors.New("not available")).Once()
+	s.sut.EntityEventCB("smgw", nil, s.grid, ucmgcp.DataUpdateCurrentPerPhase)
+}
+
+func (s *ControllerSuite) Test_Measurement() {
+	evcem := mocks.NewUCEVCEMInterface(s.T())
+	s.sut = NewController(s.mgcp, s.opev, evcem, Config{FuseLimit: 32, SafetyMargin: 2})
+
+	s.opev.EXPECT().CurrentLimits(s.ev1).Return([]float64{6, 6, 6}, []float64{32, 32, 32}, []float64{0, 0, 0}, nil)
+	s.sut.EntityEventCB("evse1", nil, s.ev1, ucopev.DataUpdateCurrentLimits)
+
+	// the EV draws 8 A, so other consumers draw 12 A
+	evcem.EXPECT().CurrentPerPhase(s.ev1).Return([]float64{8, 8, 8}, nil).Once()
+	s.mgcp.EXPECT().CurrentPerPhase(s.grid).Return([]float64{20, 20, 20}, nil).Once()
+	s.opev.EXPECT().WriteLoadControlLimits(s.ev1, limits(3, 18)).Return(nil, nil).Once()
+	s.sut.EntityEventCB("smgw", nil, s.grid, ucmgcp.DataUpdateCurrentPerPhase)
+}
+
+func (s *ControllerSuite) Test_NoMeasurement() {
+	s.opev.EXPECT().CurrentLimits(s.ev1).Return([]float64{6, 6, 6}, []float64{32, 32, 32}, []float64{0, 0, 0}, nil)
+	s.sut.EntityEventCB("evse1", nil, s.ev1, ucopev.DataUpdateCurrentLimits)
+
+	s.mgcp.EXPECT().CurrentPerPhase(s.grid).Return([]float64{10, 10, 10}, nil).Once()
+	s.opev.EXPECT().WriteLoadControlLimits(s.ev1, limits(3, 22)).Return(nil, nil).Once()
+	s.sut.EntityEventCB("smgw", nil, s.grid, ucmgcp.DataUpdateCurrentPerPhase)
+
+	// the EV draws 8 A below its limit, without a measurement the grid current
+	// is attributed to the other consumers, so the fuse limit is not exceeded
+	s.mgcp.EXPECT().CurrentPerPhase(s.grid).Return([]float64{18, 18, 18}, nil).Once()
+	s.opev.EXPECT().WriteLoadControlLimits(s.ev1, limits(3, 14)).Return(nil, nil).Once()
+	s.sut.EntityEventCB("smgw", nil, s.grid, ucmgcp.DataUpdateCurrentPerPhase)
+
+	// EVs without a current measurement are assumed to draw 0 A
+	evcem := mocks.NewUCEVCEMInterface(s.T())
+	s.sut.evcem = evcem
+	evcem.EXPECT().CurrentPerPhase(s.ev1).Return(nil, errors.New("not available")).Once()
+	s.mgcp.EXPECT().CurrentPerPhase(s.grid).Return([]float64{20, 20, 20}, nil).Once()
+	s.opev.EXPECT().WriteLoadControlLimits(s.ev1, limits(3, 12)).Return(nil, nil).Once()
+	s.sut.EntityEventCB("smgw", nil, s.grid, ucmgcp.DataUpdateCurrentPerPhase)
+}
+
+func (s *ControllerSuite) Test_Allocate() {
+	tests := []struct {
+		name      string
+		available []float64
+		demands   []demand
+		currents  []float64
+	}{
+		{
+			name:      "everything fits",
+			available: []float64{63, 63, 63},
+			demands:   []demand{{phases: 3, minimum: 6, maximum: 16}, {phases: 1, minimum: 6, maximum: 32}},
+			currents:  []float64{16, 32},
+		},
+		{
+			name:      "shared equally",
+			available: []float64{30, 30, 30},
+			demands:   []demand{{phases: 3, minimum: 6, maximum: 32}, {phases: 3, minimum: 6, maximum: 32}},
+			currents:  []float64{15, 15},
+		},
+		{
+			name:      "limited by the maximum",
+			available: []float64{30, 30, 30},
+			demands:   []demand{{phases: 3, minimum: 6, maximum: 10}, {phases: 3, minimum: 6, maximum: 32}},
+			currents:  []float64{10, 20},
+		},
+		{
+			name:      "limited by a phase",
+			available: []float64{22, 22, 22},
+			demands:   []demand{{phases: 3, minimum: 6, maximum: 16}, {phases: 1, minimum: 6, maximum: 32}},
+			currents:  []float64{11, 11},
+		},
+		{
+			name:      "shared on the scarcest phase",
+			available: []float64{12, 40, 40},
+			demands:   []demand{{phases: 1, minimum: 6, maximum: 32}, {phases: 3, minimum: 0, maximum: 6}, {phases: 2, minimum: 0, maximum: 32}},
+			currents:  []float64{8, 2, 2},
+		},
+		{
+			name:      "the priority is served first",
+			available: []float64{22, 22, 22},
+			demands:   []demand{{priority: 1, phases: 1, minimum: 6, maximum: 32}, {phases: 3, minimum: 6, maximum: 16}},
+			currents:  []float64{16, 6},
+		},
+		{
+			name:      "paused if the minimum does not fit",
+			available: []float64{10, 10, 10},
+			demands:   []demand{{phases: 3, minimum: 6, maximum: 16}, {phases: 3, minimum: 6, maximum: 16}},
+			currents:  []float64{10, 0},
+		},
+		{
+			name:      "no current available",
+			available: []float64{-5, 10, 10},
+			demands:   []demand{{phases: 3, minimum: 0, maximum: 16}},
+			currents:  []float64{0},
+		},
+	}
+
+	for _, test := range tests {
+		var demands []*demand
+		for i := range test.demands {
+			demands = append(demands, &test.demands[i])
+		}
+
+		allocate(test.available, demands)
+
+		for i, item := range demands {
+			assert.InDelta(s.T(), test.currents[i], item.current, 1e-6, test.name)
+		}
+	}
+}
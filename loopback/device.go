@@ -0,0 +1,196 @@
+package loopback
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/cem"
+	eebusapi "github.com/enbility/eebus-go/api"
+	shipapi "github.com/enbility/ship-go/api"
+	"github.com/enbility/ship-go/cert"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/spine"
+)
+
+// Device is a local SPINE device taking part in a loopback network
+//
+// Each device is backed by a generic CEM implementation, which takes care of
+// the use case handling, independent of the device type
+type Device struct {
+	Cem *cem.Cem
+
+	name string
+
+	hub shipapi.HubReaderInterface
+
+	mux      sync.Mutex
+	usecases []api.UseCaseInterface
+}
+
+// Configuration of a device in a loopback network
+type DeviceConfiguration struct {
+	Name        string                 // the name of the device, has to be unique in the network
+	DeviceType  model.DeviceTypeType   // the SPINE device type
+	EntityTypes []model.EntityTypeType // the entities added to the device
+
+	// the callback for device connections and disconnections, optional
+	EventCB api.DeviceEventCallback
+
+	// wraps the setup of the connections to remote devices,
+	// e.g. to record the exchanged datagrams, optional
+	WrapHubReader func(shipapi.HubReaderInterface) shipapi.HubReaderInterface
+}
+
+// create a new device and add it to the network
+//
+// the device is not connected to any other device, use Connect for that
+func (n *Network) NewDevice(config DeviceConfiguration) (*Device, error) {
+	if config.Name == "" || len(config.EntityTypes) == 0 {
+		return nil, ErrInvalidConfiguration
+	}
+
+	certificate, err := cert.CreateCertificate("loopback", "loopback", "DE", config.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	configuration, err := eebusapi.NewConfiguration(
+		"loopback", "loopback", "loopback", config.Name,
+		config.DeviceType,
+		config.EntityTypes,
+		4711, certificate, 230, time.Second*4)
+	if err != nil {
+		return nil, err
+	}
+
+	device := &Device{
+		name: config.Name,
+	}
+
+	eventCB := config.EventCB
+	if eventCB == nil {
+		eventCB = func(string, spineapi.DeviceRemoteInterface, api.EventType) {}
+	}
+
+	device.Cem = cem.NewCEM(configuration, &serviceReader{}, eventCB, nil)
+	if err := device.Cem.Setup(); err != nil {
+		_ = spine.Events.Unsubscribe(device.Cem)
+		return nil, err
+	}
+
+	hub, ok := device.Cem.Service.(shipapi.HubReaderInterface)
+	if !ok {
+		_ = spine.Events.Unsubscribe(device.Cem)
+		return nil, fmt.Errorf("service of device %s can not be connected", config.Name)
+	}
+	device.hub = hub
+	if config.WrapHubReader != nil {
+		device.hub = config.WrapHubReader(hub)
+	}
+
+	n.addDevice(device)
+
+	return device, nil
+}
+
+// create a new energy management system device with a CEM entity
+// and add it to the network
+func (n *Network) NewCEM(name string, eventCB api.DeviceEventCallback) (*Device, error) {
+	return n.NewDevice(DeviceConfiguration{
+		Name:        name,
+		DeviceType:  model.DeviceTypeTypeEnergyManagementSystem,
+		EntityTypes: []model.EntityTypeType{model.EntityTypeTypeCEM},
+		EventCB:     eventCB,
+	})
+}
+
+// return the name of the device
+func (d *Device) Name() string {
+	return d.name
+}
+
+// return the SKI of the device
+func (d *Device) SKI() string {
+	return d.Cem.Service.LocalService().SKI()
+}
+
+// return the EEBUS service of the device
+func (d *Device) Service() eebusapi.ServiceInterface {
+	return d.Cem.Service
+}
+
+// return the local SPINE device
+func (d *Device) LocalDevice() spineapi.DeviceLocalInterface {
+	return d.Cem.Service.LocalDevice()
+}
+
+// add a use case implementation to the device
+//
+// the use case has to be created with the service of this device
+func (d *Device) AddUseCase(usecase api.UseCaseInterface) {
+	d.mux.Lock()
+	d.usecases = append(d.usecases, usecase)
+	d.mux.Unlock()
+
+	d.Cem.AddUseCase(usecase)
+}
+
+// return the remote SPINE device of a connected device, as seen by this device
+func (d *Device) RemoteDevice(remote *Device) spineapi.DeviceRemoteInterface {
+	return d.LocalDevice().RemoteDeviceForSki(remote.SKI())
+}
+
+// return the first entity of a type of a connected device, as seen by this device
+//
+// returns nil if the device is not connected or the entity is not (yet) discovered
+func (d *Device) RemoteEntity(remote *Device, entityType model.EntityTypeType) spineapi.EntityRemoteInterface {
+	remoteDevice := d.RemoteDevice(remote)
+	if remoteDevice == nil {
+		return nil
+	}
+
+	for _, entity := range remoteDevice.Entities() {
+		if entity.EntityType() == entityType {
+			return entity
+		}
+	}
+
+	return nil
+}
+
+// remove the device and its use cases from the SPINE event handling
+func (d *Device) close() {
+	d.mux.Lock()
+	usecases := d.usecases
+	d.usecases = nil
+	d.mux.Unlock()
+
+	for _, usecase := range usecases {
+		if handler, ok := usecase.(spineapi.EventHandlerInterface); ok {
+			_ = spine.Events.Unsubscribe(handler)
+		}
+	}
+
+	_ = spine.Events.Unsubscribe(d.Cem)
+}
+
+// a service reader ignoring all service notifications, as there is
+// no SHIP pairing in a loopback network
+type serviceReader struct{}
+
+var _ eebusapi.ServiceReaderInterface = (*serviceReader)(nil)
+
+func (s *serviceReader) RemoteSKIConnected(service eebusapi.ServiceInterface, ski string) {}
+
+func (s *serviceReader) RemoteSKIDisconnected(service eebusapi.ServiceInterface, ski string) {}
+
+func (s *serviceReader) VisibleRemoteServicesUpdated(service eebusapi.ServiceInterface, entries []shipapi.RemoteService) {
+}
+
+func (s *serviceReader) ServiceShipIDUpdate(ski string, shipdID string) {}
+
+func (s *serviceReader) ServicePairingDetailUpdate(ski string, detail *shipapi.ConnectionStateDetail) {
+}
@@ -0,0 +1,11 @@
+package loopback
+
+import "errors"
+
+var ErrTimeout = errors.New("timeout waiting for the network to become idle")
+
+var ErrInvalidDevices = errors.New("invalid devices")
+
+var ErrAlreadyConnected = errors.New("devices are already connected")
+
+var ErrInvalidConfiguration = errors.New("name and entity types are required")
@@ -0,0 +1,140 @@
+package loopback
+
+import (
+	"slices"
+	"sync"
+
+	shipapi "github.com/enbility/ship-go/api"
+)
+
+// Link is an in memory connection between two devices
+type Link struct {
+	device1, device2 *Device
+
+	pipe1 *pipe // messages from device1 to device2
+	pipe2 *pipe // messages from device2 to device1
+}
+
+func newLink(network *Network, device1, device2 *Device) *Link {
+	return &Link{
+		device1: device1,
+		device2: device2,
+		pipe1:   newPipe(network),
+		pipe2:   newPipe(network),
+	}
+}
+
+// return the devices connected by this link
+func (l *Link) Devices() (*Device, *Device) {
+	return l.device1, l.device2
+}
+
+// returns if the link connects the two devices, in any order
+func (l *Link) connects(device1, device2 *Device) bool {
+	return (l.device1 == device1 && l.device2 == device2) ||
+		(l.device1 == device2 && l.device2 == device1)
+}
+
+func (l *Link) connect() {
+	l.device1.hub.RemoteSKIConnected(l.device2.SKI())
+	l.device2.hub.RemoteSKIConnected(l.device1.SKI())
+
+	// the readers are required before any message can be delivered,
+	// so the pipes are started once both sides are set up
+	l.pipe1.reader = l.device2.hub.SetupRemoteDevice(l.device1.SKI(), l.pipe2)
+	l.pipe2.reader = l.device1.hub.SetupRemoteDevice(l.device2.SKI(), l.pipe1)
+
+	l.pipe1.start()
+	l.pipe2.start()
+}
+
+func (l *Link) disconnect() {
+	l.pipe1.close()
+	l.pipe2.close()
+
+	l.device1.hub.RemoteSKIDisconnected(l.device2.SKI())
+	l.device2.hub.RemoteSKIDisconnected(l.device1.SKI())
+}
+
+// one direction of a link, delivering messages in order
+type pipe struct {
+	network *Network
+
+	reader shipapi.ShipConnectionDataReaderInterface
+
+	mux    sync.Mutex
+	cond   *sync.Cond
+	queue  [][]byte
+	closed bool
+	done   chan struct{}
+}
+
+var _ shipapi.ShipConnectionDataWriterInterface = (*pipe)(nil)
+
+func newPipe(network *Network) *pipe {
+	p := &pipe{
+		network: network,
+		done:    make(chan struct{}),
+	}
+	p.cond = sync.NewCond(&p.mux)
+
+	return p
+}
+
+func (p *pipe) WriteShipMessageWithPayload(message []byte) {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+
+	if p.closed {
+		return
+	}
+
+	p.queue = append(p.queue, slices.Clone(message))
+	p.network.messageWritten()
+	p.cond.Signal()
+}
+
+func (p *pipe) start() {
+	go p.run()
+}
+
+func (p *pipe) run() {
+	defer close(p.done)
+
+	for {
+		p.mux.Lock()
+		for len(p.queue) == 0 && !p.closed {
+			p.cond.Wait()
+		}
+
+		if p.closed {
+			dropped := len(p.queue)
+			p.queue = nil
+			p.mux.Unlock()
+
+			p.network.messagesDone(dropped)
+			return
+		}
+
+		message := p.queue[0]
+		p.queue = p.queue[1:]
+		p.mux.Unlock()
+
+		p.reader.HandleShipPayloadMessage(message)
+		p.network.messagesDone(1)
+	}
+}
+
+// stop delivering messages and wait for the message currently processed
+func (p *pipe) close() {
+	p.mux.Lock()
+	if p.closed {
+		p.mux.Unlock()
+		return
+	}
+	p.closed = true
+	p.cond.Signal()
+	p.mux.Unlock()
+
+	<-p.done
+}
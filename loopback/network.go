@@ -0,0 +1,144 @@
+// Package loopback connects local SPINE devices in memory
+//
+// It allows running CEM and remote actor use case implementations against
+// each other in one process, without SHIP, mDNS and TLS. Messages are
+// delivered asynchronously and in order per direction, the same way a SHIP
+// connection would.
+//
+// Note: spine-go publishes its events process wide, so use case
+// implementations receive the events of all devices in a network. Use the
+// SKI reported with an event to check which device it belongs to.
+package loopback
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// the duration without any message in flight for a network to be considered idle
+	idleSettleDuration = 50 * time.Millisecond
+
+	idlePollInterval = 5 * time.Millisecond
+)
+
+// Network connects local SPINE devices in memory
+type Network struct {
+	mux     sync.Mutex
+	devices []*Device
+	links   []*Link
+
+	// number of messages written but not yet processed
+	pending atomic.Int64
+	// time of the last message activity in unix nanoseconds
+	lastActivity atomic.Int64
+}
+
+func NewNetwork() *Network {
+	return &Network{}
+}
+
+// connect two devices of this network
+//
+// both devices are informed about the connection like a SHIP connection
+// would do it, and start the SPINE detailed discovery
+func (n *Network) Connect(device1, device2 *Device) (*Link, error) {
+	if device1 == nil || device2 == nil || device1 == device2 {
+		return nil, ErrInvalidDevices
+	}
+
+	n.mux.Lock()
+	for _, link := range n.links {
+		if link.connects(device1, device2) {
+			n.mux.Unlock()
+			return nil, ErrAlreadyConnected
+		}
+	}
+
+	link := newLink(n, device1, device2)
+	n.links = append(n.links, link)
+	n.mux.Unlock()
+
+	link.connect()
+
+	return link, nil
+}
+
+// disconnect two devices of this network
+func (n *Network) Disconnect(device1, device2 *Device) {
+	n.mux.Lock()
+	var link *Link
+	for index, item := range n.links {
+		if item.connects(device1, device2) {
+			link = item
+			n.links = append(n.links[:index], n.links[index+1:]...)
+			break
+		}
+	}
+	n.mux.Unlock()
+
+	if link != nil {
+		link.disconnect()
+	}
+}
+
+// wait until no message was in flight for a short period of time
+//
+// use case implementations process events asynchronously, so this only
+// ensures that the messages triggered so far are delivered
+//
+// possible errors:
+//   - ErrTimeout if the network did not become idle in time
+func (n *Network) WaitIdle(timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+
+	for time.Now().Before(deadline) {
+		lastActivity := time.Unix(0, n.lastActivity.Load())
+		if n.pending.Load() == 0 && time.Since(lastActivity) >= idleSettleDuration {
+			return nil
+		}
+
+		time.Sleep(idlePollInterval)
+	}
+
+	return ErrTimeout
+}
+
+// disconnect all devices and remove the use case implementations
+// from the SPINE event handling
+func (n *Network) Close() {
+	n.mux.Lock()
+	links := n.links
+	devices := n.devices
+	n.links = nil
+	n.devices = nil
+	n.mux.Unlock()
+
+	for _, link := range links {
+		link.disconnect()
+	}
+
+	for _, device := range devices {
+		device.close()
+	}
+}
+
+func (n *Network) addDevice(device *Device) {
+	n.mux.Lock()
+	defer n.mux.Unlock()
+
+	n.devices = append(n.devices, device)
+}
+
+// a message was written to a link
+func (n *Network) messageWritten() {
+	n.pending.Add(1)
+	n.lastActivity.Store(time.Now().UnixNano())
+}
+
+// a number of messages were processed or dropped
+func (n *Network) messagesDone(count int) {
+	n.pending.Add(-int64(count))
+	n.lastActivity.Store(time.Now().UnixNano())
+}
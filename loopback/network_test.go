@@ -0,0 +1,123 @@
+package loopback
+
+import (
+	"testing"
+	"time"
+
+	"github.com/enbility/cemd/cem"
+	"github.com/enbility/spine-go/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestNetworkSuite(t *testing.T) {
+	suite.Run(t, new(NetworkSuite))
+}
+
+type NetworkSuite struct {
+	suite.Suite
+
+	sut *Network
+
+	cemEvents *EventRecorder
+	cem       *Device
+	evse      *Device
+}
+
+func (s *NetworkSuite) BeforeTest(suiteName, testName string) {
+	s.sut = NewNetwork()
+
+	s.cemEvents = NewEventRecorder()
+
+	var err error
+	s.cem, err = s.sut.NewCEM("cem", s.cemEvents.DeviceEventCB)
+	assert.Nil(s.T(), err)
+
+	s.evse, err = s.sut.NewDevice(DeviceConfiguration{
+		Name:        "evse",
+		DeviceType:  model.DeviceTypeTypeChargingStation,
+		EntityTypes: []model.EntityTypeType{model.EntityTypeTypeEVSE},
+	})
+	assert.Nil(s.T(), err)
+}
+
+func (s *NetworkSuite) AfterTest(suiteName, testName string) {
+	s.sut.Close()
+}
+
+func (s *NetworkSuite) Test_NewDevice() {
+	device, err := s.sut.NewDevice(DeviceConfiguration{})
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), device)
+
+	assert.Equal(s.T(), "cem", s.cem.Name())
+	assert.NotEqual(s.T(), s.cem.SKI(), s.evse.SKI())
+	assert.NotNil(s.T(), s.evse.Service())
+	assert.NotNil(s.T(), s.evse.LocalDevice().EntityForType(model.EntityTypeTypeEVSE))
+}
+
+func (s *NetworkSuite) Test_Connect() {
+	link, err := s.sut.Connect(s.cem, s.cem)
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), link)
+
+	link, err = s.sut.Connect(s.cem, s.evse)
+	assert.Nil(s.T(), err)
+	assert.NotNil(s.T(), link)
+
+	device1, device2 := link.Devices()
+	assert.Equal(s.T(), s.cem, device1)
+	assert.Equal(s.T(), s.evse, device2)
+
+	_, err = s.sut.Connect(s.evse, s.cem)
+	assert.NotNil(s.T(), err)
+
+	err = s.sut.WaitIdle(time.Second * 5)
+	assert.Nil(s.T(), err)
+
+	_, ok := s.cemEvents.WaitFor(s.evse.SKI(), cem.DeviceConnected, time.Second)
+	assert.True(s.T(), ok)
+
+	assert.NotNil(s.T(), s.cem.RemoteDevice(s.evse))
+	assert.NotNil(s.T(), s.cem.RemoteEntity(s.evse, model.EntityTypeTypeEVSE))
+	assert.Nil(s.T(), s.cem.RemoteEntity(s.evse, model.EntityTypeTypeEV))
+	assert.NotNil(s.T(), s.evse.RemoteEntity(s.cem, model.EntityTypeTypeCEM))
+
+	s.sut.Disconnect(s.cem, s.evse)
+
+	_, ok = s.cemEvents.WaitFor(s.evse.SKI(), cem.DeviceDisconnected, time.Second)
+	assert.True(s.T(), ok)
+
+	assert.Nil(s.T(), s.cem.RemoteDevice(s.evse))
+	assert.Nil(s.T(), s.evse.RemoteEntity(s.cem, model.EntityTypeTypeCEM))
+
+	// reconnecting is possible after a disconnect
+	_, err = s.sut.Connect(s.evse, s.cem)
+	assert.Nil(s.T(), err)
+
+	err = s.sut.WaitIdle(time.Second * 5)
+	assert.Nil(s.T(), err)
+	assert.NotNil(s.T(), s.cem.RemoteEntity(s.evse, model.EntityTypeTypeEVSE))
+}
+
+func (s *NetworkSuite) Test_EventRecorder() {
+	recorder := NewEventRecorder()
+	assert.Equal(s.T(), 0, len(recorder.Events()))
+
+	_, ok := recorder.WaitFor("ski", cem.DeviceConnected, time.Millisecond*10)
+	assert.False(s.T(), ok)
+
+	go recorder.DeviceEventCB("ski", nil, cem.DeviceConnected)
+
+	event, ok := recorder.WaitFor("ski", cem.DeviceConnected, time.Second)
+	assert.True(s.T(), ok)
+	assert.Equal(s.T(), "ski", event.Ski)
+	assert.True(s.T(), recorder.Received("ski", cem.DeviceConnected))
+	assert.False(s.T(), recorder.Received("other", cem.DeviceConnected))
+
+	recorder.EntityEventCB("ski", nil, nil, cem.DeviceDisconnected)
+	assert.Equal(s.T(), 2, len(recorder.Events()))
+
+	recorder.Reset()
+	assert.Equal(s.T(), 0, len(recorder.Events()))
+}
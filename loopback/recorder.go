@@ -0,0 +1,125 @@
+package loopback
+
+import (
+	"sync"
+	"time"
+
+	"github.com/enbility/cemd/api"
+	spineapi "github.com/enbility/spine-go/api"
+)
+
+// an event reported by a use case or CEM implementation
+type RecordedEvent struct {
+	Ski    string
+	Device spineapi.DeviceRemoteInterface
+	Entity spineapi.EntityRemoteInterface // nil for device events
+	Event  api.EventType
+}
+
+// EventRecorder collects the events reported to its callbacks
+//
+// use case implementations report events asynchronously, so the recorder
+// is safe for concurrent use
+type EventRecorder struct {
+	mux     sync.Mutex
+	events  []RecordedEvent
+	changed chan struct{}
+}
+
+func NewEventRecorder() *EventRecorder {
+	return &EventRecorder{
+		changed: make(chan struct{}),
+	}
+}
+
+// callback to be used as api.DeviceEventCallback
+func (r *EventRecorder) DeviceEventCB(ski string, device spineapi.DeviceRemoteInterface, event api.EventType) {
+	r.add(RecordedEvent{
+		Ski:    ski,
+		Device: device,
+		Event:  event,
+	})
+}
+
+// callback to be used as api.EntityEventCallback
+func (r *EventRecorder) EntityEventCB(ski string, device spineapi.DeviceRemoteInterface, entity spineapi.EntityRemoteInterface, event api.EventType) {
+	r.add(RecordedEvent{
+		Ski:    ski,
+		Device: device,
+		Entity: entity,
+		Event:  event,
+	})
+}
+
+// return all events recorded so far
+func (r *EventRecorder) Events() []RecordedEvent {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	result := make([]RecordedEvent, len(r.events))
+	copy(result, r.events)
+
+	return result
+}
+
+// returns if an event was reported for a SKI
+func (r *EventRecorder) Received(ski string, event api.EventType) bool {
+	_, ok := r.find(ski, event)
+	return ok
+}
+
+// wait for an event to be reported for a SKI
+//
+// returns the first matching event, also if it was recorded before calling this method
+func (r *EventRecorder) WaitFor(ski string, event api.EventType, timeout time.Duration) (RecordedEvent, bool) {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	for {
+		r.mux.Lock()
+		changed := r.changed
+		r.mux.Unlock()
+
+		if item, ok := r.find(ski, event); ok {
+			return item, true
+		}
+
+		select {
+		case <-changed:
+		case <-timer.C:
+			return RecordedEvent{}, false
+		}
+	}
+}
+
+// remove all recorded events
+func (r *EventRecorder) Reset() {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	r.events = nil
+}
+
+func (r *EventRecorder) add(item RecordedEvent) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	r.events = append(r.events, item)
+
+	// wake up all waiting callers
+	close(r.changed)
+	r.changed = make(chan struct{})
+}
+
+func (r *EventRecorder) find(ski string, event api.EventType) (RecordedEvent, bool) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	for _, item := range r.events {
+		if item.Ski == ski && item.Event == event {
+			return item, true
+		}
+	}
+
+	return RecordedEvent{}, false
+}
@@ -0,0 +1,250 @@
+package loopback
+
+import (
+	"testing"
+	"time"
+
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/uccevc"
+	"github.com/enbility/cemd/ucevccserver"
+	eebusutil "github.com/enbility/eebus-go/util"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/spine"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestCEVCSuite(t *testing.T) {
+	suite.Run(t, new(CEVCSuite))
+}
+
+// runs the CEVC implementation against an EVSE with a connected EV
+// providing the time series and incentive table data of the use case
+type CEVCSuite struct {
+	suite.Suite
+
+	network *Network
+
+	sut       *uccevc.UCCEVC
+	sutEvents *EventRecorder
+	cem       *Device
+
+	evcc *ucevccserver.UCEVCCServer
+	evse *Device
+}
+
+func (s *CEVCSuite) BeforeTest(suiteName, testName string) {
+	s.network = NewNetwork()
+
+	var err error
+	s.cem, err = s.network.NewCEM("cem", nil)
+	assert.Nil(s.T(), err)
+
+	s.sutEvents = NewEventRecorder()
+	s.sut = uccevc.NewUCCEVC(s.cem.Service(), s.sutEvents.EntityEventCB)
+	s.cem.AddUseCase(s.sut)
+
+	s.evse, err = s.network.NewDevice(DeviceConfiguration{
+		Name:        "evse",
+		DeviceType:  model.DeviceTypeTypeChargingStation,
+		EntityTypes: []model.EntityTypeType{model.EntityTypeTypeEVSE},
+	})
+	assert.Nil(s.T(), err)
+
+	s.evcc = ucevccserver.NewUCEVCC(s.evse.Service(), nil)
+	s.evcc.AddEVUseCase(&cevcEV{})
+	s.evse.AddUseCase(s.evcc)
+
+	_, err = s.network.Connect(s.cem, s.evse)
+	assert.Nil(s.T(), err)
+
+	err = s.evcc.SetEVConnected(true)
+	assert.Nil(s.T(), err)
+}
+
+func (s *CEVCSuite) AfterTest(suiteName, testName string) {
+	s.network.Close()
+}
+
+func (s *CEVCSuite) Test_Discovery() {
+	assert.Eventually(s.T(), func() bool {
+		entity := s.cem.RemoteEntity(s.evse, model.EntityTypeTypeEV)
+		supported, err := s.sut.IsUseCaseSupported(entity)
+		return err == nil && supported
+	}, time.Second*5, time.Millisecond*10)
+}
+
+func (s *CEVCSuite) Test_EnergyDemand() {
+	_, ok := s.sutEvents.WaitFor(s.evse.SKI(), uccevc.DataUpdateEnergyDemand, time.Second*5)
+	assert.True(s.T(), ok)
+
+	entity := s.cem.RemoteEntity(s.evse, model.EntityTypeTypeEV)
+
+	demand, err := s.sut.EnergyDemand(entity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 1000.0, demand.MinDemand)
+	assert.Equal(s.T(), 5000.0, demand.OptDemand)
+	assert.Equal(s.T(), 10000.0, demand.MaxDemand)
+	assert.Equal(s.T(), time.Hour.Seconds()*8, demand.DurationUntilEnd)
+
+	strategy := s.sut.ChargeStrategy(entity)
+	assert.Equal(s.T(), api.EVChargeStrategyTypeMinSoC, strategy)
+
+	constraints, err := s.sut.TimeSlotConstraints(entity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), uint(1), constraints.MinSlots)
+	assert.Equal(s.T(), uint(30), constraints.MaxSlots)
+	assert.Equal(s.T(), time.Minute*2, constraints.MinSlotDuration)
+}
+
+func (s *CEVCSuite) Test_WritePowerLimits() {
+	_, ok := s.sutEvents.WaitFor(s.evse.SKI(), uccevc.DataUpdateEnergyDemand, time.Second*5)
+	assert.True(s.T(), ok)
+
+	entity := s.cem.RemoteEntity(s.evse, model.EntityTypeTypeEV)
+
+	// too many slots
+	data := make([]api.DurationSlotValue, 31)
+	err := s.sut.WritePowerLimits(entity, data)
+	assert.NotNil(s.T(), err)
+
+	data = []api.DurationSlotValue{
+		{Duration: time.Hour, Value: 11000},
+		{Duration: time.Hour * 2, Value: 4200},
+	}
+	err = s.sut.WritePowerLimits(entity, data)
+	assert.Nil(s.T(), err)
+
+	err = s.network.WaitIdle(time.Second * 5)
+	assert.Nil(s.T(), err)
+
+	evEntity := s.evse.LocalDevice().EntityForType(model.EntityTypeTypeEV)
+	feature := evEntity.FeatureOfTypeAndRole(model.FeatureTypeTypeTimeSeries, model.RoleTypeServer)
+	values, err := spine.LocalFeatureDataCopyOfType[*model.TimeSeriesListDataType](
+		feature, model.FunctionTypeTimeSeriesListData)
+	assert.Nil(s.T(), err)
+
+	var limits *model.TimeSeriesDataType
+	for _, item := range values.TimeSeriesData {
+		if item.TimeSeriesId != nil && *item.TimeSeriesId == cevcConstraintsId {
+			limits = &item
+		}
+	}
+	if assert.NotNil(s.T(), limits) {
+		assert.Equal(s.T(), 2, len(limits.TimeSeriesSlot))
+		assert.Equal(s.T(), 11000.0, limits.TimeSeriesSlot[0].MaxValue.GetValue())
+		assert.Equal(s.T(), 4200.0, limits.TimeSeriesSlot[1].MaxValue.GetValue())
+	}
+}
+
+const (
+	cevcSingleDemandId model.TimeSeriesIdType = 1
+	cevcConstraintsId  model.TimeSeriesIdType = 2
+	cevcPlanId         model.TimeSeriesIdType = 3
+)
+
+// a minimal EV side of the CEVC use case, providing static data
+type cevcEV struct{}
+
+var _ api.EVUseCaseInterface = (*cevcEV)(nil)
+
+func (c *cevcEV) UseCaseName() model.UseCaseNameType {
+	return model.UseCaseNameTypeCoordinatedEVCharging
+}
+
+func (c *cevcEV) AddFeatures() {}
+
+func (c *cevcEV) AddUseCase() {}
+
+func (c *cevcEV) UpdateUseCaseAvailability(available bool) {}
+
+func (c *cevcEV) IsUseCaseSupported(entity spineapi.EntityRemoteInterface) (bool, error) {
+	return false, nil
+}
+
+func (c *cevcEV) AddEVFeatures(entity spineapi.EntityLocalInterface) {
+	f := entity.GetOrAddFeature(model.FeatureTypeTypeTimeSeries, model.RoleTypeServer)
+	f.AddFunctionType(model.FunctionTypeTimeSeriesDescriptionListData, true, false)
+	f.AddFunctionType(model.FunctionTypeTimeSeriesConstraintsListData, true, false)
+	f.AddFunctionType(model.FunctionTypeTimeSeriesListData, true, true)
+
+	f.SetData(model.FunctionTypeTimeSeriesDescriptionListData, &model.TimeSeriesDescriptionListDataType{
+		TimeSeriesDescriptionData: []model.TimeSeriesDescriptionDataType{
+			{
+				TimeSeriesId:        eebusutil.Ptr(cevcSingleDemandId),
+				TimeSeriesType:      eebusutil.Ptr(model.TimeSeriesTypeTypeSingleDemand),
+				TimeSeriesWriteable: eebusutil.Ptr(false),
+				Unit:                eebusutil.Ptr(model.UnitOfMeasurementTypeWh),
+			},
+			{
+				TimeSeriesId:        eebusutil.Ptr(cevcConstraintsId),
+				TimeSeriesType:      eebusutil.Ptr(model.TimeSeriesTypeTypeConstraints),
+				TimeSeriesWriteable: eebusutil.Ptr(true),
+				UpdateRequired:      eebusutil.Ptr(true),
+				Unit:                eebusutil.Ptr(model.UnitOfMeasurementTypeW),
+			},
+			{
+				TimeSeriesId:        eebusutil.Ptr(cevcPlanId),
+				TimeSeriesType:      eebusutil.Ptr(model.TimeSeriesTypeTypePlan),
+				TimeSeriesWriteable: eebusutil.Ptr(false),
+				Unit:                eebusutil.Ptr(model.UnitOfMeasurementTypeW),
+			},
+		},
+	})
+	f.SetData(model.FunctionTypeTimeSeriesConstraintsListData, &model.TimeSeriesConstraintsListDataType{
+		TimeSeriesConstraintsData: []model.TimeSeriesConstraintsDataType{
+			{
+				TimeSeriesId:    eebusutil.Ptr(cevcConstraintsId),
+				SlotCountMin:    eebusutil.Ptr(model.TimeSeriesSlotCountType(1)),
+				SlotCountMax:    eebusutil.Ptr(model.TimeSeriesSlotCountType(30)),
+				SlotDurationMin: model.NewDurationType(time.Minute * 2),
+			},
+		},
+	})
+	f.SetData(model.FunctionTypeTimeSeriesListData, &model.TimeSeriesListDataType{
+		TimeSeriesData: []model.TimeSeriesDataType{
+			{
+				TimeSeriesId: eebusutil.Ptr(cevcSingleDemandId),
+				TimePeriod: &model.TimePeriodType{
+					StartTime: model.NewAbsoluteOrRelativeTimeType("PT0S"),
+				},
+				TimeSeriesSlot: []model.TimeSeriesSlotType{
+					{
+						TimeSeriesSlotId: eebusutil.Ptr(model.TimeSeriesSlotIdType(0)),
+						Duration:         model.NewDurationType(time.Hour * 8),
+						MinValue:         model.NewScaledNumberType(1000),
+						Value:            model.NewScaledNumberType(5000),
+						MaxValue:         model.NewScaledNumberType(10000),
+					},
+				},
+			},
+		},
+	})
+
+	f = entity.GetOrAddFeature(model.FeatureTypeTypeIncentiveTable, model.RoleTypeServer)
+	f.AddFunctionType(model.FunctionTypeIncentiveTableDescriptionData, true, true)
+	f.AddFunctionType(model.FunctionTypeIncentiveTableConstraintsData, true, false)
+	f.AddFunctionType(model.FunctionTypeIncentiveTableData, true, true)
+
+	f.SetData(model.FunctionTypeIncentiveTableDescriptionData, &model.IncentiveTableDescriptionDataType{
+		IncentiveTableDescription: []model.IncentiveTableDescriptionType{
+			{
+				TariffDescription: &model.TariffDescriptionDataType{
+					TariffId:        eebusutil.Ptr(model.TariffIdType(0)),
+					TariffWriteable: eebusutil.Ptr(true),
+					UpdateRequired:  eebusutil.Ptr(false),
+					ScopeType:       eebusutil.Ptr(model.ScopeTypeTypeSimpleIncentiveTable),
+				},
+			},
+		},
+	})
+
+	entity.AddUseCaseSupport(
+		model.UseCaseActorTypeEV,
+		c.UseCaseName(),
+		model.SpecificationVersionType("1.0.1"),
+		"release",
+		true,
+		[]model.UseCaseScenarioSupportType{1, 2, 3, 4, 5, 6, 7, 8})
+}
@@ -0,0 +1,164 @@
+package loopback
+
+import (
+	"testing"
+	"time"
+
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/uclpc"
+	"github.com/enbility/cemd/uclpcserver"
+	"github.com/enbility/spine-go/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestLPCLoopbackSuite(t *testing.T) {
+	suite.Run(t, new(LPCLoopbackSuite))
+}
+
+// runs the LPC Energy Guard against the Controllable System implementation
+type LPCLoopbackSuite struct {
+	suite.Suite
+
+	network *Network
+
+	sut       *uclpc.UCLPC
+	sutEvents *EventRecorder
+	guard     *Device
+
+	server       *uclpcserver.UCLPCServer
+	serverEvents *EventRecorder
+	system       *Device
+}
+
+func (s *LPCLoopbackSuite) BeforeTest(suiteName, testName string) {
+	s.network = NewNetwork()
+
+	var err error
+	s.guard, err = s.network.NewCEM("energyguard", nil)
+	assert.Nil(s.T(), err)
+
+	s.sutEvents = NewEventRecorder()
+	s.sut = uclpc.NewUCLPC(s.guard.Service(), s.sutEvents.EntityEventCB)
+	s.guard.AddUseCase(s.sut)
+
+	s.system, err = s.network.NewCEM("controllablesystem", nil)
+	assert.Nil(s.T(), err)
+
+	s.serverEvents = NewEventRecorder()
+	s.server = uclpcserver.NewUCLPC(s.system.Service(), s.serverEvents.EntityEventCB)
+	s.system.AddUseCase(s.server)
+
+	err = s.server.SetConsumptionLimit(api.LoadLimit{
+		IsChangeable: true,
+		IsActive:     false,
+		Value:        0,
+	})
+	assert.Nil(s.T(), err)
+	err = s.server.SetFailsafeConsumptionActivePowerLimit(4300, true)
+	assert.Nil(s.T(), err)
+	err = s.server.SetFailsafeDurationMinimum(time.Hour*2, true)
+	assert.Nil(s.T(), err)
+
+	_, err = s.network.Connect(s.guard, s.system)
+	assert.Nil(s.T(), err)
+}
+
+func (s *LPCLoopbackSuite) AfterTest(suiteName, testName string) {
+	s.network.Close()
+}
+
+func (s *LPCLoopbackSuite) Test_Discovery() {
+	assert.Eventually(s.T(), func() bool {
+		entity := s.guard.RemoteEntity(s.system, model.EntityTypeTypeCEM)
+		supported, err := s.sut.IsUseCaseSupported(entity)
+		return err == nil && supported
+	}, time.Second*5, time.Millisecond*10)
+
+	_, ok := s.sutEvents.WaitFor(s.system.SKI(), uclpc.DataUpdateFailsafeConsumptionActivePowerLimit, time.Second*5)
+	assert.True(s.T(), ok)
+
+	entity := s.guard.RemoteEntity(s.system, model.EntityTypeTypeCEM)
+
+	assert.Eventually(s.T(), func() bool {
+		value, err := s.sut.FailsafeConsumptionActivePowerLimit(entity)
+		return err == nil && value == 4300
+	}, time.Second*5, time.Millisecond*10)
+
+	assert.Eventually(s.T(), func() bool {
+		value, err := s.sut.FailsafeDurationMinimum(entity)
+		return err == nil && value == time.Hour*2
+	}, time.Second*5, time.Millisecond*10)
+}
+
+func (s *LPCLoopbackSuite) Test_WriteConsumptionLimit() {
+	entity := s.guard.RemoteEntity(s.system, model.EntityTypeTypeCEM)
+	assert.Eventually(s.T(), func() bool {
+		entity = s.guard.RemoteEntity(s.system, model.EntityTypeTypeCEM)
+		_, err := s.sut.ConsumptionLimit(entity)
+		return err == nil
+	}, time.Second*5, time.Millisecond*10)
+
+	_, err := s.sut.WriteConsumptionLimit(entity, api.LoadLimit{
+		IsActive: true,
+		Value:    4200,
+	})
+	assert.Nil(s.T(), err)
+
+	// the Controllable System has to approve the limit
+	_, ok := s.serverEvents.WaitFor(s.guard.SKI(), uclpcserver.WriteApprovalRequired, time.Second*5)
+	assert.True(s.T(), ok)
+
+	pending := s.server.PendingConsumptionLimits()
+	assert.Equal(s.T(), 1, len(pending))
+	for msgCounter, limit := range pending {
+		assert.Equal(s.T(), 4200.0, limit.Value)
+		s.server.ApproveOrDenyConsumptionLimit(msgCounter, true, "")
+	}
+
+	_, ok = s.sutEvents.WaitFor(s.system.SKI(), uclpc.DataUpdateLimit, time.Second*5)
+	assert.True(s.T(), ok)
+
+	assert.Eventually(s.T(), func() bool {
+		limit, err := s.sut.ConsumptionLimit(entity)
+		return err == nil && limit.IsActive && limit.Value == 4200
+	}, time.Second*5, time.Millisecond*10)
+
+	limit, err := s.server.ConsumptionLimit()
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), true, limit.IsActive)
+	assert.Equal(s.T(), 4200.0, limit.Value)
+}
+
+func (s *LPCLoopbackSuite) Test_DenyConsumptionLimit() {
+	entity := s.guard.RemoteEntity(s.system, model.EntityTypeTypeCEM)
+	assert.Eventually(s.T(), func() bool {
+		entity = s.guard.RemoteEntity(s.system, model.EntityTypeTypeCEM)
+		_, err := s.sut.ConsumptionLimit(entity)
+		return err == nil
+	}, time.Second*5, time.Millisecond*10)
+
+	_, err := s.sut.WriteConsumptionLimit(entity, api.LoadLimit{
+		IsActive: true,
+		Value:    4200,
+	})
+	assert.Nil(s.T(), err)
+
+	_, ok := s.serverEvents.WaitFor(s.guard.SKI(), uclpcserver.WriteApprovalRequired, time.Second*5)
+	assert.True(s.T(), ok)
+
+	for msgCounter := range s.server.PendingConsumptionLimits() {
+		s.server.ApproveOrDenyConsumptionLimit(msgCounter, false, "denied")
+	}
+
+	err = s.network.WaitIdle(time.Second * 5)
+	assert.Nil(s.T(), err)
+
+	limit, err := s.sut.ConsumptionLimit(entity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), false, limit.IsActive)
+
+	limit, err = s.server.ConsumptionLimit()
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), false, limit.IsActive)
+}
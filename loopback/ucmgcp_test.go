@@ -0,0 +1,138 @@
+package loopback
+
+import (
+	"testing"
+	"time"
+
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/ucmgcp"
+	"github.com/enbility/cemd/ucmgcpserver"
+	"github.com/enbility/spine-go/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestMGCPSuite(t *testing.T) {
+	suite.Run(t, new(MGCPSuite))
+}
+
+// runs the MGCP Monitoring Appliance against the Grid Connection Point implementation
+type MGCPSuite struct {
+	suite.Suite
+
+	network *Network
+
+	sut       *ucmgcp.UCMGCP
+	sutEvents *EventRecorder
+	cem       *Device
+
+	server *ucmgcpserver.UCMGCPServer
+	smgw   *Device
+}
+
+func (s *MGCPSuite) BeforeTest(suiteName, testName string) {
+	s.network = NewNetwork()
+
+	var err error
+	s.cem, err = s.network.NewCEM("cem", nil)
+	assert.Nil(s.T(), err)
+
+	s.sutEvents = NewEventRecorder()
+	s.sut = ucmgcp.NewUCMGCP(s.cem.Service(), s.sutEvents.EntityEventCB)
+	s.cem.AddUseCase(s.sut)
+
+	s.smgw, err = s.network.NewCEM("smgw", nil)
+	assert.Nil(s.T(), err)
+
+	s.server = ucmgcpserver.NewUCMGCP(s.smgw.Service(), nil)
+	s.smgw.AddUseCase(s.server)
+
+	_, err = s.network.Connect(s.cem, s.smgw)
+	assert.Nil(s.T(), err)
+}
+
+func (s *MGCPSuite) AfterTest(suiteName, testName string) {
+	s.network.Close()
+}
+
+func (s *MGCPSuite) Test_Discovery() {
+	assert.Eventually(s.T(), func() bool {
+		entity := s.cem.RemoteEntity(s.smgw, model.EntityTypeTypeCEM)
+		supported, err := s.sut.IsUseCaseSupported(entity)
+		return err == nil && supported
+	}, time.Second*5, time.Millisecond*10)
+}
+
+func (s *MGCPSuite) Test_Measurements() {
+	err := s.network.WaitIdle(time.Second * 5)
+	assert.Nil(s.T(), err)
+
+	err = s.server.SetPower(-1000)
+	assert.Nil(s.T(), err)
+	err = s.server.SetEnergyFeedIn(200)
+	assert.Nil(s.T(), err)
+	err = s.server.SetEnergyConsumed(300)
+	assert.Nil(s.T(), err)
+	err = s.server.SetCurrentPerPhase([]float64{-1, -2, -3})
+	assert.Nil(s.T(), err)
+	err = s.server.SetVoltagePerPhase([]float64{230, 231, 232})
+	assert.Nil(s.T(), err)
+	err = s.server.SetFrequency(50)
+	assert.Nil(s.T(), err)
+
+	_, ok := s.sutEvents.WaitFor(s.smgw.SKI(), ucmgcp.DataUpdateFrequency, time.Second*5)
+	assert.True(s.T(), ok)
+
+	entity := s.cem.RemoteEntity(s.smgw, model.EntityTypeTypeCEM)
+
+	power, err := s.sut.Power(entity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), -1000.0, power)
+
+	energy, err := s.sut.EnergyFeedIn(entity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 200.0, energy)
+
+	energy, err = s.sut.EnergyConsumed(entity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 300.0, energy)
+
+	currents, err := s.sut.CurrentPerPhase(entity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []float64{-1, -2, -3}, currents)
+
+	voltages, err := s.sut.VoltagePerPhase(entity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []float64{230, 231, 232}, voltages)
+
+	frequency, err := s.sut.Frequency(entity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 50.0, frequency)
+
+	for _, event := range []api.EventType{
+		ucmgcp.DataUpdatePower,
+		ucmgcp.DataUpdateEnergyFeedIn,
+		ucmgcp.DataUpdateEnergyConsumed,
+		ucmgcp.DataUpdateCurrentPerPhase,
+		ucmgcp.DataUpdateVoltagePerPhase,
+	} {
+		assert.True(s.T(), s.sutEvents.Received(s.smgw.SKI(), event), event)
+	}
+}
+
+func (s *MGCPSuite) Test_PowerLimitationFactor() {
+	err := s.network.WaitIdle(time.Second * 5)
+	assert.Nil(s.T(), err)
+
+	err = s.server.SetPowerLimitationFactor(70)
+	assert.Nil(s.T(), err)
+
+	_, ok := s.sutEvents.WaitFor(s.smgw.SKI(), ucmgcp.DataUpdatePowerLimitationFactor, time.Second*5)
+	assert.True(s.T(), ok)
+
+	entity := s.cem.RemoteEntity(s.smgw, model.EntityTypeTypeCEM)
+
+	factor, err := s.sut.PowerLimitationFactor(entity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 70.0, factor)
+}
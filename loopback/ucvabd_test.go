@@ -0,0 +1,117 @@
+package loopback
+
+import (
+	"testing"
+	"time"
+
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/ucvabd"
+	"github.com/enbility/cemd/ucvabdserver"
+	"github.com/enbility/spine-go/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestVABDSuite(t *testing.T) {
+	suite.Run(t, new(VABDSuite))
+}
+
+// runs the VABD Visualization Appliance against the Battery System implementation
+type VABDSuite struct {
+	suite.Suite
+
+	network *Network
+
+	sut       *ucvabd.UCVABD
+	sutEvents *EventRecorder
+	cem       *Device
+
+	server       *ucvabdserver.UCVABDServer
+	serverEvents *EventRecorder
+	battery      *Device
+}
+
+func (s *VABDSuite) BeforeTest(suiteName, testName string) {
+	s.network = NewNetwork()
+
+	var err error
+	s.cem, err = s.network.NewCEM("cem", nil)
+	assert.Nil(s.T(), err)
+
+	s.sutEvents = NewEventRecorder()
+	s.sut = ucvabd.NewUCVABD(s.cem.Service(), s.sutEvents.EntityEventCB)
+	s.cem.AddUseCase(s.sut)
+
+	s.battery, err = s.network.NewDevice(DeviceConfiguration{
+		Name:        "battery",
+		DeviceType:  model.DeviceTypeTypeEnergyManagementSystem,
+		EntityTypes: []model.EntityTypeType{model.EntityTypeTypeCEM, model.EntityTypeTypeElectricityStorageSystem},
+	})
+	assert.Nil(s.T(), err)
+
+	s.serverEvents = NewEventRecorder()
+	s.server = ucvabdserver.NewUCVABD(s.battery.Service(), s.serverEvents.EntityEventCB)
+	s.battery.AddUseCase(s.server)
+
+	_, err = s.network.Connect(s.cem, s.battery)
+	assert.Nil(s.T(), err)
+}
+
+func (s *VABDSuite) AfterTest(suiteName, testName string) {
+	s.network.Close()
+}
+
+func (s *VABDSuite) Test_Discovery() {
+	assert.Eventually(s.T(), func() bool {
+		entity := s.cem.RemoteEntity(s.battery, model.EntityTypeTypeElectricityStorageSystem)
+		supported, err := s.sut.IsUseCaseSupported(entity)
+		return err == nil && supported
+	}, time.Second*5, time.Millisecond*10)
+
+	// the Visualization Appliance subscribes to the measurements of the Battery System
+	_, ok := s.serverEvents.WaitFor(s.cem.SKI(), ucvabdserver.VisualizationApplianceSubscribed, time.Second*5)
+	assert.True(s.T(), ok)
+}
+
+func (s *VABDSuite) Test_Measurements() {
+	err := s.network.WaitIdle(time.Second * 5)
+	assert.Nil(s.T(), err)
+
+	err = s.server.SetPower(-1500)
+	assert.Nil(s.T(), err)
+	err = s.server.SetEnergyCharged(400)
+	assert.Nil(s.T(), err)
+	err = s.server.SetEnergyDischarged(300)
+	assert.Nil(s.T(), err)
+	err = s.server.SetStateOfCharge(80)
+	assert.Nil(s.T(), err)
+
+	_, ok := s.sutEvents.WaitFor(s.battery.SKI(), ucvabd.DataUpdateStateOfCharge, time.Second*5)
+	assert.True(s.T(), ok)
+
+	entity := s.cem.RemoteEntity(s.battery, model.EntityTypeTypeElectricityStorageSystem)
+
+	power, err := s.sut.Power(entity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), -1500.0, power)
+
+	energy, err := s.sut.EnergyCharged(entity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 400.0, energy)
+
+	energy, err = s.sut.EnergyDischarged(entity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 300.0, energy)
+
+	soc, err := s.sut.StateOfCharge(entity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 80.0, soc)
+
+	for _, event := range []api.EventType{
+		ucvabd.DataUpdatePower,
+		ucvabd.DataUpdateEnergyCharged,
+		ucvabd.DataUpdateEnergyDischarged,
+	} {
+		assert.True(s.T(), s.sutEvents.Received(s.battery.SKI(), event), event)
+	}
+}
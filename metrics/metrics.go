@@ -0,0 +1,250 @@
+// Package metrics exports the values of the use cases of a CEM as Prometheus metrics
+//
+// Every numeric value of the datapoints package is exported as a gauge named
+// after the data point, e.g. cemd_power or cemd_current_per_phase, labeled
+// with the SKI, the address and type of the remote entity and the use case.
+// Values per phase are additionally labeled with the phase.
+//
+// Additionally the connected devices, the pending LPC write approvals and the
+// failed write operations per use case are exported.
+package metrics
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/cem"
+	"github.com/enbility/cemd/datapoints"
+	"github.com/enbility/cemd/uclpcserver"
+	"github.com/enbility/cemd/util"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// the prefix of all metric names
+const namespace = "cemd"
+
+// the labels of the use case values
+const (
+	labelSki        = "ski"
+	labelEntity     = "entity"
+	labelEntityType = "entity_type"
+	labelUseCase    = "usecase"
+	labelPhase      = "phase"
+)
+
+var (
+	connectedDevicesDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "", "connected_devices"),
+		"Number of connected remote devices",
+		nil, nil)
+
+	pendingApprovalsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "lpc", "pending_approvals"),
+		"Number of incoming LPC consumption limits waiting for an approval",
+		nil, nil)
+
+	writeFailuresDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "", "write_failures_total"),
+		"Number of failed write operations",
+		[]string{labelUseCase}, nil)
+)
+
+// a value of a data point, with an empty phase for values not specific to a phase
+type sample struct {
+	phase string
+	value float64
+}
+
+// Prometheus collector of the use case values of a CEM
+type Exporter struct {
+	cem *cem.Cem
+
+	registry *prometheus.Registry
+
+	mux           sync.Mutex
+	writeFailures map[string]float64
+}
+
+var _ prometheus.Collector = (*Exporter)(nil)
+
+// create a new exporter for the use cases added to the CEM
+//
+// use cases added to the CEM later on are exported as well
+func NewExporter(c *cem.Cem) *Exporter {
+	e := &Exporter{
+		cem:           c,
+		registry:      prometheus.NewRegistry(),
+		writeFailures: make(map[string]float64),
+	}
+
+	e.registry.MustRegister(e)
+
+	return e
+}
+
+// returns the HTTP handler serving the metrics in the Prometheus text format
+func (e *Exporter) Handler() http.Handler {
+	return promhttp.HandlerFor(e.registry, promhttp.HandlerOpts{})
+}
+
+// count failed write operations
+//
+// has the signature of datapoints.WriteCallback
+func (e *Exporter) WriteCB(entity spineapi.EntityRemoteInterface, usecase, operation string, msgCounter *model.MsgCounterType, err error) {
+	e.mux.Lock()
+	defer e.mux.Unlock()
+
+	// the counter is exported as soon as the use case was written once
+	if _, ok := e.writeFailures[usecase]; !ok {
+		e.writeFailures[usecase] = 0
+	}
+	if err != nil {
+		e.writeFailures[usecase]++
+	}
+}
+
+// the metrics of the use case values depend on the connected devices,
+// so the exporter is an unchecked collector
+func (e *Exporter) Describe(ch chan<- *prometheus.Desc) {}
+
+func (e *Exporter) Collect(ch chan<- prometheus.Metric) {
+	e.collectWriteFailures(ch)
+
+	localDevice := e.cem.Service.LocalDevice()
+	if localDevice == nil {
+		return
+	}
+
+	devices := localDevice.RemoteDevices()
+	ch <- prometheus.MustNewConstMetric(connectedDevicesDesc, prometheus.GaugeValue, float64(len(devices)))
+
+	pending := 0
+	for _, usecase := range e.cem.UseCases() {
+		if server, ok := usecase.(uclpcserver.UCLPCServerInterface); ok {
+			pending += len(server.PendingConsumptionLimits())
+		}
+	}
+	ch <- prometheus.MustNewConstMetric(pendingApprovalsDesc, prometheus.GaugeValue, float64(pending))
+
+	usecases := datapoints.ForUseCases(e.cem.UseCases())
+	for _, device := range devices {
+		for _, entity := range device.Entities() {
+			e.collectEntity(ch, device.Ski(), entity, usecases)
+		}
+	}
+}
+
+func (e *Exporter) collectWriteFailures(ch chan<- prometheus.Metric) {
+	e.mux.Lock()
+	defer e.mux.Unlock()
+
+	for usecase, count := range e.writeFailures {
+		ch <- prometheus.MustNewConstMetric(writeFailuresDesc, prometheus.CounterValue, count, usecase)
+	}
+}
+
+func (e *Exporter) collectEntity(ch chan<- prometheus.Metric, ski string, entity spineapi.EntityRemoteInterface, usecases []datapoints.UseCase) {
+	address := datapoints.EntityAddress(entity)
+
+	for _, usecase := range usecases {
+		if supported, err := usecase.UseCase.IsUseCaseSupported(entity); err != nil || !supported {
+			continue
+		}
+
+		for _, dataPoint := range usecase.DataPoints {
+			value, err := dataPoint.Read(entity)
+			if err != nil {
+				continue
+			}
+
+			samples, perPhase, ok := samplesOf(value)
+			if !ok {
+				continue
+			}
+
+			name := metricName(dataPoint.Name, value)
+			labels := []string{labelSki, labelEntity, labelEntityType, labelUseCase}
+			if perPhase {
+				labels = append(labels, labelPhase)
+			}
+			desc := prometheus.NewDesc(name, "Value of the data point "+dataPoint.Name+" of the use cases", labels, nil)
+
+			for _, sample := range samples {
+				values := []string{ski, address, string(entity.EntityType()), usecase.Name}
+				if perPhase {
+					values = append(values, sample.phase)
+				}
+
+				ch <- prometheus.MustNewConstMetric(desc, prometheus.GaugeValue, sample.value, values...)
+			}
+		}
+	}
+}
+
+// returns the samples of a data point value
+//
+// return values:
+//   - the samples of the value
+//   - if the samples are specific to a phase
+//   - if the value is numeric
+func samplesOf(value any) ([]sample, bool, bool) {
+	switch value := value.(type) {
+	case float64:
+		return []sample{{value: value}}, false, true
+	case uint:
+		return []sample{{value: float64(value)}}, false, true
+	case bool:
+		result := 0.0
+		if value {
+			result = 1
+		}
+		return []sample{{value: result}}, false, true
+	case time.Duration:
+		return []sample{{value: value.Seconds()}}, false, true
+	case api.LoadLimit:
+		return []sample{{value: value.Value}}, false, true
+	case []float64:
+		// the values are in the order of the phases, e.g. CurrentPerPhase
+		var result []sample
+		for i, item := range value {
+			if i < len(util.PhaseNameMapping) {
+				result = append(result, sample{phase: string(util.PhaseNameMapping[i]), value: item})
+			}
+		}
+		return result, true, true
+	case []api.LoadLimitsPhase:
+		var result []sample
+		for _, item := range value {
+			result = append(result, sample{phase: string(item.Phase), value: item.Value})
+		}
+		return result, true, true
+	}
+
+	return nil, false, false
+}
+
+// returns the metric name of a data point, e.g. "cemd_current_per_phase" for "currentPerPhase"
+func metricName(dataPoint string, value any) string {
+	var name strings.Builder
+
+	for _, r := range dataPoint {
+		if r >= 'A' && r <= 'Z' {
+			name.WriteByte('_')
+			r += 'a' - 'A'
+		}
+		name.WriteRune(r)
+	}
+
+	// following the Prometheus conventions for the base unit of durations
+	if _, ok := value.(time.Duration); ok {
+		name.WriteString("_seconds")
+	}
+
+	return prometheus.BuildFQName(namespace, "", name.String())
+}
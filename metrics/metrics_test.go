@@ -0,0 +1,170 @@
+package metrics
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/approval"
+	"github.com/enbility/cemd/datapoints"
+	"github.com/enbility/cemd/loopback"
+	"github.com/enbility/cemd/uclpc"
+	"github.com/enbility/cemd/uclpcserver"
+	"github.com/enbility/cemd/ucmgcp"
+	"github.com/enbility/cemd/ucmgcpserver"
+	"github.com/enbility/spine-go/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestMetricsSuite(t *testing.T) {
+	suite.Run(t, new(MetricsSuite))
+}
+
+type MetricsSuite struct {
+	suite.Suite
+
+	network *loopback.Network
+
+	cem        *loopback.Device
+	smgw       *loopback.Device
+	mgcpServer *ucmgcpserver.UCMGCPServer
+	lpcClient  *uclpc.UCLPC
+
+	sut *Exporter
+
+	// the address of the remote CEM entity
+	entity string
+}
+
+func (s *MetricsSuite) BeforeTest(suiteName, testName string) {
+	s.network = loopback.NewNetwork()
+
+	var err error
+	s.cem, err = s.network.NewCEM("cem", nil)
+	assert.Nil(s.T(), err)
+
+	events := loopback.NewEventRecorder()
+	s.cem.AddUseCase(ucmgcp.NewUCMGCP(s.cem.Service(), events.EntityEventCB))
+	lpcServer := uclpcserver.NewUCLPC(s.cem.Service(), events.EntityEventCB)
+	lpcServer.SetApprovalPolicy(approval.NewManualPolicy())
+	s.cem.AddUseCase(lpcServer)
+
+	s.sut = NewExporter(s.cem.Cem)
+
+	err = lpcServer.SetConsumptionLimit(api.LoadLimit{IsChangeable: true})
+	assert.Nil(s.T(), err)
+
+	s.smgw, err = s.network.NewCEM("smgw", nil)
+	assert.Nil(s.T(), err)
+
+	s.mgcpServer = ucmgcpserver.NewUCMGCP(s.smgw.Service(), events.EntityEventCB)
+	s.smgw.AddUseCase(s.mgcpServer)
+	s.lpcClient = uclpc.NewUCLPC(s.smgw.Service(), events.EntityEventCB)
+	s.smgw.AddUseCase(s.lpcClient)
+
+	_, err = s.network.Connect(s.cem, s.smgw)
+	assert.Nil(s.T(), err)
+
+	err = s.network.WaitIdle(time.Second * 5)
+	assert.Nil(s.T(), err)
+
+	s.entity = datapoints.EntityAddress(s.cem.RemoteEntity(s.smgw, model.EntityTypeTypeCEM))
+}
+
+// returns the labels of an MGCP value of the remote CEM entity in the exported order
+func (s *MetricsSuite) labels(phase string) string {
+	result := `{entity="` + s.entity + `",entity_type="CEM",`
+	if phase != "" {
+		result += `phase="` + phase + `",`
+	}
+	return result + `ski="` + s.smgw.SKI() + `",usecase="mgcp"}`
+}
+
+func (s *MetricsSuite) AfterTest(suiteName, testName string) {
+	s.network.Close()
+}
+
+// returns the metrics in the Prometheus text format
+func (s *MetricsSuite) scrape() string {
+	server := httptest.NewServer(s.sut.Handler())
+	defer server.Close()
+
+	response, err := http.Get(server.URL)
+	if !assert.Nil(s.T(), err) {
+		return ""
+	}
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(response.Body)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), http.StatusOK, response.StatusCode)
+
+	return string(body)
+}
+
+func (s *MetricsSuite) Test_Values() {
+	err := s.mgcpServer.SetPower(-1000)
+	assert.Nil(s.T(), err)
+	err = s.mgcpServer.SetCurrentPerPhase([]float64{1, 2, 3})
+	assert.Nil(s.T(), err)
+
+	assert.Eventually(s.T(), func() bool {
+		return strings.Contains(s.scrape(), "cemd_current_per_phase"+s.labels("c")+" 3")
+	}, time.Second*5, time.Millisecond*10)
+
+	metrics := s.scrape()
+	assert.Contains(s.T(), metrics, "cemd_power"+s.labels("")+" -1000")
+	assert.Contains(s.T(), metrics, "cemd_current_per_phase"+s.labels("a")+" 1")
+	assert.Contains(s.T(), metrics, "cemd_connected_devices 1")
+
+	// values not yet available are not exported
+	assert.NotContains(s.T(), metrics, "cemd_frequency")
+}
+
+func (s *MetricsSuite) Test_PendingApprovals() {
+	assert.Contains(s.T(), s.scrape(), "cemd_lpc_pending_approvals 0")
+
+	entity := s.smgw.RemoteEntity(s.cem, model.EntityTypeTypeCEM)
+	_, err := s.lpcClient.WriteConsumptionLimit(entity, api.LoadLimit{IsActive: true, Value: 4200})
+	assert.Nil(s.T(), err)
+
+	assert.Eventually(s.T(), func() bool {
+		return strings.Contains(s.scrape(), "cemd_lpc_pending_approvals 1")
+	}, time.Second*5, time.Millisecond*10)
+}
+
+func (s *MetricsSuite) Test_WriteFailures() {
+	assert.NotContains(s.T(), s.scrape(), "cemd_write_failures_total")
+
+	s.sut.WriteCB(nil, "lpc", "consumptionLimit", nil, nil)
+	s.sut.WriteCB(nil, "opev", "loadControlLimits", nil, nil)
+	s.sut.WriteCB(nil, "opev", "loadControlLimits", nil, errors.New("failed"))
+
+	metrics := s.scrape()
+	assert.Contains(s.T(), metrics, `cemd_write_failures_total{usecase="lpc"} 0`)
+	assert.Contains(s.T(), metrics, `cemd_write_failures_total{usecase="opev"} 1`)
+}
+
+func (s *MetricsSuite) Test_Samples() {
+	samples, perPhase, ok := samplesOf(true)
+	assert.True(s.T(), ok)
+	assert.False(s.T(), perPhase)
+	assert.Equal(s.T(), []sample{{value: 1}}, samples)
+
+	samples, perPhase, ok = samplesOf([]api.LoadLimitsPhase{{Phase: model.ElectricalConnectionPhaseNameTypeB, Value: 16}})
+	assert.True(s.T(), ok)
+	assert.True(s.T(), perPhase)
+	assert.Equal(s.T(), []sample{{phase: "b", value: 16}}, samples)
+
+	_, _, ok = samplesOf("text")
+	assert.False(s.T(), ok)
+
+	assert.Equal(s.T(), "cemd_failsafe_duration_minimum_seconds", metricName("failsafeDurationMinimum", time.Hour))
+	assert.Equal(s.T(), "cemd_energy_feed_in", metricName("energyFeedIn", 1.0))
+}
@@ -0,0 +1,81 @@
+// Code generated by mockery v2.42.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	api "github.com/enbility/cemd/api"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ApprovalPolicyInterface is an autogenerated mock type for the ApprovalPolicyInterface type
+type ApprovalPolicyInterface struct {
+	mock.Mock
+}
+
+type ApprovalPolicyInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ApprovalPolicyInterface) EXPECT() *ApprovalPolicyInterface_Expecter {
+	return &ApprovalPolicyInterface_Expecter{mock: &_m.Mock}
+}
+
+// Decide provides a mock function with given fields: request
+func (_m *ApprovalPolicyInterface) Decide(request api.LimitApprovalRequest) api.ApprovalDecision {
+	ret := _m.Called(request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Decide")
+	}
+
+	var r0 api.ApprovalDecision
+	if rf, ok := ret.Get(0).(func(api.LimitApprovalRequest) api.ApprovalDecision); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Get(0).(api.ApprovalDecision)
+	}
+
+	return r0
+}
+
+// ApprovalPolicyInterface_Decide_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Decide'
+type ApprovalPolicyInterface_Decide_Call struct {
+	*mock.Call
+}
+
+// Decide is a helper method to define mock.On call
+//   - request api.LimitApprovalRequest
+func (_e *ApprovalPolicyInterface_Expecter) Decide(request interface{}) *ApprovalPolicyInterface_Decide_Call {
+	return &ApprovalPolicyInterface_Decide_Call{Call: _e.mock.On("Decide", request)}
+}
+
+func (_c *ApprovalPolicyInterface_Decide_Call) Run(run func(request api.LimitApprovalRequest)) *ApprovalPolicyInterface_Decide_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(api.LimitApprovalRequest))
+	})
+	return _c
+}
+
+func (_c *ApprovalPolicyInterface_Decide_Call) Return(_a0 api.ApprovalDecision) *ApprovalPolicyInterface_Decide_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ApprovalPolicyInterface_Decide_Call) RunAndReturn(run func(api.LimitApprovalRequest) api.ApprovalDecision) *ApprovalPolicyInterface_Decide_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewApprovalPolicyInterface creates a new instance of ApprovalPolicyInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewApprovalPolicyInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ApprovalPolicyInterface {
+	mock := &ApprovalPolicyInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,81 @@
+// Code generated by mockery v2.42.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	api "github.com/enbility/cemd/api"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AuthorizationPolicyInterface is an autogenerated mock type for the AuthorizationPolicyInterface type
+type AuthorizationPolicyInterface struct {
+	mock.Mock
+}
+
+type AuthorizationPolicyInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *AuthorizationPolicyInterface) EXPECT() *AuthorizationPolicyInterface_Expecter {
+	return &AuthorizationPolicyInterface_Expecter{mock: &_m.Mock}
+}
+
+// Decide provides a mock function with given fields: request
+func (_m *AuthorizationPolicyInterface) Decide(request api.AuthorizationRequest) api.AuthorizationDecision {
+	ret := _m.Called(request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Decide")
+	}
+
+	var r0 api.AuthorizationDecision
+	if rf, ok := ret.Get(0).(func(api.AuthorizationRequest) api.AuthorizationDecision); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Get(0).(api.AuthorizationDecision)
+	}
+
+	return r0
+}
+
+// AuthorizationPolicyInterface_Decide_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Decide'
+type AuthorizationPolicyInterface_Decide_Call struct {
+	*mock.Call
+}
+
+// Decide is a helper method to define mock.On call
+//   - request api.AuthorizationRequest
+func (_e *AuthorizationPolicyInterface_Expecter) Decide(request interface{}) *AuthorizationPolicyInterface_Decide_Call {
+	return &AuthorizationPolicyInterface_Decide_Call{Call: _e.mock.On("Decide", request)}
+}
+
+func (_c *AuthorizationPolicyInterface_Decide_Call) Run(run func(request api.AuthorizationRequest)) *AuthorizationPolicyInterface_Decide_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(api.AuthorizationRequest))
+	})
+	return _c
+}
+
+func (_c *AuthorizationPolicyInterface_Decide_Call) Return(_a0 api.AuthorizationDecision) *AuthorizationPolicyInterface_Decide_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AuthorizationPolicyInterface_Decide_Call) RunAndReturn(run func(api.AuthorizationRequest) api.AuthorizationDecision) *AuthorizationPolicyInterface_Decide_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewAuthorizationPolicyInterface creates a new instance of AuthorizationPolicyInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAuthorizationPolicyInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AuthorizationPolicyInterface {
+	mock := &AuthorizationPolicyInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -162,6 +162,53 @@ func (_c *CemInterface_Start_Call) RunAndReturn(run func()) *CemInterface_Start_
 	return _c
 }
 
+// UseCases provides a mock function with given fields:
+func (_m *CemInterface) UseCases() []api.UseCaseInterface {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for UseCases")
+	}
+
+	var r0 []api.UseCaseInterface
+	if rf, ok := ret.Get(0).(func() []api.UseCaseInterface); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]api.UseCaseInterface)
+		}
+	}
+
+	return r0
+}
+
+// CemInterface_UseCases_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UseCases'
+type CemInterface_UseCases_Call struct {
+	*mock.Call
+}
+
+// UseCases is a helper method to define mock.On call
+func (_e *CemInterface_Expecter) UseCases() *CemInterface_UseCases_Call {
+	return &CemInterface_UseCases_Call{Call: _e.mock.On("UseCases")}
+}
+
+func (_c *CemInterface_UseCases_Call) Run(run func()) *CemInterface_UseCases_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *CemInterface_UseCases_Call) Return(_a0 []api.UseCaseInterface) *CemInterface_UseCases_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CemInterface_UseCases_Call) RunAndReturn(run func() []api.UseCaseInterface) *CemInterface_UseCases_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewCemInterface creates a new instance of CemInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewCemInterface(t interface {
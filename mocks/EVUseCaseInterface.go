@@ -0,0 +1,269 @@
+// Code generated by mockery v2.42.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	api "github.com/enbility/spine-go/api"
+
+	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/enbility/spine-go/model"
+)
+
+// EVUseCaseInterface is an autogenerated mock type for the EVUseCaseInterface type
+type EVUseCaseInterface struct {
+	mock.Mock
+}
+
+type EVUseCaseInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *EVUseCaseInterface) EXPECT() *EVUseCaseInterface_Expecter {
+	return &EVUseCaseInterface_Expecter{mock: &_m.Mock}
+}
+
+// AddEVFeatures provides a mock function with given fields: entity
+func (_m *EVUseCaseInterface) AddEVFeatures(entity api.EntityLocalInterface) {
+	_m.Called(entity)
+}
+
+// EVUseCaseInterface_AddEVFeatures_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddEVFeatures'
+type EVUseCaseInterface_AddEVFeatures_Call struct {
+	*mock.Call
+}
+
+// AddEVFeatures is a helper method to define mock.On call
+//   - entity api.EntityLocalInterface
+func (_e *EVUseCaseInterface_Expecter) AddEVFeatures(entity interface{}) *EVUseCaseInterface_AddEVFeatures_Call {
+	return &EVUseCaseInterface_AddEVFeatures_Call{Call: _e.mock.On("AddEVFeatures", entity)}
+}
+
+func (_c *EVUseCaseInterface_AddEVFeatures_Call) Run(run func(entity api.EntityLocalInterface)) *EVUseCaseInterface_AddEVFeatures_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(api.EntityLocalInterface))
+	})
+	return _c
+}
+
+func (_c *EVUseCaseInterface_AddEVFeatures_Call) Return() *EVUseCaseInterface_AddEVFeatures_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *EVUseCaseInterface_AddEVFeatures_Call) RunAndReturn(run func(api.EntityLocalInterface)) *EVUseCaseInterface_AddEVFeatures_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddFeatures provides a mock function with given fields:
+func (_m *EVUseCaseInterface) AddFeatures() {
+	_m.Called()
+}
+
+// EVUseCaseInterface_AddFeatures_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddFeatures'
+type EVUseCaseInterface_AddFeatures_Call struct {
+	*mock.Call
+}
+
+// AddFeatures is a helper method to define mock.On call
+func (_e *EVUseCaseInterface_Expecter) AddFeatures() *EVUseCaseInterface_AddFeatures_Call {
+	return &EVUseCaseInterface_AddFeatures_Call{Call: _e.mock.On("AddFeatures")}
+}
+
+func (_c *EVUseCaseInterface_AddFeatures_Call) Run(run func()) *EVUseCaseInterface_AddFeatures_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *EVUseCaseInterface_AddFeatures_Call) Return() *EVUseCaseInterface_AddFeatures_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *EVUseCaseInterface_AddFeatures_Call) RunAndReturn(run func()) *EVUseCaseInterface_AddFeatures_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddUseCase provides a mock function with given fields:
+func (_m *EVUseCaseInterface) AddUseCase() {
+	_m.Called()
+}
+
+// EVUseCaseInterface_AddUseCase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddUseCase'
+type EVUseCaseInterface_AddUseCase_Call struct {
+	*mock.Call
+}
+
+// AddUseCase is a helper method to define mock.On call
+func (_e *EVUseCaseInterface_Expecter) AddUseCase() *EVUseCaseInterface_AddUseCase_Call {
+	return &EVUseCaseInterface_AddUseCase_Call{Call: _e.mock.On("AddUseCase")}
+}
+
+func (_c *EVUseCaseInterface_AddUseCase_Call) Run(run func()) *EVUseCaseInterface_AddUseCase_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *EVUseCaseInterface_AddUseCase_Call) Return() *EVUseCaseInterface_AddUseCase_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *EVUseCaseInterface_AddUseCase_Call) RunAndReturn(run func()) *EVUseCaseInterface_AddUseCase_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsUseCaseSupported provides a mock function with given fields: remoteEntity
+func (_m *EVUseCaseInterface) IsUseCaseSupported(remoteEntity api.EntityRemoteInterface) (bool, error) {
+	ret := _m.Called(remoteEntity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsUseCaseSupported")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(api.EntityRemoteInterface) (bool, error)); ok {
+		return rf(remoteEntity)
+	}
+	if rf, ok := ret.Get(0).(func(api.EntityRemoteInterface) bool); ok {
+		r0 = rf(remoteEntity)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(api.EntityRemoteInterface) error); ok {
+		r1 = rf(remoteEntity)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EVUseCaseInterface_IsUseCaseSupported_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsUseCaseSupported'
+type EVUseCaseInterface_IsUseCaseSupported_Call struct {
+	*mock.Call
+}
+
+// IsUseCaseSupported is a helper method to define mock.On call
+//   - remoteEntity api.EntityRemoteInterface
+func (_e *EVUseCaseInterface_Expecter) IsUseCaseSupported(remoteEntity interface{}) *EVUseCaseInterface_IsUseCaseSupported_Call {
+	return &EVUseCaseInterface_IsUseCaseSupported_Call{Call: _e.mock.On("IsUseCaseSupported", remoteEntity)}
+}
+
+func (_c *EVUseCaseInterface_IsUseCaseSupported_Call) Run(run func(remoteEntity api.EntityRemoteInterface)) *EVUseCaseInterface_IsUseCaseSupported_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(api.EntityRemoteInterface))
+	})
+	return _c
+}
+
+func (_c *EVUseCaseInterface_IsUseCaseSupported_Call) Return(_a0 bool, _a1 error) *EVUseCaseInterface_IsUseCaseSupported_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EVUseCaseInterface_IsUseCaseSupported_Call) RunAndReturn(run func(api.EntityRemoteInterface) (bool, error)) *EVUseCaseInterface_IsUseCaseSupported_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateUseCaseAvailability provides a mock function with given fields: available
+func (_m *EVUseCaseInterface) UpdateUseCaseAvailability(available bool) {
+	_m.Called(available)
+}
+
+// EVUseCaseInterface_UpdateUseCaseAvailability_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUseCaseAvailability'
+type EVUseCaseInterface_UpdateUseCaseAvailability_Call struct {
+	*mock.Call
+}
+
+// UpdateUseCaseAvailability is a helper method to define mock.On call
+//   - available bool
+func (_e *EVUseCaseInterface_Expecter) UpdateUseCaseAvailability(available interface{}) *EVUseCaseInterface_UpdateUseCaseAvailability_Call {
+	return &EVUseCaseInterface_UpdateUseCaseAvailability_Call{Call: _e.mock.On("UpdateUseCaseAvailability", available)}
+}
+
+func (_c *EVUseCaseInterface_UpdateUseCaseAvailability_Call) Run(run func(available bool)) *EVUseCaseInterface_UpdateUseCaseAvailability_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(bool))
+	})
+	return _c
+}
+
+func (_c *EVUseCaseInterface_UpdateUseCaseAvailability_Call) Return() *EVUseCaseInterface_UpdateUseCaseAvailability_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *EVUseCaseInterface_UpdateUseCaseAvailability_Call) RunAndReturn(run func(bool)) *EVUseCaseInterface_UpdateUseCaseAvailability_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UseCaseName provides a mock function with given fields:
+func (_m *EVUseCaseInterface) UseCaseName() model.UseCaseNameType {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for UseCaseName")
+	}
+
+	var r0 model.UseCaseNameType
+	if rf, ok := ret.Get(0).(func() model.UseCaseNameType); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(model.UseCaseNameType)
+	}
+
+	return r0
+}
+
+// EVUseCaseInterface_UseCaseName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UseCaseName'
+type EVUseCaseInterface_UseCaseName_Call struct {
+	*mock.Call
+}
+
+// UseCaseName is a helper method to define mock.On call
+func (_e *EVUseCaseInterface_Expecter) UseCaseName() *EVUseCaseInterface_UseCaseName_Call {
+	return &EVUseCaseInterface_UseCaseName_Call{Call: _e.mock.On("UseCaseName")}
+}
+
+func (_c *EVUseCaseInterface_UseCaseName_Call) Run(run func()) *EVUseCaseInterface_UseCaseName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *EVUseCaseInterface_UseCaseName_Call) Return(_a0 model.UseCaseNameType) *EVUseCaseInterface_UseCaseName_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *EVUseCaseInterface_UseCaseName_Call) RunAndReturn(run func() model.UseCaseNameType) *EVUseCaseInterface_UseCaseName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewEVUseCaseInterface creates a new instance of EVUseCaseInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewEVUseCaseInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *EVUseCaseInterface {
+	mock := &EVUseCaseInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,128 @@
+// Code generated by mockery v2.42.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	api "github.com/enbility/cemd/api"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// QuirkRegistryInterface is an autogenerated mock type for the QuirkRegistryInterface type
+type QuirkRegistryInterface struct {
+	mock.Mock
+}
+
+type QuirkRegistryInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *QuirkRegistryInterface) EXPECT() *QuirkRegistryInterface_Expecter {
+	return &QuirkRegistryInterface_Expecter{mock: &_m.Mock}
+}
+
+// HasQuirk provides a mock function with given fields: data, quirk
+func (_m *QuirkRegistryInterface) HasQuirk(data api.ManufacturerData, quirk api.QuirkType) bool {
+	ret := _m.Called(data, quirk)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HasQuirk")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(api.ManufacturerData, api.QuirkType) bool); ok {
+		r0 = rf(data, quirk)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// QuirkRegistryInterface_HasQuirk_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HasQuirk'
+type QuirkRegistryInterface_HasQuirk_Call struct {
+	*mock.Call
+}
+
+// HasQuirk is a helper method to define mock.On call
+//   - data api.ManufacturerData
+//   - quirk api.QuirkType
+func (_e *QuirkRegistryInterface_Expecter) HasQuirk(data interface{}, quirk interface{}) *QuirkRegistryInterface_HasQuirk_Call {
+	return &QuirkRegistryInterface_HasQuirk_Call{Call: _e.mock.On("HasQuirk", data, quirk)}
+}
+
+func (_c *QuirkRegistryInterface_HasQuirk_Call) Run(run func(data api.ManufacturerData, quirk api.QuirkType)) *QuirkRegistryInterface_HasQuirk_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(api.ManufacturerData), args[1].(api.QuirkType))
+	})
+	return _c
+}
+
+func (_c *QuirkRegistryInterface_HasQuirk_Call) Return(_a0 bool) *QuirkRegistryInterface_HasQuirk_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *QuirkRegistryInterface_HasQuirk_Call) RunAndReturn(run func(api.ManufacturerData, api.QuirkType) bool) *QuirkRegistryInterface_HasQuirk_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HasQuirkForAnyDevice provides a mock function with given fields: quirk
+func (_m *QuirkRegistryInterface) HasQuirkForAnyDevice(quirk api.QuirkType) bool {
+	ret := _m.Called(quirk)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HasQuirkForAnyDevice")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(api.QuirkType) bool); ok {
+		r0 = rf(quirk)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// QuirkRegistryInterface_HasQuirkForAnyDevice_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HasQuirkForAnyDevice'
+type QuirkRegistryInterface_HasQuirkForAnyDevice_Call struct {
+	*mock.Call
+}
+
+// HasQuirkForAnyDevice is a helper method to define mock.On call
+//   - quirk api.QuirkType
+func (_e *QuirkRegistryInterface_Expecter) HasQuirkForAnyDevice(quirk interface{}) *QuirkRegistryInterface_HasQuirkForAnyDevice_Call {
+	return &QuirkRegistryInterface_HasQuirkForAnyDevice_Call{Call: _e.mock.On("HasQuirkForAnyDevice", quirk)}
+}
+
+func (_c *QuirkRegistryInterface_HasQuirkForAnyDevice_Call) Run(run func(quirk api.QuirkType)) *QuirkRegistryInterface_HasQuirkForAnyDevice_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(api.QuirkType))
+	})
+	return _c
+}
+
+func (_c *QuirkRegistryInterface_HasQuirkForAnyDevice_Call) Return(_a0 bool) *QuirkRegistryInterface_HasQuirkForAnyDevice_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *QuirkRegistryInterface_HasQuirkForAnyDevice_Call) RunAndReturn(run func(api.QuirkType) bool) *QuirkRegistryInterface_HasQuirkForAnyDevice_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewQuirkRegistryInterface creates a new instance of QuirkRegistryInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewQuirkRegistryInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *QuirkRegistryInterface {
+	mock := &QuirkRegistryInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
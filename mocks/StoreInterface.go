@@ -0,0 +1,126 @@
+// Code generated by mockery v2.42.1. DO NOT EDIT.
+
+package mocks
+
+import mock "github.com/stretchr/testify/mock"
+
+// StoreInterface is an autogenerated mock type for the StoreInterface type
+type StoreInterface struct {
+	mock.Mock
+}
+
+type StoreInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *StoreInterface) EXPECT() *StoreInterface_Expecter {
+	return &StoreInterface_Expecter{mock: &_m.Mock}
+}
+
+// Load provides a mock function with given fields: key, value
+func (_m *StoreInterface) Load(key string, value interface{}) error {
+	ret := _m.Called(key, value)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Load")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, interface{}) error); ok {
+		r0 = rf(key, value)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StoreInterface_Load_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Load'
+type StoreInterface_Load_Call struct {
+	*mock.Call
+}
+
+// Load is a helper method to define mock.On call
+//   - key string
+//   - value interface{}
+func (_e *StoreInterface_Expecter) Load(key interface{}, value interface{}) *StoreInterface_Load_Call {
+	return &StoreInterface_Load_Call{Call: _e.mock.On("Load", key, value)}
+}
+
+func (_c *StoreInterface_Load_Call) Run(run func(key string, value interface{})) *StoreInterface_Load_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(interface{}))
+	})
+	return _c
+}
+
+func (_c *StoreInterface_Load_Call) Return(_a0 error) *StoreInterface_Load_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StoreInterface_Load_Call) RunAndReturn(run func(string, interface{}) error) *StoreInterface_Load_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Save provides a mock function with given fields: key, value
+func (_m *StoreInterface) Save(key string, value interface{}) error {
+	ret := _m.Called(key, value)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Save")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, interface{}) error); ok {
+		r0 = rf(key, value)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StoreInterface_Save_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Save'
+type StoreInterface_Save_Call struct {
+	*mock.Call
+}
+
+// Save is a helper method to define mock.On call
+//   - key string
+//   - value interface{}
+func (_e *StoreInterface_Expecter) Save(key interface{}, value interface{}) *StoreInterface_Save_Call {
+	return &StoreInterface_Save_Call{Call: _e.mock.On("Save", key, value)}
+}
+
+func (_c *StoreInterface_Save_Call) Run(run func(key string, value interface{})) *StoreInterface_Save_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(interface{}))
+	})
+	return _c
+}
+
+func (_c *StoreInterface_Save_Call) Return(_a0 error) *StoreInterface_Save_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StoreInterface_Save_Call) RunAndReturn(run func(string, interface{}) error) *StoreInterface_Save_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewStoreInterface creates a new instance of StoreInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewStoreInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *StoreInterface {
+	mock := &StoreInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,672 @@
+// Code generated by mockery v2.42.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	cemdapi "github.com/enbility/cemd/api"
+	api "github.com/enbility/spine-go/api"
+
+	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/enbility/spine-go/model"
+)
+
+// UCEVCCServerInterface is an autogenerated mock type for the UCEVCCServerInterface type
+type UCEVCCServerInterface struct {
+	mock.Mock
+}
+
+type UCEVCCServerInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *UCEVCCServerInterface) EXPECT() *UCEVCCServerInterface_Expecter {
+	return &UCEVCCServerInterface_Expecter{mock: &_m.Mock}
+}
+
+// AddEVFeatures provides a mock function with given fields: entity
+func (_m *UCEVCCServerInterface) AddEVFeatures(entity api.EntityLocalInterface) {
+	_m.Called(entity)
+}
+
+// UCEVCCServerInterface_AddEVFeatures_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddEVFeatures'
+type UCEVCCServerInterface_AddEVFeatures_Call struct {
+	*mock.Call
+}
+
+// AddEVFeatures is a helper method to define mock.On call
+//   - entity api.EntityLocalInterface
+func (_e *UCEVCCServerInterface_Expecter) AddEVFeatures(entity interface{}) *UCEVCCServerInterface_AddEVFeatures_Call {
+	return &UCEVCCServerInterface_AddEVFeatures_Call{Call: _e.mock.On("AddEVFeatures", entity)}
+}
+
+func (_c *UCEVCCServerInterface_AddEVFeatures_Call) Run(run func(entity api.EntityLocalInterface)) *UCEVCCServerInterface_AddEVFeatures_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(api.EntityLocalInterface))
+	})
+	return _c
+}
+
+func (_c *UCEVCCServerInterface_AddEVFeatures_Call) Return() *UCEVCCServerInterface_AddEVFeatures_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *UCEVCCServerInterface_AddEVFeatures_Call) RunAndReturn(run func(api.EntityLocalInterface)) *UCEVCCServerInterface_AddEVFeatures_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddEVUseCase provides a mock function with given fields: usecase
+func (_m *UCEVCCServerInterface) AddEVUseCase(usecase cemdapi.EVUseCaseInterface) {
+	_m.Called(usecase)
+}
+
+// UCEVCCServerInterface_AddEVUseCase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddEVUseCase'
+type UCEVCCServerInterface_AddEVUseCase_Call struct {
+	*mock.Call
+}
+
+// AddEVUseCase is a helper method to define mock.On call
+//   - usecase cemdapi.EVUseCaseInterface
+func (_e *UCEVCCServerInterface_Expecter) AddEVUseCase(usecase interface{}) *UCEVCCServerInterface_AddEVUseCase_Call {
+	return &UCEVCCServerInterface_AddEVUseCase_Call{Call: _e.mock.On("AddEVUseCase", usecase)}
+}
+
+func (_c *UCEVCCServerInterface_AddEVUseCase_Call) Run(run func(usecase cemdapi.EVUseCaseInterface)) *UCEVCCServerInterface_AddEVUseCase_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(cemdapi.EVUseCaseInterface))
+	})
+	return _c
+}
+
+func (_c *UCEVCCServerInterface_AddEVUseCase_Call) Return() *UCEVCCServerInterface_AddEVUseCase_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *UCEVCCServerInterface_AddEVUseCase_Call) RunAndReturn(run func(cemdapi.EVUseCaseInterface)) *UCEVCCServerInterface_AddEVUseCase_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddFeatures provides a mock function with given fields:
+func (_m *UCEVCCServerInterface) AddFeatures() {
+	_m.Called()
+}
+
+// UCEVCCServerInterface_AddFeatures_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddFeatures'
+type UCEVCCServerInterface_AddFeatures_Call struct {
+	*mock.Call
+}
+
+// AddFeatures is a helper method to define mock.On call
+func (_e *UCEVCCServerInterface_Expecter) AddFeatures() *UCEVCCServerInterface_AddFeatures_Call {
+	return &UCEVCCServerInterface_AddFeatures_Call{Call: _e.mock.On("AddFeatures")}
+}
+
+func (_c *UCEVCCServerInterface_AddFeatures_Call) Run(run func()) *UCEVCCServerInterface_AddFeatures_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *UCEVCCServerInterface_AddFeatures_Call) Return() *UCEVCCServerInterface_AddFeatures_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *UCEVCCServerInterface_AddFeatures_Call) RunAndReturn(run func()) *UCEVCCServerInterface_AddFeatures_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddUseCase provides a mock function with given fields:
+func (_m *UCEVCCServerInterface) AddUseCase() {
+	_m.Called()
+}
+
+// UCEVCCServerInterface_AddUseCase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddUseCase'
+type UCEVCCServerInterface_AddUseCase_Call struct {
+	*mock.Call
+}
+
+// AddUseCase is a helper method to define mock.On call
+func (_e *UCEVCCServerInterface_Expecter) AddUseCase() *UCEVCCServerInterface_AddUseCase_Call {
+	return &UCEVCCServerInterface_AddUseCase_Call{Call: _e.mock.On("AddUseCase")}
+}
+
+func (_c *UCEVCCServerInterface_AddUseCase_Call) Run(run func()) *UCEVCCServerInterface_AddUseCase_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *UCEVCCServerInterface_AddUseCase_Call) Return() *UCEVCCServerInterface_AddUseCase_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *UCEVCCServerInterface_AddUseCase_Call) RunAndReturn(run func()) *UCEVCCServerInterface_AddUseCase_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EVConnected provides a mock function with given fields:
+func (_m *UCEVCCServerInterface) EVConnected() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for EVConnected")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// UCEVCCServerInterface_EVConnected_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EVConnected'
+type UCEVCCServerInterface_EVConnected_Call struct {
+	*mock.Call
+}
+
+// EVConnected is a helper method to define mock.On call
+func (_e *UCEVCCServerInterface_Expecter) EVConnected() *UCEVCCServerInterface_EVConnected_Call {
+	return &UCEVCCServerInterface_EVConnected_Call{Call: _e.mock.On("EVConnected")}
+}
+
+func (_c *UCEVCCServerInterface_EVConnected_Call) Run(run func()) *UCEVCCServerInterface_EVConnected_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *UCEVCCServerInterface_EVConnected_Call) Return(_a0 bool) *UCEVCCServerInterface_EVConnected_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UCEVCCServerInterface_EVConnected_Call) RunAndReturn(run func() bool) *UCEVCCServerInterface_EVConnected_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsUseCaseSupported provides a mock function with given fields: remoteEntity
+func (_m *UCEVCCServerInterface) IsUseCaseSupported(remoteEntity api.EntityRemoteInterface) (bool, error) {
+	ret := _m.Called(remoteEntity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsUseCaseSupported")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(api.EntityRemoteInterface) (bool, error)); ok {
+		return rf(remoteEntity)
+	}
+	if rf, ok := ret.Get(0).(func(api.EntityRemoteInterface) bool); ok {
+		r0 = rf(remoteEntity)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(api.EntityRemoteInterface) error); ok {
+		r1 = rf(remoteEntity)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UCEVCCServerInterface_IsUseCaseSupported_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsUseCaseSupported'
+type UCEVCCServerInterface_IsUseCaseSupported_Call struct {
+	*mock.Call
+}
+
+// IsUseCaseSupported is a helper method to define mock.On call
+//   - remoteEntity api.EntityRemoteInterface
+func (_e *UCEVCCServerInterface_Expecter) IsUseCaseSupported(remoteEntity interface{}) *UCEVCCServerInterface_IsUseCaseSupported_Call {
+	return &UCEVCCServerInterface_IsUseCaseSupported_Call{Call: _e.mock.On("IsUseCaseSupported", remoteEntity)}
+}
+
+func (_c *UCEVCCServerInterface_IsUseCaseSupported_Call) Run(run func(remoteEntity api.EntityRemoteInterface)) *UCEVCCServerInterface_IsUseCaseSupported_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(api.EntityRemoteInterface))
+	})
+	return _c
+}
+
+func (_c *UCEVCCServerInterface_IsUseCaseSupported_Call) Return(_a0 bool, _a1 error) *UCEVCCServerInterface_IsUseCaseSupported_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UCEVCCServerInterface_IsUseCaseSupported_Call) RunAndReturn(run func(api.EntityRemoteInterface) (bool, error)) *UCEVCCServerInterface_IsUseCaseSupported_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetAsymmetricChargingSupport provides a mock function with given fields: value
+func (_m *UCEVCCServerInterface) SetAsymmetricChargingSupport(value bool) error {
+	ret := _m.Called(value)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetAsymmetricChargingSupport")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(bool) error); ok {
+		r0 = rf(value)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UCEVCCServerInterface_SetAsymmetricChargingSupport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetAsymmetricChargingSupport'
+type UCEVCCServerInterface_SetAsymmetricChargingSupport_Call struct {
+	*mock.Call
+}
+
+// SetAsymmetricChargingSupport is a helper method to define mock.On call
+//   - value bool
+func (_e *UCEVCCServerInterface_Expecter) SetAsymmetricChargingSupport(value interface{}) *UCEVCCServerInterface_SetAsymmetricChargingSupport_Call {
+	return &UCEVCCServerInterface_SetAsymmetricChargingSupport_Call{Call: _e.mock.On("SetAsymmetricChargingSupport", value)}
+}
+
+func (_c *UCEVCCServerInterface_SetAsymmetricChargingSupport_Call) Run(run func(value bool)) *UCEVCCServerInterface_SetAsymmetricChargingSupport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(bool))
+	})
+	return _c
+}
+
+func (_c *UCEVCCServerInterface_SetAsymmetricChargingSupport_Call) Return(resultErr error) *UCEVCCServerInterface_SetAsymmetricChargingSupport_Call {
+	_c.Call.Return(resultErr)
+	return _c
+}
+
+func (_c *UCEVCCServerInterface_SetAsymmetricChargingSupport_Call) RunAndReturn(run func(bool) error) *UCEVCCServerInterface_SetAsymmetricChargingSupport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetChargeState provides a mock function with given fields: state
+func (_m *UCEVCCServerInterface) SetChargeState(state cemdapi.EVChargeStateType) error {
+	ret := _m.Called(state)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetChargeState")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(cemdapi.EVChargeStateType) error); ok {
+		r0 = rf(state)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UCEVCCServerInterface_SetChargeState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetChargeState'
+type UCEVCCServerInterface_SetChargeState_Call struct {
+	*mock.Call
+}
+
+// SetChargeState is a helper method to define mock.On call
+//   - state cemdapi.EVChargeStateType
+func (_e *UCEVCCServerInterface_Expecter) SetChargeState(state interface{}) *UCEVCCServerInterface_SetChargeState_Call {
+	return &UCEVCCServerInterface_SetChargeState_Call{Call: _e.mock.On("SetChargeState", state)}
+}
+
+func (_c *UCEVCCServerInterface_SetChargeState_Call) Run(run func(state cemdapi.EVChargeStateType)) *UCEVCCServerInterface_SetChargeState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(cemdapi.EVChargeStateType))
+	})
+	return _c
+}
+
+func (_c *UCEVCCServerInterface_SetChargeState_Call) Return(resultErr error) *UCEVCCServerInterface_SetChargeState_Call {
+	_c.Call.Return(resultErr)
+	return _c
+}
+
+func (_c *UCEVCCServerInterface_SetChargeState_Call) RunAndReturn(run func(cemdapi.EVChargeStateType) error) *UCEVCCServerInterface_SetChargeState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetChargingPowerLimits provides a mock function with given fields: minimum, maximum, standby
+func (_m *UCEVCCServerInterface) SetChargingPowerLimits(minimum float64, maximum float64, standby float64) error {
+	ret := _m.Called(minimum, maximum, standby)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetChargingPowerLimits")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(float64, float64, float64) error); ok {
+		r0 = rf(minimum, maximum, standby)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UCEVCCServerInterface_SetChargingPowerLimits_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetChargingPowerLimits'
+type UCEVCCServerInterface_SetChargingPowerLimits_Call struct {
+	*mock.Call
+}
+
+// SetChargingPowerLimits is a helper method to define mock.On call
+//   - minimum float64
+//   - maximum float64
+//   - standby float64
+func (_e *UCEVCCServerInterface_Expecter) SetChargingPowerLimits(minimum interface{}, maximum interface{}, standby interface{}) *UCEVCCServerInterface_SetChargingPowerLimits_Call {
+	return &UCEVCCServerInterface_SetChargingPowerLimits_Call{Call: _e.mock.On("SetChargingPowerLimits", minimum, maximum, standby)}
+}
+
+func (_c *UCEVCCServerInterface_SetChargingPowerLimits_Call) Run(run func(minimum float64, maximum float64, standby float64)) *UCEVCCServerInterface_SetChargingPowerLimits_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(float64), args[1].(float64), args[2].(float64))
+	})
+	return _c
+}
+
+func (_c *UCEVCCServerInterface_SetChargingPowerLimits_Call) Return(resultErr error) *UCEVCCServerInterface_SetChargingPowerLimits_Call {
+	_c.Call.Return(resultErr)
+	return _c
+}
+
+func (_c *UCEVCCServerInterface_SetChargingPowerLimits_Call) RunAndReturn(run func(float64, float64, float64) error) *UCEVCCServerInterface_SetChargingPowerLimits_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetCommunicationStandard provides a mock function with given fields: value
+func (_m *UCEVCCServerInterface) SetCommunicationStandard(value model.DeviceConfigurationKeyValueStringType) error {
+	ret := _m.Called(value)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetCommunicationStandard")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(model.DeviceConfigurationKeyValueStringType) error); ok {
+		r0 = rf(value)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UCEVCCServerInterface_SetCommunicationStandard_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetCommunicationStandard'
+type UCEVCCServerInterface_SetCommunicationStandard_Call struct {
+	*mock.Call
+}
+
+// SetCommunicationStandard is a helper method to define mock.On call
+//   - value model.DeviceConfigurationKeyValueStringType
+func (_e *UCEVCCServerInterface_Expecter) SetCommunicationStandard(value interface{}) *UCEVCCServerInterface_SetCommunicationStandard_Call {
+	return &UCEVCCServerInterface_SetCommunicationStandard_Call{Call: _e.mock.On("SetCommunicationStandard", value)}
+}
+
+func (_c *UCEVCCServerInterface_SetCommunicationStandard_Call) Run(run func(value model.DeviceConfigurationKeyValueStringType)) *UCEVCCServerInterface_SetCommunicationStandard_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(model.DeviceConfigurationKeyValueStringType))
+	})
+	return _c
+}
+
+func (_c *UCEVCCServerInterface_SetCommunicationStandard_Call) Return(resultErr error) *UCEVCCServerInterface_SetCommunicationStandard_Call {
+	_c.Call.Return(resultErr)
+	return _c
+}
+
+func (_c *UCEVCCServerInterface_SetCommunicationStandard_Call) RunAndReturn(run func(model.DeviceConfigurationKeyValueStringType) error) *UCEVCCServerInterface_SetCommunicationStandard_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetEVConnected provides a mock function with given fields: connected
+func (_m *UCEVCCServerInterface) SetEVConnected(connected bool) error {
+	ret := _m.Called(connected)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetEVConnected")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(bool) error); ok {
+		r0 = rf(connected)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UCEVCCServerInterface_SetEVConnected_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetEVConnected'
+type UCEVCCServerInterface_SetEVConnected_Call struct {
+	*mock.Call
+}
+
+// SetEVConnected is a helper method to define mock.On call
+//   - connected bool
+func (_e *UCEVCCServerInterface_Expecter) SetEVConnected(connected interface{}) *UCEVCCServerInterface_SetEVConnected_Call {
+	return &UCEVCCServerInterface_SetEVConnected_Call{Call: _e.mock.On("SetEVConnected", connected)}
+}
+
+func (_c *UCEVCCServerInterface_SetEVConnected_Call) Run(run func(connected bool)) *UCEVCCServerInterface_SetEVConnected_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(bool))
+	})
+	return _c
+}
+
+func (_c *UCEVCCServerInterface_SetEVConnected_Call) Return(resultErr error) *UCEVCCServerInterface_SetEVConnected_Call {
+	_c.Call.Return(resultErr)
+	return _c
+}
+
+func (_c *UCEVCCServerInterface_SetEVConnected_Call) RunAndReturn(run func(bool) error) *UCEVCCServerInterface_SetEVConnected_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetIdentifications provides a mock function with given fields: values
+func (_m *UCEVCCServerInterface) SetIdentifications(values []cemdapi.IdentificationItem) error {
+	ret := _m.Called(values)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetIdentifications")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]cemdapi.IdentificationItem) error); ok {
+		r0 = rf(values)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UCEVCCServerInterface_SetIdentifications_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetIdentifications'
+type UCEVCCServerInterface_SetIdentifications_Call struct {
+	*mock.Call
+}
+
+// SetIdentifications is a helper method to define mock.On call
+//   - values []cemdapi.IdentificationItem
+func (_e *UCEVCCServerInterface_Expecter) SetIdentifications(values interface{}) *UCEVCCServerInterface_SetIdentifications_Call {
+	return &UCEVCCServerInterface_SetIdentifications_Call{Call: _e.mock.On("SetIdentifications", values)}
+}
+
+func (_c *UCEVCCServerInterface_SetIdentifications_Call) Run(run func(values []cemdapi.IdentificationItem)) *UCEVCCServerInterface_SetIdentifications_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]cemdapi.IdentificationItem))
+	})
+	return _c
+}
+
+func (_c *UCEVCCServerInterface_SetIdentifications_Call) Return(resultErr error) *UCEVCCServerInterface_SetIdentifications_Call {
+	_c.Call.Return(resultErr)
+	return _c
+}
+
+func (_c *UCEVCCServerInterface_SetIdentifications_Call) RunAndReturn(run func([]cemdapi.IdentificationItem) error) *UCEVCCServerInterface_SetIdentifications_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetManufacturerData provides a mock function with given fields: data
+func (_m *UCEVCCServerInterface) SetManufacturerData(data cemdapi.ManufacturerData) error {
+	ret := _m.Called(data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetManufacturerData")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(cemdapi.ManufacturerData) error); ok {
+		r0 = rf(data)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UCEVCCServerInterface_SetManufacturerData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetManufacturerData'
+type UCEVCCServerInterface_SetManufacturerData_Call struct {
+	*mock.Call
+}
+
+// SetManufacturerData is a helper method to define mock.On call
+//   - data cemdapi.ManufacturerData
+func (_e *UCEVCCServerInterface_Expecter) SetManufacturerData(data interface{}) *UCEVCCServerInterface_SetManufacturerData_Call {
+	return &UCEVCCServerInterface_SetManufacturerData_Call{Call: _e.mock.On("SetManufacturerData", data)}
+}
+
+func (_c *UCEVCCServerInterface_SetManufacturerData_Call) Run(run func(data cemdapi.ManufacturerData)) *UCEVCCServerInterface_SetManufacturerData_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(cemdapi.ManufacturerData))
+	})
+	return _c
+}
+
+func (_c *UCEVCCServerInterface_SetManufacturerData_Call) Return(resultErr error) *UCEVCCServerInterface_SetManufacturerData_Call {
+	_c.Call.Return(resultErr)
+	return _c
+}
+
+func (_c *UCEVCCServerInterface_SetManufacturerData_Call) RunAndReturn(run func(cemdapi.ManufacturerData) error) *UCEVCCServerInterface_SetManufacturerData_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateUseCaseAvailability provides a mock function with given fields: available
+func (_m *UCEVCCServerInterface) UpdateUseCaseAvailability(available bool) {
+	_m.Called(available)
+}
+
+// UCEVCCServerInterface_UpdateUseCaseAvailability_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUseCaseAvailability'
+type UCEVCCServerInterface_UpdateUseCaseAvailability_Call struct {
+	*mock.Call
+}
+
+// UpdateUseCaseAvailability is a helper method to define mock.On call
+//   - available bool
+func (_e *UCEVCCServerInterface_Expecter) UpdateUseCaseAvailability(available interface{}) *UCEVCCServerInterface_UpdateUseCaseAvailability_Call {
+	return &UCEVCCServerInterface_UpdateUseCaseAvailability_Call{Call: _e.mock.On("UpdateUseCaseAvailability", available)}
+}
+
+func (_c *UCEVCCServerInterface_UpdateUseCaseAvailability_Call) Run(run func(available bool)) *UCEVCCServerInterface_UpdateUseCaseAvailability_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(bool))
+	})
+	return _c
+}
+
+func (_c *UCEVCCServerInterface_UpdateUseCaseAvailability_Call) Return() *UCEVCCServerInterface_UpdateUseCaseAvailability_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *UCEVCCServerInterface_UpdateUseCaseAvailability_Call) RunAndReturn(run func(bool)) *UCEVCCServerInterface_UpdateUseCaseAvailability_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UseCaseName provides a mock function with given fields:
+func (_m *UCEVCCServerInterface) UseCaseName() model.UseCaseNameType {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for UseCaseName")
+	}
+
+	var r0 model.UseCaseNameType
+	if rf, ok := ret.Get(0).(func() model.UseCaseNameType); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(model.UseCaseNameType)
+	}
+
+	return r0
+}
+
+// UCEVCCServerInterface_UseCaseName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UseCaseName'
+type UCEVCCServerInterface_UseCaseName_Call struct {
+	*mock.Call
+}
+
+// UseCaseName is a helper method to define mock.On call
+func (_e *UCEVCCServerInterface_Expecter) UseCaseName() *UCEVCCServerInterface_UseCaseName_Call {
+	return &UCEVCCServerInterface_UseCaseName_Call{Call: _e.mock.On("UseCaseName")}
+}
+
+func (_c *UCEVCCServerInterface_UseCaseName_Call) Run(run func()) *UCEVCCServerInterface_UseCaseName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *UCEVCCServerInterface_UseCaseName_Call) Return(_a0 model.UseCaseNameType) *UCEVCCServerInterface_UseCaseName_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UCEVCCServerInterface_UseCaseName_Call) RunAndReturn(run func() model.UseCaseNameType) *UCEVCCServerInterface_UseCaseName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewUCEVCCServerInterface creates a new instance of UCEVCCServerInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUCEVCCServerInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UCEVCCServerInterface {
+	mock := &UCEVCCServerInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,406 @@
+// Code generated by mockery v2.42.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	api "github.com/enbility/spine-go/api"
+	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/enbility/spine-go/model"
+)
+
+// UCEVCEMServerInterface is an autogenerated mock type for the UCEVCEMServerInterface type
+type UCEVCEMServerInterface struct {
+	mock.Mock
+}
+
+type UCEVCEMServerInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *UCEVCEMServerInterface) EXPECT() *UCEVCEMServerInterface_Expecter {
+	return &UCEVCEMServerInterface_Expecter{mock: &_m.Mock}
+}
+
+// AddEVFeatures provides a mock function with given fields: entity
+func (_m *UCEVCEMServerInterface) AddEVFeatures(entity api.EntityLocalInterface) {
+	_m.Called(entity)
+}
+
+// UCEVCEMServerInterface_AddEVFeatures_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddEVFeatures'
+type UCEVCEMServerInterface_AddEVFeatures_Call struct {
+	*mock.Call
+}
+
+// AddEVFeatures is a helper method to define mock.On call
+//   - entity api.EntityLocalInterface
+func (_e *UCEVCEMServerInterface_Expecter) AddEVFeatures(entity interface{}) *UCEVCEMServerInterface_AddEVFeatures_Call {
+	return &UCEVCEMServerInterface_AddEVFeatures_Call{Call: _e.mock.On("AddEVFeatures", entity)}
+}
+
+func (_c *UCEVCEMServerInterface_AddEVFeatures_Call) Run(run func(entity api.EntityLocalInterface)) *UCEVCEMServerInterface_AddEVFeatures_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(api.EntityLocalInterface))
+	})
+	return _c
+}
+
+func (_c *UCEVCEMServerInterface_AddEVFeatures_Call) Return() *UCEVCEMServerInterface_AddEVFeatures_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *UCEVCEMServerInterface_AddEVFeatures_Call) RunAndReturn(run func(api.EntityLocalInterface)) *UCEVCEMServerInterface_AddEVFeatures_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddFeatures provides a mock function with given fields:
+func (_m *UCEVCEMServerInterface) AddFeatures() {
+	_m.Called()
+}
+
+// UCEVCEMServerInterface_AddFeatures_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddFeatures'
+type UCEVCEMServerInterface_AddFeatures_Call struct {
+	*mock.Call
+}
+
+// AddFeatures is a helper method to define mock.On call
+func (_e *UCEVCEMServerInterface_Expecter) AddFeatures() *UCEVCEMServerInterface_AddFeatures_Call {
+	return &UCEVCEMServerInterface_AddFeatures_Call{Call: _e.mock.On("AddFeatures")}
+}
+
+func (_c *UCEVCEMServerInterface_AddFeatures_Call) Run(run func()) *UCEVCEMServerInterface_AddFeatures_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *UCEVCEMServerInterface_AddFeatures_Call) Return() *UCEVCEMServerInterface_AddFeatures_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *UCEVCEMServerInterface_AddFeatures_Call) RunAndReturn(run func()) *UCEVCEMServerInterface_AddFeatures_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddUseCase provides a mock function with given fields:
+func (_m *UCEVCEMServerInterface) AddUseCase() {
+	_m.Called()
+}
+
+// UCEVCEMServerInterface_AddUseCase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddUseCase'
+type UCEVCEMServerInterface_AddUseCase_Call struct {
+	*mock.Call
+}
+
+// AddUseCase is a helper method to define mock.On call
+func (_e *UCEVCEMServerInterface_Expecter) AddUseCase() *UCEVCEMServerInterface_AddUseCase_Call {
+	return &UCEVCEMServerInterface_AddUseCase_Call{Call: _e.mock.On("AddUseCase")}
+}
+
+func (_c *UCEVCEMServerInterface_AddUseCase_Call) Run(run func()) *UCEVCEMServerInterface_AddUseCase_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *UCEVCEMServerInterface_AddUseCase_Call) Return() *UCEVCEMServerInterface_AddUseCase_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *UCEVCEMServerInterface_AddUseCase_Call) RunAndReturn(run func()) *UCEVCEMServerInterface_AddUseCase_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsUseCaseSupported provides a mock function with given fields: remoteEntity
+func (_m *UCEVCEMServerInterface) IsUseCaseSupported(remoteEntity api.EntityRemoteInterface) (bool, error) {
+	ret := _m.Called(remoteEntity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsUseCaseSupported")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(api.EntityRemoteInterface) (bool, error)); ok {
+		return rf(remoteEntity)
+	}
+	if rf, ok := ret.Get(0).(func(api.EntityRemoteInterface) bool); ok {
+		r0 = rf(remoteEntity)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(api.EntityRemoteInterface) error); ok {
+		r1 = rf(remoteEntity)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UCEVCEMServerInterface_IsUseCaseSupported_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsUseCaseSupported'
+type UCEVCEMServerInterface_IsUseCaseSupported_Call struct {
+	*mock.Call
+}
+
+// IsUseCaseSupported is a helper method to define mock.On call
+//   - remoteEntity api.EntityRemoteInterface
+func (_e *UCEVCEMServerInterface_Expecter) IsUseCaseSupported(remoteEntity interface{}) *UCEVCEMServerInterface_IsUseCaseSupported_Call {
+	return &UCEVCEMServerInterface_IsUseCaseSupported_Call{Call: _e.mock.On("IsUseCaseSupported", remoteEntity)}
+}
+
+func (_c *UCEVCEMServerInterface_IsUseCaseSupported_Call) Run(run func(remoteEntity api.EntityRemoteInterface)) *UCEVCEMServerInterface_IsUseCaseSupported_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(api.EntityRemoteInterface))
+	})
+	return _c
+}
+
+func (_c *UCEVCEMServerInterface_IsUseCaseSupported_Call) Return(_a0 bool, _a1 error) *UCEVCEMServerInterface_IsUseCaseSupported_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UCEVCEMServerInterface_IsUseCaseSupported_Call) RunAndReturn(run func(api.EntityRemoteInterface) (bool, error)) *UCEVCEMServerInterface_IsUseCaseSupported_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetCurrentPerPhase provides a mock function with given fields: values
+func (_m *UCEVCEMServerInterface) SetCurrentPerPhase(values []float64) error {
+	ret := _m.Called(values)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetCurrentPerPhase")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]float64) error); ok {
+		r0 = rf(values)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UCEVCEMServerInterface_SetCurrentPerPhase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetCurrentPerPhase'
+type UCEVCEMServerInterface_SetCurrentPerPhase_Call struct {
+	*mock.Call
+}
+
+// SetCurrentPerPhase is a helper method to define mock.On call
+//   - values []float64
+func (_e *UCEVCEMServerInterface_Expecter) SetCurrentPerPhase(values interface{}) *UCEVCEMServerInterface_SetCurrentPerPhase_Call {
+	return &UCEVCEMServerInterface_SetCurrentPerPhase_Call{Call: _e.mock.On("SetCurrentPerPhase", values)}
+}
+
+func (_c *UCEVCEMServerInterface_SetCurrentPerPhase_Call) Run(run func(values []float64)) *UCEVCEMServerInterface_SetCurrentPerPhase_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]float64))
+	})
+	return _c
+}
+
+func (_c *UCEVCEMServerInterface_SetCurrentPerPhase_Call) Return(resultErr error) *UCEVCEMServerInterface_SetCurrentPerPhase_Call {
+	_c.Call.Return(resultErr)
+	return _c
+}
+
+func (_c *UCEVCEMServerInterface_SetCurrentPerPhase_Call) RunAndReturn(run func([]float64) error) *UCEVCEMServerInterface_SetCurrentPerPhase_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetEnergyCharged provides a mock function with given fields: value
+func (_m *UCEVCEMServerInterface) SetEnergyCharged(value float64) error {
+	ret := _m.Called(value)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetEnergyCharged")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(float64) error); ok {
+		r0 = rf(value)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UCEVCEMServerInterface_SetEnergyCharged_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetEnergyCharged'
+type UCEVCEMServerInterface_SetEnergyCharged_Call struct {
+	*mock.Call
+}
+
+// SetEnergyCharged is a helper method to define mock.On call
+//   - value float64
+func (_e *UCEVCEMServerInterface_Expecter) SetEnergyCharged(value interface{}) *UCEVCEMServerInterface_SetEnergyCharged_Call {
+	return &UCEVCEMServerInterface_SetEnergyCharged_Call{Call: _e.mock.On("SetEnergyCharged", value)}
+}
+
+func (_c *UCEVCEMServerInterface_SetEnergyCharged_Call) Run(run func(value float64)) *UCEVCEMServerInterface_SetEnergyCharged_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(float64))
+	})
+	return _c
+}
+
+func (_c *UCEVCEMServerInterface_SetEnergyCharged_Call) Return(resultErr error) *UCEVCEMServerInterface_SetEnergyCharged_Call {
+	_c.Call.Return(resultErr)
+	return _c
+}
+
+func (_c *UCEVCEMServerInterface_SetEnergyCharged_Call) RunAndReturn(run func(float64) error) *UCEVCEMServerInterface_SetEnergyCharged_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetPowerPerPhase provides a mock function with given fields: values
+func (_m *UCEVCEMServerInterface) SetPowerPerPhase(values []float64) error {
+	ret := _m.Called(values)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetPowerPerPhase")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]float64) error); ok {
+		r0 = rf(values)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UCEVCEMServerInterface_SetPowerPerPhase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetPowerPerPhase'
+type UCEVCEMServerInterface_SetPowerPerPhase_Call struct {
+	*mock.Call
+}
+
+// SetPowerPerPhase is a helper method to define mock.On call
+//   - values []float64
+func (_e *UCEVCEMServerInterface_Expecter) SetPowerPerPhase(values interface{}) *UCEVCEMServerInterface_SetPowerPerPhase_Call {
+	return &UCEVCEMServerInterface_SetPowerPerPhase_Call{Call: _e.mock.On("SetPowerPerPhase", values)}
+}
+
+func (_c *UCEVCEMServerInterface_SetPowerPerPhase_Call) Run(run func(values []float64)) *UCEVCEMServerInterface_SetPowerPerPhase_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]float64))
+	})
+	return _c
+}
+
+func (_c *UCEVCEMServerInterface_SetPowerPerPhase_Call) Return(resultErr error) *UCEVCEMServerInterface_SetPowerPerPhase_Call {
+	_c.Call.Return(resultErr)
+	return _c
+}
+
+func (_c *UCEVCEMServerInterface_SetPowerPerPhase_Call) RunAndReturn(run func([]float64) error) *UCEVCEMServerInterface_SetPowerPerPhase_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateUseCaseAvailability provides a mock function with given fields: available
+func (_m *UCEVCEMServerInterface) UpdateUseCaseAvailability(available bool) {
+	_m.Called(available)
+}
+
+// UCEVCEMServerInterface_UpdateUseCaseAvailability_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUseCaseAvailability'
+type UCEVCEMServerInterface_UpdateUseCaseAvailability_Call struct {
+	*mock.Call
+}
+
+// UpdateUseCaseAvailability is a helper method to define mock.On call
+//   - available bool
+func (_e *UCEVCEMServerInterface_Expecter) UpdateUseCaseAvailability(available interface{}) *UCEVCEMServerInterface_UpdateUseCaseAvailability_Call {
+	return &UCEVCEMServerInterface_UpdateUseCaseAvailability_Call{Call: _e.mock.On("UpdateUseCaseAvailability", available)}
+}
+
+func (_c *UCEVCEMServerInterface_UpdateUseCaseAvailability_Call) Run(run func(available bool)) *UCEVCEMServerInterface_UpdateUseCaseAvailability_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(bool))
+	})
+	return _c
+}
+
+func (_c *UCEVCEMServerInterface_UpdateUseCaseAvailability_Call) Return() *UCEVCEMServerInterface_UpdateUseCaseAvailability_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *UCEVCEMServerInterface_UpdateUseCaseAvailability_Call) RunAndReturn(run func(bool)) *UCEVCEMServerInterface_UpdateUseCaseAvailability_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UseCaseName provides a mock function with given fields:
+func (_m *UCEVCEMServerInterface) UseCaseName() model.UseCaseNameType {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for UseCaseName")
+	}
+
+	var r0 model.UseCaseNameType
+	if rf, ok := ret.Get(0).(func() model.UseCaseNameType); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(model.UseCaseNameType)
+	}
+
+	return r0
+}
+
+// UCEVCEMServerInterface_UseCaseName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UseCaseName'
+type UCEVCEMServerInterface_UseCaseName_Call struct {
+	*mock.Call
+}
+
+// UseCaseName is a helper method to define mock.On call
+func (_e *UCEVCEMServerInterface_Expecter) UseCaseName() *UCEVCEMServerInterface_UseCaseName_Call {
+	return &UCEVCEMServerInterface_UseCaseName_Call{Call: _e.mock.On("UseCaseName")}
+}
+
+func (_c *UCEVCEMServerInterface_UseCaseName_Call) Run(run func()) *UCEVCEMServerInterface_UseCaseName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *UCEVCEMServerInterface_UseCaseName_Call) Return(_a0 model.UseCaseNameType) *UCEVCEMServerInterface_UseCaseName_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UCEVCEMServerInterface_UseCaseName_Call) RunAndReturn(run func() model.UseCaseNameType) *UCEVCEMServerInterface_UseCaseName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewUCEVCEMServerInterface creates a new instance of UCEVCEMServerInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUCEVCEMServerInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UCEVCEMServerInterface {
+	mock := &UCEVCEMServerInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,330 @@
+// Code generated by mockery v2.42.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	cemdapi "github.com/enbility/cemd/api"
+	api "github.com/enbility/spine-go/api"
+
+	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/enbility/spine-go/model"
+)
+
+// UCEVSECCServerInterface is an autogenerated mock type for the UCEVSECCServerInterface type
+type UCEVSECCServerInterface struct {
+	mock.Mock
+}
+
+type UCEVSECCServerInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *UCEVSECCServerInterface) EXPECT() *UCEVSECCServerInterface_Expecter {
+	return &UCEVSECCServerInterface_Expecter{mock: &_m.Mock}
+}
+
+// AddFeatures provides a mock function with given fields:
+func (_m *UCEVSECCServerInterface) AddFeatures() {
+	_m.Called()
+}
+
+// UCEVSECCServerInterface_AddFeatures_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddFeatures'
+type UCEVSECCServerInterface_AddFeatures_Call struct {
+	*mock.Call
+}
+
+// AddFeatures is a helper method to define mock.On call
+func (_e *UCEVSECCServerInterface_Expecter) AddFeatures() *UCEVSECCServerInterface_AddFeatures_Call {
+	return &UCEVSECCServerInterface_AddFeatures_Call{Call: _e.mock.On("AddFeatures")}
+}
+
+func (_c *UCEVSECCServerInterface_AddFeatures_Call) Run(run func()) *UCEVSECCServerInterface_AddFeatures_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *UCEVSECCServerInterface_AddFeatures_Call) Return() *UCEVSECCServerInterface_AddFeatures_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *UCEVSECCServerInterface_AddFeatures_Call) RunAndReturn(run func()) *UCEVSECCServerInterface_AddFeatures_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddUseCase provides a mock function with given fields:
+func (_m *UCEVSECCServerInterface) AddUseCase() {
+	_m.Called()
+}
+
+// UCEVSECCServerInterface_AddUseCase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddUseCase'
+type UCEVSECCServerInterface_AddUseCase_Call struct {
+	*mock.Call
+}
+
+// AddUseCase is a helper method to define mock.On call
+func (_e *UCEVSECCServerInterface_Expecter) AddUseCase() *UCEVSECCServerInterface_AddUseCase_Call {
+	return &UCEVSECCServerInterface_AddUseCase_Call{Call: _e.mock.On("AddUseCase")}
+}
+
+func (_c *UCEVSECCServerInterface_AddUseCase_Call) Run(run func()) *UCEVSECCServerInterface_AddUseCase_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *UCEVSECCServerInterface_AddUseCase_Call) Return() *UCEVSECCServerInterface_AddUseCase_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *UCEVSECCServerInterface_AddUseCase_Call) RunAndReturn(run func()) *UCEVSECCServerInterface_AddUseCase_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsUseCaseSupported provides a mock function with given fields: remoteEntity
+func (_m *UCEVSECCServerInterface) IsUseCaseSupported(remoteEntity api.EntityRemoteInterface) (bool, error) {
+	ret := _m.Called(remoteEntity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsUseCaseSupported")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(api.EntityRemoteInterface) (bool, error)); ok {
+		return rf(remoteEntity)
+	}
+	if rf, ok := ret.Get(0).(func(api.EntityRemoteInterface) bool); ok {
+		r0 = rf(remoteEntity)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(api.EntityRemoteInterface) error); ok {
+		r1 = rf(remoteEntity)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UCEVSECCServerInterface_IsUseCaseSupported_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsUseCaseSupported'
+type UCEVSECCServerInterface_IsUseCaseSupported_Call struct {
+	*mock.Call
+}
+
+// IsUseCaseSupported is a helper method to define mock.On call
+//   - remoteEntity api.EntityRemoteInterface
+func (_e *UCEVSECCServerInterface_Expecter) IsUseCaseSupported(remoteEntity interface{}) *UCEVSECCServerInterface_IsUseCaseSupported_Call {
+	return &UCEVSECCServerInterface_IsUseCaseSupported_Call{Call: _e.mock.On("IsUseCaseSupported", remoteEntity)}
+}
+
+func (_c *UCEVSECCServerInterface_IsUseCaseSupported_Call) Run(run func(remoteEntity api.EntityRemoteInterface)) *UCEVSECCServerInterface_IsUseCaseSupported_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(api.EntityRemoteInterface))
+	})
+	return _c
+}
+
+func (_c *UCEVSECCServerInterface_IsUseCaseSupported_Call) Return(_a0 bool, _a1 error) *UCEVSECCServerInterface_IsUseCaseSupported_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UCEVSECCServerInterface_IsUseCaseSupported_Call) RunAndReturn(run func(api.EntityRemoteInterface) (bool, error)) *UCEVSECCServerInterface_IsUseCaseSupported_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetManufacturerData provides a mock function with given fields: data
+func (_m *UCEVSECCServerInterface) SetManufacturerData(data cemdapi.ManufacturerData) error {
+	ret := _m.Called(data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetManufacturerData")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(cemdapi.ManufacturerData) error); ok {
+		r0 = rf(data)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UCEVSECCServerInterface_SetManufacturerData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetManufacturerData'
+type UCEVSECCServerInterface_SetManufacturerData_Call struct {
+	*mock.Call
+}
+
+// SetManufacturerData is a helper method to define mock.On call
+//   - data cemdapi.ManufacturerData
+func (_e *UCEVSECCServerInterface_Expecter) SetManufacturerData(data interface{}) *UCEVSECCServerInterface_SetManufacturerData_Call {
+	return &UCEVSECCServerInterface_SetManufacturerData_Call{Call: _e.mock.On("SetManufacturerData", data)}
+}
+
+func (_c *UCEVSECCServerInterface_SetManufacturerData_Call) Run(run func(data cemdapi.ManufacturerData)) *UCEVSECCServerInterface_SetManufacturerData_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(cemdapi.ManufacturerData))
+	})
+	return _c
+}
+
+func (_c *UCEVSECCServerInterface_SetManufacturerData_Call) Return(resultErr error) *UCEVSECCServerInterface_SetManufacturerData_Call {
+	_c.Call.Return(resultErr)
+	return _c
+}
+
+func (_c *UCEVSECCServerInterface_SetManufacturerData_Call) RunAndReturn(run func(cemdapi.ManufacturerData) error) *UCEVSECCServerInterface_SetManufacturerData_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetOperatingState provides a mock function with given fields: operatingState, lastErrorCode
+func (_m *UCEVSECCServerInterface) SetOperatingState(operatingState model.DeviceDiagnosisOperatingStateType, lastErrorCode string) error {
+	ret := _m.Called(operatingState, lastErrorCode)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetOperatingState")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(model.DeviceDiagnosisOperatingStateType, string) error); ok {
+		r0 = rf(operatingState, lastErrorCode)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UCEVSECCServerInterface_SetOperatingState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetOperatingState'
+type UCEVSECCServerInterface_SetOperatingState_Call struct {
+	*mock.Call
+}
+
+// SetOperatingState is a helper method to define mock.On call
+//   - operatingState model.DeviceDiagnosisOperatingStateType
+//   - lastErrorCode string
+func (_e *UCEVSECCServerInterface_Expecter) SetOperatingState(operatingState interface{}, lastErrorCode interface{}) *UCEVSECCServerInterface_SetOperatingState_Call {
+	return &UCEVSECCServerInterface_SetOperatingState_Call{Call: _e.mock.On("SetOperatingState", operatingState, lastErrorCode)}
+}
+
+func (_c *UCEVSECCServerInterface_SetOperatingState_Call) Run(run func(operatingState model.DeviceDiagnosisOperatingStateType, lastErrorCode string)) *UCEVSECCServerInterface_SetOperatingState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(model.DeviceDiagnosisOperatingStateType), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *UCEVSECCServerInterface_SetOperatingState_Call) Return(resultErr error) *UCEVSECCServerInterface_SetOperatingState_Call {
+	_c.Call.Return(resultErr)
+	return _c
+}
+
+func (_c *UCEVSECCServerInterface_SetOperatingState_Call) RunAndReturn(run func(model.DeviceDiagnosisOperatingStateType, string) error) *UCEVSECCServerInterface_SetOperatingState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateUseCaseAvailability provides a mock function with given fields: available
+func (_m *UCEVSECCServerInterface) UpdateUseCaseAvailability(available bool) {
+	_m.Called(available)
+}
+
+// UCEVSECCServerInterface_UpdateUseCaseAvailability_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUseCaseAvailability'
+type UCEVSECCServerInterface_UpdateUseCaseAvailability_Call struct {
+	*mock.Call
+}
+
+// UpdateUseCaseAvailability is a helper method to define mock.On call
+//   - available bool
+func (_e *UCEVSECCServerInterface_Expecter) UpdateUseCaseAvailability(available interface{}) *UCEVSECCServerInterface_UpdateUseCaseAvailability_Call {
+	return &UCEVSECCServerInterface_UpdateUseCaseAvailability_Call{Call: _e.mock.On("UpdateUseCaseAvailability", available)}
+}
+
+func (_c *UCEVSECCServerInterface_UpdateUseCaseAvailability_Call) Run(run func(available bool)) *UCEVSECCServerInterface_UpdateUseCaseAvailability_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(bool))
+	})
+	return _c
+}
+
+func (_c *UCEVSECCServerInterface_UpdateUseCaseAvailability_Call) Return() *UCEVSECCServerInterface_UpdateUseCaseAvailability_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *UCEVSECCServerInterface_UpdateUseCaseAvailability_Call) RunAndReturn(run func(bool)) *UCEVSECCServerInterface_UpdateUseCaseAvailability_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UseCaseName provides a mock function with given fields:
+func (_m *UCEVSECCServerInterface) UseCaseName() model.UseCaseNameType {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for UseCaseName")
+	}
+
+	var r0 model.UseCaseNameType
+	if rf, ok := ret.Get(0).(func() model.UseCaseNameType); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(model.UseCaseNameType)
+	}
+
+	return r0
+}
+
+// UCEVSECCServerInterface_UseCaseName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UseCaseName'
+type UCEVSECCServerInterface_UseCaseName_Call struct {
+	*mock.Call
+}
+
+// UseCaseName is a helper method to define mock.On call
+func (_e *UCEVSECCServerInterface_Expecter) UseCaseName() *UCEVSECCServerInterface_UseCaseName_Call {
+	return &UCEVSECCServerInterface_UseCaseName_Call{Call: _e.mock.On("UseCaseName")}
+}
+
+func (_c *UCEVSECCServerInterface_UseCaseName_Call) Run(run func()) *UCEVSECCServerInterface_UseCaseName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *UCEVSECCServerInterface_UseCaseName_Call) Return(_a0 model.UseCaseNameType) *UCEVSECCServerInterface_UseCaseName_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UCEVSECCServerInterface_UseCaseName_Call) RunAndReturn(run func() model.UseCaseNameType) *UCEVSECCServerInterface_UseCaseName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewUCEVSECCServerInterface creates a new instance of UCEVSECCServerInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUCEVSECCServerInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UCEVSECCServerInterface {
+	mock := &UCEVSECCServerInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
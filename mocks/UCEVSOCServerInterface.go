@@ -0,0 +1,314 @@
+// Code generated by mockery v2.42.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	api "github.com/enbility/spine-go/api"
+	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/enbility/spine-go/model"
+)
+
+// UCEVSOCServerInterface is an autogenerated mock type for the UCEVSOCServerInterface type
+type UCEVSOCServerInterface struct {
+	mock.Mock
+}
+
+type UCEVSOCServerInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *UCEVSOCServerInterface) EXPECT() *UCEVSOCServerInterface_Expecter {
+	return &UCEVSOCServerInterface_Expecter{mock: &_m.Mock}
+}
+
+// AddEVFeatures provides a mock function with given fields: entity
+func (_m *UCEVSOCServerInterface) AddEVFeatures(entity api.EntityLocalInterface) {
+	_m.Called(entity)
+}
+
+// UCEVSOCServerInterface_AddEVFeatures_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddEVFeatures'
+type UCEVSOCServerInterface_AddEVFeatures_Call struct {
+	*mock.Call
+}
+
+// AddEVFeatures is a helper method to define mock.On call
+//   - entity api.EntityLocalInterface
+func (_e *UCEVSOCServerInterface_Expecter) AddEVFeatures(entity interface{}) *UCEVSOCServerInterface_AddEVFeatures_Call {
+	return &UCEVSOCServerInterface_AddEVFeatures_Call{Call: _e.mock.On("AddEVFeatures", entity)}
+}
+
+func (_c *UCEVSOCServerInterface_AddEVFeatures_Call) Run(run func(entity api.EntityLocalInterface)) *UCEVSOCServerInterface_AddEVFeatures_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(api.EntityLocalInterface))
+	})
+	return _c
+}
+
+func (_c *UCEVSOCServerInterface_AddEVFeatures_Call) Return() *UCEVSOCServerInterface_AddEVFeatures_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *UCEVSOCServerInterface_AddEVFeatures_Call) RunAndReturn(run func(api.EntityLocalInterface)) *UCEVSOCServerInterface_AddEVFeatures_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddFeatures provides a mock function with given fields:
+func (_m *UCEVSOCServerInterface) AddFeatures() {
+	_m.Called()
+}
+
+// UCEVSOCServerInterface_AddFeatures_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddFeatures'
+type UCEVSOCServerInterface_AddFeatures_Call struct {
+	*mock.Call
+}
+
+// AddFeatures is a helper method to define mock.On call
+func (_e *UCEVSOCServerInterface_Expecter) AddFeatures() *UCEVSOCServerInterface_AddFeatures_Call {
+	return &UCEVSOCServerInterface_AddFeatures_Call{Call: _e.mock.On("AddFeatures")}
+}
+
+func (_c *UCEVSOCServerInterface_AddFeatures_Call) Run(run func()) *UCEVSOCServerInterface_AddFeatures_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *UCEVSOCServerInterface_AddFeatures_Call) Return() *UCEVSOCServerInterface_AddFeatures_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *UCEVSOCServerInterface_AddFeatures_Call) RunAndReturn(run func()) *UCEVSOCServerInterface_AddFeatures_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddUseCase provides a mock function with given fields:
+func (_m *UCEVSOCServerInterface) AddUseCase() {
+	_m.Called()
+}
+
+// UCEVSOCServerInterface_AddUseCase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddUseCase'
+type UCEVSOCServerInterface_AddUseCase_Call struct {
+	*mock.Call
+}
+
+// AddUseCase is a helper method to define mock.On call
+func (_e *UCEVSOCServerInterface_Expecter) AddUseCase() *UCEVSOCServerInterface_AddUseCase_Call {
+	return &UCEVSOCServerInterface_AddUseCase_Call{Call: _e.mock.On("AddUseCase")}
+}
+
+func (_c *UCEVSOCServerInterface_AddUseCase_Call) Run(run func()) *UCEVSOCServerInterface_AddUseCase_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *UCEVSOCServerInterface_AddUseCase_Call) Return() *UCEVSOCServerInterface_AddUseCase_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *UCEVSOCServerInterface_AddUseCase_Call) RunAndReturn(run func()) *UCEVSOCServerInterface_AddUseCase_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsUseCaseSupported provides a mock function with given fields: remoteEntity
+func (_m *UCEVSOCServerInterface) IsUseCaseSupported(remoteEntity api.EntityRemoteInterface) (bool, error) {
+	ret := _m.Called(remoteEntity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsUseCaseSupported")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(api.EntityRemoteInterface) (bool, error)); ok {
+		return rf(remoteEntity)
+	}
+	if rf, ok := ret.Get(0).(func(api.EntityRemoteInterface) bool); ok {
+		r0 = rf(remoteEntity)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(api.EntityRemoteInterface) error); ok {
+		r1 = rf(remoteEntity)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UCEVSOCServerInterface_IsUseCaseSupported_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsUseCaseSupported'
+type UCEVSOCServerInterface_IsUseCaseSupported_Call struct {
+	*mock.Call
+}
+
+// IsUseCaseSupported is a helper method to define mock.On call
+//   - remoteEntity api.EntityRemoteInterface
+func (_e *UCEVSOCServerInterface_Expecter) IsUseCaseSupported(remoteEntity interface{}) *UCEVSOCServerInterface_IsUseCaseSupported_Call {
+	return &UCEVSOCServerInterface_IsUseCaseSupported_Call{Call: _e.mock.On("IsUseCaseSupported", remoteEntity)}
+}
+
+func (_c *UCEVSOCServerInterface_IsUseCaseSupported_Call) Run(run func(remoteEntity api.EntityRemoteInterface)) *UCEVSOCServerInterface_IsUseCaseSupported_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(api.EntityRemoteInterface))
+	})
+	return _c
+}
+
+func (_c *UCEVSOCServerInterface_IsUseCaseSupported_Call) Return(_a0 bool, _a1 error) *UCEVSOCServerInterface_IsUseCaseSupported_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UCEVSOCServerInterface_IsUseCaseSupported_Call) RunAndReturn(run func(api.EntityRemoteInterface) (bool, error)) *UCEVSOCServerInterface_IsUseCaseSupported_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetStateOfCharge provides a mock function with given fields: value
+func (_m *UCEVSOCServerInterface) SetStateOfCharge(value float64) error {
+	ret := _m.Called(value)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetStateOfCharge")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(float64) error); ok {
+		r0 = rf(value)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UCEVSOCServerInterface_SetStateOfCharge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetStateOfCharge'
+type UCEVSOCServerInterface_SetStateOfCharge_Call struct {
+	*mock.Call
+}
+
+// SetStateOfCharge is a helper method to define mock.On call
+//   - value float64
+func (_e *UCEVSOCServerInterface_Expecter) SetStateOfCharge(value interface{}) *UCEVSOCServerInterface_SetStateOfCharge_Call {
+	return &UCEVSOCServerInterface_SetStateOfCharge_Call{Call: _e.mock.On("SetStateOfCharge", value)}
+}
+
+func (_c *UCEVSOCServerInterface_SetStateOfCharge_Call) Run(run func(value float64)) *UCEVSOCServerInterface_SetStateOfCharge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(float64))
+	})
+	return _c
+}
+
+func (_c *UCEVSOCServerInterface_SetStateOfCharge_Call) Return(resultErr error) *UCEVSOCServerInterface_SetStateOfCharge_Call {
+	_c.Call.Return(resultErr)
+	return _c
+}
+
+func (_c *UCEVSOCServerInterface_SetStateOfCharge_Call) RunAndReturn(run func(float64) error) *UCEVSOCServerInterface_SetStateOfCharge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateUseCaseAvailability provides a mock function with given fields: available
+func (_m *UCEVSOCServerInterface) UpdateUseCaseAvailability(available bool) {
+	_m.Called(available)
+}
+
+// UCEVSOCServerInterface_UpdateUseCaseAvailability_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUseCaseAvailability'
+type UCEVSOCServerInterface_UpdateUseCaseAvailability_Call struct {
+	*mock.Call
+}
+
+// UpdateUseCaseAvailability is a helper method to define mock.On call
+//   - available bool
+func (_e *UCEVSOCServerInterface_Expecter) UpdateUseCaseAvailability(available interface{}) *UCEVSOCServerInterface_UpdateUseCaseAvailability_Call {
+	return &UCEVSOCServerInterface_UpdateUseCaseAvailability_Call{Call: _e.mock.On("UpdateUseCaseAvailability", available)}
+}
+
+func (_c *UCEVSOCServerInterface_UpdateUseCaseAvailability_Call) Run(run func(available bool)) *UCEVSOCServerInterface_UpdateUseCaseAvailability_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(bool))
+	})
+	return _c
+}
+
+func (_c *UCEVSOCServerInterface_UpdateUseCaseAvailability_Call) Return() *UCEVSOCServerInterface_UpdateUseCaseAvailability_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *UCEVSOCServerInterface_UpdateUseCaseAvailability_Call) RunAndReturn(run func(bool)) *UCEVSOCServerInterface_UpdateUseCaseAvailability_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UseCaseName provides a mock function with given fields:
+func (_m *UCEVSOCServerInterface) UseCaseName() model.UseCaseNameType {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for UseCaseName")
+	}
+
+	var r0 model.UseCaseNameType
+	if rf, ok := ret.Get(0).(func() model.UseCaseNameType); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(model.UseCaseNameType)
+	}
+
+	return r0
+}
+
+// UCEVSOCServerInterface_UseCaseName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UseCaseName'
+type UCEVSOCServerInterface_UseCaseName_Call struct {
+	*mock.Call
+}
+
+// UseCaseName is a helper method to define mock.On call
+func (_e *UCEVSOCServerInterface_Expecter) UseCaseName() *UCEVSOCServerInterface_UseCaseName_Call {
+	return &UCEVSOCServerInterface_UseCaseName_Call{Call: _e.mock.On("UseCaseName")}
+}
+
+func (_c *UCEVSOCServerInterface_UseCaseName_Call) Run(run func()) *UCEVSOCServerInterface_UseCaseName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *UCEVSOCServerInterface_UseCaseName_Call) Return(_a0 model.UseCaseNameType) *UCEVSOCServerInterface_UseCaseName_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UCEVSOCServerInterface_UseCaseName_Call) RunAndReturn(run func() model.UseCaseNameType) *UCEVSOCServerInterface_UseCaseName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewUCEVSOCServerInterface creates a new instance of UCEVSOCServerInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUCEVSOCServerInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UCEVSOCServerInterface {
+	mock := &UCEVSOCServerInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -90,6 +90,41 @@ func (_c *UCLPCServerInterface_AddUseCase_Call) RunAndReturn(run func()) *UCLPCS
 	return _c
 }
 
+// ApproveOrDenyConsumptionLimit provides a mock function with given fields: msgCounter, approve, reason
+func (_m *UCLPCServerInterface) ApproveOrDenyConsumptionLimit(msgCounter model.MsgCounterType, approve bool, reason string) {
+	_m.Called(msgCounter, approve, reason)
+}
+
+// UCLPCServerInterface_ApproveOrDenyConsumptionLimit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ApproveOrDenyConsumptionLimit'
+type UCLPCServerInterface_ApproveOrDenyConsumptionLimit_Call struct {
+	*mock.Call
+}
+
+// ApproveOrDenyConsumptionLimit is a helper method to define mock.On call
+//   - msgCounter model.MsgCounterType
+//   - approve bool
+//   - reason string
+func (_e *UCLPCServerInterface_Expecter) ApproveOrDenyConsumptionLimit(msgCounter interface{}, approve interface{}, reason interface{}) *UCLPCServerInterface_ApproveOrDenyConsumptionLimit_Call {
+	return &UCLPCServerInterface_ApproveOrDenyConsumptionLimit_Call{Call: _e.mock.On("ApproveOrDenyConsumptionLimit", msgCounter, approve, reason)}
+}
+
+func (_c *UCLPCServerInterface_ApproveOrDenyConsumptionLimit_Call) Run(run func(msgCounter model.MsgCounterType, approve bool, reason string)) *UCLPCServerInterface_ApproveOrDenyConsumptionLimit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(model.MsgCounterType), args[1].(bool), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *UCLPCServerInterface_ApproveOrDenyConsumptionLimit_Call) Return() *UCLPCServerInterface_ApproveOrDenyConsumptionLimit_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *UCLPCServerInterface_ApproveOrDenyConsumptionLimit_Call) RunAndReturn(run func(model.MsgCounterType, bool, string)) *UCLPCServerInterface_ApproveOrDenyConsumptionLimit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ConsumptionLimit provides a mock function with given fields:
 func (_m *UCLPCServerInterface) ConsumptionLimit() (api.LoadLimit, error) {
 	ret := _m.Called()
@@ -145,6 +180,61 @@ func (_c *UCLPCServerInterface_ConsumptionLimit_Call) RunAndReturn(run func() (a
 	return _c
 }
 
+// ConsumptionLimitRemainingDuration provides a mock function with given fields:
+func (_m *UCLPCServerInterface) ConsumptionLimitRemainingDuration() (time.Duration, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConsumptionLimitRemainingDuration")
+	}
+
+	var r0 time.Duration
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (time.Duration, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UCLPCServerInterface_ConsumptionLimitRemainingDuration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConsumptionLimitRemainingDuration'
+type UCLPCServerInterface_ConsumptionLimitRemainingDuration_Call struct {
+	*mock.Call
+}
+
+// ConsumptionLimitRemainingDuration is a helper method to define mock.On call
+func (_e *UCLPCServerInterface_Expecter) ConsumptionLimitRemainingDuration() *UCLPCServerInterface_ConsumptionLimitRemainingDuration_Call {
+	return &UCLPCServerInterface_ConsumptionLimitRemainingDuration_Call{Call: _e.mock.On("ConsumptionLimitRemainingDuration")}
+}
+
+func (_c *UCLPCServerInterface_ConsumptionLimitRemainingDuration_Call) Run(run func()) *UCLPCServerInterface_ConsumptionLimitRemainingDuration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *UCLPCServerInterface_ConsumptionLimitRemainingDuration_Call) Return(_a0 time.Duration, _a1 error) *UCLPCServerInterface_ConsumptionLimitRemainingDuration_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UCLPCServerInterface_ConsumptionLimitRemainingDuration_Call) RunAndReturn(run func() (time.Duration, error)) *UCLPCServerInterface_ConsumptionLimitRemainingDuration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ContractualConsumptionNominalMax provides a mock function with given fields:
 func (_m *UCLPCServerInterface) ContractualConsumptionNominalMax() (float64, error) {
 	ret := _m.Called()
@@ -200,6 +290,113 @@ func (_c *UCLPCServerInterface_ContractualConsumptionNominalMax_Call) RunAndRetu
 	return _c
 }
 
+// ControllableSystemState provides a mock function with given fields:
+func (_m *UCLPCServerInterface) ControllableSystemState() api.ControllableSystemStateType {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ControllableSystemState")
+	}
+
+	var r0 api.ControllableSystemStateType
+	if rf, ok := ret.Get(0).(func() api.ControllableSystemStateType); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(api.ControllableSystemStateType)
+	}
+
+	return r0
+}
+
+// UCLPCServerInterface_ControllableSystemState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ControllableSystemState'
+type UCLPCServerInterface_ControllableSystemState_Call struct {
+	*mock.Call
+}
+
+// ControllableSystemState is a helper method to define mock.On call
+func (_e *UCLPCServerInterface_Expecter) ControllableSystemState() *UCLPCServerInterface_ControllableSystemState_Call {
+	return &UCLPCServerInterface_ControllableSystemState_Call{Call: _e.mock.On("ControllableSystemState")}
+}
+
+func (_c *UCLPCServerInterface_ControllableSystemState_Call) Run(run func()) *UCLPCServerInterface_ControllableSystemState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *UCLPCServerInterface_ControllableSystemState_Call) Return(_a0 api.ControllableSystemStateType) *UCLPCServerInterface_ControllableSystemState_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UCLPCServerInterface_ControllableSystemState_Call) RunAndReturn(run func() api.ControllableSystemStateType) *UCLPCServerInterface_ControllableSystemState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EffectiveConsumptionLimit provides a mock function with given fields:
+func (_m *UCLPCServerInterface) EffectiveConsumptionLimit() (float64, bool, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for EffectiveConsumptionLimit")
+	}
+
+	var r0 float64
+	var r1 bool
+	var r2 error
+	if rf, ok := ret.Get(0).(func() (float64, bool, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() float64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(float64)
+	}
+
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	if rf, ok := ret.Get(2).(func() error); ok {
+		r2 = rf()
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// UCLPCServerInterface_EffectiveConsumptionLimit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EffectiveConsumptionLimit'
+type UCLPCServerInterface_EffectiveConsumptionLimit_Call struct {
+	*mock.Call
+}
+
+// EffectiveConsumptionLimit is a helper method to define mock.On call
+func (_e *UCLPCServerInterface_Expecter) EffectiveConsumptionLimit() *UCLPCServerInterface_EffectiveConsumptionLimit_Call {
+	return &UCLPCServerInterface_EffectiveConsumptionLimit_Call{Call: _e.mock.On("EffectiveConsumptionLimit")}
+}
+
+func (_c *UCLPCServerInterface_EffectiveConsumptionLimit_Call) Run(run func()) *UCLPCServerInterface_EffectiveConsumptionLimit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *UCLPCServerInterface_EffectiveConsumptionLimit_Call) Return(value float64, isLimited bool, resultErr error) *UCLPCServerInterface_EffectiveConsumptionLimit_Call {
+	_c.Call.Return(value, isLimited, resultErr)
+	return _c
+}
+
+func (_c *UCLPCServerInterface_EffectiveConsumptionLimit_Call) RunAndReturn(run func() (float64, bool, error)) *UCLPCServerInterface_EffectiveConsumptionLimit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FailsafeConsumptionActivePowerLimit provides a mock function with given fields:
 func (_m *UCLPCServerInterface) FailsafeConsumptionActivePowerLimit() (float64, bool, error) {
 	ret := _m.Called()
@@ -380,6 +577,133 @@ func (_c *UCLPCServerInterface_IsUseCaseSupported_Call) RunAndReturn(run func(sp
 	return _c
 }
 
+// PendingConsumptionLimits provides a mock function with given fields:
+func (_m *UCLPCServerInterface) PendingConsumptionLimits() map[model.MsgCounterType]api.LoadLimit {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for PendingConsumptionLimits")
+	}
+
+	var r0 map[model.MsgCounterType]api.LoadLimit
+	if rf, ok := ret.Get(0).(func() map[model.MsgCounterType]api.LoadLimit); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[model.MsgCounterType]api.LoadLimit)
+		}
+	}
+
+	return r0
+}
+
+// UCLPCServerInterface_PendingConsumptionLimits_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PendingConsumptionLimits'
+type UCLPCServerInterface_PendingConsumptionLimits_Call struct {
+	*mock.Call
+}
+
+// PendingConsumptionLimits is a helper method to define mock.On call
+func (_e *UCLPCServerInterface_Expecter) PendingConsumptionLimits() *UCLPCServerInterface_PendingConsumptionLimits_Call {
+	return &UCLPCServerInterface_PendingConsumptionLimits_Call{Call: _e.mock.On("PendingConsumptionLimits")}
+}
+
+func (_c *UCLPCServerInterface_PendingConsumptionLimits_Call) Run(run func()) *UCLPCServerInterface_PendingConsumptionLimits_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *UCLPCServerInterface_PendingConsumptionLimits_Call) Return(_a0 map[model.MsgCounterType]api.LoadLimit) *UCLPCServerInterface_PendingConsumptionLimits_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UCLPCServerInterface_PendingConsumptionLimits_Call) RunAndReturn(run func() map[model.MsgCounterType]api.LoadLimit) *UCLPCServerInterface_PendingConsumptionLimits_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetApprovalPolicy provides a mock function with given fields: policy
+func (_m *UCLPCServerInterface) SetApprovalPolicy(policy api.ApprovalPolicyInterface) {
+	_m.Called(policy)
+}
+
+// UCLPCServerInterface_SetApprovalPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetApprovalPolicy'
+type UCLPCServerInterface_SetApprovalPolicy_Call struct {
+	*mock.Call
+}
+
+// SetApprovalPolicy is a helper method to define mock.On call
+//   - policy api.ApprovalPolicyInterface
+func (_e *UCLPCServerInterface_Expecter) SetApprovalPolicy(policy interface{}) *UCLPCServerInterface_SetApprovalPolicy_Call {
+	return &UCLPCServerInterface_SetApprovalPolicy_Call{Call: _e.mock.On("SetApprovalPolicy", policy)}
+}
+
+func (_c *UCLPCServerInterface_SetApprovalPolicy_Call) Run(run func(policy api.ApprovalPolicyInterface)) *UCLPCServerInterface_SetApprovalPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(api.ApprovalPolicyInterface))
+	})
+	return _c
+}
+
+func (_c *UCLPCServerInterface_SetApprovalPolicy_Call) Return() *UCLPCServerInterface_SetApprovalPolicy_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *UCLPCServerInterface_SetApprovalPolicy_Call) RunAndReturn(run func(api.ApprovalPolicyInterface)) *UCLPCServerInterface_SetApprovalPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetApprovalTimeout provides a mock function with given fields: timeout, approve
+func (_m *UCLPCServerInterface) SetApprovalTimeout(timeout time.Duration, approve bool) error {
+	ret := _m.Called(timeout, approve)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetApprovalTimeout")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(time.Duration, bool) error); ok {
+		r0 = rf(timeout, approve)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UCLPCServerInterface_SetApprovalTimeout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetApprovalTimeout'
+type UCLPCServerInterface_SetApprovalTimeout_Call struct {
+	*mock.Call
+}
+
+// SetApprovalTimeout is a helper method to define mock.On call
+//   - timeout time.Duration
+//   - approve bool
+func (_e *UCLPCServerInterface_Expecter) SetApprovalTimeout(timeout interface{}, approve interface{}) *UCLPCServerInterface_SetApprovalTimeout_Call {
+	return &UCLPCServerInterface_SetApprovalTimeout_Call{Call: _e.mock.On("SetApprovalTimeout", timeout, approve)}
+}
+
+func (_c *UCLPCServerInterface_SetApprovalTimeout_Call) Run(run func(timeout time.Duration, approve bool)) *UCLPCServerInterface_SetApprovalTimeout_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(time.Duration), args[1].(bool))
+	})
+	return _c
+}
+
+func (_c *UCLPCServerInterface_SetApprovalTimeout_Call) Return(resultErr error) *UCLPCServerInterface_SetApprovalTimeout_Call {
+	_c.Call.Return(resultErr)
+	return _c
+}
+
+func (_c *UCLPCServerInterface_SetApprovalTimeout_Call) RunAndReturn(run func(time.Duration, bool) error) *UCLPCServerInterface_SetApprovalTimeout_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetConsumptionLimit provides a mock function with given fields: limit
 func (_m *UCLPCServerInterface) SetConsumptionLimit(limit api.LoadLimit) error {
 	ret := _m.Called(limit)
@@ -566,6 +890,85 @@ func (_c *UCLPCServerInterface_SetFailsafeDurationMinimum_Call) RunAndReturn(run
 	return _c
 }
 
+// SetQuirkRegistry provides a mock function with given fields: registry
+func (_m *UCLPCServerInterface) SetQuirkRegistry(registry api.QuirkRegistryInterface) error {
+	ret := _m.Called(registry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetQuirkRegistry")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(api.QuirkRegistryInterface) error); ok {
+		r0 = rf(registry)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UCLPCServerInterface_SetQuirkRegistry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetQuirkRegistry'
+type UCLPCServerInterface_SetQuirkRegistry_Call struct {
+	*mock.Call
+}
+
+// SetQuirkRegistry is a helper method to define mock.On call
+//   - registry api.QuirkRegistryInterface
+func (_e *UCLPCServerInterface_Expecter) SetQuirkRegistry(registry interface{}) *UCLPCServerInterface_SetQuirkRegistry_Call {
+	return &UCLPCServerInterface_SetQuirkRegistry_Call{Call: _e.mock.On("SetQuirkRegistry", registry)}
+}
+
+func (_c *UCLPCServerInterface_SetQuirkRegistry_Call) Run(run func(registry api.QuirkRegistryInterface)) *UCLPCServerInterface_SetQuirkRegistry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(api.QuirkRegistryInterface))
+	})
+	return _c
+}
+
+func (_c *UCLPCServerInterface_SetQuirkRegistry_Call) Return(resultErr error) *UCLPCServerInterface_SetQuirkRegistry_Call {
+	_c.Call.Return(resultErr)
+	return _c
+}
+
+func (_c *UCLPCServerInterface_SetQuirkRegistry_Call) RunAndReturn(run func(api.QuirkRegistryInterface) error) *UCLPCServerInterface_SetQuirkRegistry_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetStore provides a mock function with given fields: store
+func (_m *UCLPCServerInterface) SetStore(store api.StoreInterface) {
+	_m.Called(store)
+}
+
+// UCLPCServerInterface_SetStore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetStore'
+type UCLPCServerInterface_SetStore_Call struct {
+	*mock.Call
+}
+
+// SetStore is a helper method to define mock.On call
+//   - store api.StoreInterface
+func (_e *UCLPCServerInterface_Expecter) SetStore(store interface{}) *UCLPCServerInterface_SetStore_Call {
+	return &UCLPCServerInterface_SetStore_Call{Call: _e.mock.On("SetStore", store)}
+}
+
+func (_c *UCLPCServerInterface_SetStore_Call) Run(run func(store api.StoreInterface)) *UCLPCServerInterface_SetStore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(api.StoreInterface))
+	})
+	return _c
+}
+
+func (_c *UCLPCServerInterface_SetStore_Call) Return() *UCLPCServerInterface_SetStore_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *UCLPCServerInterface_SetStore_Call) RunAndReturn(run func(api.StoreInterface)) *UCLPCServerInterface_SetStore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateUseCaseAvailability provides a mock function with given fields: available
 func (_m *UCLPCServerInterface) UpdateUseCaseAvailability(available bool) {
 	_m.Called(available)
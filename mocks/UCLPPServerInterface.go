@@ -3,13 +3,13 @@
 package mocks
 
 import (
-	cemdapi "github.com/enbility/cemd/api"
-	api "github.com/enbility/spine-go/api"
-
+	api "github.com/enbility/cemd/api"
 	mock "github.com/stretchr/testify/mock"
 
 	model "github.com/enbility/spine-go/model"
 
+	spine_goapi "github.com/enbility/spine-go/api"
+
 	time "time"
 )
 
@@ -90,6 +90,41 @@ func (_c *UCLPPServerInterface_AddUseCase_Call) RunAndReturn(run func()) *UCLPPS
 	return _c
 }
 
+// ApproveOrDenyProductionLimit provides a mock function with given fields: msgCounter, approve, reason
+func (_m *UCLPPServerInterface) ApproveOrDenyProductionLimit(msgCounter model.MsgCounterType, approve bool, reason string) {
+	_m.Called(msgCounter, approve, reason)
+}
+
+// UCLPPServerInterface_ApproveOrDenyProductionLimit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ApproveOrDenyProductionLimit'
+type UCLPPServerInterface_ApproveOrDenyProductionLimit_Call struct {
+	*mock.Call
+}
+
+// ApproveOrDenyProductionLimit is a helper method to define mock.On call
+//   - msgCounter model.MsgCounterType
+//   - approve bool
+//   - reason string
+func (_e *UCLPPServerInterface_Expecter) ApproveOrDenyProductionLimit(msgCounter interface{}, approve interface{}, reason interface{}) *UCLPPServerInterface_ApproveOrDenyProductionLimit_Call {
+	return &UCLPPServerInterface_ApproveOrDenyProductionLimit_Call{Call: _e.mock.On("ApproveOrDenyProductionLimit", msgCounter, approve, reason)}
+}
+
+func (_c *UCLPPServerInterface_ApproveOrDenyProductionLimit_Call) Run(run func(msgCounter model.MsgCounterType, approve bool, reason string)) *UCLPPServerInterface_ApproveOrDenyProductionLimit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(model.MsgCounterType), args[1].(bool), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *UCLPPServerInterface_ApproveOrDenyProductionLimit_Call) Return() *UCLPPServerInterface_ApproveOrDenyProductionLimit_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *UCLPPServerInterface_ApproveOrDenyProductionLimit_Call) RunAndReturn(run func(model.MsgCounterType, bool, string)) *UCLPPServerInterface_ApproveOrDenyProductionLimit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ContractualProductionNominalMax provides a mock function with given fields:
 func (_m *UCLPPServerInterface) ContractualProductionNominalMax() (float64, error) {
 	ret := _m.Called()
@@ -145,6 +180,113 @@ func (_c *UCLPPServerInterface_ContractualProductionNominalMax_Call) RunAndRetur
 	return _c
 }
 
+// ControllableSystemState provides a mock function with given fields:
+func (_m *UCLPPServerInterface) ControllableSystemState() api.ControllableSystemStateType {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ControllableSystemState")
+	}
+
+	var r0 api.ControllableSystemStateType
+	if rf, ok := ret.Get(0).(func() api.ControllableSystemStateType); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(api.ControllableSystemStateType)
+	}
+
+	return r0
+}
+
+// UCLPPServerInterface_ControllableSystemState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ControllableSystemState'
+type UCLPPServerInterface_ControllableSystemState_Call struct {
+	*mock.Call
+}
+
+// ControllableSystemState is a helper method to define mock.On call
+func (_e *UCLPPServerInterface_Expecter) ControllableSystemState() *UCLPPServerInterface_ControllableSystemState_Call {
+	return &UCLPPServerInterface_ControllableSystemState_Call{Call: _e.mock.On("ControllableSystemState")}
+}
+
+func (_c *UCLPPServerInterface_ControllableSystemState_Call) Run(run func()) *UCLPPServerInterface_ControllableSystemState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *UCLPPServerInterface_ControllableSystemState_Call) Return(_a0 api.ControllableSystemStateType) *UCLPPServerInterface_ControllableSystemState_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UCLPPServerInterface_ControllableSystemState_Call) RunAndReturn(run func() api.ControllableSystemStateType) *UCLPPServerInterface_ControllableSystemState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EffectiveProductionLimit provides a mock function with given fields:
+func (_m *UCLPPServerInterface) EffectiveProductionLimit() (float64, bool, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for EffectiveProductionLimit")
+	}
+
+	var r0 float64
+	var r1 bool
+	var r2 error
+	if rf, ok := ret.Get(0).(func() (float64, bool, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() float64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(float64)
+	}
+
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	if rf, ok := ret.Get(2).(func() error); ok {
+		r2 = rf()
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// UCLPPServerInterface_EffectiveProductionLimit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EffectiveProductionLimit'
+type UCLPPServerInterface_EffectiveProductionLimit_Call struct {
+	*mock.Call
+}
+
+// EffectiveProductionLimit is a helper method to define mock.On call
+func (_e *UCLPPServerInterface_Expecter) EffectiveProductionLimit() *UCLPPServerInterface_EffectiveProductionLimit_Call {
+	return &UCLPPServerInterface_EffectiveProductionLimit_Call{Call: _e.mock.On("EffectiveProductionLimit")}
+}
+
+func (_c *UCLPPServerInterface_EffectiveProductionLimit_Call) Run(run func()) *UCLPPServerInterface_EffectiveProductionLimit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *UCLPPServerInterface_EffectiveProductionLimit_Call) Return(value float64, isLimited bool, resultErr error) *UCLPPServerInterface_EffectiveProductionLimit_Call {
+	_c.Call.Return(value, isLimited, resultErr)
+	return _c
+}
+
+func (_c *UCLPPServerInterface_EffectiveProductionLimit_Call) RunAndReturn(run func() (float64, bool, error)) *UCLPPServerInterface_EffectiveProductionLimit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FailsafeDurationMinimum provides a mock function with given fields:
 func (_m *UCLPPServerInterface) FailsafeDurationMinimum() (time.Duration, bool, error) {
 	ret := _m.Called()
@@ -270,7 +412,7 @@ func (_c *UCLPPServerInterface_FailsafeProductionActivePowerLimit_Call) RunAndRe
 }
 
 // IsUseCaseSupported provides a mock function with given fields: remoteEntity
-func (_m *UCLPPServerInterface) IsUseCaseSupported(remoteEntity api.EntityRemoteInterface) (bool, error) {
+func (_m *UCLPPServerInterface) IsUseCaseSupported(remoteEntity spine_goapi.EntityRemoteInterface) (bool, error) {
 	ret := _m.Called(remoteEntity)
 
 	if len(ret) == 0 {
@@ -279,16 +421,16 @@ func (_m *UCLPPServerInterface) IsUseCaseSupported(remoteEntity api.EntityRemote
 
 	var r0 bool
 	var r1 error
-	if rf, ok := ret.Get(0).(func(api.EntityRemoteInterface) (bool, error)); ok {
+	if rf, ok := ret.Get(0).(func(spine_goapi.EntityRemoteInterface) (bool, error)); ok {
 		return rf(remoteEntity)
 	}
-	if rf, ok := ret.Get(0).(func(api.EntityRemoteInterface) bool); ok {
+	if rf, ok := ret.Get(0).(func(spine_goapi.EntityRemoteInterface) bool); ok {
 		r0 = rf(remoteEntity)
 	} else {
 		r0 = ret.Get(0).(bool)
 	}
 
-	if rf, ok := ret.Get(1).(func(api.EntityRemoteInterface) error); ok {
+	if rf, ok := ret.Get(1).(func(spine_goapi.EntityRemoteInterface) error); ok {
 		r1 = rf(remoteEntity)
 	} else {
 		r1 = ret.Error(1)
@@ -303,14 +445,14 @@ type UCLPPServerInterface_IsUseCaseSupported_Call struct {
 }
 
 // IsUseCaseSupported is a helper method to define mock.On call
-//   - remoteEntity api.EntityRemoteInterface
+//   - remoteEntity spine_goapi.EntityRemoteInterface
 func (_e *UCLPPServerInterface_Expecter) IsUseCaseSupported(remoteEntity interface{}) *UCLPPServerInterface_IsUseCaseSupported_Call {
 	return &UCLPPServerInterface_IsUseCaseSupported_Call{Call: _e.mock.On("IsUseCaseSupported", remoteEntity)}
 }
 
-func (_c *UCLPPServerInterface_IsUseCaseSupported_Call) Run(run func(remoteEntity api.EntityRemoteInterface)) *UCLPPServerInterface_IsUseCaseSupported_Call {
+func (_c *UCLPPServerInterface_IsUseCaseSupported_Call) Run(run func(remoteEntity spine_goapi.EntityRemoteInterface)) *UCLPPServerInterface_IsUseCaseSupported_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(api.EntityRemoteInterface))
+		run(args[0].(spine_goapi.EntityRemoteInterface))
 	})
 	return _c
 }
@@ -320,28 +462,75 @@ func (_c *UCLPPServerInterface_IsUseCaseSupported_Call) Return(_a0 bool, _a1 err
 	return _c
 }
 
-func (_c *UCLPPServerInterface_IsUseCaseSupported_Call) RunAndReturn(run func(api.EntityRemoteInterface) (bool, error)) *UCLPPServerInterface_IsUseCaseSupported_Call {
+func (_c *UCLPPServerInterface_IsUseCaseSupported_Call) RunAndReturn(run func(spine_goapi.EntityRemoteInterface) (bool, error)) *UCLPPServerInterface_IsUseCaseSupported_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PendingProductionLimits provides a mock function with given fields:
+func (_m *UCLPPServerInterface) PendingProductionLimits() map[model.MsgCounterType]api.LoadLimit {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for PendingProductionLimits")
+	}
+
+	var r0 map[model.MsgCounterType]api.LoadLimit
+	if rf, ok := ret.Get(0).(func() map[model.MsgCounterType]api.LoadLimit); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[model.MsgCounterType]api.LoadLimit)
+		}
+	}
+
+	return r0
+}
+
+// UCLPPServerInterface_PendingProductionLimits_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PendingProductionLimits'
+type UCLPPServerInterface_PendingProductionLimits_Call struct {
+	*mock.Call
+}
+
+// PendingProductionLimits is a helper method to define mock.On call
+func (_e *UCLPPServerInterface_Expecter) PendingProductionLimits() *UCLPPServerInterface_PendingProductionLimits_Call {
+	return &UCLPPServerInterface_PendingProductionLimits_Call{Call: _e.mock.On("PendingProductionLimits")}
+}
+
+func (_c *UCLPPServerInterface_PendingProductionLimits_Call) Run(run func()) *UCLPPServerInterface_PendingProductionLimits_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *UCLPPServerInterface_PendingProductionLimits_Call) Return(_a0 map[model.MsgCounterType]api.LoadLimit) *UCLPPServerInterface_PendingProductionLimits_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UCLPPServerInterface_PendingProductionLimits_Call) RunAndReturn(run func() map[model.MsgCounterType]api.LoadLimit) *UCLPPServerInterface_PendingProductionLimits_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // ProductionLimit provides a mock function with given fields:
-func (_m *UCLPPServerInterface) ProductionLimit() (cemdapi.LoadLimit, error) {
+func (_m *UCLPPServerInterface) ProductionLimit() (api.LoadLimit, error) {
 	ret := _m.Called()
 
 	if len(ret) == 0 {
 		panic("no return value specified for ProductionLimit")
 	}
 
-	var r0 cemdapi.LoadLimit
+	var r0 api.LoadLimit
 	var r1 error
-	if rf, ok := ret.Get(0).(func() (cemdapi.LoadLimit, error)); ok {
+	if rf, ok := ret.Get(0).(func() (api.LoadLimit, error)); ok {
 		return rf()
 	}
-	if rf, ok := ret.Get(0).(func() cemdapi.LoadLimit); ok {
+	if rf, ok := ret.Get(0).(func() api.LoadLimit); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(cemdapi.LoadLimit)
+		r0 = ret.Get(0).(api.LoadLimit)
 	}
 
 	if rf, ok := ret.Get(1).(func() error); ok {
@@ -370,12 +559,147 @@ func (_c *UCLPPServerInterface_ProductionLimit_Call) Run(run func()) *UCLPPServe
 	return _c
 }
 
-func (_c *UCLPPServerInterface_ProductionLimit_Call) Return(_a0 cemdapi.LoadLimit, _a1 error) *UCLPPServerInterface_ProductionLimit_Call {
+func (_c *UCLPPServerInterface_ProductionLimit_Call) Return(_a0 api.LoadLimit, _a1 error) *UCLPPServerInterface_ProductionLimit_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *UCLPPServerInterface_ProductionLimit_Call) RunAndReturn(run func() (cemdapi.LoadLimit, error)) *UCLPPServerInterface_ProductionLimit_Call {
+func (_c *UCLPPServerInterface_ProductionLimit_Call) RunAndReturn(run func() (api.LoadLimit, error)) *UCLPPServerInterface_ProductionLimit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ProductionLimitRemainingDuration provides a mock function with given fields:
+func (_m *UCLPPServerInterface) ProductionLimitRemainingDuration() (time.Duration, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProductionLimitRemainingDuration")
+	}
+
+	var r0 time.Duration
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (time.Duration, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UCLPPServerInterface_ProductionLimitRemainingDuration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProductionLimitRemainingDuration'
+type UCLPPServerInterface_ProductionLimitRemainingDuration_Call struct {
+	*mock.Call
+}
+
+// ProductionLimitRemainingDuration is a helper method to define mock.On call
+func (_e *UCLPPServerInterface_Expecter) ProductionLimitRemainingDuration() *UCLPPServerInterface_ProductionLimitRemainingDuration_Call {
+	return &UCLPPServerInterface_ProductionLimitRemainingDuration_Call{Call: _e.mock.On("ProductionLimitRemainingDuration")}
+}
+
+func (_c *UCLPPServerInterface_ProductionLimitRemainingDuration_Call) Run(run func()) *UCLPPServerInterface_ProductionLimitRemainingDuration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *UCLPPServerInterface_ProductionLimitRemainingDuration_Call) Return(_a0 time.Duration, _a1 error) *UCLPPServerInterface_ProductionLimitRemainingDuration_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UCLPPServerInterface_ProductionLimitRemainingDuration_Call) RunAndReturn(run func() (time.Duration, error)) *UCLPPServerInterface_ProductionLimitRemainingDuration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetApprovalPolicy provides a mock function with given fields: policy
+func (_m *UCLPPServerInterface) SetApprovalPolicy(policy api.ApprovalPolicyInterface) {
+	_m.Called(policy)
+}
+
+// UCLPPServerInterface_SetApprovalPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetApprovalPolicy'
+type UCLPPServerInterface_SetApprovalPolicy_Call struct {
+	*mock.Call
+}
+
+// SetApprovalPolicy is a helper method to define mock.On call
+//   - policy api.ApprovalPolicyInterface
+func (_e *UCLPPServerInterface_Expecter) SetApprovalPolicy(policy interface{}) *UCLPPServerInterface_SetApprovalPolicy_Call {
+	return &UCLPPServerInterface_SetApprovalPolicy_Call{Call: _e.mock.On("SetApprovalPolicy", policy)}
+}
+
+func (_c *UCLPPServerInterface_SetApprovalPolicy_Call) Run(run func(policy api.ApprovalPolicyInterface)) *UCLPPServerInterface_SetApprovalPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(api.ApprovalPolicyInterface))
+	})
+	return _c
+}
+
+func (_c *UCLPPServerInterface_SetApprovalPolicy_Call) Return() *UCLPPServerInterface_SetApprovalPolicy_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *UCLPPServerInterface_SetApprovalPolicy_Call) RunAndReturn(run func(api.ApprovalPolicyInterface)) *UCLPPServerInterface_SetApprovalPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetApprovalTimeout provides a mock function with given fields: timeout, approve
+func (_m *UCLPPServerInterface) SetApprovalTimeout(timeout time.Duration, approve bool) error {
+	ret := _m.Called(timeout, approve)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetApprovalTimeout")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(time.Duration, bool) error); ok {
+		r0 = rf(timeout, approve)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UCLPPServerInterface_SetApprovalTimeout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetApprovalTimeout'
+type UCLPPServerInterface_SetApprovalTimeout_Call struct {
+	*mock.Call
+}
+
+// SetApprovalTimeout is a helper method to define mock.On call
+//   - timeout time.Duration
+//   - approve bool
+func (_e *UCLPPServerInterface_Expecter) SetApprovalTimeout(timeout interface{}, approve interface{}) *UCLPPServerInterface_SetApprovalTimeout_Call {
+	return &UCLPPServerInterface_SetApprovalTimeout_Call{Call: _e.mock.On("SetApprovalTimeout", timeout, approve)}
+}
+
+func (_c *UCLPPServerInterface_SetApprovalTimeout_Call) Run(run func(timeout time.Duration, approve bool)) *UCLPPServerInterface_SetApprovalTimeout_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(time.Duration), args[1].(bool))
+	})
+	return _c
+}
+
+func (_c *UCLPPServerInterface_SetApprovalTimeout_Call) Return(resultErr error) *UCLPPServerInterface_SetApprovalTimeout_Call {
+	_c.Call.Return(resultErr)
+	return _c
+}
+
+func (_c *UCLPPServerInterface_SetApprovalTimeout_Call) RunAndReturn(run func(time.Duration, bool) error) *UCLPPServerInterface_SetApprovalTimeout_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -521,7 +845,7 @@ func (_c *UCLPPServerInterface_SetFailsafeProductionActivePowerLimit_Call) RunAn
 }
 
 // SetProductionLimit provides a mock function with given fields: limit
-func (_m *UCLPPServerInterface) SetProductionLimit(limit cemdapi.LoadLimit) error {
+func (_m *UCLPPServerInterface) SetProductionLimit(limit api.LoadLimit) error {
 	ret := _m.Called(limit)
 
 	if len(ret) == 0 {
@@ -529,7 +853,7 @@ func (_m *UCLPPServerInterface) SetProductionLimit(limit cemdapi.LoadLimit) erro
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(cemdapi.LoadLimit) error); ok {
+	if rf, ok := ret.Get(0).(func(api.LoadLimit) error); ok {
 		r0 = rf(limit)
 	} else {
 		r0 = ret.Error(0)
@@ -544,14 +868,14 @@ type UCLPPServerInterface_SetProductionLimit_Call struct {
 }
 
 // SetProductionLimit is a helper method to define mock.On call
-//   - limit cemdapi.LoadLimit
+//   - limit api.LoadLimit
 func (_e *UCLPPServerInterface_Expecter) SetProductionLimit(limit interface{}) *UCLPPServerInterface_SetProductionLimit_Call {
 	return &UCLPPServerInterface_SetProductionLimit_Call{Call: _e.mock.On("SetProductionLimit", limit)}
 }
 
-func (_c *UCLPPServerInterface_SetProductionLimit_Call) Run(run func(limit cemdapi.LoadLimit)) *UCLPPServerInterface_SetProductionLimit_Call {
+func (_c *UCLPPServerInterface_SetProductionLimit_Call) Run(run func(limit api.LoadLimit)) *UCLPPServerInterface_SetProductionLimit_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(cemdapi.LoadLimit))
+		run(args[0].(api.LoadLimit))
 	})
 	return _c
 }
@@ -561,7 +885,86 @@ func (_c *UCLPPServerInterface_SetProductionLimit_Call) Return(resultErr error)
 	return _c
 }
 
-func (_c *UCLPPServerInterface_SetProductionLimit_Call) RunAndReturn(run func(cemdapi.LoadLimit) error) *UCLPPServerInterface_SetProductionLimit_Call {
+func (_c *UCLPPServerInterface_SetProductionLimit_Call) RunAndReturn(run func(api.LoadLimit) error) *UCLPPServerInterface_SetProductionLimit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetQuirkRegistry provides a mock function with given fields: registry
+func (_m *UCLPPServerInterface) SetQuirkRegistry(registry api.QuirkRegistryInterface) error {
+	ret := _m.Called(registry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetQuirkRegistry")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(api.QuirkRegistryInterface) error); ok {
+		r0 = rf(registry)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UCLPPServerInterface_SetQuirkRegistry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetQuirkRegistry'
+type UCLPPServerInterface_SetQuirkRegistry_Call struct {
+	*mock.Call
+}
+
+// SetQuirkRegistry is a helper method to define mock.On call
+//   - registry api.QuirkRegistryInterface
+func (_e *UCLPPServerInterface_Expecter) SetQuirkRegistry(registry interface{}) *UCLPPServerInterface_SetQuirkRegistry_Call {
+	return &UCLPPServerInterface_SetQuirkRegistry_Call{Call: _e.mock.On("SetQuirkRegistry", registry)}
+}
+
+func (_c *UCLPPServerInterface_SetQuirkRegistry_Call) Run(run func(registry api.QuirkRegistryInterface)) *UCLPPServerInterface_SetQuirkRegistry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(api.QuirkRegistryInterface))
+	})
+	return _c
+}
+
+func (_c *UCLPPServerInterface_SetQuirkRegistry_Call) Return(resultErr error) *UCLPPServerInterface_SetQuirkRegistry_Call {
+	_c.Call.Return(resultErr)
+	return _c
+}
+
+func (_c *UCLPPServerInterface_SetQuirkRegistry_Call) RunAndReturn(run func(api.QuirkRegistryInterface) error) *UCLPPServerInterface_SetQuirkRegistry_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetStore provides a mock function with given fields: store
+func (_m *UCLPPServerInterface) SetStore(store api.StoreInterface) {
+	_m.Called(store)
+}
+
+// UCLPPServerInterface_SetStore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetStore'
+type UCLPPServerInterface_SetStore_Call struct {
+	*mock.Call
+}
+
+// SetStore is a helper method to define mock.On call
+//   - store api.StoreInterface
+func (_e *UCLPPServerInterface_Expecter) SetStore(store interface{}) *UCLPPServerInterface_SetStore_Call {
+	return &UCLPPServerInterface_SetStore_Call{Call: _e.mock.On("SetStore", store)}
+}
+
+func (_c *UCLPPServerInterface_SetStore_Call) Run(run func(store api.StoreInterface)) *UCLPPServerInterface_SetStore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(api.StoreInterface))
+	})
+	return _c
+}
+
+func (_c *UCLPPServerInterface_SetStore_Call) Return() *UCLPPServerInterface_SetStore_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *UCLPPServerInterface_SetStore_Call) RunAndReturn(run func(api.StoreInterface)) *UCLPPServerInterface_SetStore_Call {
 	_c.Call.Return(run)
 	return _c
 }
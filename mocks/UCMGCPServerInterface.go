@@ -0,0 +1,557 @@
+// Code generated by mockery v2.42.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	api "github.com/enbility/spine-go/api"
+	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/enbility/spine-go/model"
+)
+
+// UCMGCPServerInterface is an autogenerated mock type for the UCMGCPServerInterface type
+type UCMGCPServerInterface struct {
+	mock.Mock
+}
+
+type UCMGCPServerInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *UCMGCPServerInterface) EXPECT() *UCMGCPServerInterface_Expecter {
+	return &UCMGCPServerInterface_Expecter{mock: &_m.Mock}
+}
+
+// AddFeatures provides a mock function with given fields:
+func (_m *UCMGCPServerInterface) AddFeatures() {
+	_m.Called()
+}
+
+// UCMGCPServerInterface_AddFeatures_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddFeatures'
+type UCMGCPServerInterface_AddFeatures_Call struct {
+	*mock.Call
+}
+
+// AddFeatures is a helper method to define mock.On call
+func (_e *UCMGCPServerInterface_Expecter) AddFeatures() *UCMGCPServerInterface_AddFeatures_Call {
+	return &UCMGCPServerInterface_AddFeatures_Call{Call: _e.mock.On("AddFeatures")}
+}
+
+func (_c *UCMGCPServerInterface_AddFeatures_Call) Run(run func()) *UCMGCPServerInterface_AddFeatures_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *UCMGCPServerInterface_AddFeatures_Call) Return() *UCMGCPServerInterface_AddFeatures_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *UCMGCPServerInterface_AddFeatures_Call) RunAndReturn(run func()) *UCMGCPServerInterface_AddFeatures_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddUseCase provides a mock function with given fields:
+func (_m *UCMGCPServerInterface) AddUseCase() {
+	_m.Called()
+}
+
+// UCMGCPServerInterface_AddUseCase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddUseCase'
+type UCMGCPServerInterface_AddUseCase_Call struct {
+	*mock.Call
+}
+
+// AddUseCase is a helper method to define mock.On call
+func (_e *UCMGCPServerInterface_Expecter) AddUseCase() *UCMGCPServerInterface_AddUseCase_Call {
+	return &UCMGCPServerInterface_AddUseCase_Call{Call: _e.mock.On("AddUseCase")}
+}
+
+func (_c *UCMGCPServerInterface_AddUseCase_Call) Run(run func()) *UCMGCPServerInterface_AddUseCase_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *UCMGCPServerInterface_AddUseCase_Call) Return() *UCMGCPServerInterface_AddUseCase_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *UCMGCPServerInterface_AddUseCase_Call) RunAndReturn(run func()) *UCMGCPServerInterface_AddUseCase_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsUseCaseSupported provides a mock function with given fields: remoteEntity
+func (_m *UCMGCPServerInterface) IsUseCaseSupported(remoteEntity api.EntityRemoteInterface) (bool, error) {
+	ret := _m.Called(remoteEntity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsUseCaseSupported")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(api.EntityRemoteInterface) (bool, error)); ok {
+		return rf(remoteEntity)
+	}
+	if rf, ok := ret.Get(0).(func(api.EntityRemoteInterface) bool); ok {
+		r0 = rf(remoteEntity)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(api.EntityRemoteInterface) error); ok {
+		r1 = rf(remoteEntity)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UCMGCPServerInterface_IsUseCaseSupported_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsUseCaseSupported'
+type UCMGCPServerInterface_IsUseCaseSupported_Call struct {
+	*mock.Call
+}
+
+// IsUseCaseSupported is a helper method to define mock.On call
+//   - remoteEntity api.EntityRemoteInterface
+func (_e *UCMGCPServerInterface_Expecter) IsUseCaseSupported(remoteEntity interface{}) *UCMGCPServerInterface_IsUseCaseSupported_Call {
+	return &UCMGCPServerInterface_IsUseCaseSupported_Call{Call: _e.mock.On("IsUseCaseSupported", remoteEntity)}
+}
+
+func (_c *UCMGCPServerInterface_IsUseCaseSupported_Call) Run(run func(remoteEntity api.EntityRemoteInterface)) *UCMGCPServerInterface_IsUseCaseSupported_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(api.EntityRemoteInterface))
+	})
+	return _c
+}
+
+func (_c *UCMGCPServerInterface_IsUseCaseSupported_Call) Return(_a0 bool, _a1 error) *UCMGCPServerInterface_IsUseCaseSupported_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UCMGCPServerInterface_IsUseCaseSupported_Call) RunAndReturn(run func(api.EntityRemoteInterface) (bool, error)) *UCMGCPServerInterface_IsUseCaseSupported_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetCurrentPerPhase provides a mock function with given fields: values
+func (_m *UCMGCPServerInterface) SetCurrentPerPhase(values []float64) error {
+	ret := _m.Called(values)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetCurrentPerPhase")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]float64) error); ok {
+		r0 = rf(values)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UCMGCPServerInterface_SetCurrentPerPhase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetCurrentPerPhase'
+type UCMGCPServerInterface_SetCurrentPerPhase_Call struct {
+	*mock.Call
+}
+
+// SetCurrentPerPhase is a helper method to define mock.On call
+//   - values []float64
+func (_e *UCMGCPServerInterface_Expecter) SetCurrentPerPhase(values interface{}) *UCMGCPServerInterface_SetCurrentPerPhase_Call {
+	return &UCMGCPServerInterface_SetCurrentPerPhase_Call{Call: _e.mock.On("SetCurrentPerPhase", values)}
+}
+
+func (_c *UCMGCPServerInterface_SetCurrentPerPhase_Call) Run(run func(values []float64)) *UCMGCPServerInterface_SetCurrentPerPhase_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]float64))
+	})
+	return _c
+}
+
+func (_c *UCMGCPServerInterface_SetCurrentPerPhase_Call) Return(resultErr error) *UCMGCPServerInterface_SetCurrentPerPhase_Call {
+	_c.Call.Return(resultErr)
+	return _c
+}
+
+func (_c *UCMGCPServerInterface_SetCurrentPerPhase_Call) RunAndReturn(run func([]float64) error) *UCMGCPServerInterface_SetCurrentPerPhase_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetEnergyConsumed provides a mock function with given fields: value
+func (_m *UCMGCPServerInterface) SetEnergyConsumed(value float64) error {
+	ret := _m.Called(value)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetEnergyConsumed")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(float64) error); ok {
+		r0 = rf(value)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UCMGCPServerInterface_SetEnergyConsumed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetEnergyConsumed'
+type UCMGCPServerInterface_SetEnergyConsumed_Call struct {
+	*mock.Call
+}
+
+// SetEnergyConsumed is a helper method to define mock.On call
+//   - value float64
+func (_e *UCMGCPServerInterface_Expecter) SetEnergyConsumed(value interface{}) *UCMGCPServerInterface_SetEnergyConsumed_Call {
+	return &UCMGCPServerInterface_SetEnergyConsumed_Call{Call: _e.mock.On("SetEnergyConsumed", value)}
+}
+
+func (_c *UCMGCPServerInterface_SetEnergyConsumed_Call) Run(run func(value float64)) *UCMGCPServerInterface_SetEnergyConsumed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(float64))
+	})
+	return _c
+}
+
+func (_c *UCMGCPServerInterface_SetEnergyConsumed_Call) Return(resultErr error) *UCMGCPServerInterface_SetEnergyConsumed_Call {
+	_c.Call.Return(resultErr)
+	return _c
+}
+
+func (_c *UCMGCPServerInterface_SetEnergyConsumed_Call) RunAndReturn(run func(float64) error) *UCMGCPServerInterface_SetEnergyConsumed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetEnergyFeedIn provides a mock function with given fields: value
+func (_m *UCMGCPServerInterface) SetEnergyFeedIn(value float64) error {
+	ret := _m.Called(value)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetEnergyFeedIn")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(float64) error); ok {
+		r0 = rf(value)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UCMGCPServerInterface_SetEnergyFeedIn_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetEnergyFeedIn'
+type UCMGCPServerInterface_SetEnergyFeedIn_Call struct {
+	*mock.Call
+}
+
+// SetEnergyFeedIn is a helper method to define mock.On call
+//   - value float64
+func (_e *UCMGCPServerInterface_Expecter) SetEnergyFeedIn(value interface{}) *UCMGCPServerInterface_SetEnergyFeedIn_Call {
+	return &UCMGCPServerInterface_SetEnergyFeedIn_Call{Call: _e.mock.On("SetEnergyFeedIn", value)}
+}
+
+func (_c *UCMGCPServerInterface_SetEnergyFeedIn_Call) Run(run func(value float64)) *UCMGCPServerInterface_SetEnergyFeedIn_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(float64))
+	})
+	return _c
+}
+
+func (_c *UCMGCPServerInterface_SetEnergyFeedIn_Call) Return(resultErr error) *UCMGCPServerInterface_SetEnergyFeedIn_Call {
+	_c.Call.Return(resultErr)
+	return _c
+}
+
+func (_c *UCMGCPServerInterface_SetEnergyFeedIn_Call) RunAndReturn(run func(float64) error) *UCMGCPServerInterface_SetEnergyFeedIn_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetFrequency provides a mock function with given fields: value
+func (_m *UCMGCPServerInterface) SetFrequency(value float64) error {
+	ret := _m.Called(value)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetFrequency")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(float64) error); ok {
+		r0 = rf(value)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UCMGCPServerInterface_SetFrequency_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetFrequency'
+type UCMGCPServerInterface_SetFrequency_Call struct {
+	*mock.Call
+}
+
+// SetFrequency is a helper method to define mock.On call
+//   - value float64
+func (_e *UCMGCPServerInterface_Expecter) SetFrequency(value interface{}) *UCMGCPServerInterface_SetFrequency_Call {
+	return &UCMGCPServerInterface_SetFrequency_Call{Call: _e.mock.On("SetFrequency", value)}
+}
+
+func (_c *UCMGCPServerInterface_SetFrequency_Call) Run(run func(value float64)) *UCMGCPServerInterface_SetFrequency_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(float64))
+	})
+	return _c
+}
+
+func (_c *UCMGCPServerInterface_SetFrequency_Call) Return(resultErr error) *UCMGCPServerInterface_SetFrequency_Call {
+	_c.Call.Return(resultErr)
+	return _c
+}
+
+func (_c *UCMGCPServerInterface_SetFrequency_Call) RunAndReturn(run func(float64) error) *UCMGCPServerInterface_SetFrequency_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetPower provides a mock function with given fields: value
+func (_m *UCMGCPServerInterface) SetPower(value float64) error {
+	ret := _m.Called(value)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetPower")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(float64) error); ok {
+		r0 = rf(value)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UCMGCPServerInterface_SetPower_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetPower'
+type UCMGCPServerInterface_SetPower_Call struct {
+	*mock.Call
+}
+
+// SetPower is a helper method to define mock.On call
+//   - value float64
+func (_e *UCMGCPServerInterface_Expecter) SetPower(value interface{}) *UCMGCPServerInterface_SetPower_Call {
+	return &UCMGCPServerInterface_SetPower_Call{Call: _e.mock.On("SetPower", value)}
+}
+
+func (_c *UCMGCPServerInterface_SetPower_Call) Run(run func(value float64)) *UCMGCPServerInterface_SetPower_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(float64))
+	})
+	return _c
+}
+
+func (_c *UCMGCPServerInterface_SetPower_Call) Return(resultErr error) *UCMGCPServerInterface_SetPower_Call {
+	_c.Call.Return(resultErr)
+	return _c
+}
+
+func (_c *UCMGCPServerInterface_SetPower_Call) RunAndReturn(run func(float64) error) *UCMGCPServerInterface_SetPower_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetPowerLimitationFactor provides a mock function with given fields: value
+func (_m *UCMGCPServerInterface) SetPowerLimitationFactor(value float64) error {
+	ret := _m.Called(value)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetPowerLimitationFactor")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(float64) error); ok {
+		r0 = rf(value)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UCMGCPServerInterface_SetPowerLimitationFactor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetPowerLimitationFactor'
+type UCMGCPServerInterface_SetPowerLimitationFactor_Call struct {
+	*mock.Call
+}
+
+// SetPowerLimitationFactor is a helper method to define mock.On call
+//   - value float64
+func (_e *UCMGCPServerInterface_Expecter) SetPowerLimitationFactor(value interface{}) *UCMGCPServerInterface_SetPowerLimitationFactor_Call {
+	return &UCMGCPServerInterface_SetPowerLimitationFactor_Call{Call: _e.mock.On("SetPowerLimitationFactor", value)}
+}
+
+func (_c *UCMGCPServerInterface_SetPowerLimitationFactor_Call) Run(run func(value float64)) *UCMGCPServerInterface_SetPowerLimitationFactor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(float64))
+	})
+	return _c
+}
+
+func (_c *UCMGCPServerInterface_SetPowerLimitationFactor_Call) Return(resultErr error) *UCMGCPServerInterface_SetPowerLimitationFactor_Call {
+	_c.Call.Return(resultErr)
+	return _c
+}
+
+func (_c *UCMGCPServerInterface_SetPowerLimitationFactor_Call) RunAndReturn(run func(float64) error) *UCMGCPServerInterface_SetPowerLimitationFactor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetVoltagePerPhase provides a mock function with given fields: values
+func (_m *UCMGCPServerInterface) SetVoltagePerPhase(values []float64) error {
+	ret := _m.Called(values)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetVoltagePerPhase")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]float64) error); ok {
+		r0 = rf(values)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UCMGCPServerInterface_SetVoltagePerPhase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetVoltagePerPhase'
+type UCMGCPServerInterface_SetVoltagePerPhase_Call struct {
+	*mock.Call
+}
+
+// SetVoltagePerPhase is a helper method to define mock.On call
+//   - values []float64
+func (_e *UCMGCPServerInterface_Expecter) SetVoltagePerPhase(values interface{}) *UCMGCPServerInterface_SetVoltagePerPhase_Call {
+	return &UCMGCPServerInterface_SetVoltagePerPhase_Call{Call: _e.mock.On("SetVoltagePerPhase", values)}
+}
+
+func (_c *UCMGCPServerInterface_SetVoltagePerPhase_Call) Run(run func(values []float64)) *UCMGCPServerInterface_SetVoltagePerPhase_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]float64))
+	})
+	return _c
+}
+
+func (_c *UCMGCPServerInterface_SetVoltagePerPhase_Call) Return(resultErr error) *UCMGCPServerInterface_SetVoltagePerPhase_Call {
+	_c.Call.Return(resultErr)
+	return _c
+}
+
+func (_c *UCMGCPServerInterface_SetVoltagePerPhase_Call) RunAndReturn(run func([]float64) error) *UCMGCPServerInterface_SetVoltagePerPhase_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateUseCaseAvailability provides a mock function with given fields: available
+func (_m *UCMGCPServerInterface) UpdateUseCaseAvailability(available bool) {
+	_m.Called(available)
+}
+
+// UCMGCPServerInterface_UpdateUseCaseAvailability_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUseCaseAvailability'
+type UCMGCPServerInterface_UpdateUseCaseAvailability_Call struct {
+	*mock.Call
+}
+
+// UpdateUseCaseAvailability is a helper method to define mock.On call
+//   - available bool
+func (_e *UCMGCPServerInterface_Expecter) UpdateUseCaseAvailability(available interface{}) *UCMGCPServerInterface_UpdateUseCaseAvailability_Call {
+	return &UCMGCPServerInterface_UpdateUseCaseAvailability_Call{Call: _e.mock.On("UpdateUseCaseAvailability", available)}
+}
+
+func (_c *UCMGCPServerInterface_UpdateUseCaseAvailability_Call) Run(run func(available bool)) *UCMGCPServerInterface_UpdateUseCaseAvailability_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(bool))
+	})
+	return _c
+}
+
+func (_c *UCMGCPServerInterface_UpdateUseCaseAvailability_Call) Return() *UCMGCPServerInterface_UpdateUseCaseAvailability_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *UCMGCPServerInterface_UpdateUseCaseAvailability_Call) RunAndReturn(run func(bool)) *UCMGCPServerInterface_UpdateUseCaseAvailability_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UseCaseName provides a mock function with given fields:
+func (_m *UCMGCPServerInterface) UseCaseName() model.UseCaseNameType {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for UseCaseName")
+	}
+
+	var r0 model.UseCaseNameType
+	if rf, ok := ret.Get(0).(func() model.UseCaseNameType); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(model.UseCaseNameType)
+	}
+
+	return r0
+}
+
+// UCMGCPServerInterface_UseCaseName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UseCaseName'
+type UCMGCPServerInterface_UseCaseName_Call struct {
+	*mock.Call
+}
+
+// UseCaseName is a helper method to define mock.On call
+func (_e *UCMGCPServerInterface_Expecter) UseCaseName() *UCMGCPServerInterface_UseCaseName_Call {
+	return &UCMGCPServerInterface_UseCaseName_Call{Call: _e.mock.On("UseCaseName")}
+}
+
+func (_c *UCMGCPServerInterface_UseCaseName_Call) Run(run func()) *UCMGCPServerInterface_UseCaseName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *UCMGCPServerInterface_UseCaseName_Call) Return(_a0 model.UseCaseNameType) *UCMGCPServerInterface_UseCaseName_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UCMGCPServerInterface_UseCaseName_Call) RunAndReturn(run func() model.UseCaseNameType) *UCMGCPServerInterface_UseCaseName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewUCMGCPServerInterface creates a new instance of UCMGCPServerInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUCMGCPServerInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UCMGCPServerInterface {
+	mock := &UCMGCPServerInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
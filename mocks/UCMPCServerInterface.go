@@ -0,0 +1,557 @@
+// Code generated by mockery v2.42.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	api "github.com/enbility/spine-go/api"
+	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/enbility/spine-go/model"
+)
+
+// UCMPCServerInterface is an autogenerated mock type for the UCMPCServerInterface type
+type UCMPCServerInterface struct {
+	mock.Mock
+}
+
+type UCMPCServerInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *UCMPCServerInterface) EXPECT() *UCMPCServerInterface_Expecter {
+	return &UCMPCServerInterface_Expecter{mock: &_m.Mock}
+}
+
+// AddFeatures provides a mock function with given fields:
+func (_m *UCMPCServerInterface) AddFeatures() {
+	_m.Called()
+}
+
+// UCMPCServerInterface_AddFeatures_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddFeatures'
+type UCMPCServerInterface_AddFeatures_Call struct {
+	*mock.Call
+}
+
+// AddFeatures is a helper method to define mock.On call
+func (_e *UCMPCServerInterface_Expecter) AddFeatures() *UCMPCServerInterface_AddFeatures_Call {
+	return &UCMPCServerInterface_AddFeatures_Call{Call: _e.mock.On("AddFeatures")}
+}
+
+func (_c *UCMPCServerInterface_AddFeatures_Call) Run(run func()) *UCMPCServerInterface_AddFeatures_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *UCMPCServerInterface_AddFeatures_Call) Return() *UCMPCServerInterface_AddFeatures_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *UCMPCServerInterface_AddFeatures_Call) RunAndReturn(run func()) *UCMPCServerInterface_AddFeatures_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddUseCase provides a mock function with given fields:
+func (_m *UCMPCServerInterface) AddUseCase() {
+	_m.Called()
+}
+
+// UCMPCServerInterface_AddUseCase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddUseCase'
+type UCMPCServerInterface_AddUseCase_Call struct {
+	*mock.Call
+}
+
+// AddUseCase is a helper method to define mock.On call
+func (_e *UCMPCServerInterface_Expecter) AddUseCase() *UCMPCServerInterface_AddUseCase_Call {
+	return &UCMPCServerInterface_AddUseCase_Call{Call: _e.mock.On("AddUseCase")}
+}
+
+func (_c *UCMPCServerInterface_AddUseCase_Call) Run(run func()) *UCMPCServerInterface_AddUseCase_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *UCMPCServerInterface_AddUseCase_Call) Return() *UCMPCServerInterface_AddUseCase_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *UCMPCServerInterface_AddUseCase_Call) RunAndReturn(run func()) *UCMPCServerInterface_AddUseCase_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsUseCaseSupported provides a mock function with given fields: remoteEntity
+func (_m *UCMPCServerInterface) IsUseCaseSupported(remoteEntity api.EntityRemoteInterface) (bool, error) {
+	ret := _m.Called(remoteEntity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsUseCaseSupported")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(api.EntityRemoteInterface) (bool, error)); ok {
+		return rf(remoteEntity)
+	}
+	if rf, ok := ret.Get(0).(func(api.EntityRemoteInterface) bool); ok {
+		r0 = rf(remoteEntity)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(api.EntityRemoteInterface) error); ok {
+		r1 = rf(remoteEntity)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UCMPCServerInterface_IsUseCaseSupported_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsUseCaseSupported'
+type UCMPCServerInterface_IsUseCaseSupported_Call struct {
+	*mock.Call
+}
+
+// IsUseCaseSupported is a helper method to define mock.On call
+//   - remoteEntity api.EntityRemoteInterface
+func (_e *UCMPCServerInterface_Expecter) IsUseCaseSupported(remoteEntity interface{}) *UCMPCServerInterface_IsUseCaseSupported_Call {
+	return &UCMPCServerInterface_IsUseCaseSupported_Call{Call: _e.mock.On("IsUseCaseSupported", remoteEntity)}
+}
+
+func (_c *UCMPCServerInterface_IsUseCaseSupported_Call) Run(run func(remoteEntity api.EntityRemoteInterface)) *UCMPCServerInterface_IsUseCaseSupported_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(api.EntityRemoteInterface))
+	})
+	return _c
+}
+
+func (_c *UCMPCServerInterface_IsUseCaseSupported_Call) Return(_a0 bool, _a1 error) *UCMPCServerInterface_IsUseCaseSupported_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UCMPCServerInterface_IsUseCaseSupported_Call) RunAndReturn(run func(api.EntityRemoteInterface) (bool, error)) *UCMPCServerInterface_IsUseCaseSupported_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetCurrentPerPhase provides a mock function with given fields: values
+func (_m *UCMPCServerInterface) SetCurrentPerPhase(values []float64) error {
+	ret := _m.Called(values)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetCurrentPerPhase")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]float64) error); ok {
+		r0 = rf(values)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UCMPCServerInterface_SetCurrentPerPhase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetCurrentPerPhase'
+type UCMPCServerInterface_SetCurrentPerPhase_Call struct {
+	*mock.Call
+}
+
+// SetCurrentPerPhase is a helper method to define mock.On call
+//   - values []float64
+func (_e *UCMPCServerInterface_Expecter) SetCurrentPerPhase(values interface{}) *UCMPCServerInterface_SetCurrentPerPhase_Call {
+	return &UCMPCServerInterface_SetCurrentPerPhase_Call{Call: _e.mock.On("SetCurrentPerPhase", values)}
+}
+
+func (_c *UCMPCServerInterface_SetCurrentPerPhase_Call) Run(run func(values []float64)) *UCMPCServerInterface_SetCurrentPerPhase_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]float64))
+	})
+	return _c
+}
+
+func (_c *UCMPCServerInterface_SetCurrentPerPhase_Call) Return(resultErr error) *UCMPCServerInterface_SetCurrentPerPhase_Call {
+	_c.Call.Return(resultErr)
+	return _c
+}
+
+func (_c *UCMPCServerInterface_SetCurrentPerPhase_Call) RunAndReturn(run func([]float64) error) *UCMPCServerInterface_SetCurrentPerPhase_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetEnergyConsumed provides a mock function with given fields: value
+func (_m *UCMPCServerInterface) SetEnergyConsumed(value float64) error {
+	ret := _m.Called(value)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetEnergyConsumed")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(float64) error); ok {
+		r0 = rf(value)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UCMPCServerInterface_SetEnergyConsumed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetEnergyConsumed'
+type UCMPCServerInterface_SetEnergyConsumed_Call struct {
+	*mock.Call
+}
+
+// SetEnergyConsumed is a helper method to define mock.On call
+//   - value float64
+func (_e *UCMPCServerInterface_Expecter) SetEnergyConsumed(value interface{}) *UCMPCServerInterface_SetEnergyConsumed_Call {
+	return &UCMPCServerInterface_SetEnergyConsumed_Call{Call: _e.mock.On("SetEnergyConsumed", value)}
+}
+
+func (_c *UCMPCServerInterface_SetEnergyConsumed_Call) Run(run func(value float64)) *UCMPCServerInterface_SetEnergyConsumed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(float64))
+	})
+	return _c
+}
+
+func (_c *UCMPCServerInterface_SetEnergyConsumed_Call) Return(resultErr error) *UCMPCServerInterface_SetEnergyConsumed_Call {
+	_c.Call.Return(resultErr)
+	return _c
+}
+
+func (_c *UCMPCServerInterface_SetEnergyConsumed_Call) RunAndReturn(run func(float64) error) *UCMPCServerInterface_SetEnergyConsumed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetEnergyProduced provides a mock function with given fields: value
+func (_m *UCMPCServerInterface) SetEnergyProduced(value float64) error {
+	ret := _m.Called(value)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetEnergyProduced")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(float64) error); ok {
+		r0 = rf(value)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UCMPCServerInterface_SetEnergyProduced_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetEnergyProduced'
+type UCMPCServerInterface_SetEnergyProduced_Call struct {
+	*mock.Call
+}
+
+// SetEnergyProduced is a helper method to define mock.On call
+//   - value float64
+func (_e *UCMPCServerInterface_Expecter) SetEnergyProduced(value interface{}) *UCMPCServerInterface_SetEnergyProduced_Call {
+	return &UCMPCServerInterface_SetEnergyProduced_Call{Call: _e.mock.On("SetEnergyProduced", value)}
+}
+
+func (_c *UCMPCServerInterface_SetEnergyProduced_Call) Run(run func(value float64)) *UCMPCServerInterface_SetEnergyProduced_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(float64))
+	})
+	return _c
+}
+
+func (_c *UCMPCServerInterface_SetEnergyProduced_Call) Return(resultErr error) *UCMPCServerInterface_SetEnergyProduced_Call {
+	_c.Call.Return(resultErr)
+	return _c
+}
+
+func (_c *UCMPCServerInterface_SetEnergyProduced_Call) RunAndReturn(run func(float64) error) *UCMPCServerInterface_SetEnergyProduced_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetFrequency provides a mock function with given fields: value
+func (_m *UCMPCServerInterface) SetFrequency(value float64) error {
+	ret := _m.Called(value)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetFrequency")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(float64) error); ok {
+		r0 = rf(value)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UCMPCServerInterface_SetFrequency_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetFrequency'
+type UCMPCServerInterface_SetFrequency_Call struct {
+	*mock.Call
+}
+
+// SetFrequency is a helper method to define mock.On call
+//   - value float64
+func (_e *UCMPCServerInterface_Expecter) SetFrequency(value interface{}) *UCMPCServerInterface_SetFrequency_Call {
+	return &UCMPCServerInterface_SetFrequency_Call{Call: _e.mock.On("SetFrequency", value)}
+}
+
+func (_c *UCMPCServerInterface_SetFrequency_Call) Run(run func(value float64)) *UCMPCServerInterface_SetFrequency_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(float64))
+	})
+	return _c
+}
+
+func (_c *UCMPCServerInterface_SetFrequency_Call) Return(resultErr error) *UCMPCServerInterface_SetFrequency_Call {
+	_c.Call.Return(resultErr)
+	return _c
+}
+
+func (_c *UCMPCServerInterface_SetFrequency_Call) RunAndReturn(run func(float64) error) *UCMPCServerInterface_SetFrequency_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetPower provides a mock function with given fields: value
+func (_m *UCMPCServerInterface) SetPower(value float64) error {
+	ret := _m.Called(value)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetPower")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(float64) error); ok {
+		r0 = rf(value)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UCMPCServerInterface_SetPower_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetPower'
+type UCMPCServerInterface_SetPower_Call struct {
+	*mock.Call
+}
+
+// SetPower is a helper method to define mock.On call
+//   - value float64
+func (_e *UCMPCServerInterface_Expecter) SetPower(value interface{}) *UCMPCServerInterface_SetPower_Call {
+	return &UCMPCServerInterface_SetPower_Call{Call: _e.mock.On("SetPower", value)}
+}
+
+func (_c *UCMPCServerInterface_SetPower_Call) Run(run func(value float64)) *UCMPCServerInterface_SetPower_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(float64))
+	})
+	return _c
+}
+
+func (_c *UCMPCServerInterface_SetPower_Call) Return(resultErr error) *UCMPCServerInterface_SetPower_Call {
+	_c.Call.Return(resultErr)
+	return _c
+}
+
+func (_c *UCMPCServerInterface_SetPower_Call) RunAndReturn(run func(float64) error) *UCMPCServerInterface_SetPower_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetPowerPerPhase provides a mock function with given fields: values
+func (_m *UCMPCServerInterface) SetPowerPerPhase(values []float64) error {
+	ret := _m.Called(values)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetPowerPerPhase")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]float64) error); ok {
+		r0 = rf(values)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UCMPCServerInterface_SetPowerPerPhase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetPowerPerPhase'
+type UCMPCServerInterface_SetPowerPerPhase_Call struct {
+	*mock.Call
+}
+
+// SetPowerPerPhase is a helper method to define mock.On call
+//   - values []float64
+func (_e *UCMPCServerInterface_Expecter) SetPowerPerPhase(values interface{}) *UCMPCServerInterface_SetPowerPerPhase_Call {
+	return &UCMPCServerInterface_SetPowerPerPhase_Call{Call: _e.mock.On("SetPowerPerPhase", values)}
+}
+
+func (_c *UCMPCServerInterface_SetPowerPerPhase_Call) Run(run func(values []float64)) *UCMPCServerInterface_SetPowerPerPhase_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]float64))
+	})
+	return _c
+}
+
+func (_c *UCMPCServerInterface_SetPowerPerPhase_Call) Return(resultErr error) *UCMPCServerInterface_SetPowerPerPhase_Call {
+	_c.Call.Return(resultErr)
+	return _c
+}
+
+func (_c *UCMPCServerInterface_SetPowerPerPhase_Call) RunAndReturn(run func([]float64) error) *UCMPCServerInterface_SetPowerPerPhase_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetVoltagePerPhase provides a mock function with given fields: values
+func (_m *UCMPCServerInterface) SetVoltagePerPhase(values []float64) error {
+	ret := _m.Called(values)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetVoltagePerPhase")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]float64) error); ok {
+		r0 = rf(values)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UCMPCServerInterface_SetVoltagePerPhase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetVoltagePerPhase'
+type UCMPCServerInterface_SetVoltagePerPhase_Call struct {
+	*mock.Call
+}
+
+// SetVoltagePerPhase is a helper method to define mock.On call
+//   - values []float64
+func (_e *UCMPCServerInterface_Expecter) SetVoltagePerPhase(values interface{}) *UCMPCServerInterface_SetVoltagePerPhase_Call {
+	return &UCMPCServerInterface_SetVoltagePerPhase_Call{Call: _e.mock.On("SetVoltagePerPhase", values)}
+}
+
+func (_c *UCMPCServerInterface_SetVoltagePerPhase_Call) Run(run func(values []float64)) *UCMPCServerInterface_SetVoltagePerPhase_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]float64))
+	})
+	return _c
+}
+
+func (_c *UCMPCServerInterface_SetVoltagePerPhase_Call) Return(resultErr error) *UCMPCServerInterface_SetVoltagePerPhase_Call {
+	_c.Call.Return(resultErr)
+	return _c
+}
+
+func (_c *UCMPCServerInterface_SetVoltagePerPhase_Call) RunAndReturn(run func([]float64) error) *UCMPCServerInterface_SetVoltagePerPhase_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateUseCaseAvailability provides a mock function with given fields: available
+func (_m *UCMPCServerInterface) UpdateUseCaseAvailability(available bool) {
+	_m.Called(available)
+}
+
+// UCMPCServerInterface_UpdateUseCaseAvailability_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUseCaseAvailability'
+type UCMPCServerInterface_UpdateUseCaseAvailability_Call struct {
+	*mock.Call
+}
+
+// UpdateUseCaseAvailability is a helper method to define mock.On call
+//   - available bool
+func (_e *UCMPCServerInterface_Expecter) UpdateUseCaseAvailability(available interface{}) *UCMPCServerInterface_UpdateUseCaseAvailability_Call {
+	return &UCMPCServerInterface_UpdateUseCaseAvailability_Call{Call: _e.mock.On("UpdateUseCaseAvailability", available)}
+}
+
+func (_c *UCMPCServerInterface_UpdateUseCaseAvailability_Call) Run(run func(available bool)) *UCMPCServerInterface_UpdateUseCaseAvailability_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(bool))
+	})
+	return _c
+}
+
+func (_c *UCMPCServerInterface_UpdateUseCaseAvailability_Call) Return() *UCMPCServerInterface_UpdateUseCaseAvailability_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *UCMPCServerInterface_UpdateUseCaseAvailability_Call) RunAndReturn(run func(bool)) *UCMPCServerInterface_UpdateUseCaseAvailability_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UseCaseName provides a mock function with given fields:
+func (_m *UCMPCServerInterface) UseCaseName() model.UseCaseNameType {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for UseCaseName")
+	}
+
+	var r0 model.UseCaseNameType
+	if rf, ok := ret.Get(0).(func() model.UseCaseNameType); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(model.UseCaseNameType)
+	}
+
+	return r0
+}
+
+// UCMPCServerInterface_UseCaseName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UseCaseName'
+type UCMPCServerInterface_UseCaseName_Call struct {
+	*mock.Call
+}
+
+// UseCaseName is a helper method to define mock.On call
+func (_e *UCMPCServerInterface_Expecter) UseCaseName() *UCMPCServerInterface_UseCaseName_Call {
+	return &UCMPCServerInterface_UseCaseName_Call{Call: _e.mock.On("UseCaseName")}
+}
+
+func (_c *UCMPCServerInterface_UseCaseName_Call) Run(run func()) *UCMPCServerInterface_UseCaseName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *UCMPCServerInterface_UseCaseName_Call) Return(_a0 model.UseCaseNameType) *UCMPCServerInterface_UseCaseName_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UCMPCServerInterface_UseCaseName_Call) RunAndReturn(run func() model.UseCaseNameType) *UCMPCServerInterface_UseCaseName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewUCMPCServerInterface creates a new instance of UCMPCServerInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUCMPCServerInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UCMPCServerInterface {
+	mock := &UCMPCServerInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
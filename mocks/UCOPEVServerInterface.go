@@ -0,0 +1,375 @@
+// Code generated by mockery v2.42.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	cemdapi "github.com/enbility/cemd/api"
+	api "github.com/enbility/spine-go/api"
+
+	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/enbility/spine-go/model"
+)
+
+// UCOPEVServerInterface is an autogenerated mock type for the UCOPEVServerInterface type
+type UCOPEVServerInterface struct {
+	mock.Mock
+}
+
+type UCOPEVServerInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *UCOPEVServerInterface) EXPECT() *UCOPEVServerInterface_Expecter {
+	return &UCOPEVServerInterface_Expecter{mock: &_m.Mock}
+}
+
+// AddEVFeatures provides a mock function with given fields: entity
+func (_m *UCOPEVServerInterface) AddEVFeatures(entity api.EntityLocalInterface) {
+	_m.Called(entity)
+}
+
+// UCOPEVServerInterface_AddEVFeatures_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddEVFeatures'
+type UCOPEVServerInterface_AddEVFeatures_Call struct {
+	*mock.Call
+}
+
+// AddEVFeatures is a helper method to define mock.On call
+//   - entity api.EntityLocalInterface
+func (_e *UCOPEVServerInterface_Expecter) AddEVFeatures(entity interface{}) *UCOPEVServerInterface_AddEVFeatures_Call {
+	return &UCOPEVServerInterface_AddEVFeatures_Call{Call: _e.mock.On("AddEVFeatures", entity)}
+}
+
+func (_c *UCOPEVServerInterface_AddEVFeatures_Call) Run(run func(entity api.EntityLocalInterface)) *UCOPEVServerInterface_AddEVFeatures_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(api.EntityLocalInterface))
+	})
+	return _c
+}
+
+func (_c *UCOPEVServerInterface_AddEVFeatures_Call) Return() *UCOPEVServerInterface_AddEVFeatures_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *UCOPEVServerInterface_AddEVFeatures_Call) RunAndReturn(run func(api.EntityLocalInterface)) *UCOPEVServerInterface_AddEVFeatures_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddFeatures provides a mock function with given fields:
+func (_m *UCOPEVServerInterface) AddFeatures() {
+	_m.Called()
+}
+
+// UCOPEVServerInterface_AddFeatures_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddFeatures'
+type UCOPEVServerInterface_AddFeatures_Call struct {
+	*mock.Call
+}
+
+// AddFeatures is a helper method to define mock.On call
+func (_e *UCOPEVServerInterface_Expecter) AddFeatures() *UCOPEVServerInterface_AddFeatures_Call {
+	return &UCOPEVServerInterface_AddFeatures_Call{Call: _e.mock.On("AddFeatures")}
+}
+
+func (_c *UCOPEVServerInterface_AddFeatures_Call) Run(run func()) *UCOPEVServerInterface_AddFeatures_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *UCOPEVServerInterface_AddFeatures_Call) Return() *UCOPEVServerInterface_AddFeatures_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *UCOPEVServerInterface_AddFeatures_Call) RunAndReturn(run func()) *UCOPEVServerInterface_AddFeatures_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddUseCase provides a mock function with given fields:
+func (_m *UCOPEVServerInterface) AddUseCase() {
+	_m.Called()
+}
+
+// UCOPEVServerInterface_AddUseCase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddUseCase'
+type UCOPEVServerInterface_AddUseCase_Call struct {
+	*mock.Call
+}
+
+// AddUseCase is a helper method to define mock.On call
+func (_e *UCOPEVServerInterface_Expecter) AddUseCase() *UCOPEVServerInterface_AddUseCase_Call {
+	return &UCOPEVServerInterface_AddUseCase_Call{Call: _e.mock.On("AddUseCase")}
+}
+
+func (_c *UCOPEVServerInterface_AddUseCase_Call) Run(run func()) *UCOPEVServerInterface_AddUseCase_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *UCOPEVServerInterface_AddUseCase_Call) Return() *UCOPEVServerInterface_AddUseCase_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *UCOPEVServerInterface_AddUseCase_Call) RunAndReturn(run func()) *UCOPEVServerInterface_AddUseCase_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsUseCaseSupported provides a mock function with given fields: remoteEntity
+func (_m *UCOPEVServerInterface) IsUseCaseSupported(remoteEntity api.EntityRemoteInterface) (bool, error) {
+	ret := _m.Called(remoteEntity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsUseCaseSupported")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(api.EntityRemoteInterface) (bool, error)); ok {
+		return rf(remoteEntity)
+	}
+	if rf, ok := ret.Get(0).(func(api.EntityRemoteInterface) bool); ok {
+		r0 = rf(remoteEntity)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(api.EntityRemoteInterface) error); ok {
+		r1 = rf(remoteEntity)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UCOPEVServerInterface_IsUseCaseSupported_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsUseCaseSupported'
+type UCOPEVServerInterface_IsUseCaseSupported_Call struct {
+	*mock.Call
+}
+
+// IsUseCaseSupported is a helper method to define mock.On call
+//   - remoteEntity api.EntityRemoteInterface
+func (_e *UCOPEVServerInterface_Expecter) IsUseCaseSupported(remoteEntity interface{}) *UCOPEVServerInterface_IsUseCaseSupported_Call {
+	return &UCOPEVServerInterface_IsUseCaseSupported_Call{Call: _e.mock.On("IsUseCaseSupported", remoteEntity)}
+}
+
+func (_c *UCOPEVServerInterface_IsUseCaseSupported_Call) Run(run func(remoteEntity api.EntityRemoteInterface)) *UCOPEVServerInterface_IsUseCaseSupported_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(api.EntityRemoteInterface))
+	})
+	return _c
+}
+
+func (_c *UCOPEVServerInterface_IsUseCaseSupported_Call) Return(_a0 bool, _a1 error) *UCOPEVServerInterface_IsUseCaseSupported_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UCOPEVServerInterface_IsUseCaseSupported_Call) RunAndReturn(run func(api.EntityRemoteInterface) (bool, error)) *UCOPEVServerInterface_IsUseCaseSupported_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LoadControlLimits provides a mock function with given fields:
+func (_m *UCOPEVServerInterface) LoadControlLimits() ([]cemdapi.LoadLimitsPhase, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for LoadControlLimits")
+	}
+
+	var r0 []cemdapi.LoadLimitsPhase
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]cemdapi.LoadLimitsPhase, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []cemdapi.LoadLimitsPhase); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]cemdapi.LoadLimitsPhase)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UCOPEVServerInterface_LoadControlLimits_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LoadControlLimits'
+type UCOPEVServerInterface_LoadControlLimits_Call struct {
+	*mock.Call
+}
+
+// LoadControlLimits is a helper method to define mock.On call
+func (_e *UCOPEVServerInterface_Expecter) LoadControlLimits() *UCOPEVServerInterface_LoadControlLimits_Call {
+	return &UCOPEVServerInterface_LoadControlLimits_Call{Call: _e.mock.On("LoadControlLimits")}
+}
+
+func (_c *UCOPEVServerInterface_LoadControlLimits_Call) Run(run func()) *UCOPEVServerInterface_LoadControlLimits_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *UCOPEVServerInterface_LoadControlLimits_Call) Return(limits []cemdapi.LoadLimitsPhase, resultErr error) *UCOPEVServerInterface_LoadControlLimits_Call {
+	_c.Call.Return(limits, resultErr)
+	return _c
+}
+
+func (_c *UCOPEVServerInterface_LoadControlLimits_Call) RunAndReturn(run func() ([]cemdapi.LoadLimitsPhase, error)) *UCOPEVServerInterface_LoadControlLimits_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetCurrentLimits provides a mock function with given fields: minimum, maximum, defaults
+func (_m *UCOPEVServerInterface) SetCurrentLimits(minimum []float64, maximum []float64, defaults []float64) error {
+	ret := _m.Called(minimum, maximum, defaults)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetCurrentLimits")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]float64, []float64, []float64) error); ok {
+		r0 = rf(minimum, maximum, defaults)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UCOPEVServerInterface_SetCurrentLimits_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetCurrentLimits'
+type UCOPEVServerInterface_SetCurrentLimits_Call struct {
+	*mock.Call
+}
+
+// SetCurrentLimits is a helper method to define mock.On call
+//   - minimum []float64
+//   - maximum []float64
+//   - defaults []float64
+func (_e *UCOPEVServerInterface_Expecter) SetCurrentLimits(minimum interface{}, maximum interface{}, defaults interface{}) *UCOPEVServerInterface_SetCurrentLimits_Call {
+	return &UCOPEVServerInterface_SetCurrentLimits_Call{Call: _e.mock.On("SetCurrentLimits", minimum, maximum, defaults)}
+}
+
+func (_c *UCOPEVServerInterface_SetCurrentLimits_Call) Run(run func(minimum []float64, maximum []float64, defaults []float64)) *UCOPEVServerInterface_SetCurrentLimits_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]float64), args[1].([]float64), args[2].([]float64))
+	})
+	return _c
+}
+
+func (_c *UCOPEVServerInterface_SetCurrentLimits_Call) Return(resultErr error) *UCOPEVServerInterface_SetCurrentLimits_Call {
+	_c.Call.Return(resultErr)
+	return _c
+}
+
+func (_c *UCOPEVServerInterface_SetCurrentLimits_Call) RunAndReturn(run func([]float64, []float64, []float64) error) *UCOPEVServerInterface_SetCurrentLimits_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateUseCaseAvailability provides a mock function with given fields: available
+func (_m *UCOPEVServerInterface) UpdateUseCaseAvailability(available bool) {
+	_m.Called(available)
+}
+
+// UCOPEVServerInterface_UpdateUseCaseAvailability_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUseCaseAvailability'
+type UCOPEVServerInterface_UpdateUseCaseAvailability_Call struct {
+	*mock.Call
+}
+
+// UpdateUseCaseAvailability is a helper method to define mock.On call
+//   - available bool
+func (_e *UCOPEVServerInterface_Expecter) UpdateUseCaseAvailability(available interface{}) *UCOPEVServerInterface_UpdateUseCaseAvailability_Call {
+	return &UCOPEVServerInterface_UpdateUseCaseAvailability_Call{Call: _e.mock.On("UpdateUseCaseAvailability", available)}
+}
+
+func (_c *UCOPEVServerInterface_UpdateUseCaseAvailability_Call) Run(run func(available bool)) *UCOPEVServerInterface_UpdateUseCaseAvailability_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(bool))
+	})
+	return _c
+}
+
+func (_c *UCOPEVServerInterface_UpdateUseCaseAvailability_Call) Return() *UCOPEVServerInterface_UpdateUseCaseAvailability_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *UCOPEVServerInterface_UpdateUseCaseAvailability_Call) RunAndReturn(run func(bool)) *UCOPEVServerInterface_UpdateUseCaseAvailability_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UseCaseName provides a mock function with given fields:
+func (_m *UCOPEVServerInterface) UseCaseName() model.UseCaseNameType {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for UseCaseName")
+	}
+
+	var r0 model.UseCaseNameType
+	if rf, ok := ret.Get(0).(func() model.UseCaseNameType); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(model.UseCaseNameType)
+	}
+
+	return r0
+}
+
+// UCOPEVServerInterface_UseCaseName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UseCaseName'
+type UCOPEVServerInterface_UseCaseName_Call struct {
+	*mock.Call
+}
+
+// UseCaseName is a helper method to define mock.On call
+func (_e *UCOPEVServerInterface_Expecter) UseCaseName() *UCOPEVServerInterface_UseCaseName_Call {
+	return &UCOPEVServerInterface_UseCaseName_Call{Call: _e.mock.On("UseCaseName")}
+}
+
+func (_c *UCOPEVServerInterface_UseCaseName_Call) Run(run func()) *UCOPEVServerInterface_UseCaseName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *UCOPEVServerInterface_UseCaseName_Call) Return(_a0 model.UseCaseNameType) *UCOPEVServerInterface_UseCaseName_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UCOPEVServerInterface_UseCaseName_Call) RunAndReturn(run func() model.UseCaseNameType) *UCOPEVServerInterface_UseCaseName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewUCOPEVServerInterface creates a new instance of UCOPEVServerInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUCOPEVServerInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UCOPEVServerInterface {
+	mock := &UCOPEVServerInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,327 @@
+// Code generated by mockery v2.42.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	cemdapi "github.com/enbility/cemd/api"
+	api "github.com/enbility/spine-go/api"
+
+	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/enbility/spine-go/model"
+)
+
+// UCOSCEVServerInterface is an autogenerated mock type for the UCOSCEVServerInterface type
+type UCOSCEVServerInterface struct {
+	mock.Mock
+}
+
+type UCOSCEVServerInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *UCOSCEVServerInterface) EXPECT() *UCOSCEVServerInterface_Expecter {
+	return &UCOSCEVServerInterface_Expecter{mock: &_m.Mock}
+}
+
+// AddEVFeatures provides a mock function with given fields: entity
+func (_m *UCOSCEVServerInterface) AddEVFeatures(entity api.EntityLocalInterface) {
+	_m.Called(entity)
+}
+
+// UCOSCEVServerInterface_AddEVFeatures_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddEVFeatures'
+type UCOSCEVServerInterface_AddEVFeatures_Call struct {
+	*mock.Call
+}
+
+// AddEVFeatures is a helper method to define mock.On call
+//   - entity api.EntityLocalInterface
+func (_e *UCOSCEVServerInterface_Expecter) AddEVFeatures(entity interface{}) *UCOSCEVServerInterface_AddEVFeatures_Call {
+	return &UCOSCEVServerInterface_AddEVFeatures_Call{Call: _e.mock.On("AddEVFeatures", entity)}
+}
+
+func (_c *UCOSCEVServerInterface_AddEVFeatures_Call) Run(run func(entity api.EntityLocalInterface)) *UCOSCEVServerInterface_AddEVFeatures_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(api.EntityLocalInterface))
+	})
+	return _c
+}
+
+func (_c *UCOSCEVServerInterface_AddEVFeatures_Call) Return() *UCOSCEVServerInterface_AddEVFeatures_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *UCOSCEVServerInterface_AddEVFeatures_Call) RunAndReturn(run func(api.EntityLocalInterface)) *UCOSCEVServerInterface_AddEVFeatures_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddFeatures provides a mock function with given fields:
+func (_m *UCOSCEVServerInterface) AddFeatures() {
+	_m.Called()
+}
+
+// UCOSCEVServerInterface_AddFeatures_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddFeatures'
+type UCOSCEVServerInterface_AddFeatures_Call struct {
+	*mock.Call
+}
+
+// AddFeatures is a helper method to define mock.On call
+func (_e *UCOSCEVServerInterface_Expecter) AddFeatures() *UCOSCEVServerInterface_AddFeatures_Call {
+	return &UCOSCEVServerInterface_AddFeatures_Call{Call: _e.mock.On("AddFeatures")}
+}
+
+func (_c *UCOSCEVServerInterface_AddFeatures_Call) Run(run func()) *UCOSCEVServerInterface_AddFeatures_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *UCOSCEVServerInterface_AddFeatures_Call) Return() *UCOSCEVServerInterface_AddFeatures_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *UCOSCEVServerInterface_AddFeatures_Call) RunAndReturn(run func()) *UCOSCEVServerInterface_AddFeatures_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddUseCase provides a mock function with given fields:
+func (_m *UCOSCEVServerInterface) AddUseCase() {
+	_m.Called()
+}
+
+// UCOSCEVServerInterface_AddUseCase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddUseCase'
+type UCOSCEVServerInterface_AddUseCase_Call struct {
+	*mock.Call
+}
+
+// AddUseCase is a helper method to define mock.On call
+func (_e *UCOSCEVServerInterface_Expecter) AddUseCase() *UCOSCEVServerInterface_AddUseCase_Call {
+	return &UCOSCEVServerInterface_AddUseCase_Call{Call: _e.mock.On("AddUseCase")}
+}
+
+func (_c *UCOSCEVServerInterface_AddUseCase_Call) Run(run func()) *UCOSCEVServerInterface_AddUseCase_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *UCOSCEVServerInterface_AddUseCase_Call) Return() *UCOSCEVServerInterface_AddUseCase_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *UCOSCEVServerInterface_AddUseCase_Call) RunAndReturn(run func()) *UCOSCEVServerInterface_AddUseCase_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsUseCaseSupported provides a mock function with given fields: remoteEntity
+func (_m *UCOSCEVServerInterface) IsUseCaseSupported(remoteEntity api.EntityRemoteInterface) (bool, error) {
+	ret := _m.Called(remoteEntity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsUseCaseSupported")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(api.EntityRemoteInterface) (bool, error)); ok {
+		return rf(remoteEntity)
+	}
+	if rf, ok := ret.Get(0).(func(api.EntityRemoteInterface) bool); ok {
+		r0 = rf(remoteEntity)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(api.EntityRemoteInterface) error); ok {
+		r1 = rf(remoteEntity)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UCOSCEVServerInterface_IsUseCaseSupported_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsUseCaseSupported'
+type UCOSCEVServerInterface_IsUseCaseSupported_Call struct {
+	*mock.Call
+}
+
+// IsUseCaseSupported is a helper method to define mock.On call
+//   - remoteEntity api.EntityRemoteInterface
+func (_e *UCOSCEVServerInterface_Expecter) IsUseCaseSupported(remoteEntity interface{}) *UCOSCEVServerInterface_IsUseCaseSupported_Call {
+	return &UCOSCEVServerInterface_IsUseCaseSupported_Call{Call: _e.mock.On("IsUseCaseSupported", remoteEntity)}
+}
+
+func (_c *UCOSCEVServerInterface_IsUseCaseSupported_Call) Run(run func(remoteEntity api.EntityRemoteInterface)) *UCOSCEVServerInterface_IsUseCaseSupported_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(api.EntityRemoteInterface))
+	})
+	return _c
+}
+
+func (_c *UCOSCEVServerInterface_IsUseCaseSupported_Call) Return(_a0 bool, _a1 error) *UCOSCEVServerInterface_IsUseCaseSupported_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UCOSCEVServerInterface_IsUseCaseSupported_Call) RunAndReturn(run func(api.EntityRemoteInterface) (bool, error)) *UCOSCEVServerInterface_IsUseCaseSupported_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LoadControlLimits provides a mock function with given fields:
+func (_m *UCOSCEVServerInterface) LoadControlLimits() ([]cemdapi.LoadLimitsPhase, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for LoadControlLimits")
+	}
+
+	var r0 []cemdapi.LoadLimitsPhase
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]cemdapi.LoadLimitsPhase, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []cemdapi.LoadLimitsPhase); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]cemdapi.LoadLimitsPhase)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UCOSCEVServerInterface_LoadControlLimits_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LoadControlLimits'
+type UCOSCEVServerInterface_LoadControlLimits_Call struct {
+	*mock.Call
+}
+
+// LoadControlLimits is a helper method to define mock.On call
+func (_e *UCOSCEVServerInterface_Expecter) LoadControlLimits() *UCOSCEVServerInterface_LoadControlLimits_Call {
+	return &UCOSCEVServerInterface_LoadControlLimits_Call{Call: _e.mock.On("LoadControlLimits")}
+}
+
+func (_c *UCOSCEVServerInterface_LoadControlLimits_Call) Run(run func()) *UCOSCEVServerInterface_LoadControlLimits_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *UCOSCEVServerInterface_LoadControlLimits_Call) Return(limits []cemdapi.LoadLimitsPhase, resultErr error) *UCOSCEVServerInterface_LoadControlLimits_Call {
+	_c.Call.Return(limits, resultErr)
+	return _c
+}
+
+func (_c *UCOSCEVServerInterface_LoadControlLimits_Call) RunAndReturn(run func() ([]cemdapi.LoadLimitsPhase, error)) *UCOSCEVServerInterface_LoadControlLimits_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateUseCaseAvailability provides a mock function with given fields: available
+func (_m *UCOSCEVServerInterface) UpdateUseCaseAvailability(available bool) {
+	_m.Called(available)
+}
+
+// UCOSCEVServerInterface_UpdateUseCaseAvailability_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUseCaseAvailability'
+type UCOSCEVServerInterface_UpdateUseCaseAvailability_Call struct {
+	*mock.Call
+}
+
+// UpdateUseCaseAvailability is a helper method to define mock.On call
+//   - available bool
+func (_e *UCOSCEVServerInterface_Expecter) UpdateUseCaseAvailability(available interface{}) *UCOSCEVServerInterface_UpdateUseCaseAvailability_Call {
+	return &UCOSCEVServerInterface_UpdateUseCaseAvailability_Call{Call: _e.mock.On("UpdateUseCaseAvailability", available)}
+}
+
+func (_c *UCOSCEVServerInterface_UpdateUseCaseAvailability_Call) Run(run func(available bool)) *UCOSCEVServerInterface_UpdateUseCaseAvailability_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(bool))
+	})
+	return _c
+}
+
+func (_c *UCOSCEVServerInterface_UpdateUseCaseAvailability_Call) Return() *UCOSCEVServerInterface_UpdateUseCaseAvailability_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *UCOSCEVServerInterface_UpdateUseCaseAvailability_Call) RunAndReturn(run func(bool)) *UCOSCEVServerInterface_UpdateUseCaseAvailability_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UseCaseName provides a mock function with given fields:
+func (_m *UCOSCEVServerInterface) UseCaseName() model.UseCaseNameType {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for UseCaseName")
+	}
+
+	var r0 model.UseCaseNameType
+	if rf, ok := ret.Get(0).(func() model.UseCaseNameType); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(model.UseCaseNameType)
+	}
+
+	return r0
+}
+
+// UCOSCEVServerInterface_UseCaseName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UseCaseName'
+type UCOSCEVServerInterface_UseCaseName_Call struct {
+	*mock.Call
+}
+
+// UseCaseName is a helper method to define mock.On call
+func (_e *UCOSCEVServerInterface_Expecter) UseCaseName() *UCOSCEVServerInterface_UseCaseName_Call {
+	return &UCOSCEVServerInterface_UseCaseName_Call{Call: _e.mock.On("UseCaseName")}
+}
+
+func (_c *UCOSCEVServerInterface_UseCaseName_Call) Run(run func()) *UCOSCEVServerInterface_UseCaseName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *UCOSCEVServerInterface_UseCaseName_Call) Return(_a0 model.UseCaseNameType) *UCOSCEVServerInterface_UseCaseName_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UCOSCEVServerInterface_UseCaseName_Call) RunAndReturn(run func() model.UseCaseNameType) *UCOSCEVServerInterface_UseCaseName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewUCOSCEVServerInterface creates a new instance of UCOSCEVServerInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUCOSCEVServerInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UCOSCEVServerInterface {
+	mock := &UCOSCEVServerInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
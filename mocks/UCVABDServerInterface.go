@@ -0,0 +1,419 @@
+// Code generated by mockery v2.42.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	api "github.com/enbility/spine-go/api"
+	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/enbility/spine-go/model"
+)
+
+// UCVABDServerInterface is an autogenerated mock type for the UCVABDServerInterface type
+type UCVABDServerInterface struct {
+	mock.Mock
+}
+
+type UCVABDServerInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *UCVABDServerInterface) EXPECT() *UCVABDServerInterface_Expecter {
+	return &UCVABDServerInterface_Expecter{mock: &_m.Mock}
+}
+
+// AddFeatures provides a mock function with given fields:
+func (_m *UCVABDServerInterface) AddFeatures() {
+	_m.Called()
+}
+
+// UCVABDServerInterface_AddFeatures_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddFeatures'
+type UCVABDServerInterface_AddFeatures_Call struct {
+	*mock.Call
+}
+
+// AddFeatures is a helper method to define mock.On call
+func (_e *UCVABDServerInterface_Expecter) AddFeatures() *UCVABDServerInterface_AddFeatures_Call {
+	return &UCVABDServerInterface_AddFeatures_Call{Call: _e.mock.On("AddFeatures")}
+}
+
+func (_c *UCVABDServerInterface_AddFeatures_Call) Run(run func()) *UCVABDServerInterface_AddFeatures_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *UCVABDServerInterface_AddFeatures_Call) Return() *UCVABDServerInterface_AddFeatures_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *UCVABDServerInterface_AddFeatures_Call) RunAndReturn(run func()) *UCVABDServerInterface_AddFeatures_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddUseCase provides a mock function with given fields:
+func (_m *UCVABDServerInterface) AddUseCase() {
+	_m.Called()
+}
+
+// UCVABDServerInterface_AddUseCase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddUseCase'
+type UCVABDServerInterface_AddUseCase_Call struct {
+	*mock.Call
+}
+
+// AddUseCase is a helper method to define mock.On call
+func (_e *UCVABDServerInterface_Expecter) AddUseCase() *UCVABDServerInterface_AddUseCase_Call {
+	return &UCVABDServerInterface_AddUseCase_Call{Call: _e.mock.On("AddUseCase")}
+}
+
+func (_c *UCVABDServerInterface_AddUseCase_Call) Run(run func()) *UCVABDServerInterface_AddUseCase_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *UCVABDServerInterface_AddUseCase_Call) Return() *UCVABDServerInterface_AddUseCase_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *UCVABDServerInterface_AddUseCase_Call) RunAndReturn(run func()) *UCVABDServerInterface_AddUseCase_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsUseCaseSupported provides a mock function with given fields: remoteEntity
+func (_m *UCVABDServerInterface) IsUseCaseSupported(remoteEntity api.EntityRemoteInterface) (bool, error) {
+	ret := _m.Called(remoteEntity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsUseCaseSupported")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(api.EntityRemoteInterface) (bool, error)); ok {
+		return rf(remoteEntity)
+	}
+	if rf, ok := ret.Get(0).(func(api.EntityRemoteInterface) bool); ok {
+		r0 = rf(remoteEntity)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(api.EntityRemoteInterface) error); ok {
+		r1 = rf(remoteEntity)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UCVABDServerInterface_IsUseCaseSupported_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsUseCaseSupported'
+type UCVABDServerInterface_IsUseCaseSupported_Call struct {
+	*mock.Call
+}
+
+// IsUseCaseSupported is a helper method to define mock.On call
+//   - remoteEntity api.EntityRemoteInterface
+func (_e *UCVABDServerInterface_Expecter) IsUseCaseSupported(remoteEntity interface{}) *UCVABDServerInterface_IsUseCaseSupported_Call {
+	return &UCVABDServerInterface_IsUseCaseSupported_Call{Call: _e.mock.On("IsUseCaseSupported", remoteEntity)}
+}
+
+func (_c *UCVABDServerInterface_IsUseCaseSupported_Call) Run(run func(remoteEntity api.EntityRemoteInterface)) *UCVABDServerInterface_IsUseCaseSupported_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(api.EntityRemoteInterface))
+	})
+	return _c
+}
+
+func (_c *UCVABDServerInterface_IsUseCaseSupported_Call) Return(_a0 bool, _a1 error) *UCVABDServerInterface_IsUseCaseSupported_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UCVABDServerInterface_IsUseCaseSupported_Call) RunAndReturn(run func(api.EntityRemoteInterface) (bool, error)) *UCVABDServerInterface_IsUseCaseSupported_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetEnergyCharged provides a mock function with given fields: value
+func (_m *UCVABDServerInterface) SetEnergyCharged(value float64) error {
+	ret := _m.Called(value)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetEnergyCharged")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(float64) error); ok {
+		r0 = rf(value)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UCVABDServerInterface_SetEnergyCharged_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetEnergyCharged'
+type UCVABDServerInterface_SetEnergyCharged_Call struct {
+	*mock.Call
+}
+
+// SetEnergyCharged is a helper method to define mock.On call
+//   - value float64
+func (_e *UCVABDServerInterface_Expecter) SetEnergyCharged(value interface{}) *UCVABDServerInterface_SetEnergyCharged_Call {
+	return &UCVABDServerInterface_SetEnergyCharged_Call{Call: _e.mock.On("SetEnergyCharged", value)}
+}
+
+func (_c *UCVABDServerInterface_SetEnergyCharged_Call) Run(run func(value float64)) *UCVABDServerInterface_SetEnergyCharged_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(float64))
+	})
+	return _c
+}
+
+func (_c *UCVABDServerInterface_SetEnergyCharged_Call) Return(resultErr error) *UCVABDServerInterface_SetEnergyCharged_Call {
+	_c.Call.Return(resultErr)
+	return _c
+}
+
+func (_c *UCVABDServerInterface_SetEnergyCharged_Call) RunAndReturn(run func(float64) error) *UCVABDServerInterface_SetEnergyCharged_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetEnergyDischarged provides a mock function with given fields: value
+func (_m *UCVABDServerInterface) SetEnergyDischarged(value float64) error {
+	ret := _m.Called(value)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetEnergyDischarged")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(float64) error); ok {
+		r0 = rf(value)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UCVABDServerInterface_SetEnergyDischarged_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetEnergyDischarged'
+type UCVABDServerInterface_SetEnergyDischarged_Call struct {
+	*mock.Call
+}
+
+// SetEnergyDischarged is a helper method to define mock.On call
+//   - value float64
+func (_e *UCVABDServerInterface_Expecter) SetEnergyDischarged(value interface{}) *UCVABDServerInterface_SetEnergyDischarged_Call {
+	return &UCVABDServerInterface_SetEnergyDischarged_Call{Call: _e.mock.On("SetEnergyDischarged", value)}
+}
+
+func (_c *UCVABDServerInterface_SetEnergyDischarged_Call) Run(run func(value float64)) *UCVABDServerInterface_SetEnergyDischarged_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(float64))
+	})
+	return _c
+}
+
+func (_c *UCVABDServerInterface_SetEnergyDischarged_Call) Return(resultErr error) *UCVABDServerInterface_SetEnergyDischarged_Call {
+	_c.Call.Return(resultErr)
+	return _c
+}
+
+func (_c *UCVABDServerInterface_SetEnergyDischarged_Call) RunAndReturn(run func(float64) error) *UCVABDServerInterface_SetEnergyDischarged_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetPower provides a mock function with given fields: value
+func (_m *UCVABDServerInterface) SetPower(value float64) error {
+	ret := _m.Called(value)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetPower")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(float64) error); ok {
+		r0 = rf(value)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UCVABDServerInterface_SetPower_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetPower'
+type UCVABDServerInterface_SetPower_Call struct {
+	*mock.Call
+}
+
+// SetPower is a helper method to define mock.On call
+//   - value float64
+func (_e *UCVABDServerInterface_Expecter) SetPower(value interface{}) *UCVABDServerInterface_SetPower_Call {
+	return &UCVABDServerInterface_SetPower_Call{Call: _e.mock.On("SetPower", value)}
+}
+
+func (_c *UCVABDServerInterface_SetPower_Call) Run(run func(value float64)) *UCVABDServerInterface_SetPower_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(float64))
+	})
+	return _c
+}
+
+func (_c *UCVABDServerInterface_SetPower_Call) Return(resultErr error) *UCVABDServerInterface_SetPower_Call {
+	_c.Call.Return(resultErr)
+	return _c
+}
+
+func (_c *UCVABDServerInterface_SetPower_Call) RunAndReturn(run func(float64) error) *UCVABDServerInterface_SetPower_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetStateOfCharge provides a mock function with given fields: value
+func (_m *UCVABDServerInterface) SetStateOfCharge(value float64) error {
+	ret := _m.Called(value)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetStateOfCharge")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(float64) error); ok {
+		r0 = rf(value)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UCVABDServerInterface_SetStateOfCharge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetStateOfCharge'
+type UCVABDServerInterface_SetStateOfCharge_Call struct {
+	*mock.Call
+}
+
+// SetStateOfCharge is a helper method to define mock.On call
+//   - value float64
+func (_e *UCVABDServerInterface_Expecter) SetStateOfCharge(value interface{}) *UCVABDServerInterface_SetStateOfCharge_Call {
+	return &UCVABDServerInterface_SetStateOfCharge_Call{Call: _e.mock.On("SetStateOfCharge", value)}
+}
+
+func (_c *UCVABDServerInterface_SetStateOfCharge_Call) Run(run func(value float64)) *UCVABDServerInterface_SetStateOfCharge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(float64))
+	})
+	return _c
+}
+
+func (_c *UCVABDServerInterface_SetStateOfCharge_Call) Return(resultErr error) *UCVABDServerInterface_SetStateOfCharge_Call {
+	_c.Call.Return(resultErr)
+	return _c
+}
+
+func (_c *UCVABDServerInterface_SetStateOfCharge_Call) RunAndReturn(run func(float64) error) *UCVABDServerInterface_SetStateOfCharge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateUseCaseAvailability provides a mock function with given fields: available
+func (_m *UCVABDServerInterface) UpdateUseCaseAvailability(available bool) {
+	_m.Called(available)
+}
+
+// UCVABDServerInterface_UpdateUseCaseAvailability_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUseCaseAvailability'
+type UCVABDServerInterface_UpdateUseCaseAvailability_Call struct {
+	*mock.Call
+}
+
+// UpdateUseCaseAvailability is a helper method to define mock.On call
+//   - available bool
+func (_e *UCVABDServerInterface_Expecter) UpdateUseCaseAvailability(available interface{}) *UCVABDServerInterface_UpdateUseCaseAvailability_Call {
+	return &UCVABDServerInterface_UpdateUseCaseAvailability_Call{Call: _e.mock.On("UpdateUseCaseAvailability", available)}
+}
+
+func (_c *UCVABDServerInterface_UpdateUseCaseAvailability_Call) Run(run func(available bool)) *UCVABDServerInterface_UpdateUseCaseAvailability_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(bool))
+	})
+	return _c
+}
+
+func (_c *UCVABDServerInterface_UpdateUseCaseAvailability_Call) Return() *UCVABDServerInterface_UpdateUseCaseAvailability_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *UCVABDServerInterface_UpdateUseCaseAvailability_Call) RunAndReturn(run func(bool)) *UCVABDServerInterface_UpdateUseCaseAvailability_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UseCaseName provides a mock function with given fields:
+func (_m *UCVABDServerInterface) UseCaseName() model.UseCaseNameType {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for UseCaseName")
+	}
+
+	var r0 model.UseCaseNameType
+	if rf, ok := ret.Get(0).(func() model.UseCaseNameType); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(model.UseCaseNameType)
+	}
+
+	return r0
+}
+
+// UCVABDServerInterface_UseCaseName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UseCaseName'
+type UCVABDServerInterface_UseCaseName_Call struct {
+	*mock.Call
+}
+
+// UseCaseName is a helper method to define mock.On call
+func (_e *UCVABDServerInterface_Expecter) UseCaseName() *UCVABDServerInterface_UseCaseName_Call {
+	return &UCVABDServerInterface_UseCaseName_Call{Call: _e.mock.On("UseCaseName")}
+}
+
+func (_c *UCVABDServerInterface_UseCaseName_Call) Run(run func()) *UCVABDServerInterface_UseCaseName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *UCVABDServerInterface_UseCaseName_Call) Return(_a0 model.UseCaseNameType) *UCVABDServerInterface_UseCaseName_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UCVABDServerInterface_UseCaseName_Call) RunAndReturn(run func() model.UseCaseNameType) *UCVABDServerInterface_UseCaseName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewUCVABDServerInterface creates a new instance of UCVABDServerInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUCVABDServerInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UCVABDServerInterface {
+	mock := &UCVABDServerInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,373 @@
+// Code generated by mockery v2.42.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	api "github.com/enbility/spine-go/api"
+	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/enbility/spine-go/model"
+)
+
+// UCVAPDServerInterface is an autogenerated mock type for the UCVAPDServerInterface type
+type UCVAPDServerInterface struct {
+	mock.Mock
+}
+
+type UCVAPDServerInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *UCVAPDServerInterface) EXPECT() *UCVAPDServerInterface_Expecter {
+	return &UCVAPDServerInterface_Expecter{mock: &_m.Mock}
+}
+
+// AddFeatures provides a mock function with given fields:
+func (_m *UCVAPDServerInterface) AddFeatures() {
+	_m.Called()
+}
+
+// UCVAPDServerInterface_AddFeatures_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddFeatures'
+type UCVAPDServerInterface_AddFeatures_Call struct {
+	*mock.Call
+}
+
+// AddFeatures is a helper method to define mock.On call
+func (_e *UCVAPDServerInterface_Expecter) AddFeatures() *UCVAPDServerInterface_AddFeatures_Call {
+	return &UCVAPDServerInterface_AddFeatures_Call{Call: _e.mock.On("AddFeatures")}
+}
+
+func (_c *UCVAPDServerInterface_AddFeatures_Call) Run(run func()) *UCVAPDServerInterface_AddFeatures_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *UCVAPDServerInterface_AddFeatures_Call) Return() *UCVAPDServerInterface_AddFeatures_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *UCVAPDServerInterface_AddFeatures_Call) RunAndReturn(run func()) *UCVAPDServerInterface_AddFeatures_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddUseCase provides a mock function with given fields:
+func (_m *UCVAPDServerInterface) AddUseCase() {
+	_m.Called()
+}
+
+// UCVAPDServerInterface_AddUseCase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddUseCase'
+type UCVAPDServerInterface_AddUseCase_Call struct {
+	*mock.Call
+}
+
+// AddUseCase is a helper method to define mock.On call
+func (_e *UCVAPDServerInterface_Expecter) AddUseCase() *UCVAPDServerInterface_AddUseCase_Call {
+	return &UCVAPDServerInterface_AddUseCase_Call{Call: _e.mock.On("AddUseCase")}
+}
+
+func (_c *UCVAPDServerInterface_AddUseCase_Call) Run(run func()) *UCVAPDServerInterface_AddUseCase_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *UCVAPDServerInterface_AddUseCase_Call) Return() *UCVAPDServerInterface_AddUseCase_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *UCVAPDServerInterface_AddUseCase_Call) RunAndReturn(run func()) *UCVAPDServerInterface_AddUseCase_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsUseCaseSupported provides a mock function with given fields: remoteEntity
+func (_m *UCVAPDServerInterface) IsUseCaseSupported(remoteEntity api.EntityRemoteInterface) (bool, error) {
+	ret := _m.Called(remoteEntity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsUseCaseSupported")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(api.EntityRemoteInterface) (bool, error)); ok {
+		return rf(remoteEntity)
+	}
+	if rf, ok := ret.Get(0).(func(api.EntityRemoteInterface) bool); ok {
+		r0 = rf(remoteEntity)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(api.EntityRemoteInterface) error); ok {
+		r1 = rf(remoteEntity)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UCVAPDServerInterface_IsUseCaseSupported_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsUseCaseSupported'
+type UCVAPDServerInterface_IsUseCaseSupported_Call struct {
+	*mock.Call
+}
+
+// IsUseCaseSupported is a helper method to define mock.On call
+//   - remoteEntity api.EntityRemoteInterface
+func (_e *UCVAPDServerInterface_Expecter) IsUseCaseSupported(remoteEntity interface{}) *UCVAPDServerInterface_IsUseCaseSupported_Call {
+	return &UCVAPDServerInterface_IsUseCaseSupported_Call{Call: _e.mock.On("IsUseCaseSupported", remoteEntity)}
+}
+
+func (_c *UCVAPDServerInterface_IsUseCaseSupported_Call) Run(run func(remoteEntity api.EntityRemoteInterface)) *UCVAPDServerInterface_IsUseCaseSupported_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(api.EntityRemoteInterface))
+	})
+	return _c
+}
+
+func (_c *UCVAPDServerInterface_IsUseCaseSupported_Call) Return(_a0 bool, _a1 error) *UCVAPDServerInterface_IsUseCaseSupported_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UCVAPDServerInterface_IsUseCaseSupported_Call) RunAndReturn(run func(api.EntityRemoteInterface) (bool, error)) *UCVAPDServerInterface_IsUseCaseSupported_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetPVYieldTotal provides a mock function with given fields: value
+func (_m *UCVAPDServerInterface) SetPVYieldTotal(value float64) error {
+	ret := _m.Called(value)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetPVYieldTotal")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(float64) error); ok {
+		r0 = rf(value)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UCVAPDServerInterface_SetPVYieldTotal_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetPVYieldTotal'
+type UCVAPDServerInterface_SetPVYieldTotal_Call struct {
+	*mock.Call
+}
+
+// SetPVYieldTotal is a helper method to define mock.On call
+//   - value float64
+func (_e *UCVAPDServerInterface_Expecter) SetPVYieldTotal(value interface{}) *UCVAPDServerInterface_SetPVYieldTotal_Call {
+	return &UCVAPDServerInterface_SetPVYieldTotal_Call{Call: _e.mock.On("SetPVYieldTotal", value)}
+}
+
+func (_c *UCVAPDServerInterface_SetPVYieldTotal_Call) Run(run func(value float64)) *UCVAPDServerInterface_SetPVYieldTotal_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(float64))
+	})
+	return _c
+}
+
+func (_c *UCVAPDServerInterface_SetPVYieldTotal_Call) Return(resultErr error) *UCVAPDServerInterface_SetPVYieldTotal_Call {
+	_c.Call.Return(resultErr)
+	return _c
+}
+
+func (_c *UCVAPDServerInterface_SetPVYieldTotal_Call) RunAndReturn(run func(float64) error) *UCVAPDServerInterface_SetPVYieldTotal_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetPower provides a mock function with given fields: value
+func (_m *UCVAPDServerInterface) SetPower(value float64) error {
+	ret := _m.Called(value)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetPower")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(float64) error); ok {
+		r0 = rf(value)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UCVAPDServerInterface_SetPower_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetPower'
+type UCVAPDServerInterface_SetPower_Call struct {
+	*mock.Call
+}
+
+// SetPower is a helper method to define mock.On call
+//   - value float64
+func (_e *UCVAPDServerInterface_Expecter) SetPower(value interface{}) *UCVAPDServerInterface_SetPower_Call {
+	return &UCVAPDServerInterface_SetPower_Call{Call: _e.mock.On("SetPower", value)}
+}
+
+func (_c *UCVAPDServerInterface_SetPower_Call) Run(run func(value float64)) *UCVAPDServerInterface_SetPower_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(float64))
+	})
+	return _c
+}
+
+func (_c *UCVAPDServerInterface_SetPower_Call) Return(resultErr error) *UCVAPDServerInterface_SetPower_Call {
+	_c.Call.Return(resultErr)
+	return _c
+}
+
+func (_c *UCVAPDServerInterface_SetPower_Call) RunAndReturn(run func(float64) error) *UCVAPDServerInterface_SetPower_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetPowerNominalPeak provides a mock function with given fields: value
+func (_m *UCVAPDServerInterface) SetPowerNominalPeak(value float64) error {
+	ret := _m.Called(value)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetPowerNominalPeak")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(float64) error); ok {
+		r0 = rf(value)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UCVAPDServerInterface_SetPowerNominalPeak_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetPowerNominalPeak'
+type UCVAPDServerInterface_SetPowerNominalPeak_Call struct {
+	*mock.Call
+}
+
+// SetPowerNominalPeak is a helper method to define mock.On call
+//   - value float64
+func (_e *UCVAPDServerInterface_Expecter) SetPowerNominalPeak(value interface{}) *UCVAPDServerInterface_SetPowerNominalPeak_Call {
+	return &UCVAPDServerInterface_SetPowerNominalPeak_Call{Call: _e.mock.On("SetPowerNominalPeak", value)}
+}
+
+func (_c *UCVAPDServerInterface_SetPowerNominalPeak_Call) Run(run func(value float64)) *UCVAPDServerInterface_SetPowerNominalPeak_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(float64))
+	})
+	return _c
+}
+
+func (_c *UCVAPDServerInterface_SetPowerNominalPeak_Call) Return(resultErr error) *UCVAPDServerInterface_SetPowerNominalPeak_Call {
+	_c.Call.Return(resultErr)
+	return _c
+}
+
+func (_c *UCVAPDServerInterface_SetPowerNominalPeak_Call) RunAndReturn(run func(float64) error) *UCVAPDServerInterface_SetPowerNominalPeak_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateUseCaseAvailability provides a mock function with given fields: available
+func (_m *UCVAPDServerInterface) UpdateUseCaseAvailability(available bool) {
+	_m.Called(available)
+}
+
+// UCVAPDServerInterface_UpdateUseCaseAvailability_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUseCaseAvailability'
+type UCVAPDServerInterface_UpdateUseCaseAvailability_Call struct {
+	*mock.Call
+}
+
+// UpdateUseCaseAvailability is a helper method to define mock.On call
+//   - available bool
+func (_e *UCVAPDServerInterface_Expecter) UpdateUseCaseAvailability(available interface{}) *UCVAPDServerInterface_UpdateUseCaseAvailability_Call {
+	return &UCVAPDServerInterface_UpdateUseCaseAvailability_Call{Call: _e.mock.On("UpdateUseCaseAvailability", available)}
+}
+
+func (_c *UCVAPDServerInterface_UpdateUseCaseAvailability_Call) Run(run func(available bool)) *UCVAPDServerInterface_UpdateUseCaseAvailability_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(bool))
+	})
+	return _c
+}
+
+func (_c *UCVAPDServerInterface_UpdateUseCaseAvailability_Call) Return() *UCVAPDServerInterface_UpdateUseCaseAvailability_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *UCVAPDServerInterface_UpdateUseCaseAvailability_Call) RunAndReturn(run func(bool)) *UCVAPDServerInterface_UpdateUseCaseAvailability_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UseCaseName provides a mock function with given fields:
+func (_m *UCVAPDServerInterface) UseCaseName() model.UseCaseNameType {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for UseCaseName")
+	}
+
+	var r0 model.UseCaseNameType
+	if rf, ok := ret.Get(0).(func() model.UseCaseNameType); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(model.UseCaseNameType)
+	}
+
+	return r0
+}
+
+// UCVAPDServerInterface_UseCaseName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UseCaseName'
+type UCVAPDServerInterface_UseCaseName_Call struct {
+	*mock.Call
+}
+
+// UseCaseName is a helper method to define mock.On call
+func (_e *UCVAPDServerInterface_Expecter) UseCaseName() *UCVAPDServerInterface_UseCaseName_Call {
+	return &UCVAPDServerInterface_UseCaseName_Call{Call: _e.mock.On("UseCaseName")}
+}
+
+func (_c *UCVAPDServerInterface_UseCaseName_Call) Run(run func()) *UCVAPDServerInterface_UseCaseName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *UCVAPDServerInterface_UseCaseName_Call) Return(_a0 model.UseCaseNameType) *UCVAPDServerInterface_UseCaseName_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UCVAPDServerInterface_UseCaseName_Call) RunAndReturn(run func() model.UseCaseNameType) *UCVAPDServerInterface_UseCaseName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewUCVAPDServerInterface creates a new instance of UCVAPDServerInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUCVAPDServerInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UCVAPDServerInterface {
+	mock := &UCVAPDServerInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
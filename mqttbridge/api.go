@@ -0,0 +1,19 @@
+package mqttbridge
+
+// Implemented by MQTT clients
+//
+// Used by the bridge to publish values and to receive commands,
+// PahoClient provides an implementation for an MQTT broker
+type ClientInterface interface {
+	// publish the payload to the topic
+	Publish(topic string, payload []byte) error
+
+	// subscribe to the topics matching the filter
+	//
+	// the subscription has to be kept across reconnects,
+	// retained messages must not be passed to the handler
+	Subscribe(filter string, handler func(topic string, payload []byte)) error
+
+	// remove the subscription of the filter
+	Unsubscribe(filter string) error
+}
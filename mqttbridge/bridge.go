@@ -0,0 +1,228 @@
+// Package mqttbridge connects the use cases of a CEM to an MQTT broker
+//
+// The values of the configured use cases are published as JSON whenever
+// their DataUpdate events fire. Write operations, e.g. the consumption limit
+// of LPC or the load control limits of OPEV, are called for JSON payloads
+// received on the command topics, in the format of the use case write method.
+//
+// The use case, data point and operation names are the ones of the datapoints
+// package, the entity address is the one of datapoints.EntityAddress.
+package mqttbridge
+
+import (
+	"encoding/json"
+	"errors"
+	"slices"
+
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/cem"
+	"github.com/enbility/cemd/datapoints"
+	"github.com/enbility/ship-go/logging"
+	spineapi "github.com/enbility/spine-go/api"
+)
+
+const (
+	// the default topic the values are published to
+	DefaultStateTopic = "cemd/{ski}/{entity}/{usecase}/{datapoint}"
+
+	// the default topic commands are received on
+	DefaultCommandTopic = "cemd/{ski}/{entity}/{usecase}/{operation}/set"
+)
+
+// the use cases published by default
+var DefaultUseCases = []string{"mgcp", "mpc", "evcem", "evsoc", "vapd", "vabd"}
+
+var (
+	errDeviceNotFound    = errors.New("device not found")
+	errEntityNotFound    = errors.New("entity not found")
+	errOperationNotFound = errors.New("operation not found")
+)
+
+// Configuration of a bridge, empty values are replaced by the defaults
+type Config struct {
+	// the topic template the values are published to,
+	// has to contain the placeholders {ski}, {entity}, {usecase} and {datapoint}
+	StateTopic string
+
+	// the topic template commands are received on,
+	// has to contain the placeholders {ski}, {entity}, {usecase} and {operation}
+	CommandTopic string
+
+	// the short names of the use cases whose values are published
+	UseCases []string
+}
+
+// Publishes the values of the use cases of a CEM and calls their write
+// operations for received commands
+type Bridge struct {
+	cem    *cem.Cem
+	client ClientInterface
+
+	stateTopic   topicTemplate
+	commandTopic topicTemplate
+	useCases     []string
+
+	writeCB datapoints.WriteCallback
+}
+
+// create a new bridge for the use cases added to the CEM
+//
+// the use case events have to be passed to EntityEventCB, usually by calling
+// it from the callback provided to the use cases
+//
+// returns an error if a topic template is invalid
+func NewBridge(c *cem.Cem, client ClientInterface, config Config) (*Bridge, error) {
+	if config.StateTopic == "" {
+		config.StateTopic = DefaultStateTopic
+	}
+	if config.CommandTopic == "" {
+		config.CommandTopic = DefaultCommandTopic
+	}
+	if len(config.UseCases) == 0 {
+		config.UseCases = DefaultUseCases
+	}
+
+	stateTopic, err := parseTopicTemplate(config.StateTopic,
+		PlaceholderSki, PlaceholderEntity, PlaceholderUseCase, PlaceholderDataPoint)
+	if err != nil {
+		return nil, err
+	}
+
+	commandTopic, err := parseTopicTemplate(config.CommandTopic,
+		PlaceholderSki, PlaceholderEntity, PlaceholderUseCase, PlaceholderOperation)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Bridge{
+		cem:          c,
+		client:       client,
+		stateTopic:   stateTopic,
+		commandTopic: commandTopic,
+		useCases:     config.UseCases,
+	}, nil
+}
+
+// subscribe to the command topics
+func (b *Bridge) Start() error {
+	return b.client.Subscribe(b.commandTopic.filter(), b.handleCommand)
+}
+
+// unsubscribe from the command topics
+func (b *Bridge) Stop() {
+	_ = b.client.Unsubscribe(b.commandTopic.filter())
+}
+
+// set a callback invoked after each write operation, has to be set before Start
+func (b *Bridge) SetWriteCallback(cb datapoints.WriteCallback) {
+	b.writeCB = cb
+}
+
+// returns the topic the values of a data point are published to
+func (b *Bridge) StateTopic(ski, entity, usecase, dataPoint string) string {
+	return b.stateTopic.topic(map[string]string{
+		PlaceholderSki:       ski,
+		PlaceholderEntity:    entity,
+		PlaceholderUseCase:   usecase,
+		PlaceholderDataPoint: dataPoint,
+	})
+}
+
+// returns the topic the commands of a write operation are received on
+func (b *Bridge) CommandTopic(ski, entity, usecase, operation string) string {
+	return b.commandTopic.topic(map[string]string{
+		PlaceholderSki:       ski,
+		PlaceholderEntity:    entity,
+		PlaceholderUseCase:   usecase,
+		PlaceholderOperation: operation,
+	})
+}
+
+// returns if the values of a use case are published
+func (b *Bridge) Publishes(usecase string) bool {
+	return slices.Contains(b.useCases, usecase)
+}
+
+// publish the values updated by a use case event
+//
+// has the signature of api.EntityEventCallback
+func (b *Bridge) EntityEventCB(ski string, device spineapi.DeviceRemoteInterface, entity spineapi.EntityRemoteInterface, event api.EventType) {
+	if entity == nil {
+		return
+	}
+
+	for _, usecase := range datapoints.ForUseCases(b.cem.UseCases()) {
+		if !b.Publishes(usecase.Name) {
+			continue
+		}
+
+		for _, dataPoint := range usecase.DataPointsForEvent(event) {
+			b.publish(ski, entity, usecase.Name, dataPoint)
+		}
+	}
+}
+
+func (b *Bridge) publish(ski string, entity spineapi.EntityRemoteInterface, usecase string, dataPoint datapoints.DataPoint) {
+	value, err := dataPoint.Read(entity)
+	if err != nil {
+		return
+	}
+
+	payload, err := json.Marshal(value)
+	if err != nil {
+		logging.Log().Error("MQTT encoding", dataPoint.Name, err)
+		return
+	}
+
+	topic := b.StateTopic(ski, datapoints.EntityAddress(entity), usecase, dataPoint.Name)
+
+	if err := b.client.Publish(topic, payload); err != nil {
+		logging.Log().Error("MQTT publishing", topic, err)
+	}
+}
+
+// handle a message received on a command topic
+func (b *Bridge) handleCommand(topic string, payload []byte) {
+	if err := b.command(topic, payload); err != nil {
+		logging.Log().Error("MQTT command", topic, err)
+	}
+}
+
+// call the write operation of a command topic with the payload
+func (b *Bridge) command(topic string, payload []byte) error {
+	values, ok := b.commandTopic.match(topic)
+	if !ok {
+		return errOperationNotFound
+	}
+
+	device := b.cem.Service.LocalDevice().RemoteDeviceForSki(values[PlaceholderSki])
+	if device == nil {
+		return errDeviceNotFound
+	}
+
+	ids, err := datapoints.ParseEntityAddress(values[PlaceholderEntity])
+	if err != nil {
+		return err
+	}
+
+	entity := device.Entity(ids)
+	if entity == nil {
+		return errEntityNotFound
+	}
+
+	for _, usecase := range datapoints.ForUseCases(b.cem.UseCases()) {
+		if usecase.Name != values[PlaceholderUseCase] {
+			continue
+		}
+
+		if operation, ok := usecase.Operation(values[PlaceholderOperation]); ok {
+			msgCounter, err := operation.Write(entity, payload)
+			if b.writeCB != nil {
+				b.writeCB(entity, usecase.Name, operation.Name, msgCounter, err)
+			}
+			return err
+		}
+	}
+
+	return errOperationNotFound
+}
@@ -0,0 +1,241 @@
+package mqttbridge
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/approval"
+	"github.com/enbility/cemd/datapoints"
+	"github.com/enbility/cemd/loopback"
+	"github.com/enbility/cemd/uclpc"
+	"github.com/enbility/cemd/uclpcserver"
+	"github.com/enbility/cemd/ucmgcp"
+	"github.com/enbility/cemd/ucmgcpserver"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestBridgeSuite(t *testing.T) {
+	suite.Run(t, new(BridgeSuite))
+}
+
+// records the published messages and delivers commands to the subscriptions
+type testClient struct {
+	mux           sync.Mutex
+	published     map[string]string
+	subscriptions map[string]func(topic string, payload []byte)
+}
+
+func newTestClient() *testClient {
+	return &testClient{
+		published:     make(map[string]string),
+		subscriptions: make(map[string]func(topic string, payload []byte)),
+	}
+}
+
+func (c *testClient) Publish(topic string, payload []byte) error {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	c.published[topic] = string(payload)
+	return nil
+}
+
+func (c *testClient) Subscribe(filter string, handler func(topic string, payload []byte)) error {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	c.subscriptions[filter] = handler
+	return nil
+}
+
+func (c *testClient) Unsubscribe(filter string) error {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	delete(c.subscriptions, filter)
+	return nil
+}
+
+func (c *testClient) message(topic string) (string, bool) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	payload, ok := c.published[topic]
+	return payload, ok
+}
+
+func (c *testClient) deliver(filter, topic, payload string) bool {
+	c.mux.Lock()
+	handler, ok := c.subscriptions[filter]
+	c.mux.Unlock()
+
+	if ok {
+		handler(topic, []byte(payload))
+	}
+	return ok
+}
+
+type BridgeSuite struct {
+	suite.Suite
+
+	network *loopback.Network
+
+	cem        *loopback.Device
+	smgw       *loopback.Device
+	mgcpServer *ucmgcpserver.UCMGCPServer
+	lpcServer  *uclpcserver.UCLPCServer
+
+	client *testClient
+	sut    *Bridge
+
+	// the address of the remote CEM entity
+	entity string
+}
+
+func (s *BridgeSuite) BeforeTest(suiteName, testName string) {
+	s.network = loopback.NewNetwork()
+
+	var err error
+	s.cem, err = s.network.NewCEM("cem", nil)
+	assert.Nil(s.T(), err)
+
+	s.client = newTestClient()
+	s.sut, err = NewBridge(s.cem.Cem, s.client, Config{})
+	assert.Nil(s.T(), err)
+
+	// the use cases added later on are bridged as well
+	s.cem.AddUseCase(ucmgcp.NewUCMGCP(s.cem.Service(), s.sut.EntityEventCB))
+	s.cem.AddUseCase(uclpc.NewUCLPC(s.cem.Service(), s.sut.EntityEventCB))
+
+	err = s.sut.Start()
+	assert.Nil(s.T(), err)
+
+	s.smgw, err = s.network.NewCEM("smgw", nil)
+	assert.Nil(s.T(), err)
+
+	serverEvents := loopback.NewEventRecorder()
+	s.mgcpServer = ucmgcpserver.NewUCMGCP(s.smgw.Service(), serverEvents.EntityEventCB)
+	s.smgw.AddUseCase(s.mgcpServer)
+	s.lpcServer = uclpcserver.NewUCLPC(s.smgw.Service(), serverEvents.EntityEventCB)
+	s.lpcServer.SetApprovalPolicy(approval.NewAutoApprovePolicy())
+	s.smgw.AddUseCase(s.lpcServer)
+
+	err = s.lpcServer.SetConsumptionLimit(api.LoadLimit{IsChangeable: true})
+	assert.Nil(s.T(), err)
+
+	_, err = s.network.Connect(s.cem, s.smgw)
+	assert.Nil(s.T(), err)
+
+	err = s.network.WaitIdle(time.Second * 5)
+	assert.Nil(s.T(), err)
+
+	s.entity = datapoints.EntityAddress(s.cem.RemoteEntity(s.smgw, model.EntityTypeTypeCEM))
+}
+
+func (s *BridgeSuite) AfterTest(suiteName, testName string) {
+	s.network.Close()
+}
+
+func (s *BridgeSuite) Test_Publish() {
+	err := s.mgcpServer.SetPower(-1000)
+	assert.Nil(s.T(), err)
+
+	topic := "cemd/" + s.smgw.SKI() + "/" + s.entity + "/mgcp/power"
+	assert.Eventually(s.T(), func() bool {
+		payload, ok := s.client.message(topic)
+		return ok && payload == "-1000"
+	}, time.Second*5, time.Millisecond*10)
+
+	// LPC is not published by default
+	s.client.mux.Lock()
+	for topic := range s.client.published {
+		assert.NotContains(s.T(), topic, "/lpc/")
+	}
+	s.client.mux.Unlock()
+}
+
+func (s *BridgeSuite) Test_Command() {
+	filter := "cemd/+/+/+/+/set"
+	topic := "cemd/" + s.smgw.SKI() + "/" + s.entity + "/lpc/consumptionLimit/set"
+
+	var failures []string
+	s.sut.SetWriteCallback(func(entity spineapi.EntityRemoteInterface, usecase, operation string, msgCounter *model.MsgCounterType, err error) {
+		if err != nil {
+			failures = append(failures, usecase+"/"+operation)
+		}
+	})
+
+	ok := s.client.deliver(filter, topic, `{"Value": 4200, "IsActive": true}`)
+	assert.True(s.T(), ok)
+
+	assert.Eventually(s.T(), func() bool {
+		limit, err := s.lpcServer.ConsumptionLimit()
+		return err == nil && limit.IsActive && limit.Value == 4200
+	}, time.Second*5, time.Millisecond*10)
+
+	for _, topic := range []string{
+		"cemd/unknown/" + s.entity + "/lpc/consumptionLimit/set",
+		"cemd/" + s.smgw.SKI() + "/a/lpc/consumptionLimit/set",
+		"cemd/" + s.smgw.SKI() + "/99/lpc/consumptionLimit/set",
+		"cemd/" + s.smgw.SKI() + "/" + s.entity + "/mgcp/power/set",
+		"other/" + s.smgw.SKI() + "/" + s.entity + "/lpc/consumptionLimit/set",
+	} {
+		assert.NotNil(s.T(), s.sut.command(topic, []byte(`{"Value": 0}`)), topic)
+	}
+
+	err := s.sut.command(topic, []byte(`{"Unknown": 1}`))
+	assert.ErrorIs(s.T(), err, datapoints.ErrInvalidData)
+	assert.Equal(s.T(), []string{"lpc/consumptionLimit"}, failures)
+
+	s.sut.Stop()
+	assert.False(s.T(), s.client.deliver(filter, topic, "{}"))
+}
+
+func (s *BridgeSuite) Test_Config() {
+	sut, err := NewBridge(s.cem.Cem, s.client, Config{
+		StateTopic:   "home/{usecase}/{datapoint}/{entity}/{ski}",
+		CommandTopic: "home/{usecase}/{operation}/{entity}/{ski}",
+		UseCases:     []string{"lpc"},
+	})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "home/+/+/+/+", sut.commandTopic.filter())
+	assert.Equal(s.T(), "home/lpc/consumptionLimit/1/ski", sut.StateTopic("ski", "1", "lpc", "consumptionLimit"))
+	assert.Equal(s.T(), "home/lpc/consumptionLimit/1/ski", sut.CommandTopic("ski", "1", "lpc", "consumptionLimit"))
+	assert.True(s.T(), sut.Publishes("lpc"))
+	assert.False(s.T(), sut.Publishes("mgcp"))
+
+	for _, config := range []Config{
+		{StateTopic: "cemd/{ski}/{entity}/{usecase}"},
+		{StateTopic: "cemd/{ski}/{entity}/{usecase}/{datapoint}/#"},
+		{StateTopic: "cemd/{ski}/{entity}/{usecase}/{datapoint}/{unknown}"},
+		{StateTopic: "cemd/{ski}/{entity}/{usecase}/{datapoint}/{datapoint}"},
+		{CommandTopic: "cemd/{ski}/{entity}/{usecase}/{datapoint}"},
+		{CommandTopic: "cemd/{ski}-{entity}/{usecase}/{operation}"},
+	} {
+		_, err = NewBridge(s.cem.Cem, s.client, config)
+		assert.NotNil(s.T(), err, config)
+	}
+}
+
+func (s *BridgeSuite) Test_TopicTemplate() {
+	sut, err := parseTopicTemplate("a/{ski}/b/{entity}", PlaceholderSki)
+	assert.Nil(s.T(), err)
+
+	values := map[string]string{PlaceholderSki: "ski", PlaceholderEntity: "1.1"}
+	assert.Equal(s.T(), "a/ski/b/1.1", sut.topic(values))
+	assert.Equal(s.T(), "a/+/b/+", sut.filter())
+
+	result, ok := sut.match("a/ski/b/1.1")
+	assert.True(s.T(), ok)
+	assert.Equal(s.T(), values, result)
+
+	_, ok = sut.match("a/ski/c/1.1")
+	assert.False(s.T(), ok)
+	_, ok = sut.match("a/ski/b")
+	assert.False(s.T(), ok)
+}
@@ -0,0 +1,145 @@
+package mqttbridge
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+	"github.com/enbility/ship-go/logging"
+)
+
+const (
+	// the maximum duration for a broker to acknowledge a request
+	brokerTimeout = time.Second * 5
+
+	// the milliseconds pending work may take when disconnecting
+	disconnectQuiesce = 250
+)
+
+var (
+	// the client is not connected to the broker
+	ErrNotConnected = errors.New("not connected to the MQTT broker")
+
+	// the broker did not acknowledge a request in time
+	ErrTimeout = errors.New("timeout waiting for the MQTT broker")
+)
+
+// MQTT client for a broker connection using the Eclipse Paho library
+//
+// The client reconnects automatically and restores its subscriptions afterwards
+type PahoClient struct {
+	client mqtt.Client
+
+	qos    byte
+	retain bool
+
+	mux           sync.Mutex
+	subscriptions map[string]mqtt.MessageHandler
+}
+
+var _ ClientInterface = (*PahoClient)(nil)
+
+// create a new client, use Connect to connect it to the broker
+//
+// parameters:
+//   - options: the broker and client options, the connect, reconnect and order options are overwritten
+//   - qos: the quality of service of the published messages and subscriptions
+//   - retain: if the published messages are retained by the broker
+func NewPahoClient(options *mqtt.ClientOptions, qos byte, retain bool) *PahoClient {
+	c := &PahoClient{
+		qos:           qos,
+		retain:        retain,
+		subscriptions: make(map[string]mqtt.MessageHandler),
+	}
+
+	options.SetAutoReconnect(true)
+	options.SetConnectRetry(true)
+	// commands are handled in parallel, as write operations may take a while
+	options.SetOrderMatters(false)
+	options.SetOnConnectHandler(c.onConnect)
+
+	c.client = mqtt.NewClient(options)
+
+	return c
+}
+
+// connect to the broker, retrying in the background until it succeeds
+func (c *PahoClient) Connect() {
+	c.client.Connect()
+}
+
+// disconnect from the broker
+func (c *PahoClient) Disconnect() {
+	c.client.Disconnect(disconnectQuiesce)
+}
+
+func (c *PahoClient) Publish(topic string, payload []byte) error {
+	if !c.client.IsConnectionOpen() {
+		return ErrNotConnected
+	}
+
+	return wait(c.client.Publish(topic, c.qos, c.retain, payload))
+}
+
+// subscribe to the topics matching the filter
+//
+// retained messages are dropped, as they would be delivered again on
+// every (re)subscription and repeat the commands they contain
+func (c *PahoClient) Subscribe(filter string, handler func(topic string, payload []byte)) error {
+	callback := func(_ mqtt.Client, message mqtt.Message) {
+		if message.Retained() {
+			logging.Log().Debug("MQTT dropping retained message", message.Topic())
+			return
+		}
+
+		handler(message.Topic(), message.Payload())
+	}
+
+	c.mux.Lock()
+	c.subscriptions[filter] = callback
+	c.mux.Unlock()
+
+	// subscribed on connect otherwise
+	if !c.client.IsConnectionOpen() {
+		return nil
+	}
+
+	return wait(c.client.Subscribe(filter, c.qos, callback))
+}
+
+func (c *PahoClient) Unsubscribe(filter string) error {
+	c.mux.Lock()
+	delete(c.subscriptions, filter)
+	c.mux.Unlock()
+
+	if !c.client.IsConnectionOpen() {
+		return nil
+	}
+
+	return wait(c.client.Unsubscribe(filter))
+}
+
+// restore the subscriptions after a (re)connect
+func (c *PahoClient) onConnect(client mqtt.Client) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	for filter, callback := range c.subscriptions {
+		// waiting for the result would block the client
+		go func(filter string, callback mqtt.MessageHandler) {
+			if err := wait(client.Subscribe(filter, c.qos, callback)); err != nil {
+				logging.Log().Error("MQTT subscribing", filter, err)
+			}
+		}(filter, callback)
+	}
+}
+
+// wait for the broker to acknowledge a request
+func wait(token mqtt.Token) error {
+	if !token.WaitTimeout(brokerTimeout) {
+		return ErrTimeout
+	}
+
+	return token.Error()
+}
@@ -0,0 +1,70 @@
+package mqttbridge
+
+import (
+	"testing"
+
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestPahoClientSuite(t *testing.T) {
+	suite.Run(t, new(PahoClientSuite))
+}
+
+// a message as received from the broker
+type testMessage struct {
+	topic    string
+	payload  []byte
+	retained bool
+}
+
+var _ mqtt.Message = (*testMessage)(nil)
+
+func (m *testMessage) Duplicate() bool   { return false }
+func (m *testMessage) Qos() byte         { return 0 }
+func (m *testMessage) Retained() bool    { return m.retained }
+func (m *testMessage) Topic() string     { return m.topic }
+func (m *testMessage) MessageID() uint16 { return 0 }
+func (m *testMessage) Payload() []byte   { return m.payload }
+func (m *testMessage) Ack()              {}
+
+type PahoClientSuite struct {
+	suite.Suite
+
+	sut *PahoClient
+}
+
+func (s *PahoClientSuite) BeforeTest(suiteName, testName string) {
+	s.sut = NewPahoClient(mqtt.NewClientOptions(), 1, false)
+}
+
+func (s *PahoClientSuite) Test_Subscribe() {
+	var received []string
+	handler := func(topic string, payload []byte) {
+		received = append(received, topic+"="+string(payload))
+	}
+
+	// the client is not connected, the subscription is done on connect
+	err := s.sut.Subscribe("cem/+/set", handler)
+	assert.Nil(s.T(), err)
+
+	s.sut.mux.Lock()
+	callback, ok := s.sut.subscriptions["cem/+/set"]
+	s.sut.mux.Unlock()
+	assert.True(s.T(), ok)
+
+	callback(s.sut.client, &testMessage{topic: "cem/limit/set", payload: []byte("1000")})
+	assert.Equal(s.T(), []string{"cem/limit/set=1000"}, received)
+
+	// a retained command is delivered again on every subscription and must not be executed
+	callback(s.sut.client, &testMessage{topic: "cem/limit/set", payload: []byte("2000"), retained: true})
+	assert.Equal(s.T(), []string{"cem/limit/set=1000"}, received)
+
+	err = s.sut.Unsubscribe("cem/+/set")
+	assert.Nil(s.T(), err)
+
+	s.sut.mux.Lock()
+	assert.Equal(s.T(), 0, len(s.sut.subscriptions))
+	s.sut.mux.Unlock()
+}
@@ -0,0 +1,106 @@
+package mqttbridge
+
+import (
+	"fmt"
+	"strings"
+)
+
+// the placeholders of the topic templates
+const (
+	PlaceholderSki       = "{ski}"
+	PlaceholderEntity    = "{entity}"
+	PlaceholderUseCase   = "{usecase}"
+	PlaceholderDataPoint = "{datapoint}"
+	PlaceholderOperation = "{operation}"
+)
+
+// a topic template with placeholders, e.g. "cemd/{ski}/{entity}/{usecase}/{datapoint}"
+//
+// each placeholder has to be a complete topic level
+type topicTemplate []string
+
+// parse a template, which has to contain all required placeholders
+func parseTopicTemplate(template string, required ...string) (topicTemplate, error) {
+	levels := strings.Split(template, "/")
+
+	for _, level := range levels {
+		if strings.ContainsAny(level, "+#") {
+			return nil, fmt.Errorf("topic %s must not contain wildcards", template)
+		}
+		if strings.Contains(level, "{") && !isPlaceholder(level) {
+			return nil, fmt.Errorf("topic %s contains an invalid placeholder %s", template, level)
+		}
+	}
+
+	for _, placeholder := range required {
+		count := 0
+		for _, level := range levels {
+			if level == placeholder {
+				count++
+			}
+		}
+		if count != 1 {
+			return nil, fmt.Errorf("topic %s has to contain %s exactly once", template, placeholder)
+		}
+	}
+
+	return levels, nil
+}
+
+func isPlaceholder(level string) bool {
+	switch level {
+	case PlaceholderSki, PlaceholderEntity, PlaceholderUseCase, PlaceholderDataPoint, PlaceholderOperation:
+		return true
+	}
+
+	return false
+}
+
+// returns the topic with the placeholders replaced by the values
+func (t topicTemplate) topic(values map[string]string) string {
+	levels := make([]string, len(t))
+
+	for i, level := range t {
+		if value, ok := values[level]; ok {
+			level = value
+		}
+		levels[i] = level
+	}
+
+	return strings.Join(levels, "/")
+}
+
+// returns the subscription filter matching all topics of the template
+func (t topicTemplate) filter() string {
+	levels := make([]string, len(t))
+
+	for i, level := range t {
+		if isPlaceholder(level) {
+			level = "+"
+		}
+		levels[i] = level
+	}
+
+	return strings.Join(levels, "/")
+}
+
+// returns the placeholder values of a topic, false if it does not match the template
+func (t topicTemplate) match(topic string) (map[string]string, bool) {
+	levels := strings.Split(topic, "/")
+	if len(levels) != len(t) {
+		return nil, false
+	}
+
+	values := make(map[string]string)
+
+	for i, level := range t {
+		switch {
+		case isPlaceholder(level):
+			values[level] = levels[i]
+		case level != levels[i]:
+			return nil, false
+		}
+	}
+
+	return values, true
+}
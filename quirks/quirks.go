@@ -0,0 +1,139 @@
+package quirks
+
+import (
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/enbility/cemd/api"
+)
+
+// the profiles of the known devices deviating from the specifications
+var DefaultProfiles = []api.QuirkProfile{
+	{
+		// uses multiple identical entities for the same functionality,
+		// and requires a MeasurementId even without an Electrical Connection server
+		Name:       "KEO",
+		VendorName: "KEO",
+		Quirks: []api.QuirkType{
+			api.QuirkTypeHeartbeatOnBindingEntity,
+			api.QuirkTypeLimitDescriptionMeasurementId,
+		},
+	},
+}
+
+// Registry holds the quirk profiles of devices
+//
+// Applications can register their own profiles, also to replace
+// a default profile by registering one with the same name
+type Registry struct {
+	mux      sync.Mutex
+	profiles []api.QuirkProfile
+}
+
+var _ api.QuirkRegistryInterface = (*Registry)(nil)
+
+// create a registry containing the provided profiles
+func NewRegistry(profiles ...api.QuirkProfile) *Registry {
+	r := &Registry{}
+
+	for _, profile := range profiles {
+		r.Register(profile)
+	}
+
+	return r
+}
+
+// create a registry containing the DefaultProfiles
+func NewDefaultRegistry() *Registry {
+	return NewRegistry(DefaultProfiles...)
+}
+
+// add a profile, replacing an existing profile with the same name
+func (r *Registry) Register(profile api.QuirkProfile) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	profile.Quirks = slices.Clone(profile.Quirks)
+
+	index := slices.IndexFunc(r.profiles, func(item api.QuirkProfile) bool {
+		return item.Name == profile.Name
+	})
+	if index >= 0 {
+		r.profiles[index] = profile
+		return
+	}
+
+	r.profiles = append(r.profiles, profile)
+}
+
+// remove the profile with the provided name
+func (r *Registry) Unregister(name string) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	r.profiles = slices.DeleteFunc(r.profiles, func(item api.QuirkProfile) bool {
+		return item.Name == name
+	})
+}
+
+// return all registered profiles
+func (r *Registry) Profiles() []api.QuirkProfile {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	result := make([]api.QuirkProfile, 0, len(r.profiles))
+	for _, profile := range r.profiles {
+		profile.Quirks = slices.Clone(profile.Quirks)
+		result = append(result, profile)
+	}
+
+	return result
+}
+
+func (r *Registry) HasQuirk(data api.ManufacturerData, quirk api.QuirkType) bool {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	for _, profile := range r.profiles {
+		if slices.Contains(profile.Quirks, quirk) && matches(profile, data) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r *Registry) HasQuirkForAnyDevice(quirk api.QuirkType) bool {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	for _, profile := range r.profiles {
+		if slices.Contains(profile.Quirks, quirk) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// returns if the manufacturer data matches the profile
+func matches(profile api.QuirkProfile, data api.ManufacturerData) bool {
+	contains := func(value, part string) bool {
+		return strings.Contains(strings.ToLower(value), strings.ToLower(part))
+	}
+
+	if profile.VendorName != "" && !contains(data.VendorName, profile.VendorName) {
+		return false
+	}
+
+	if profile.BrandName != "" && !contains(data.BrandName, profile.BrandName) {
+		return false
+	}
+
+	if profile.DeviceCode != "" && !strings.EqualFold(data.DeviceCode, profile.DeviceCode) {
+		return false
+	}
+
+	return true
+}
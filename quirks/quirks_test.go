@@ -0,0 +1,99 @@
+package quirks
+
+import (
+	"testing"
+
+	"github.com/enbility/cemd/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestRegistrySuite(t *testing.T) {
+	suite.Run(t, new(RegistrySuite))
+}
+
+type RegistrySuite struct {
+	suite.Suite
+
+	sut *Registry
+}
+
+func (s *RegistrySuite) BeforeTest(suiteName, testName string) {
+	s.sut = NewDefaultRegistry()
+}
+
+func (s *RegistrySuite) Test_Register() {
+	profiles := s.sut.Profiles()
+	assert.Equal(s.T(), len(DefaultProfiles), len(profiles))
+
+	s.sut.Register(api.QuirkProfile{
+		Name:       "Test",
+		VendorName: "Test",
+		Quirks:     []api.QuirkType{api.QuirkTypeHeartbeatOnBindingEntity},
+	})
+	assert.Equal(s.T(), len(DefaultProfiles)+1, len(s.sut.Profiles()))
+
+	// replaces the existing profile
+	s.sut.Register(api.QuirkProfile{
+		Name:       "Test",
+		VendorName: "Other",
+	})
+	profiles = s.sut.Profiles()
+	assert.Equal(s.T(), len(DefaultProfiles)+1, len(profiles))
+	assert.Equal(s.T(), "Other", profiles[len(profiles)-1].VendorName)
+	assert.Equal(s.T(), 0, len(profiles[len(profiles)-1].Quirks))
+
+	s.sut.Unregister("Test")
+	assert.Equal(s.T(), len(DefaultProfiles), len(s.sut.Profiles()))
+
+	s.sut.Unregister("KEO")
+	assert.Equal(s.T(), len(DefaultProfiles)-1, len(s.sut.Profiles()))
+	assert.False(s.T(), s.sut.HasQuirkForAnyDevice(api.QuirkTypeLimitDescriptionMeasurementId))
+
+	// the default profiles are not modified
+	assert.Equal(s.T(), "KEO", DefaultProfiles[0].Name)
+}
+
+func (s *RegistrySuite) Test_HasQuirk() {
+	data := api.ManufacturerData{}
+	assert.False(s.T(), s.sut.HasQuirk(data, api.QuirkTypeHeartbeatOnBindingEntity))
+
+	data.VendorName = "keo GmbH"
+	assert.True(s.T(), s.sut.HasQuirk(data, api.QuirkTypeHeartbeatOnBindingEntity))
+	assert.True(s.T(), s.sut.HasQuirk(data, api.QuirkTypeLimitDescriptionMeasurementId))
+
+	data = api.ManufacturerData{BrandName: "KEO"}
+	assert.False(s.T(), s.sut.HasQuirk(data, api.QuirkTypeHeartbeatOnBindingEntity))
+
+	s.sut.Register(api.QuirkProfile{
+		Name:       "Device",
+		VendorName: "Vendor",
+		DeviceCode: "Code1",
+		Quirks:     []api.QuirkType{api.QuirkTypeHeartbeatOnBindingEntity},
+	})
+
+	data = api.ManufacturerData{VendorName: "Vendor"}
+	assert.False(s.T(), s.sut.HasQuirk(data, api.QuirkTypeHeartbeatOnBindingEntity))
+
+	data.DeviceCode = "Code2"
+	assert.False(s.T(), s.sut.HasQuirk(data, api.QuirkTypeHeartbeatOnBindingEntity))
+
+	data.DeviceCode = "code1"
+	assert.True(s.T(), s.sut.HasQuirk(data, api.QuirkTypeHeartbeatOnBindingEntity))
+}
+
+func (s *RegistrySuite) Test_HasQuirkForAnyDevice() {
+	assert.True(s.T(), s.sut.HasQuirkForAnyDevice(api.QuirkTypeLimitDescriptionMeasurementId))
+
+	s.sut = NewRegistry()
+	assert.Equal(s.T(), 0, len(s.sut.Profiles()))
+	assert.False(s.T(), s.sut.HasQuirkForAnyDevice(api.QuirkTypeLimitDescriptionMeasurementId))
+	assert.False(s.T(), s.sut.HasQuirk(api.ManufacturerData{}, api.QuirkTypeLimitDescriptionMeasurementId))
+
+	s.sut.Register(api.QuirkProfile{
+		Name:   "All",
+		Quirks: []api.QuirkType{api.QuirkTypeLimitDescriptionMeasurementId},
+	})
+	assert.True(s.T(), s.sut.HasQuirkForAnyDevice(api.QuirkTypeLimitDescriptionMeasurementId))
+	assert.True(s.T(), s.sut.HasQuirk(api.ManufacturerData{}, api.QuirkTypeLimitDescriptionMeasurementId))
+}
@@ -0,0 +1,122 @@
+package recording
+
+import (
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+
+	shipapi "github.com/enbility/ship-go/api"
+	"github.com/enbility/ship-go/logging"
+)
+
+// Recorder writes the SPINE datagrams exchanged with remote devices
+//
+// The datagrams are captured where they are passed between the SHIP
+// connection and the SPINE device, by wrapping the hub reader, which
+// sets up the remote devices. Use NewService for an EEBUS service
+// recording all its connections.
+//
+// Example:
+//
+//	file, _ := os.Create("traffic.jsonl")
+//	service := recording.NewService(configuration, serviceHandler, recording.NewRecorder(file))
+//	cem := cem.NewCEMWithService(service, eventCB, logger)
+type Recorder struct {
+	mux     sync.Mutex
+	encoder *json.Encoder
+}
+
+// parameters:
+//   - writer: the destination of the recording
+func NewRecorder(writer io.Writer) *Recorder {
+	return &Recorder{
+		encoder: json.NewEncoder(writer),
+	}
+}
+
+// add a SPINE datagram to the recording
+func (r *Recorder) Record(ski string, direction Direction, payload []byte) error {
+	entry := Entry{
+		Timestamp: time.Now().UTC(),
+		Ski:       ski,
+		Direction: direction,
+		Payload:   json.RawMessage(payload),
+	}
+
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	return r.encoder.Encode(entry)
+}
+
+// return a hub reader recording the datagrams of all remote devices
+// set up by the given hub reader
+func (r *Recorder) WrapHubReader(reader shipapi.HubReaderInterface) shipapi.HubReaderInterface {
+	return &hubReader{
+		HubReaderInterface: reader,
+		recorder:           r,
+	}
+}
+
+func (r *Recorder) record(ski string, direction Direction, payload []byte) {
+	if err := r.Record(ski, direction, payload); err != nil {
+		logging.Log().Error("error recording datagram:", err)
+	}
+}
+
+// a hub reader wrapping the SHIP data reader and writer of each remote device
+type hubReader struct {
+	shipapi.HubReaderInterface
+
+	recorder *Recorder
+}
+
+func (h *hubReader) SetupRemoteDevice(ski string, writeI shipapi.ShipConnectionDataWriterInterface) shipapi.ShipConnectionDataReaderInterface {
+	writer := &dataWriter{
+		writer:   writeI,
+		recorder: h.recorder,
+		ski:      ski,
+	}
+
+	reader := h.HubReaderInterface.SetupRemoteDevice(ski, writer)
+	if reader == nil {
+		return nil
+	}
+
+	return &dataReader{
+		reader:   reader,
+		recorder: h.recorder,
+		ski:      ski,
+	}
+}
+
+// records the datagrams sent to a remote device
+type dataWriter struct {
+	writer   shipapi.ShipConnectionDataWriterInterface
+	recorder *Recorder
+	ski      string
+}
+
+var _ shipapi.ShipConnectionDataWriterInterface = (*dataWriter)(nil)
+
+func (w *dataWriter) WriteShipMessageWithPayload(message []byte) {
+	w.recorder.record(w.ski, DirectionOutgoing, message)
+
+	w.writer.WriteShipMessageWithPayload(message)
+}
+
+// records the datagrams received from a remote device
+type dataReader struct {
+	reader   shipapi.ShipConnectionDataReaderInterface
+	recorder *Recorder
+	ski      string
+}
+
+var _ shipapi.ShipConnectionDataReaderInterface = (*dataReader)(nil)
+
+func (r *dataReader) HandleShipPayloadMessage(message []byte) {
+	r.recorder.record(r.ski, DirectionIncoming, message)
+
+	r.reader.HandleShipPayloadMessage(message)
+}
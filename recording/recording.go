@@ -0,0 +1,72 @@
+// Package recording captures the SPINE datagrams exchanged with remote
+// devices and replays them against local use case implementations.
+//
+// A recording is a file with one JSON encoded Entry per line, so it can be
+// shortened or anonymized with any text editor before adding it to a bug
+// report or a regression test.
+package recording
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"os"
+	"time"
+)
+
+// the direction of a recorded datagram, as seen by the local device
+type Direction string
+
+const (
+	DirectionIncoming Direction = "in"  // sent by the remote device
+	DirectionOutgoing Direction = "out" // sent by the local device
+)
+
+// a single recorded SPINE datagram
+type Entry struct {
+	Timestamp time.Time `json:"timestamp"`
+	Ski       string    `json:"ski"` // the SKI of the remote device
+	Direction Direction `json:"direction"`
+
+	// the SPINE message, as passed between the SHIP and SPINE layer
+	Payload json.RawMessage `json:"payload"`
+}
+
+// read all entries of a recording
+func Load(reader io.Reader) ([]Entry, error) {
+	var entries []Entry
+
+	scanner := bufio.NewScanner(reader)
+	// datagrams, e.g. detailed discovery replies, can be rather large
+	scanner.Buffer(make([]byte, 0, 64*1024), 4*1024*1024)
+
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+
+		var entry Entry
+		if err := json.Unmarshal(line, &entry); err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+// read all entries of a recording file
+func LoadFile(path string) ([]Entry, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return Load(file)
+}
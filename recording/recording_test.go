@@ -0,0 +1,229 @@
+package recording
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/loopback"
+	"github.com/enbility/cemd/ucmgcp"
+	eebusapi "github.com/enbility/eebus-go/api"
+	"github.com/enbility/ship-go/cert"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestRecordingSuite(t *testing.T) {
+	suite.Run(t, new(RecordingSuite))
+}
+
+type RecordingSuite struct {
+	suite.Suite
+}
+
+func (s *RecordingSuite) Test_Load() {
+	entries, err := Load(strings.NewReader("invalid"))
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), entries)
+
+	entries, err = Load(strings.NewReader(""))
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 0, len(entries))
+
+	entries, err = LoadFile("testdata/invalid.jsonl")
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), entries)
+
+	entries, err = LoadFile("testdata/mgcp.jsonl")
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 34, len(entries))
+	assert.Equal(s.T(), DirectionIncoming, entries[0].Direction)
+	assert.Equal(s.T(), DirectionOutgoing, entries[1].Direction)
+}
+
+func (s *RecordingSuite) Test_Recorder() {
+	var buffer bytes.Buffer
+	sut := NewRecorder(&buffer)
+
+	payload := `{"datagram":{"header":{"specificationVersion":"1.3.0","msgCounter":1,"cmdClassifier":"read"},"payload":{"cmd":[{"nodeManagementUseCaseData":{}}]}}}`
+	err := sut.Record("ski", DirectionOutgoing, []byte(payload))
+	assert.Nil(s.T(), err)
+
+	entries, err := Load(&buffer)
+	assert.Nil(s.T(), err)
+	if assert.Equal(s.T(), 1, len(entries)) {
+		assert.Equal(s.T(), "ski", entries[0].Ski)
+		assert.Equal(s.T(), DirectionOutgoing, entries[0].Direction)
+		assert.JSONEq(s.T(), payload, string(entries[0].Payload))
+	}
+
+	network := loopback.NewNetwork()
+	defer network.Close()
+
+	device, err := network.NewDevice(loopback.DeviceConfiguration{
+		Name:          "cem",
+		DeviceType:    model.DeviceTypeTypeEnergyManagementSystem,
+		EntityTypes:   []model.EntityTypeType{model.EntityTypeTypeCEM},
+		WrapHubReader: sut.WrapHubReader,
+	})
+	assert.Nil(s.T(), err)
+
+	remote, err := network.NewCEM("remote", nil)
+	assert.Nil(s.T(), err)
+
+	_, err = network.Connect(device, remote)
+	assert.Nil(s.T(), err)
+	err = network.WaitIdle(time.Second * 5)
+	assert.Nil(s.T(), err)
+
+	entries, err = Load(&buffer)
+	assert.Nil(s.T(), err)
+
+	directions := make(map[Direction]int)
+	for _, entry := range entries {
+		assert.Equal(s.T(), remote.SKI(), entry.Ski)
+		assert.True(s.T(), strings.HasPrefix(string(entry.Payload), `{"datagram":`))
+		directions[entry.Direction]++
+	}
+	assert.NotEqual(s.T(), 0, directions[DirectionIncoming])
+	assert.NotEqual(s.T(), 0, directions[DirectionOutgoing])
+}
+
+func (s *RecordingSuite) Test_Service() {
+	certificate, err := cert.CreateCertificate("unit", "org", "DE", "CN")
+	assert.Nil(s.T(), err)
+
+	configuration, err := eebusapi.NewConfiguration(
+		"vendor", "brand", "model", "serial",
+		model.DeviceTypeTypeEnergyManagementSystem,
+		[]model.EntityTypeType{model.EntityTypeTypeCEM},
+		4729, certificate, 230, time.Second*4)
+	assert.Nil(s.T(), err)
+
+	var buffer bytes.Buffer
+	sut := NewService(configuration, nil, NewRecorder(&buffer))
+	err = sut.Setup()
+	assert.Nil(s.T(), err)
+
+	assert.NotNil(s.T(), sut.PairingDetailForSki("ski"))
+	assert.NotNil(s.T(), sut.RemoteServiceForSKI("ski"))
+
+	sut.SetAutoAccept(true)
+	assert.True(s.T(), sut.IsAutoAcceptEnabled())
+
+	// the local device requests the detailed discovery data of a new remote device
+	writer := &testWriter{}
+	reader := sut.SetupRemoteDevice("ski", writer)
+	assert.NotNil(s.T(), reader)
+	assert.NotEqual(s.T(), 0, len(writer.messages))
+
+	// the remote device requests the detailed discovery data as well
+	payload := `{"datagram":{"header":{"specificationVersion":"1.3.0","addressSource":{"device":"d:_i:remote","entity":[0],"feature":0},"addressDestination":{"entity":[0],"feature":0},"msgCounter":1,"cmdClassifier":"read"},"payload":{"cmd":[{"nodeManagementDetailedDiscoveryData":{}}]}}}`
+	reader.HandleShipPayloadMessage([]byte(payload))
+
+	entries, err := Load(&buffer)
+	assert.Nil(s.T(), err)
+
+	var incoming, outgoing []Entry
+	for _, entry := range entries {
+		assert.Equal(s.T(), "ski", entry.Ski)
+		if entry.Direction == DirectionIncoming {
+			incoming = append(incoming, entry)
+		} else {
+			outgoing = append(outgoing, entry)
+		}
+	}
+
+	if assert.Equal(s.T(), 1, len(incoming)) {
+		assert.JSONEq(s.T(), payload, string(incoming[0].Payload))
+	}
+	if assert.Equal(s.T(), len(writer.messages), len(outgoing)) {
+		for i, entry := range outgoing {
+			assert.JSONEq(s.T(), string(writer.messages[i]), string(entry.Payload))
+		}
+	}
+}
+
+func (s *RecordingSuite) Test_Replay() {
+	network := loopback.NewNetwork()
+	defer network.Close()
+
+	// the device has to match the one used for the recording
+	device, err := network.NewCEM("cem", nil)
+	assert.Nil(s.T(), err)
+
+	events := loopback.NewEventRecorder()
+	usecase := ucmgcp.NewUCMGCP(device.Service(), events.EntityEventCB)
+	device.AddUseCase(usecase)
+
+	entries, err := LoadFile("testdata/mgcp.jsonl")
+	assert.Nil(s.T(), err)
+	ski := entries[0].Ski
+
+	sut, err := NewReplay(device.Service(), entries)
+	assert.Nil(s.T(), err)
+	defer sut.Close()
+
+	sut.Run()
+
+	for _, event := range []api.EventType{
+		ucmgcp.DataUpdatePower,
+		ucmgcp.DataUpdateEnergyFeedIn,
+		ucmgcp.DataUpdateEnergyConsumed,
+		ucmgcp.DataUpdatePowerLimitationFactor,
+	} {
+		_, ok := events.WaitFor(ski, event, time.Second*5)
+		assert.True(s.T(), ok, event)
+	}
+
+	var entity spineapi.EntityRemoteInterface
+	remoteDevice := device.LocalDevice().RemoteDeviceForSki(ski)
+	if assert.NotNil(s.T(), remoteDevice) {
+		for _, item := range remoteDevice.Entities() {
+			if item.EntityType() == model.EntityTypeTypeCEM {
+				entity = item
+			}
+		}
+	}
+
+	supported, err := usecase.IsUseCaseSupported(entity)
+	assert.Nil(s.T(), err)
+	assert.True(s.T(), supported)
+
+	power, err := usecase.Power(entity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), -2500.0, power)
+
+	energy, err := usecase.EnergyFeedIn(entity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 1200.0, energy)
+
+	energy, err = usecase.EnergyConsumed(entity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 3400.0, energy)
+
+	factor, err := usecase.PowerLimitationFactor(entity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 70.0, factor)
+
+	// the local device requested the detailed discovery data
+	sent := sut.Sent()
+	if assert.NotEqual(s.T(), 0, len(sent)) {
+		assert.Equal(s.T(), ski, sent[0].Ski)
+		assert.Equal(s.T(), DirectionOutgoing, sent[0].Direction)
+		assert.True(s.T(), strings.Contains(string(sent[0].Payload), "nodeManagementDetailedDiscoveryData"))
+	}
+}
+
+// a SHIP connection collecting all written messages
+type testWriter struct {
+	messages [][]byte
+}
+
+func (w *testWriter) WriteShipMessageWithPayload(message []byte) {
+	w.messages = append(w.messages, message)
+}
@@ -0,0 +1,130 @@
+package recording
+
+import (
+	"encoding/json"
+	"errors"
+	"slices"
+	"sync"
+	"time"
+
+	eebusapi "github.com/enbility/eebus-go/api"
+	shipapi "github.com/enbility/ship-go/api"
+)
+
+// Replay passes the incoming datagrams of a recording to a local device
+//
+// The local device has to provide the same entities and features as the
+// device used for the recording, e.g. by adding the same use cases in
+// the same order, as the recorded datagrams address them by their ids.
+// The device address itself is not relevant.
+//
+// The datagrams are delivered without any delay, so timing related
+// behaviour, e.g. heartbeat timeouts, is not reproduced.
+type Replay struct {
+	hub     shipapi.HubReaderInterface
+	entries []Entry
+
+	mux     sync.Mutex
+	readers map[string]shipapi.ShipConnectionDataReaderInterface
+	sent    []Entry
+}
+
+// parameters:
+//   - service: the service of the local device, which has not to be started
+//   - entries: the recording to replay
+func NewReplay(service eebusapi.ServiceInterface, entries []Entry) (*Replay, error) {
+	hub, ok := service.(shipapi.HubReaderInterface)
+	if !ok {
+		return nil, errors.New("service does not support remote device connections")
+	}
+
+	return &Replay{
+		hub:     hub,
+		entries: entries,
+		readers: make(map[string]shipapi.ShipConnectionDataReaderInterface),
+	}, nil
+}
+
+// connect each remote device of the recording and pass all its
+// incoming datagrams to the local device, in the recorded order
+//
+// the datagrams are processed synchronously, but the use case
+// implementations report their events asynchronously
+func (r *Replay) Run() {
+	for _, entry := range r.entries {
+		reader := r.connect(entry.Ski)
+
+		if entry.Direction != DirectionIncoming {
+			continue
+		}
+
+		reader.HandleShipPayloadMessage(entry.Payload)
+	}
+}
+
+// return the datagrams the local device sent during the replay
+func (r *Replay) Sent() []Entry {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	return slices.Clone(r.sent)
+}
+
+// disconnect all remote devices of the recording
+func (r *Replay) Close() {
+	r.mux.Lock()
+	skis := make([]string, 0, len(r.readers))
+	for ski := range r.readers {
+		skis = append(skis, ski)
+	}
+	r.readers = make(map[string]shipapi.ShipConnectionDataReaderInterface)
+	r.mux.Unlock()
+
+	for _, ski := range skis {
+		r.hub.RemoteSKIDisconnected(ski)
+	}
+}
+
+// return the reader for a remote device, connecting it on first use
+func (r *Replay) connect(ski string) shipapi.ShipConnectionDataReaderInterface {
+	r.mux.Lock()
+	reader, ok := r.readers[ski]
+	r.mux.Unlock()
+
+	if ok {
+		return reader
+	}
+
+	r.hub.RemoteSKIConnected(ski)
+	reader = r.hub.SetupRemoteDevice(ski, &replayWriter{replay: r, ski: ski})
+
+	r.mux.Lock()
+	r.readers[ski] = reader
+	r.mux.Unlock()
+
+	return reader
+}
+
+func (r *Replay) addSent(ski string, payload []byte) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	r.sent = append(r.sent, Entry{
+		Timestamp: time.Now().UTC(),
+		Ski:       ski,
+		Direction: DirectionOutgoing,
+		Payload:   json.RawMessage(slices.Clone(payload)),
+	})
+}
+
+// collects the datagrams the local device sends to a remote device
+type replayWriter struct {
+	replay *Replay
+	ski    string
+}
+
+var _ shipapi.ShipConnectionDataWriterInterface = (*replayWriter)(nil)
+
+func (w *replayWriter) WriteShipMessageWithPayload(message []byte) {
+	w.replay.addSent(w.ski, message)
+}
@@ -0,0 +1,118 @@
+package recording
+
+import (
+	"sync"
+
+	eebusapi "github.com/enbility/eebus-go/api"
+	"github.com/enbility/eebus-go/service"
+	shipapi "github.com/enbility/ship-go/api"
+	"github.com/enbility/ship-go/hub"
+	"github.com/enbility/ship-go/mdns"
+)
+
+// Service is an EEBUS service recording the SPINE datagrams of all
+// connected remote devices
+//
+// The eebus-go service creates its connections hub internally, so this
+// service runs its own hub, which sets up the remote devices via the
+// recorder, and passes all hub related calls to it.
+type Service struct {
+	*service.Service
+
+	recorder *Recorder
+
+	connectionsHub shipapi.HubInterface
+
+	startOnce sync.Once
+}
+
+// creates a new EEBUS service recording into the given recorder
+func NewService(configuration *eebusapi.Configuration, serviceHandler eebusapi.ServiceReaderInterface, recorder *Recorder) *Service {
+	return &Service{
+		Service:  service.NewService(configuration, serviceHandler),
+		recorder: recorder,
+	}
+}
+
+var _ eebusapi.ServiceInterface = (*Service)(nil)
+var _ shipapi.HubReaderInterface = (*Service)(nil)
+
+// Sets up the service and its connections hub
+func (s *Service) Setup() error {
+	if err := s.Service.Setup(); err != nil {
+		return err
+	}
+
+	sd := s.Configuration()
+	localService := s.LocalService()
+
+	mdns := mdns.NewMDNS(
+		localService.SKI(),
+		sd.DeviceBrand(),
+		sd.DeviceModel(),
+		string(sd.DeviceType()),
+		sd.Identifier(),
+		sd.MdnsServiceName(),
+		sd.Port(),
+		sd.Interfaces(),
+		sd.MdnsProviderSelection(),
+	)
+
+	s.connectionsHub = hub.NewHub(s, mdns, sd.Port(), sd.Certificate(), localService)
+
+	return nil
+}
+
+// set up a remote device, recording its datagrams
+func (s *Service) SetupRemoteDevice(ski string, writeI shipapi.ShipConnectionDataWriterInterface) shipapi.ShipConnectionDataReaderInterface {
+	return s.recorder.WrapHubReader(s.Service).SetupRemoteDevice(ski, writeI)
+}
+
+// Starts the service
+func (s *Service) Start() {
+	s.startOnce.Do(func() {
+		s.connectionsHub.Start()
+	})
+}
+
+// Shutdown all services and stop the server.
+func (s *Service) Shutdown() {
+	s.connectionsHub.Shutdown()
+}
+
+// Get the current pairing details for a given SKI
+func (s *Service) PairingDetailForSki(ski string) *shipapi.ConnectionStateDetail {
+	return s.connectionsHub.PairingDetailForSki(ski)
+}
+
+// Returns the Service detail of a given remote SKI
+func (s *Service) RemoteServiceForSKI(ski string) *shipapi.ServiceDetails {
+	return s.connectionsHub.ServiceForSKI(ski)
+}
+
+func (s *Service) SetAutoAccept(value bool) {
+	s.LocalService().SetAutoAccept(value)
+	s.connectionsHub.SetAutoAccept(value)
+}
+
+// Sets the SKI as being paired
+// and connect it if paired and not currently being connected
+func (s *Service) RegisterRemoteSKI(ski string) {
+	s.connectionsHub.RegisterRemoteSKI(ski)
+}
+
+// Sets the SKI as not being paired
+// and disconnects it if connected
+func (s *Service) UnregisterRemoteSKI(ski string) {
+	s.connectionsHub.UnregisterRemoteSKI(ski)
+}
+
+// Close a connection to a remote SKI
+func (s *Service) DisconnectSKI(ski string, reason string) {
+	s.connectionsHub.DisconnectSKI(ski, reason)
+}
+
+// Cancels the pairing process for a SKI
+func (s *Service) CancelPairingWithSKI(ski string) {
+	s.connectionsHub.CancelPairingWithSKI(ski)
+}
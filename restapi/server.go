@@ -0,0 +1,334 @@
+// Package restapi provides an HTTP/JSON API on top of a CEM
+//
+// It exposes the connected devices, their entities and the values and write
+// operations of all use cases added to the CEM:
+//
+//	GET  /devices
+//	GET  /devices/{ski}
+//	GET  /devices/{ski}/entities
+//	GET  /devices/{ski}/entities/{address}
+//	GET  /devices/{ski}/entities/{address}/{usecase}
+//	GET  /devices/{ski}/entities/{address}/{usecase}/{datapoint}
+//	POST /devices/{ski}/entities/{address}/{usecase}/{operation}
+//
+// The entity address is the list of entity ids joined with dots, e.g. "1.1".
+// Values are encoded in the format of the use case getters, the body of a
+// write operation in the format of the use case write method.
+package restapi
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/cem"
+	"github.com/enbility/cemd/datapoints"
+	"github.com/enbility/cemd/util"
+	eebusapi "github.com/enbility/eebus-go/api"
+	spineapi "github.com/enbility/spine-go/api"
+)
+
+// the maximum size of a request body
+const maxBodySize = 1 << 20
+
+var (
+	errDeviceNotFound    = errors.New("device not found")
+	errEntityNotFound    = errors.New("entity not found")
+	errUseCaseNotFound   = errors.New("use case not found")
+	errDataPointNotFound = errors.New("data point not found")
+	errMethodNotAllowed  = errors.New("method not allowed")
+)
+
+// HTTP handler exposing the use cases of a CEM
+type Server struct {
+	cem *cem.Cem
+
+	writeCB datapoints.WriteCallback
+}
+
+// create a new server for the use cases added to the CEM
+//
+// use cases added to the CEM later on are exposed as well
+func NewServer(c *cem.Cem) *Server {
+	return &Server{
+		cem: c,
+	}
+}
+
+var _ http.Handler = (*Server)(nil)
+
+// set a callback invoked after each write operation, has to be set before serving requests
+func (s *Server) SetWriteCallback(cb datapoints.WriteCallback) {
+	s.writeCB = cb
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	segments := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	if len(segments) == 0 || segments[0] != "devices" {
+		writeError(w, http.StatusNotFound, errors.New("not found"))
+		return
+	}
+
+	// only the write operations use POST
+	if r.Method != http.MethodGet && (r.Method != http.MethodPost || len(segments) != 6) {
+		allow := http.MethodGet
+		if len(segments) == 6 {
+			allow += ", " + http.MethodPost
+		}
+		w.Header().Set("Allow", allow)
+		writeError(w, http.StatusMethodNotAllowed, errMethodNotAllowed)
+		return
+	}
+
+	switch {
+	case len(segments) == 1:
+		s.handleDevices(w)
+		return
+	case len(segments) > 2 && segments[2] != "entities":
+		writeError(w, http.StatusNotFound, errors.New("not found"))
+		return
+	case len(segments) > 6:
+		writeError(w, http.StatusNotFound, errors.New("not found"))
+		return
+	}
+
+	device := s.remoteDevice(segments[1])
+	if device == nil {
+		writeError(w, http.StatusNotFound, errDeviceNotFound)
+		return
+	}
+
+	switch len(segments) {
+	case 2:
+		writeJSON(w, http.StatusOK, s.device(device))
+		return
+	case 3:
+		writeJSON(w, http.StatusOK, s.entities(device))
+		return
+	}
+
+	entity, err := remoteEntity(device, segments[3])
+	if err != nil {
+		writeError(w, statusCode(err), err)
+		return
+	}
+
+	if len(segments) == 4 {
+		writeJSON(w, http.StatusOK, s.entity(entity))
+		return
+	}
+
+	usecase, ok := s.useCase(segments[4])
+	if !ok {
+		writeError(w, http.StatusNotFound, errUseCaseNotFound)
+		return
+	}
+
+	switch {
+	case len(segments) == 5:
+		writeJSON(w, http.StatusOK, values(usecase, entity))
+	case r.Method == http.MethodPost:
+		s.handleWrite(w, r, usecase, entity, segments[5])
+	default:
+		s.handleRead(w, usecase, entity, segments[5])
+	}
+}
+
+func (s *Server) handleDevices(w http.ResponseWriter) {
+	result := []Device{}
+
+	for _, device := range s.cem.Service.LocalDevice().RemoteDevices() {
+		// devices are added before their SKI is known
+		if device.Ski() == "" {
+			continue
+		}
+		result = append(result, s.device(device))
+	}
+
+	writeJSON(w, http.StatusOK, result)
+}
+
+func (s *Server) handleRead(w http.ResponseWriter, usecase datapoints.UseCase, entity spineapi.EntityRemoteInterface, name string) {
+	dataPoint, ok := usecase.DataPoint(name)
+	if !ok {
+		if _, ok := usecase.Operation(name); ok {
+			w.Header().Set("Allow", http.MethodPost)
+			writeError(w, http.StatusMethodNotAllowed, errMethodNotAllowed)
+			return
+		}
+
+		writeError(w, http.StatusNotFound, errDataPointNotFound)
+		return
+	}
+
+	value, err := dataPoint.Read(entity)
+	if err != nil {
+		writeError(w, statusCode(err), err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, value)
+}
+
+func (s *Server) handleWrite(w http.ResponseWriter, r *http.Request, usecase datapoints.UseCase, entity spineapi.EntityRemoteInterface, name string) {
+	operation, ok := usecase.Operation(name)
+	if !ok {
+		if _, ok := usecase.DataPoint(name); ok {
+			w.Header().Set("Allow", http.MethodGet)
+			writeError(w, http.StatusMethodNotAllowed, errMethodNotAllowed)
+			return
+		}
+
+		writeError(w, http.StatusNotFound, errDataPointNotFound)
+		return
+	}
+
+	data, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxBodySize))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, errors.Join(datapoints.ErrInvalidData, err))
+		return
+	}
+
+	msgCounter, err := operation.Write(entity, data)
+	if s.writeCB != nil {
+		s.writeCB(entity, usecase.Name, operation.Name, msgCounter, err)
+	}
+	if err != nil {
+		writeError(w, statusCode(err), err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, WriteResult{MsgCounter: msgCounter})
+}
+
+// return the connected remote device with the SKI
+func (s *Server) remoteDevice(ski string) spineapi.DeviceRemoteInterface {
+	if ski == "" {
+		return nil
+	}
+
+	return s.cem.Service.LocalDevice().RemoteDeviceForSki(ski)
+}
+
+func (s *Server) useCases() []datapoints.UseCase {
+	return datapoints.ForUseCases(s.cem.UseCases())
+}
+
+// return the use case with the short name, e.g. "mgcp"
+func (s *Server) useCase(name string) (datapoints.UseCase, bool) {
+	for _, item := range s.useCases() {
+		if item.Name == name {
+			return item, true
+		}
+	}
+
+	return datapoints.UseCase{}, false
+}
+
+func (s *Server) device(device spineapi.DeviceRemoteInterface) Device {
+	result := Device{
+		Ski:      device.Ski(),
+		Entities: s.entities(device),
+	}
+
+	if address := device.Address(); address != nil {
+		result.Address = string(*address)
+	}
+	if deviceType := device.DeviceType(); deviceType != nil {
+		result.DeviceType = string(*deviceType)
+	}
+
+	return result
+}
+
+func (s *Server) entities(device spineapi.DeviceRemoteInterface) []Entity {
+	result := []Entity{}
+
+	for _, entity := range device.Entities() {
+		result = append(result, s.entity(entity))
+	}
+
+	return result
+}
+
+func (s *Server) entity(entity spineapi.EntityRemoteInterface) Entity {
+	result := Entity{
+		Address:    datapoints.EntityAddress(entity),
+		EntityType: string(entity.EntityType()),
+		UseCases:   []string{},
+	}
+
+	for _, usecase := range s.useCases() {
+		if supported, err := usecase.UseCase.IsUseCaseSupported(entity); err == nil && supported {
+			result.UseCases = append(result.UseCases, usecase.Name)
+		}
+	}
+
+	return result
+}
+
+// return all available values of a use case, values with errors are omitted
+func values(usecase datapoints.UseCase, entity spineapi.EntityRemoteInterface) map[string]any {
+	result := map[string]any{}
+
+	for _, dataPoint := range usecase.DataPoints {
+		if value, err := dataPoint.Read(entity); err == nil {
+			result[dataPoint.Name] = value
+		}
+	}
+
+	return result
+}
+
+// return the entity of a device for an address in the format of datapoints.EntityAddress
+func remoteEntity(device spineapi.DeviceRemoteInterface, address string) (spineapi.EntityRemoteInterface, error) {
+	ids, err := datapoints.ParseEntityAddress(address)
+	if err != nil {
+		return nil, err
+	}
+
+	entity := device.Entity(ids)
+	if entity == nil {
+		return nil, errEntityNotFound
+	}
+
+	return entity, nil
+}
+
+// return the status code for an error
+func statusCode(err error) int {
+	switch {
+	case errors.Is(err, datapoints.ErrInvalidAddress),
+		errors.Is(err, datapoints.ErrInvalidData),
+		errors.Is(err, eebusapi.ErrMissingData):
+		return http.StatusBadRequest
+	case errors.Is(err, errEntityNotFound),
+		errors.Is(err, eebusapi.ErrEntityNotFound),
+		errors.Is(err, eebusapi.ErrDataNotAvailable),
+		errors.Is(err, eebusapi.ErrMetadataNotAvailable):
+		return http.StatusNotFound
+	case errors.Is(err, api.ErrNoCompatibleEntity),
+		errors.Is(err, eebusapi.ErrNotSupported),
+		errors.Is(err, eebusapi.ErrUsecCaseNotSupported),
+		errors.Is(err, eebusapi.ErrFunctionNotSupported),
+		errors.Is(err, eebusapi.ErrOperationOnFunctionNotSupported):
+		return http.StatusUnprocessableEntity
+	case errors.Is(err, util.ErrDeviceDisconnected):
+		return http.StatusServiceUnavailable
+	}
+
+	return http.StatusInternalServerError
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, Error{Error: err.Error()})
+}
+
+func writeJSON(w http.ResponseWriter, status int, value any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(value)
+}
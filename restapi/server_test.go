@@ -0,0 +1,229 @@
+package restapi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/approval"
+	"github.com/enbility/cemd/datapoints"
+	"github.com/enbility/cemd/loopback"
+	"github.com/enbility/cemd/uclpc"
+	"github.com/enbility/cemd/uclpcserver"
+	"github.com/enbility/cemd/ucmgcp"
+	"github.com/enbility/cemd/ucmgcpserver"
+	"github.com/enbility/cemd/util"
+	eebusapi "github.com/enbility/eebus-go/api"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestServerSuite(t *testing.T) {
+	suite.Run(t, new(ServerSuite))
+}
+
+type ServerSuite struct {
+	suite.Suite
+
+	network *loopback.Network
+
+	cem       *loopback.Device
+	cemEvents *loopback.EventRecorder
+
+	smgw       *loopback.Device
+	mgcpServer *ucmgcpserver.UCMGCPServer
+	lpcServer  *uclpcserver.UCLPCServer
+
+	sut *Server
+
+	// the path of the remote CEM entity
+	entityPath string
+}
+
+func (s *ServerSuite) BeforeTest(suiteName, testName string) {
+	s.network = loopback.NewNetwork()
+
+	var err error
+	s.cem, err = s.network.NewCEM("cem", nil)
+	assert.Nil(s.T(), err)
+
+	s.cemEvents = loopback.NewEventRecorder()
+	s.cem.AddUseCase(ucmgcp.NewUCMGCP(s.cem.Service(), s.cemEvents.EntityEventCB))
+	s.cem.AddUseCase(uclpc.NewUCLPC(s.cem.Service(), s.cemEvents.EntityEventCB))
+
+	s.smgw, err = s.network.NewCEM("smgw", nil)
+	assert.Nil(s.T(), err)
+
+	serverEvents := loopback.NewEventRecorder()
+	s.mgcpServer = ucmgcpserver.NewUCMGCP(s.smgw.Service(), serverEvents.EntityEventCB)
+	s.smgw.AddUseCase(s.mgcpServer)
+	s.lpcServer = uclpcserver.NewUCLPC(s.smgw.Service(), serverEvents.EntityEventCB)
+	s.lpcServer.SetApprovalPolicy(approval.NewAutoApprovePolicy())
+	s.smgw.AddUseCase(s.lpcServer)
+
+	err = s.lpcServer.SetConsumptionLimit(api.LoadLimit{IsChangeable: true})
+	assert.Nil(s.T(), err)
+
+	_, err = s.network.Connect(s.cem, s.smgw)
+	assert.Nil(s.T(), err)
+
+	err = s.network.WaitIdle(time.Second * 5)
+	assert.Nil(s.T(), err)
+
+	entity := s.cem.RemoteEntity(s.smgw, model.EntityTypeTypeCEM)
+	assert.NotNil(s.T(), entity)
+	s.entityPath = "/devices/" + s.smgw.SKI() + "/entities/" + datapoints.EntityAddress(entity)
+
+	s.sut = NewServer(s.cem.Cem)
+}
+
+func (s *ServerSuite) AfterTest(suiteName, testName string) {
+	s.network.Close()
+}
+
+func (s *ServerSuite) request(method, path, body string) *httptest.ResponseRecorder {
+	request := httptest.NewRequest(method, path, strings.NewReader(body))
+	recorder := httptest.NewRecorder()
+	s.sut.ServeHTTP(recorder, request)
+	return recorder
+}
+
+func (s *ServerSuite) Test_Devices() {
+	response := s.request(http.MethodGet, "/devices", "")
+	assert.Equal(s.T(), http.StatusOK, response.Code)
+	assert.Equal(s.T(), "application/json", response.Header().Get("Content-Type"))
+
+	var devices []Device
+	err := json.Unmarshal(response.Body.Bytes(), &devices)
+	assert.Nil(s.T(), err)
+	if assert.Equal(s.T(), 1, len(devices)) {
+		assert.Equal(s.T(), s.smgw.SKI(), devices[0].Ski)
+		assert.Equal(s.T(), string(model.DeviceTypeTypeEnergyManagementSystem), devices[0].DeviceType)
+	}
+
+	response = s.request(http.MethodGet, s.entityPath, "")
+	assert.Equal(s.T(), http.StatusOK, response.Code)
+
+	var entity Entity
+	err = json.Unmarshal(response.Body.Bytes(), &entity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), string(model.EntityTypeTypeCEM), entity.EntityType)
+	assert.Equal(s.T(), []string{"mgcp", "lpc"}, entity.UseCases)
+
+	response = s.request(http.MethodGet, "/devices/"+s.smgw.SKI()+"/entities", "")
+	assert.Equal(s.T(), http.StatusOK, response.Code)
+
+	var entities []Entity
+	err = json.Unmarshal(response.Body.Bytes(), &entities)
+	assert.Nil(s.T(), err)
+	assert.Contains(s.T(), entities, entity)
+}
+
+func (s *ServerSuite) Test_Read() {
+	err := s.mgcpServer.SetPower(-1000)
+	assert.Nil(s.T(), err)
+
+	_, ok := s.cemEvents.WaitFor(s.smgw.SKI(), ucmgcp.DataUpdatePower, time.Second*5)
+	assert.True(s.T(), ok)
+
+	response := s.request(http.MethodGet, s.entityPath+"/mgcp/power", "")
+	assert.Equal(s.T(), http.StatusOK, response.Code)
+	assert.Equal(s.T(), "-1000\n", response.Body.String())
+
+	response = s.request(http.MethodGet, s.entityPath+"/mgcp", "")
+	assert.Equal(s.T(), http.StatusOK, response.Code)
+
+	var values map[string]any
+	err = json.Unmarshal(response.Body.Bytes(), &values)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), map[string]any{"power": -1000.0}, values)
+}
+
+func (s *ServerSuite) Test_Write() {
+	var writes []string
+	s.sut.SetWriteCallback(func(entity spineapi.EntityRemoteInterface, usecase, operation string, msgCounter *model.MsgCounterType, err error) {
+		writes = append(writes, usecase+"/"+operation)
+	})
+
+	response := s.request(http.MethodPost, s.entityPath+"/lpc/consumptionLimit", `{"Value": 4200, "IsActive": true}`)
+	assert.Equal(s.T(), http.StatusOK, response.Code)
+
+	var result WriteResult
+	err := json.Unmarshal(response.Body.Bytes(), &result)
+	assert.Nil(s.T(), err)
+	assert.NotNil(s.T(), result.MsgCounter)
+
+	assert.Eventually(s.T(), func() bool {
+		limit, err := s.lpcServer.ConsumptionLimit()
+		return err == nil && limit.IsActive && limit.Value == 4200
+	}, time.Second*5, time.Millisecond*10)
+
+	response = s.request(http.MethodPost, s.entityPath+"/lpc/consumptionLimit", `{"Unknown": 1}`)
+	assert.Equal(s.T(), http.StatusBadRequest, response.Code)
+
+	var body Error
+	err = json.Unmarshal(response.Body.Bytes(), &body)
+	assert.Nil(s.T(), err)
+	assert.NotEqual(s.T(), "", body.Error)
+
+	assert.Equal(s.T(), []string{"lpc/consumptionLimit", "lpc/consumptionLimit"}, writes)
+}
+
+func (s *ServerSuite) Test_Errors() {
+	devicePath := "/devices/" + s.smgw.SKI()
+
+	tests := []struct {
+		method string
+		path   string
+		status int
+	}{
+		{http.MethodGet, "/unknown", http.StatusNotFound},
+		{http.MethodGet, "/devices/unknown", http.StatusNotFound},
+		{http.MethodGet, devicePath + "/unknown", http.StatusNotFound},
+		{http.MethodGet, devicePath + "/entities/a", http.StatusBadRequest},
+		{http.MethodGet, devicePath + "/entities/99", http.StatusNotFound},
+		{http.MethodGet, s.entityPath + "/unknown", http.StatusNotFound},
+		{http.MethodGet, s.entityPath + "/mgcp/unknown", http.StatusNotFound},
+		{http.MethodGet, s.entityPath + "/mgcp/power/unknown", http.StatusNotFound},
+		// no value has been set
+		{http.MethodGet, s.entityPath + "/mgcp/frequency", http.StatusNotFound},
+		// the device information entity does not support the use case
+		{http.MethodGet, devicePath + "/entities/0/mgcp/power", http.StatusUnprocessableEntity},
+		{http.MethodPost, s.entityPath + "/mgcp/power", http.StatusMethodNotAllowed},
+		{http.MethodPost, s.entityPath + "/mgcp", http.StatusMethodNotAllowed},
+		{http.MethodDelete, s.entityPath + "/lpc/consumptionLimit", http.StatusMethodNotAllowed},
+	}
+
+	for _, test := range tests {
+		response := s.request(test.method, test.path, "{}")
+		assert.Equal(s.T(), test.status, response.Code, test.method+" "+test.path)
+	}
+}
+
+func (s *ServerSuite) Test_StatusCode() {
+	tests := []struct {
+		err    error
+		status int
+	}{
+		{datapoints.ErrInvalidData, http.StatusBadRequest},
+		{eebusapi.ErrDataNotAvailable, http.StatusNotFound},
+		// e.g. the limit descriptions of a limit to write are missing
+		{eebusapi.ErrMetadataNotAvailable, http.StatusNotFound},
+		{fmt.Errorf("write: %w", eebusapi.ErrMetadataNotAvailable), http.StatusNotFound},
+		{api.ErrNoCompatibleEntity, http.StatusUnprocessableEntity},
+		{util.ErrDeviceDisconnected, http.StatusServiceUnavailable},
+		{errors.New("unknown"), http.StatusInternalServerError},
+	}
+
+	for _, test := range tests {
+		assert.Equal(s.T(), test.status, statusCode(test.err), test.err.Error())
+	}
+}
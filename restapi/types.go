@@ -0,0 +1,28 @@
+package restapi
+
+import "github.com/enbility/spine-go/model"
+
+// A connected remote device
+type Device struct {
+	Ski        string   `json:"ski"`
+	Address    string   `json:"address,omitempty"`
+	DeviceType string   `json:"deviceType,omitempty"`
+	Entities   []Entity `json:"entities"`
+}
+
+// An entity of a remote device
+type Entity struct {
+	Address    string   `json:"address"` // the entity ids joined with dots, e.g. "1.1"
+	EntityType string   `json:"entityType"`
+	UseCases   []string `json:"useCases"` // the short names of the supported use cases, e.g. "mgcp"
+}
+
+// The result of a write operation
+type WriteResult struct {
+	MsgCounter *model.MsgCounterType `json:"msgCounter,omitempty"` // the message counter of the sent message, if available
+}
+
+// The body of an error response
+type Error struct {
+	Error string `json:"error"`
+}
@@ -0,0 +1,130 @@
+package sessions
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// the columns of the CSV export
+var csvHeader = []string{
+	"id",
+	"ski",
+	"entity",
+	"start",
+	"end",
+	"identifications",
+	"vendorName",
+	"brandName",
+	"deviceName",
+	"communicationStandard",
+	"energy",
+	"peakPower",
+	"startSoC",
+	"endSoC",
+	"minLimit",
+	"interruptions",
+}
+
+// write the sessions as a JSON array
+func WriteJSON(w io.Writer, sessions []Session) error {
+	if sessions == nil {
+		sessions = []Session{}
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(sessions)
+}
+
+// write the sessions as CSV with a header line
+//
+// the identifications are joined with "|", the minLimit column contains the
+// lowest active limit applied to the EV, empty values are not available
+func WriteCSV(w io.Writer, sessions []Session) error {
+	writer := csv.NewWriter(w)
+
+	if err := writer.Write(csvHeader); err != nil {
+		return err
+	}
+
+	for _, session := range sessions {
+		if err := writer.Write(csvRecord(session)); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+
+	return writer.Error()
+}
+
+func csvRecord(session Session) []string {
+	var identifications []string
+	for _, item := range session.Identifications {
+		identifications = append(identifications, item.Value)
+	}
+
+	var vendorName, brandName, deviceName string
+	if session.ManufacturerData != nil {
+		vendorName = session.ManufacturerData.VendorName
+		brandName = session.ManufacturerData.BrandName
+		deviceName = session.ManufacturerData.DeviceName
+	}
+
+	var end string
+	if session.End != nil {
+		end = session.End.Format(time.RFC3339)
+	}
+
+	return []string{
+		session.ID,
+		session.Ski,
+		session.Entity,
+		session.Start.Format(time.RFC3339),
+		end,
+		strings.Join(identifications, "|"),
+		vendorName,
+		brandName,
+		deviceName,
+		session.CommunicationStandard,
+		formatFloat(session.Energy),
+		formatFloat(session.PeakPower),
+		formatOptional(session.StartSoC),
+		formatOptional(session.EndSoC),
+		formatOptional(minLimit(session.Limits)),
+		strconv.Itoa(session.Interruptions),
+	}
+}
+
+// returns the lowest active limit, nil if no limit was active
+func minLimit(limits []AppliedLimits) *float64 {
+	var result *float64
+
+	for _, item := range limits {
+		for _, limit := range item.Limits {
+			if limit.IsActive && (result == nil || limit.Value < *result) {
+				value := limit.Value
+				result = &value
+			}
+		}
+	}
+
+	return result
+}
+
+func formatFloat(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
+
+func formatOptional(value *float64) string {
+	if value == nil {
+		return ""
+	}
+
+	return formatFloat(*value)
+}
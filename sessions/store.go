@@ -0,0 +1,114 @@
+package sessions
+
+import (
+	"errors"
+	"slices"
+	"sync"
+
+	"github.com/enbility/cemd/api"
+	eebusapi "github.com/enbility/eebus-go/api"
+)
+
+// Storage of the charging sessions
+type Store interface {
+	// add a session or update the session with the same ID
+	Save(session Session) error
+
+	// return all sessions ordered by their start
+	Sessions() ([]Session, error)
+}
+
+// Stores the sessions in memory
+type MemoryStore struct {
+	mux      sync.Mutex
+	sessions []Session
+}
+
+var _ Store = (*MemoryStore)(nil)
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{}
+}
+
+func (s *MemoryStore) Save(session Session) error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	s.sessions = upsert(s.sessions, session)
+
+	return nil
+}
+
+func (s *MemoryStore) Sessions() ([]Session, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	return slices.Clone(s.sessions), nil
+}
+
+// Stores all sessions as a single value of an api.StoreInterface, e.g. a store.FileStore
+type KeyValueStore struct {
+	store api.StoreInterface
+	key   string
+
+	mux sync.Mutex
+}
+
+var _ Store = (*KeyValueStore)(nil)
+
+// parameters:
+//   - store: the store persisting the sessions
+//   - key: the key the sessions are stored for
+func NewKeyValueStore(store api.StoreInterface, key string) *KeyValueStore {
+	return &KeyValueStore{
+		store: store,
+		key:   key,
+	}
+}
+
+func (s *KeyValueStore) Save(session Session) error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	sessions, err := s.load()
+	if err != nil {
+		return err
+	}
+
+	return s.store.Save(s.key, upsert(sessions, session))
+}
+
+func (s *KeyValueStore) Sessions() ([]Session, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	return s.load()
+}
+
+// load the stored sessions, returns no sessions if nothing is stored yet
+func (s *KeyValueStore) load() ([]Session, error) {
+	var sessions []Session
+
+	err := s.store.Load(s.key, &sessions)
+	if errors.Is(err, eebusapi.ErrDataNotAvailable) {
+		return nil, nil
+	}
+
+	return sessions, err
+}
+
+// replace the session with the same ID or insert it ordered by the start
+func upsert(sessions []Session, session Session) []Session {
+	if index := slices.IndexFunc(sessions, func(item Session) bool {
+		return item.ID == session.ID
+	}); index >= 0 {
+		sessions[index] = session
+		return sessions
+	}
+
+	index, _ := slices.BinarySearchFunc(sessions, session, func(a, b Session) int {
+		return a.Start.Compare(b.Start)
+	})
+
+	return slices.Insert(sessions, index, session)
+}
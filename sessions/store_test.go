@@ -0,0 +1,112 @@
+package sessions
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestStoreSuite(t *testing.T) {
+	suite.Run(t, new(StoreSuite))
+}
+
+type StoreSuite struct {
+	suite.Suite
+
+	start time.Time
+}
+
+func (s *StoreSuite) BeforeTest(suiteName, testName string) {
+	s.start = time.Date(2024, 6, 1, 18, 0, 0, 0, time.UTC)
+}
+
+func (s *StoreSuite) testStore(sut Store) {
+	sessions, err := sut.Sessions()
+	assert.Nil(s.T(), err)
+	assert.Empty(s.T(), sessions)
+
+	later := Session{ID: "later", Start: s.start.Add(time.Hour)}
+	earlier := Session{ID: "earlier", Start: s.start}
+
+	assert.Nil(s.T(), sut.Save(later))
+	assert.Nil(s.T(), sut.Save(earlier))
+
+	// the session with the same ID is updated
+	later.Energy = 1000
+	assert.Nil(s.T(), sut.Save(later))
+
+	sessions, err = sut.Sessions()
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []string{"earlier", "later"}, []string{sessions[0].ID, sessions[1].ID})
+	assert.Equal(s.T(), 1000.0, sessions[1].Energy)
+}
+
+func (s *StoreSuite) Test_MemoryStore() {
+	s.testStore(NewMemoryStore())
+}
+
+func (s *StoreSuite) Test_KeyValueStore() {
+	path := filepath.Join(s.T().TempDir(), "store.json")
+	s.testStore(NewKeyValueStore(store.NewFileStore(path), "sessions"))
+
+	// the sessions are persisted
+	sessions, err := NewKeyValueStore(store.NewFileStore(path), "sessions").Sessions()
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 2, len(sessions))
+	assert.True(s.T(), s.start.Equal(sessions[0].Start))
+}
+
+func (s *StoreSuite) Test_Export() {
+	end := s.start.Add(time.Hour)
+	soc := 20.0
+
+	sessions := []Session{
+		{
+			ID:                    "evse-1",
+			Ski:                   "evse",
+			Entity:                "1.1",
+			Start:                 s.start,
+			End:                   &end,
+			Identifications:       []Identification{{Value: "ev1"}, {Value: "ev2"}},
+			ManufacturerData:      &api.ManufacturerData{VendorName: "vendor", BrandName: "brand"},
+			CommunicationStandard: "iec61851",
+			Energy:                7400.5,
+			PeakPower:             11040,
+			StartSoC:              &soc,
+			Limits: []AppliedLimits{
+				{Time: s.start, UseCase: "opev", Limits: []PhaseLimit{{Phase: "a", IsActive: true, Value: 16}}},
+				{Time: s.start, UseCase: "oscev", Limits: []PhaseLimit{{Phase: "a", IsActive: true, Value: 8}, {Phase: "b", Value: 6}}},
+			},
+		},
+		{
+			ID:            "evse-2",
+			Ski:           "evse",
+			Entity:        "1.1",
+			Start:         end,
+			Interruptions: 1,
+		},
+	}
+
+	var buffer bytes.Buffer
+	err := WriteCSV(&buffer, sessions)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "id,ski,entity,start,end,identifications,vendorName,brandName,deviceName,communicationStandard,energy,peakPower,startSoC,endSoC,minLimit,interruptions\n"+
+		"evse-1,evse,1.1,2024-06-01T18:00:00Z,2024-06-01T19:00:00Z,ev1|ev2,vendor,brand,,iec61851,7400.5,11040,20,,8,0\n"+
+		"evse-2,evse,1.1,2024-06-01T19:00:00Z,,,,,,,0,0,,,,1\n", buffer.String())
+
+	buffer.Reset()
+	err = WriteJSON(&buffer, sessions[1:])
+	assert.Nil(s.T(), err)
+	assert.JSONEq(s.T(), `[{"id":"evse-2","ski":"evse","entity":"1.1","start":"2024-06-01T19:00:00Z","energy":0,"peakPower":0,"interruptions":1}]`, buffer.String())
+
+	buffer.Reset()
+	err = WriteJSON(&buffer, nil)
+	assert.Nil(s.T(), err)
+	assert.JSONEq(s.T(), `[]`, buffer.String())
+}
@@ -0,0 +1,428 @@
+// Package sessions records the charging sessions of the EVs
+//
+// A session starts with the ucevcc.EvConnected event and ends with the
+// ucevcc.EvDisconnected event of the EVSE. The Tracker records the data of the
+// EV, the charged energy, the peak power, the state of charge and the applied
+// limits reported during the session, and saves the session to a Store at its
+// start, when the connection to the EVSE is lost and at its end.
+//
+// The charged energy is the increase of the ucevcem.EnergyCharged values during
+// the session. A value lower than the previous value is considered to be a reset
+// of the counter of the EVSE.
+//
+// The sessions are tracked per EV entity, so the connectors of an EVSE have
+// separate sessions.
+//
+// If the connection to the EVSE is lost, the session is kept open for the
+// resume timeout. If the EVSE reports the same EV as connected within this
+// duration, the session is resumed, otherwise it ends at the time the connection
+// was lost. An EV is considered to be the same, if it shares an identification
+// with the EV of the session or if the identifications are not known.
+package sessions
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/cem"
+	"github.com/enbility/cemd/datapoints"
+	"github.com/enbility/cemd/ucevcc"
+	"github.com/enbility/cemd/ucevcem"
+	"github.com/enbility/cemd/ucevsoc"
+	"github.com/enbility/cemd/ucopev"
+	"github.com/enbility/cemd/ucoscev"
+	"github.com/enbility/ship-go/logging"
+	spineapi "github.com/enbility/spine-go/api"
+)
+
+// Records the charging sessions of the EVs
+type Tracker struct {
+	evcc  ucevcc.UCEVCCInterface
+	evcem ucevcem.UCEVCEMInterface
+	evsoc ucevsoc.UCEVSOCInterface
+	opev  ucopev.UCOPEVInterface
+	oscev ucoscev.UCOSCEVInterface
+
+	store  Store
+	config Config
+
+	// returns the current time, replaced in tests
+	now func() time.Time
+
+	mux sync.Mutex
+	// the open sessions by SKI of the EVSE and address of the EV entity
+	active map[sessionKey]*activeSession
+}
+
+// identifies the EV entity of an EVSE, also across reconnects of the EVSE
+type sessionKey struct {
+	ski    string
+	entity string
+}
+
+// an open session and the data to continue it
+type activeSession struct {
+	session Session
+	entity  spineapi.EntityRemoteInterface
+
+	// the last value of the energy counter, if hasEnergy is true
+	lastEnergy float64
+	hasEnergy  bool
+
+	// if the connection to the EVSE is lost and since when
+	interrupted   bool
+	interruptedAt time.Time
+	// ends the session once the resume timeout passed
+	timer *time.Timer
+}
+
+// create a new tracker
+//
+// parameters:
+//   - evcc: the use case reporting the connected EVs and their data
+//   - evcem: the use case measuring the energy and power, nil if not available
+//   - evsoc: the use case providing the state of charge, nil if not available
+//   - opev: the use case providing the obligation limits, nil if not available
+//   - oscev: the use case providing the recommendation limits, nil if not available
+//   - store: the store the sessions are saved to
+//   - config: the configuration
+//
+// the events have to be passed to EntityEventCB and DeviceEventCB
+func NewTracker(
+	evcc ucevcc.UCEVCCInterface,
+	evcem ucevcem.UCEVCEMInterface,
+	evsoc ucevsoc.UCEVSOCInterface,
+	opev ucopev.UCOPEVInterface,
+	oscev ucoscev.UCOSCEVInterface,
+	store Store,
+	config Config,
+) *Tracker {
+	if config.ResumeTimeout <= 0 {
+		config.ResumeTimeout = defaultResumeTimeout
+	}
+
+	return &Tracker{
+		evcc:   evcc,
+		evcem:  evcem,
+		evsoc:  evsoc,
+		opev:   opev,
+		oscev:  oscev,
+		store:  store,
+		config: config,
+		now:    time.Now,
+		active: make(map[sessionKey]*activeSession),
+	}
+}
+
+// returns the open sessions, including the interrupted ones
+func (t *Tracker) Active() []Session {
+	t.mux.Lock()
+	defer t.mux.Unlock()
+
+	var result []Session
+	for _, item := range t.active {
+		result = append(result, item.session)
+	}
+
+	slices.SortFunc(result, func(a, b Session) int {
+		return a.Start.Compare(b.Start)
+	})
+
+	return result
+}
+
+// start, update and end the sessions
+//
+// has the signature of api.EntityEventCallback
+func (t *Tracker) EntityEventCB(ski string, device spineapi.DeviceRemoteInterface, entity spineapi.EntityRemoteInterface, event api.EventType) {
+	if entity == nil {
+		return
+	}
+
+	t.mux.Lock()
+	defer t.mux.Unlock()
+
+	key := sessionKey{ski: ski, entity: datapoints.EntityAddress(entity)}
+
+	if event == ucevcc.EvConnected {
+		t.connected(key, entity)
+		return
+	}
+
+	item, ok := t.active[key]
+	if !ok || item.interrupted {
+		return
+	}
+
+	switch event {
+	case ucevcc.EvDisconnected:
+		t.end(key, item, t.now())
+	case ucevcc.DataUpdateIdentifications:
+		item.updateIdentifications(t.evcc)
+	case ucevcc.DataUpdateManufacturerData:
+		item.updateManufacturerData(t.evcc)
+	case ucevcc.DataUpdateCommunicationStandard:
+		item.updateCommunicationStandard(t.evcc)
+	case ucevcem.DataUpdateEnergyCharged:
+		item.updateEnergy(t.evcem)
+	case ucevcem.DataUpdatePowerPerPhase:
+		item.updatePower(t.evcem)
+	case ucevsoc.DataUpdateStateOfCharge:
+		item.updateStateOfCharge(t.evsoc)
+	case ucopev.DataUpdateLimit:
+		if t.opev != nil {
+			item.updateLimits(t.now(), "opev", t.opev.LoadControlLimits)
+		}
+	case ucoscev.DataUpdateLimit:
+		if t.oscev != nil {
+			item.updateLimits(t.now(), "oscev", t.oscev.LoadControlLimits)
+		}
+	}
+}
+
+// interrupt the sessions of disconnected EVSEs
+//
+// has the signature of api.DeviceEventCallback
+func (t *Tracker) DeviceEventCB(ski string, device spineapi.DeviceRemoteInterface, event api.EventType) {
+	if event != cem.DeviceDisconnected {
+		return
+	}
+
+	t.mux.Lock()
+	defer t.mux.Unlock()
+
+	for key, item := range t.active {
+		if key.ski != ski || item.interrupted {
+			continue
+		}
+
+		t.interrupt(key, item)
+	}
+}
+
+// keep the session open for the resume timeout
+func (t *Tracker) interrupt(key sessionKey, item *activeSession) {
+	item.interrupted = true
+	item.interruptedAt = t.now()
+	item.timer = time.AfterFunc(t.config.ResumeTimeout, func() {
+		t.mux.Lock()
+		defer t.mux.Unlock()
+
+		if t.active[key] == item && item.interrupted {
+			t.end(key, item, item.interruptedAt)
+		}
+	})
+
+	t.save(item)
+}
+
+// start a new session or resume the interrupted session of the EV entity
+func (t *Tracker) connected(key sessionKey, entity spineapi.EntityRemoteInterface) {
+	if item, ok := t.active[key]; ok {
+		if !item.interrupted {
+			// the EV is reported again, e.g. after the entity was discovered again
+			item.entity = entity
+			return
+		}
+
+		item.timer.Stop()
+
+		identifications, err := t.evcc.Identifications(entity)
+		if err != nil || item.sameEV(identifications) {
+			item.entity = entity
+			item.interrupted = false
+			item.session.Interruptions++
+			item.update(t)
+			t.save(item)
+			return
+		}
+
+		t.end(key, item, item.interruptedAt)
+	}
+
+	start := t.now()
+	item := &activeSession{
+		session: Session{
+			ID:     fmt.Sprintf("%s-%s-%d", key.ski, key.entity, start.UnixNano()),
+			Ski:    key.ski,
+			Entity: key.entity,
+			Start:  start,
+		},
+		entity: entity,
+	}
+	t.active[key] = item
+
+	item.update(t)
+	t.save(item)
+}
+
+// end a session and save it
+func (t *Tracker) end(key sessionKey, item *activeSession, end time.Time) {
+	if item.timer != nil {
+		item.timer.Stop()
+	}
+
+	item.session.End = &end
+	delete(t.active, key)
+
+	t.save(item)
+}
+
+func (t *Tracker) save(item *activeSession) {
+	if err := t.store.Save(item.session); err != nil {
+		logging.Log().Error("sessions", item.session.Ski, err)
+	}
+}
+
+// read all available data of the EV
+func (s *activeSession) update(t *Tracker) {
+	s.updateIdentifications(t.evcc)
+	s.updateManufacturerData(t.evcc)
+	s.updateCommunicationStandard(t.evcc)
+	s.updateEnergy(t.evcem)
+	s.updatePower(t.evcem)
+	s.updateStateOfCharge(t.evsoc)
+}
+
+// returns if the identifications belong to the EV of the session
+func (s *activeSession) sameEV(identifications []api.IdentificationItem) bool {
+	if len(identifications) == 0 || len(s.session.Identifications) == 0 {
+		return true
+	}
+
+	for _, item := range identifications {
+		if slices.ContainsFunc(s.session.Identifications, func(known Identification) bool {
+			return known.Value == item.Value
+		}) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *activeSession) updateIdentifications(evcc ucevcc.UCEVCCInterface) {
+	identifications, err := evcc.Identifications(s.entity)
+	if err != nil || len(identifications) == 0 {
+		return
+	}
+
+	s.session.Identifications = nil
+	for _, item := range identifications {
+		s.session.Identifications = append(s.session.Identifications, Identification{
+			Value:     item.Value,
+			ValueType: string(item.ValueType),
+		})
+	}
+}
+
+func (s *activeSession) updateManufacturerData(evcc ucevcc.UCEVCCInterface) {
+	if data, err := evcc.ManufacturerData(s.entity); err == nil {
+		s.session.ManufacturerData = &data
+	}
+}
+
+func (s *activeSession) updateCommunicationStandard(evcc ucevcc.UCEVCCInterface) {
+	if standard, err := evcc.CommunicationStandard(s.entity); err == nil {
+		s.session.CommunicationStandard = string(standard)
+	}
+}
+
+func (s *activeSession) updateEnergy(evcem ucevcem.UCEVCEMInterface) {
+	if evcem == nil {
+		return
+	}
+
+	value, err := evcem.EnergyCharged(s.entity)
+	if err != nil {
+		return
+	}
+
+	switch {
+	case !s.hasEnergy:
+		// the first value is the start of the session
+	case value >= s.lastEnergy:
+		s.session.Energy += value - s.lastEnergy
+	default:
+		// the counter was reset
+		s.session.Energy += value
+	}
+
+	s.lastEnergy = value
+	s.hasEnergy = true
+}
+
+func (s *activeSession) updatePower(evcem ucevcem.UCEVCEMInterface) {
+	if evcem == nil {
+		return
+	}
+
+	powers, err := evcem.PowerPerPhase(s.entity)
+	if err != nil {
+		return
+	}
+
+	var power float64
+	for _, value := range powers {
+		power += value
+	}
+
+	s.session.PeakPower = max(s.session.PeakPower, power)
+}
+
+func (s *activeSession) updateStateOfCharge(evsoc ucevsoc.UCEVSOCInterface) {
+	if evsoc == nil {
+		return
+	}
+
+	value, err := evsoc.StateOfCharge(s.entity)
+	if err != nil {
+		return
+	}
+
+	if s.session.StartSoC == nil {
+		start := value
+		s.session.StartSoC = &start
+	}
+	s.session.EndSoC = &value
+}
+
+// record the limits of a use case, if they changed
+func (s *activeSession) updateLimits(
+	now time.Time,
+	usecase string,
+	getter func(spineapi.EntityRemoteInterface) ([]api.LoadLimitsPhase, error),
+) {
+	limits, err := getter(s.entity)
+	if err != nil {
+		return
+	}
+
+	var phaseLimits []PhaseLimit
+	for _, limit := range limits {
+		phaseLimits = append(phaseLimits, PhaseLimit{
+			Phase:    string(limit.Phase),
+			IsActive: limit.IsActive,
+			Value:    limit.Value,
+		})
+	}
+
+	for i := len(s.session.Limits) - 1; i >= 0; i-- {
+		if s.session.Limits[i].UseCase != usecase {
+			continue
+		}
+		if slices.Equal(s.session.Limits[i].Limits, phaseLimits) {
+			return
+		}
+		break
+	}
+
+	s.session.Limits = append(s.session.Limits, AppliedLimits{
+		Time:    now,
+		UseCase: usecase,
+		Limits:  phaseLimits,
+	})
+}
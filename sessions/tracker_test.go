@@ -0,0 +1,276 @@
+package sessions
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/cem"
+	"github.com/enbility/cemd/mocks"
+	"github.com/enbility/cemd/ucevcc"
+	"github.com/enbility/cemd/ucevcem"
+	"github.com/enbility/cemd/ucevsoc"
+	"github.com/enbility/cemd/ucopev"
+	spinemocks "github.com/enbility/spine-go/mocks"
+	"github.com/enbility/spine-go/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestTrackerSuite(t *testing.T) {
+	suite.Run(t, new(TrackerSuite))
+}
+
+type TrackerSuite struct {
+	suite.Suite
+
+	evcc  *mocks.UCEVCCInterface
+	evcem *mocks.UCEVCEMInterface
+	evsoc *mocks.UCEVSOCInterface
+	opev  *mocks.UCOPEVInterface
+
+	ev  *spinemocks.EntityRemoteInterface
+	ev2 *spinemocks.EntityRemoteInterface
+
+	now   time.Time
+	store *MemoryStore
+	sut   *Tracker
+}
+
+func (s *TrackerSuite) BeforeTest(suiteName, testName string) {
+	s.evcc = mocks.NewUCEVCCInterface(s.T())
+	s.evcem = mocks.NewUCEVCEMInterface(s.T())
+	s.evsoc = mocks.NewUCEVSOCInterface(s.T())
+	s.opev = mocks.NewUCOPEVInterface(s.T())
+
+	s.ev = s.entity(1)
+	s.ev2 = s.entity(2)
+
+	s.now = time.Date(2024, 6, 1, 18, 0, 0, 0, time.UTC)
+	s.store = NewMemoryStore()
+	s.sut = NewTracker(s.evcc, s.evcem, s.evsoc, s.opev, nil, s.store, Config{ResumeTimeout: time.Millisecond * 50})
+	s.sut.now = func() time.Time {
+		return s.now
+	}
+}
+
+// returns an EV entity of the EVSE entity with the id
+func (s *TrackerSuite) entity(id uint) *spinemocks.EntityRemoteInterface {
+	entity := spinemocks.NewEntityRemoteInterface(s.T())
+	entity.EXPECT().Address().Return(&model.EntityAddressType{
+		Entity: []model.AddressEntityType{model.AddressEntityType(id), 1},
+	}).Maybe()
+	return entity
+}
+
+// the data of the EV reported by EVCC
+func (s *TrackerSuite) evData(identification string) {
+	s.evcc.EXPECT().Identifications(s.ev).Return([]api.IdentificationItem{
+		{Value: identification, ValueType: model.IdentificationTypeTypeEui64},
+	}, nil).Once()
+	s.evcc.EXPECT().ManufacturerData(s.ev).Return(api.ManufacturerData{BrandName: "brand"}, nil).Maybe()
+	s.evcc.EXPECT().CommunicationStandard(s.ev).Return(model.DeviceConfigurationKeyValueStringTypeISO151182ED2, nil).Maybe()
+}
+
+// no power and state of charge are reported
+func (s *TrackerSuite) noMeasurements() {
+	s.evcem.EXPECT().PowerPerPhase(s.ev).Return(nil, errors.New("not available")).Maybe()
+	s.evsoc.EXPECT().StateOfCharge(s.ev).Return(0, errors.New("not available")).Maybe()
+}
+
+func (s *TrackerSuite) stored() []Session {
+	sessions, err := s.store.Sessions()
+	assert.Nil(s.T(), err)
+	return sessions
+}
+
+func (s *TrackerSuite) Test_Session() {
+	s.evData("ev1")
+	s.evcem.EXPECT().EnergyCharged(s.ev).Return(1000, nil).Once()
+	s.evcem.EXPECT().PowerPerPhase(s.ev).Return(nil, errors.New("not available")).Once()
+	s.evsoc.EXPECT().StateOfCharge(s.ev).Return(20, nil).Once()
+	s.sut.EntityEventCB("evse", nil, s.ev, ucevcc.EvConnected)
+
+	active := s.sut.Active()
+	if assert.Equal(s.T(), 1, len(active)) {
+		assert.Equal(s.T(), "evse", active[0].Ski)
+		assert.Equal(s.T(), s.now, active[0].Start)
+		assert.Nil(s.T(), active[0].End)
+		assert.Equal(s.T(), []Identification{{Value: "ev1", ValueType: "eui64"}}, active[0].Identifications)
+		assert.Equal(s.T(), "brand", active[0].ManufacturerData.BrandName)
+		assert.Equal(s.T(), "iso15118-2ed2", active[0].CommunicationStandard)
+	}
+	assert.Equal(s.T(), active, s.stored())
+
+	// the energy is the increase of the counter, also across a reset
+	s.evcem.EXPECT().EnergyCharged(s.ev).Return(3000, nil).Once()
+	s.sut.EntityEventCB("evse", nil, s.ev, ucevcem.DataUpdateEnergyCharged)
+	s.evcem.EXPECT().EnergyCharged(s.ev).Return(500, nil).Once()
+	s.sut.EntityEventCB("evse", nil, s.ev, ucevcem.DataUpdateEnergyCharged)
+
+	s.evcem.EXPECT().PowerPerPhase(s.ev).Return([]float64{3680, 3680, 3680}, nil).Once()
+	s.sut.EntityEventCB("evse", nil, s.ev, ucevcem.DataUpdatePowerPerPhase)
+	s.evcem.EXPECT().PowerPerPhase(s.ev).Return([]float64{1000, 1000, 1000}, nil).Once()
+	s.sut.EntityEventCB("evse", nil, s.ev, ucevcem.DataUpdatePowerPerPhase)
+
+	s.evsoc.EXPECT().StateOfCharge(s.ev).Return(80, nil).Once()
+	s.sut.EntityEventCB("evse", nil, s.ev, ucevsoc.DataUpdateStateOfCharge)
+
+	// unchanged limits are recorded once
+	s.opev.EXPECT().LoadControlLimits(s.ev).Return([]api.LoadLimitsPhase{{Phase: "a", IsActive: true, Value: 16}}, nil).Twice()
+	s.sut.EntityEventCB("evse", nil, s.ev, ucopev.DataUpdateLimit)
+	s.sut.EntityEventCB("evse", nil, s.ev, ucopev.DataUpdateLimit)
+	s.now = s.now.Add(time.Hour)
+	s.opev.EXPECT().LoadControlLimits(s.ev).Return([]api.LoadLimitsPhase{{Phase: "a", IsActive: true, Value: 10}}, nil).Once()
+	s.sut.EntityEventCB("evse", nil, s.ev, ucopev.DataUpdateLimit)
+
+	// events of other EVSEs are ignored
+	s.sut.EntityEventCB("other", nil, s.ev, ucevcem.DataUpdateEnergyCharged)
+
+	s.now = s.now.Add(time.Hour)
+	s.sut.EntityEventCB("evse", nil, s.ev, ucevcc.EvDisconnected)
+	assert.Empty(s.T(), s.sut.Active())
+
+	sessions := s.stored()
+	if assert.Equal(s.T(), 1, len(sessions)) {
+		session := sessions[0]
+		assert.Equal(s.T(), s.now, *session.End)
+		assert.Equal(s.T(), 2500.0, session.Energy)
+		assert.Equal(s.T(), 11040.0, session.PeakPower)
+		assert.Equal(s.T(), 20.0, *session.StartSoC)
+		assert.Equal(s.T(), 80.0, *session.EndSoC)
+		assert.Equal(s.T(), []AppliedLimits{
+			{Time: s.now.Add(-time.Hour * 2), UseCase: "opev", Limits: []PhaseLimit{{Phase: "a", IsActive: true, Value: 16}}},
+			{Time: s.now.Add(-time.Hour), UseCase: "opev", Limits: []PhaseLimit{{Phase: "a", IsActive: true, Value: 10}}},
+		}, session.Limits)
+	}
+}
+
+func (s *TrackerSuite) Test_Reconnect() {
+	s.noMeasurements()
+	s.evcem.EXPECT().EnergyCharged(s.ev).Return(1000, nil).Once()
+	s.evData("ev1")
+	s.sut.EntityEventCB("evse", nil, s.ev, ucevcc.EvConnected)
+	id := s.sut.Active()[0].ID
+
+	// the session is kept open while the connection is lost
+	s.now = s.now.Add(time.Minute)
+	s.sut.DeviceEventCB("evse", nil, cem.DeviceDisconnected)
+	s.sut.EntityEventCB("evse", nil, s.ev, ucevcem.DataUpdateEnergyCharged)
+	assert.Nil(s.T(), s.stored()[0].End)
+
+	// the same EV is reported again, the energy charged in the meantime is counted
+	s.evcem.EXPECT().EnergyCharged(s.ev).Return(1500, nil).Once()
+	s.evData("ev1")
+	s.evData("ev1")
+	s.sut.EntityEventCB("evse", nil, s.ev, ucevcc.EvConnected)
+
+	active := s.sut.Active()
+	if assert.Equal(s.T(), 1, len(active)) {
+		assert.Equal(s.T(), id, active[0].ID)
+		assert.Equal(s.T(), 1, active[0].Interruptions)
+		assert.Equal(s.T(), 500.0, active[0].Energy)
+	}
+
+	// another EV is reported, the session ended with the connection loss
+	interrupted := s.now.Add(time.Minute)
+	s.now = interrupted
+	s.sut.DeviceEventCB("evse", nil, cem.DeviceDisconnected)
+
+	s.now = s.now.Add(time.Minute)
+	s.evcem.EXPECT().EnergyCharged(s.ev).Return(0, nil).Once()
+	s.evData("ev2")
+	s.evData("ev2")
+	s.sut.EntityEventCB("evse", nil, s.ev, ucevcc.EvConnected)
+
+	sessions := s.stored()
+	if assert.Equal(s.T(), 2, len(sessions)) {
+		assert.Equal(s.T(), id, sessions[0].ID)
+		assert.Equal(s.T(), interrupted, *sessions[0].End)
+		assert.Equal(s.T(), []Identification{{Value: "ev2", ValueType: "eui64"}}, sessions[1].Identifications)
+		assert.Nil(s.T(), sessions[1].End)
+	}
+
+	// the session ends if the EV is not reported within the resume timeout
+	s.sut.DeviceEventCB("evse", nil, cem.DeviceDisconnected)
+	assert.Eventually(s.T(), func() bool {
+		return len(s.sut.Active()) == 0
+	}, time.Second, time.Millisecond*10)
+	assert.NotNil(s.T(), s.stored()[1].End)
+}
+
+func (s *TrackerSuite) Test_Repeated() {
+	s.noMeasurements()
+	s.evcem.EXPECT().EnergyCharged(s.ev).Return(0, nil).Once()
+	s.evData("ev1")
+	s.sut.EntityEventCB("evse", nil, s.ev, ucevcc.EvConnected)
+
+	// a repeated connect event continues the session
+	s.sut.EntityEventCB("evse", nil, s.ev, ucevcc.EvConnected)
+	assert.Equal(s.T(), 1, len(s.sut.Active()))
+	assert.Equal(s.T(), 0, s.sut.Active()[0].Interruptions)
+
+	// data of unknown EVSEs and other devices is ignored
+	s.sut.DeviceEventCB("other", nil, cem.DeviceDisconnected)
+	s.sut.DeviceEventCB("evse", nil, cem.DeviceConnected)
+	s.sut.EntityEventCB("evse", nil, nil, ucevcc.EvDisconnected)
+	assert.Equal(s.T(), 1, len(s.sut.Active()))
+
+	// without identifications the EV is considered to be the same
+	s.sut.DeviceEventCB("evse", nil, cem.DeviceDisconnected)
+	s.evcc.EXPECT().Identifications(s.ev).Return(nil, errors.New("not available"))
+	s.evcem.EXPECT().EnergyCharged(s.ev).Return(0, nil).Once()
+	s.sut.EntityEventCB("evse", nil, s.ev, ucevcc.EvConnected)
+	assert.Equal(s.T(), 1, s.sut.Active()[0].Interruptions)
+	assert.Equal(s.T(), "ev1", s.sut.Active()[0].Identifications[0].Value)
+}
+
+func (s *TrackerSuite) Test_Connectors() {
+	s.noMeasurements()
+	s.evcem.EXPECT().EnergyCharged(s.ev).Return(1000, nil).Once()
+	s.evData("ev1")
+	s.sut.EntityEventCB("evse", nil, s.ev, ucevcc.EvConnected)
+
+	// the EV at the second connector of the EVSE gets its own session
+	s.evcc.EXPECT().Identifications(s.ev2).Return(nil, errors.New("not available")).Once()
+	s.evcc.EXPECT().ManufacturerData(s.ev2).Return(api.ManufacturerData{}, errors.New("not available")).Once()
+	s.evcc.EXPECT().CommunicationStandard(s.ev2).Return("", errors.New("not available")).Once()
+	s.evcem.EXPECT().EnergyCharged(s.ev2).Return(5000, nil).Once()
+	s.evcem.EXPECT().PowerPerPhase(s.ev2).Return(nil, errors.New("not available")).Once()
+	s.evsoc.EXPECT().StateOfCharge(s.ev2).Return(0, errors.New("not available")).Once()
+	s.sut.EntityEventCB("evse", nil, s.ev2, ucevcc.EvConnected)
+
+	active := s.sut.Active()
+	if assert.Equal(s.T(), 2, len(active)) {
+		assert.NotEqual(s.T(), active[0].ID, active[1].ID)
+		assert.ElementsMatch(s.T(), []string{"1.1", "2.1"}, []string{active[0].Entity, active[1].Entity})
+	}
+
+	// the energy is counted per EV
+	s.evcem.EXPECT().EnergyCharged(s.ev).Return(1500, nil).Once()
+	s.sut.EntityEventCB("evse", nil, s.ev, ucevcem.DataUpdateEnergyCharged)
+	s.evcem.EXPECT().EnergyCharged(s.ev2).Return(7000, nil).Once()
+	s.sut.EntityEventCB("evse", nil, s.ev2, ucevcem.DataUpdateEnergyCharged)
+
+	// disconnecting one EV ends its session only
+	s.sut.EntityEventCB("evse", nil, s.ev2, ucevcc.EvDisconnected)
+	active = s.sut.Active()
+	if assert.Equal(s.T(), 1, len(active)) {
+		assert.Equal(s.T(), "1.1", active[0].Entity)
+		assert.Equal(s.T(), 500.0, active[0].Energy)
+	}
+
+	for _, session := range s.stored() {
+		if session.Entity == "2.1" {
+			assert.Equal(s.T(), 2000.0, session.Energy)
+			assert.NotNil(s.T(), session.End)
+		}
+	}
+
+	// the connection loss interrupts all sessions of the EVSE
+	s.sut.DeviceEventCB("evse", nil, cem.DeviceDisconnected)
+	assert.Eventually(s.T(), func() bool {
+		return len(s.sut.Active()) == 0
+	}, time.Second, time.Millisecond*10)
+}
@@ -0,0 +1,63 @@
+package sessions
+
+import (
+	"time"
+
+	"github.com/enbility/cemd/api"
+)
+
+// the duration an interrupted session can be resumed, if no duration is configured
+const defaultResumeTimeout = time.Minute * 15
+
+// A charging session of an EV, from connecting to disconnecting the EV
+type Session struct {
+	ID     string `json:"id"`
+	Ski    string `json:"ski"`    // the SKI of the EVSE
+	Entity string `json:"entity"` // the address of the EV entity, e.g. "1.1", distinguishing the connectors of the EVSE
+
+	Start time.Time  `json:"start"`
+	End   *time.Time `json:"end,omitempty"` // nil while the session is active
+
+	Identifications       []Identification      `json:"identifications,omitempty"`
+	ManufacturerData      *api.ManufacturerData `json:"manufacturerData,omitempty"`
+	CommunicationStandard string                `json:"communicationStandard,omitempty"`
+
+	Energy    float64  `json:"energy"`             // the charged energy in Wh
+	PeakPower float64  `json:"peakPower"`          // the highest charging power in W
+	StartSoC  *float64 `json:"startSoC,omitempty"` // the first known state of charge in %
+	EndSoC    *float64 `json:"endSoC,omitempty"`   // the last known state of charge in %
+
+	Limits []AppliedLimits `json:"limits,omitempty"` // the load control limits in the order they were applied
+
+	// the number of connection losses to the EVSE the session was resumed after
+	Interruptions int `json:"interruptions,omitempty"`
+}
+
+// An identification of the EV
+type Identification struct {
+	Value     string `json:"value"`
+	ValueType string `json:"valueType,omitempty"` // e.g. "eui64"
+}
+
+// Load control limits applied to the EV
+type AppliedLimits struct {
+	Time    time.Time    `json:"time"`
+	UseCase string       `json:"useCase"` // the short name of the use case, "opev" or "oscev"
+	Limits  []PhaseLimit `json:"limits"`
+}
+
+// The limit of a phase in A
+type PhaseLimit struct {
+	Phase    string  `json:"phase"`
+	IsActive bool    `json:"isActive"`
+	Value    float64 `json:"value"`
+}
+
+// Configuration of the tracker
+type Config struct {
+	// the duration a session is kept open after the connection to the EVSE is lost,
+	// 15 minutes if 0
+	//
+	// if the EV is reported as connected again within this duration, the session is resumed
+	ResumeTimeout time.Duration
+}
@@ -0,0 +1,122 @@
+package store
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/enbility/cemd/api"
+	eebusapi "github.com/enbility/eebus-go/api"
+)
+
+// Stores all values as JSON in a single file
+//
+// The file is rewritten on each change, by writing a temporary file
+// and renaming it afterwards, so a crash never leaves a corrupted file
+type FileStore struct {
+	path string
+
+	mux sync.Mutex
+}
+
+var _ api.StoreInterface = (*FileStore)(nil)
+
+// parameters:
+//   - path: the path of the JSON file, which is created if it does not exist
+func NewFileStore(path string) *FileStore {
+	return &FileStore{
+		path: path,
+	}
+}
+
+func (s *FileStore) Load(key string, value any) error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	data, err := s.read()
+	if err != nil {
+		return err
+	}
+
+	raw, ok := data[key]
+	if !ok {
+		return eebusapi.ErrDataNotAvailable
+	}
+
+	return json.Unmarshal(raw, value)
+}
+
+func (s *FileStore) Save(key string, value any) error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	data, err := s.read()
+	if err != nil {
+		return err
+	}
+
+	data[key] = raw
+
+	return s.write(data)
+}
+
+// read all stored values, returns an empty map if the file does not exist
+func (s *FileStore) read() (map[string]json.RawMessage, error) {
+	data := make(map[string]json.RawMessage)
+
+	content, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return data, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if len(content) == 0 {
+		return data, nil
+	}
+
+	if err := json.Unmarshal(content, &data); err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+func (s *FileStore) write(data map[string]json.RawMessage) error {
+	content, err := json.MarshalIndent(data, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmpFile, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".tmp*")
+	if err != nil {
+		return err
+	}
+	tmpPath := tmpFile.Name()
+
+	if _, err := tmpFile.Write(content); err != nil {
+		_ = tmpFile.Close()
+		_ = os.Remove(tmpPath)
+		return err
+	}
+
+	if err := tmpFile.Close(); err != nil {
+		_ = os.Remove(tmpPath)
+		return err
+	}
+
+	if err := os.Rename(tmpPath, s.path); err != nil {
+		_ = os.Remove(tmpPath)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package store
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	eebusapi "github.com/enbility/eebus-go/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestFileStoreSuite(t *testing.T) {
+	suite.Run(t, new(FileStoreSuite))
+}
+
+type testValue struct {
+	Name  string
+	Value float64
+}
+
+type FileStoreSuite struct {
+	suite.Suite
+
+	path string
+	sut  *FileStore
+}
+
+func (s *FileStoreSuite) BeforeTest(suiteName, testName string) {
+	s.path = filepath.Join(s.T().TempDir(), "store.json")
+	s.sut = NewFileStore(s.path)
+}
+
+func (s *FileStoreSuite) Test_LoadSave() {
+	var value testValue
+	err := s.sut.Load("test", &value)
+	assert.Equal(s.T(), eebusapi.ErrDataNotAvailable, err)
+
+	err = s.sut.Save("test", testValue{Name: "limit", Value: 4200})
+	assert.Nil(s.T(), err)
+
+	err = s.sut.Save("other", testValue{Name: "other", Value: 1})
+	assert.Nil(s.T(), err)
+
+	err = s.sut.Load("test", &value)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), testValue{Name: "limit", Value: 4200}, value)
+
+	// a new instance reads the values stored before
+	sut := NewFileStore(s.path)
+	err = sut.Load("other", &value)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), testValue{Name: "other", Value: 1}, value)
+
+	err = sut.Save("test", testValue{Name: "limit", Value: 0})
+	assert.Nil(s.T(), err)
+
+	err = sut.Load("test", &value)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), testValue{Name: "limit", Value: 0}, value)
+
+	err = sut.Save("invalid", func() {})
+	assert.NotNil(s.T(), err)
+}
+
+func (s *FileStoreSuite) Test_InvalidFile() {
+	var value testValue
+
+	err := os.WriteFile(s.path, []byte{}, 0600)
+	assert.Nil(s.T(), err)
+
+	err = s.sut.Load("test", &value)
+	assert.Equal(s.T(), eebusapi.ErrDataNotAvailable, err)
+
+	err = os.WriteFile(s.path, []byte("invalid"), 0600)
+	assert.Nil(s.T(), err)
+
+	err = s.sut.Load("test", &value)
+	assert.NotNil(s.T(), err)
+
+	err = s.sut.Save("test", value)
+	assert.NotNil(s.T(), err)
+
+	sut := NewFileStore(filepath.Join(s.path, "missing", "store.json"))
+	err = sut.Save("test", value)
+	assert.NotNil(s.T(), err)
+}
@@ -0,0 +1,395 @@
+// Package surplus charges an EV with the surplus power of a PV system
+//
+// The Controller reads the power of the grid connection point via MGCP and
+// writes the charging current of an EV, which uses the power fed into the grid.
+// The limits are written via OSCEV as recommendations or via OPEV as
+// obligations, depending on the use case passed as the Limiter.
+//
+// The surplus is the power fed into the grid plus the power of the EV, which
+// is measured via EVCEM or otherwise assumed to be the limit last written to the
+// phases of the EV. If a battery is provided via VABD, its charge power is added to the
+// surplus and its discharge power is subtracted, so the EV is charged before
+// the battery but never from the battery.
+//
+// The surplus is converted into a current for each phase of the EV, using the
+// number of connected phases reported via EVCEM. If the number is unknown, all
+// phases of the current limits of the EV are used. Limits are always written for
+// all phases of the EV, phases not charged with get 0 A.
+//
+// With phase switching enabled, an EV connected with 3 phases charges with
+// 1 phase while the surplus is below its minimum charging power on 3 phases,
+// e.g. 4140 W at 6 A, and switches back to 3 phases once the surplus reaches
+// it. The switch from 3 phases to 1 phase only happens once the surplus drops
+// more than the hysteresis below that power, so the EV does not switch
+// repeatedly. The EVSE has to support switching the phases, when the limits of
+// phases b and c are set to 0 A.
+//
+// In ModePV the EV starts charging once the surplus reaches its minimum current
+// and stops charging once the surplus drops more than the hysteresis below the
+// minimum current. After starting, the EV charges for at least the minimum
+// charge time. In ModeMinPV the EV always charges with at least its minimum
+// current.
+//
+// The limits are recalculated with every ucmgcp.DataUpdatePower event.
+package surplus
+
+import (
+	"math"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/cem"
+	"github.com/enbility/cemd/ucevcc"
+	"github.com/enbility/cemd/ucevcem"
+	"github.com/enbility/cemd/ucmgcp"
+	"github.com/enbility/cemd/ucopev"
+	"github.com/enbility/cemd/ucoscev"
+	"github.com/enbility/cemd/ucvabd"
+	"github.com/enbility/cemd/util"
+	"github.com/enbility/ship-go/logging"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+)
+
+// the written limits are rounded down to 1/resolution A
+const resolution = 10
+
+// the current differences below are ignored when rounding the limits
+const epsilon = 1e-9
+
+// the voltage used if no voltage is configured
+const defaultVoltage = 230
+
+// The charging mode of the controller
+type Mode string
+
+const (
+	// Charge only with the surplus power
+	ModePV Mode = "pv"
+
+	// Charge with at least the minimum current and the surplus power above it
+	ModeMinPV Mode = "minpv"
+)
+
+// The use case writing the limits of the EV
+//
+// Implemented by ucoscev.UCOSCEVInterface for recommendations and
+// ucopev.UCOPEVInterface for obligations.
+type Limiter interface {
+	CurrentLimits(entity spineapi.EntityRemoteInterface) ([]float64, []float64, []float64, error)
+	WriteLoadControlLimits(entity spineapi.EntityRemoteInterface, limits []api.LoadLimitsPhase) (*model.MsgCounterType, error)
+}
+
+// Configuration of the controller
+type Config struct {
+	// the charging mode, ModePV if empty
+	Mode Mode
+
+	// the nominal voltage of each phase in V, 230 V if 0
+	Voltage float64
+
+	// the power in W the surplus may drop below the minimum charging power,
+	// before the charging is stopped
+	Hysteresis float64
+
+	// the minimum duration of the charging once it is started
+	MinChargeTime time.Duration
+
+	// if the EV charges with 1 phase while the surplus is too low for all phases
+	PhaseSwitching bool
+}
+
+// Charges an EV with the surplus power
+type Controller struct {
+	mgcp    ucmgcp.UCMGCPInterface
+	evcem   ucevcem.UCEVCEMInterface
+	vabd    ucvabd.UCVABDInterface
+	limiter Limiter
+
+	config Config
+
+	// returns the current time, replaced in tests
+	now func() time.Time
+
+	mux sync.Mutex
+	// the entity of the grid connection point
+	grid spineapi.EntityRemoteInterface
+	// the entity of the battery inverter
+	battery spineapi.EntityRemoteInterface
+	// the charged EV
+	ev *ev
+}
+
+// the EV charged by the controller
+type ev struct {
+	ski    string
+	entity spineapi.EntityRemoteInterface
+
+	// the limit and the number of phases charged with last written to the EV,
+	// if written is true
+	limit   float64
+	phases  int
+	written bool
+
+	// if the EV is charging and since when
+	charging bool
+	since    time.Time
+}
+
+// create a new controller
+//
+// parameters:
+//   - mgcp: the use case providing the power of the grid connection point
+//   - evcem: the use case providing the connected phases and the power of the EV
+//   - vabd: the use case providing the power of the battery, nil if not available
+//   - limiter: the use case writing the limits of the EV
+//   - config: the configuration
+//
+// the events have to be passed to EntityEventCB and DeviceEventCB
+func NewController(
+	mgcp ucmgcp.UCMGCPInterface,
+	evcem ucevcem.UCEVCEMInterface,
+	vabd ucvabd.UCVABDInterface,
+	limiter Limiter,
+	config Config,
+) *Controller {
+	if config.Mode == "" {
+		config.Mode = ModePV
+	}
+	if config.Voltage == 0 {
+		config.Voltage = defaultVoltage
+	}
+
+	return &Controller{
+		mgcp:    mgcp,
+		evcem:   evcem,
+		vabd:    vabd,
+		limiter: limiter,
+		config:  config,
+		now:     time.Now,
+	}
+}
+
+// set the charging mode
+//
+// the mode is applied with the next calculation of the limit
+func (c *Controller) SetMode(mode Mode) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	c.config.Mode = mode
+}
+
+// returns the limit last written to the EV in A
+func (c *Controller) Limit() (float64, bool) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	if c.ev == nil || !c.ev.written {
+		return 0, false
+	}
+
+	return c.ev.limit, true
+}
+
+// track the EV and recalculate the limit with the grid power
+//
+// only the first EV is charged, further EVs are ignored until it is disconnected
+//
+// has the signature of api.EntityEventCallback
+func (c *Controller) EntityEventCB(ski string, device spineapi.DeviceRemoteInterface, entity spineapi.EntityRemoteInterface, event api.EventType) {
+	if entity == nil {
+		return
+	}
+
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	switch event {
+	case ucmgcp.DataUpdatePower:
+		c.grid = entity
+		c.update()
+	case ucvabd.DataUpdatePower:
+		c.battery = entity
+	case ucoscev.DataUpdateCurrentLimits, ucopev.DataUpdateCurrentLimits:
+		if c.ev != nil {
+			return
+		}
+
+		c.ev = &ev{ski: ski, entity: entity}
+		c.update()
+	case ucevcc.EvDisconnected:
+		if c.ev != nil && c.ev.entity == entity {
+			c.ev = nil
+		}
+	}
+}
+
+// forget the EV, the battery and the grid connection point of disconnected devices
+//
+// has the signature of api.DeviceEventCallback
+func (c *Controller) DeviceEventCB(ski string, device spineapi.DeviceRemoteInterface, event api.EventType) {
+	if event != cem.DeviceDisconnected {
+		return
+	}
+
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	if c.ev != nil && c.ev.ski == ski {
+		c.ev = nil
+	}
+
+	if c.grid != nil && c.grid.Device() != nil && c.grid.Device().Ski() == ski {
+		c.grid = nil
+	}
+
+	if c.battery != nil && c.battery.Device() != nil && c.battery.Device().Ski() == ski {
+		c.battery = nil
+	}
+}
+
+// calculate and write the limit of the EV
+func (c *Controller) update() {
+	if c.grid == nil || c.ev == nil {
+		return
+	}
+
+	gridPower, err := c.mgcp.Power(c.grid)
+	if err != nil {
+		return
+	}
+
+	minimum, maximum, _, err := c.limiter.CurrentLimits(c.ev.entity)
+	if err != nil || len(minimum) == 0 || len(maximum) == 0 {
+		return
+	}
+
+	// the number of phases of the limits of the EV
+	count := min(len(maximum), len(util.PhaseNameMapping))
+
+	surplus := c.evPower() - gridPower
+	if c.vabd != nil && c.battery != nil {
+		if power, err := c.vabd.Power(c.battery); err == nil {
+			surplus += power
+		}
+	}
+
+	phases := c.switchPhases(c.phases(count), surplus, slices.Max(minimum))
+	// the power of 1 A on all phases the EV charges with
+	phasePower := float64(phases) * c.config.Voltage
+
+	current := min(surplus/phasePower, slices.Min(maximum))
+	limit := c.limit(current, slices.Max(minimum), phasePower)
+
+	c.write(limit, phases, count)
+}
+
+// returns the number of phases the EV is connected with
+func (c *Controller) phases(count int) int {
+	if phases, err := c.evcem.PhasesConnected(c.ev.entity); err == nil && phases > 0 {
+		return min(int(phases), count)
+	}
+
+	return count
+}
+
+// returns the number of phases to charge with, 1 or all connected phases
+//
+// the EV charges with 1 phase while the surplus is below the minimum charging
+// power on all phases, the hysteresis applies to the switch to 1 phase
+func (c *Controller) switchPhases(phases int, surplus, minimum float64) int {
+	if !c.config.PhaseSwitching || phases == 1 {
+		return phases
+	}
+
+	// the minimum charging power on all phases
+	threshold := minimum * float64(phases) * c.config.Voltage
+
+	if c.ev.charging && c.ev.phases == phases {
+		threshold -= c.config.Hysteresis
+	}
+
+	if surplus < threshold {
+		return 1
+	}
+
+	return phases
+}
+
+// returns the power of the EV
+func (c *Controller) evPower() float64 {
+	if powers, err := c.evcem.PowerPerPhase(c.ev.entity); err == nil {
+		var result float64
+		for _, power := range powers {
+			result += power
+		}
+		return result
+	}
+
+	if c.ev.written {
+		return c.ev.limit * float64(c.ev.phases) * c.config.Voltage
+	}
+
+	return 0
+}
+
+// returns the limit for the current available from the surplus
+//
+// applies the charging mode, the hysteresis and the minimum charge time
+func (c *Controller) limit(current, minimum, phasePower float64) float64 {
+	now := c.now()
+
+	switch {
+	case current >= minimum:
+	case c.config.Mode == ModeMinPV:
+		current = minimum
+	case c.ev.charging && current*phasePower+c.config.Hysteresis >= minimum*phasePower:
+		current = minimum
+	case c.ev.charging && now.Sub(c.ev.since) < c.config.MinChargeTime:
+		current = minimum
+	default:
+		c.ev.charging = false
+		return 0
+	}
+
+	if !c.ev.charging {
+		c.ev.charging = true
+		c.ev.since = now
+	}
+
+	return math.Floor(current*resolution+epsilon) / resolution
+}
+
+// write the limit to the phases the EV charges with and 0 A to the other phases,
+// if it changed
+func (c *Controller) write(limit float64, phases, count int) {
+	if c.ev.written && c.ev.limit == limit && c.ev.phases == phases {
+		return
+	}
+
+	var limits []api.LoadLimitsPhase
+	for phase := 0; phase < count; phase++ {
+		value := limit
+		if phase >= phases {
+			value = 0
+		}
+
+		limits = append(limits, api.LoadLimitsPhase{
+			Phase:    util.PhaseNameMapping[phase],
+			IsActive: true,
+			Value:    value,
+		})
+	}
+
+	if _, err := c.limiter.WriteLoadControlLimits(c.ev.entity, limits); err != nil {
+		logging.Log().Error("surplus", c.ev.ski, err)
+		return
+	}
+
+	c.ev.limit = limit
+	c.ev.phases = phases
+	c.ev.written = true
+}
@@ -0,0 +1,300 @@
+package surplus
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/cem"
+	"github.com/enbility/cemd/mocks"
+	"github.com/enbility/cemd/ucevcc"
+	"github.com/enbility/cemd/ucmgcp"
+	"github.com/enbility/cemd/ucopev"
+	"github.com/enbility/cemd/ucoscev"
+	"github.com/enbility/cemd/ucvabd"
+	eebusapi "github.com/enbility/eebus-go/api"
+	spinemocks "github.com/enbility/spine-go/mocks"
+	"github.com/enbility/spine-go/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestControllerSuite(t *testing.T) {
+	suite.Run(t, new(ControllerSuite))
+}
+
+type ControllerSuite struct {
+	suite.Suite
+
+	mgcp  *mocks.UCMGCPInterface
+	evcem *mocks.UCEVCEMInterface
+	opev  *mocks.UCOPEVInterface
+
+	grid *spinemocks.EntityRemoteInterface
+	ev   *spinemocks.EntityRemoteInterface
+
+	now time.Time
+	sut *Controller
+}
+
+func (s *ControllerSuite) BeforeTest(suiteName, testName string) {
+	s.mgcp = mocks.NewUCMGCPInterface(s.T())
+	s.evcem = mocks.NewUCEVCEMInterface(s.T())
+	s.opev = mocks.NewUCOPEVInterface(s.T())
+
+	s.grid = s.entity("smgw")
+	s.ev = s.entity("evse")
+
+	s.now = time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	s.setController(NewController(s.mgcp, s.evcem, nil, s.opev, Config{}))
+}
+
+func (s *ControllerSuite) setController(sut *Controller) {
+	s.sut = sut
+	s.sut.now = func() time.Time {
+		return s.now
+	}
+}
+
+func (s *ControllerSuite) entity(ski string) *spinemocks.EntityRemoteInterface {
+	device := spinemocks.NewDeviceRemoteInterface(s.T())
+	device.EXPECT().Ski().Return(ski).Maybe()
+	entity := spinemocks.NewEntityRemoteInterface(s.T())
+	entity.EXPECT().Device().Return(device).Maybe()
+	return entity
+}
+
+// connect an EV with 3 phases and a current range of 6 to 16 A
+func (s *ControllerSuite) connect() {
+	s.opev.EXPECT().CurrentLimits(s.ev).Return([]float64{6, 6, 6}, []float64{16, 16, 16}, []float64{0, 0, 0}, nil).Maybe()
+	s.evcem.EXPECT().PhasesConnected(s.ev).Return(3, nil).Maybe()
+
+	s.sut.EntityEventCB("evse", nil, s.ev, ucopev.DataUpdateCurrentLimits)
+}
+
+// simulate a measurement of the grid power and the power of the EV, nil if not measured
+func (s *ControllerSuite) measure(gridPower float64, evPower []float64) {
+	s.mgcp.EXPECT().Power(s.grid).Return(gridPower, nil).Once()
+	if evPower != nil {
+		s.evcem.EXPECT().PowerPerPhase(s.ev).Return(evPower, nil).Once()
+	} else {
+		s.evcem.EXPECT().PowerPerPhase(s.ev).Return(nil, eebusapi.ErrDataNotAvailable).Once()
+	}
+
+	s.sut.EntityEventCB("smgw", nil, s.grid, ucmgcp.DataUpdatePower)
+}
+
+// returns the limits of an EV with the same value on the phases
+func limits(phases int, value float64) []api.LoadLimitsPhase {
+	var result []api.LoadLimitsPhase
+	for _, phase := range []model.ElectricalConnectionPhaseNameType{"a", "b", "c"}[:phases] {
+		result = append(result, api.LoadLimitsPhase{Phase: phase, IsActive: true, Value: value})
+	}
+	return result
+}
+
+// returns the limits of an EV with 3 phases charging with phase a
+func singlePhase(value float64) []api.LoadLimitsPhase {
+	result := limits(3, 0)
+	result[0].Value = value
+	return result
+}
+
+func (s *ControllerSuite) expectLimit(phases int, value float64) {
+	s.opev.EXPECT().WriteLoadControlLimits(s.ev, limits(phases, value)).Return(nil, nil).Once()
+}
+
+func (s *ControllerSuite) expectSinglePhase(value float64) {
+	s.opev.EXPECT().WriteLoadControlLimits(s.ev, singlePhase(value)).Return(nil, nil).Once()
+}
+
+func (s *ControllerSuite) Test_PV() {
+	s.setController(NewController(s.mgcp, s.evcem, nil, s.opev, Config{Hysteresis: 690}))
+	s.connect()
+
+	// the limit is calculated once the grid power is known
+	_, ok := s.sut.Limit()
+	assert.False(s.T(), ok)
+
+	// 3000 W are not enough for 6 A on 3 phases
+	s.expectLimit(3, 0)
+	s.measure(-3000, []float64{0, 0, 0})
+
+	limit, ok := s.sut.Limit()
+	assert.True(s.T(), ok)
+	assert.Equal(s.T(), 0.0, limit)
+
+	// 5000 W start the charging
+	s.expectLimit(3, 7.2)
+	s.measure(-5000, []float64{0, 0, 0})
+
+	// the EV uses 4968 W and 200 W are still fed in
+	s.expectLimit(3, 7.4)
+	s.measure(-200, []float64{1656, 1656, 1656})
+
+	// the surplus drops within the hysteresis
+	s.expectLimit(3, 6)
+	s.measure(1000, []float64{1702, 1702, 1702})
+
+	// the surplus drops below the hysteresis
+	s.expectLimit(3, 0)
+	s.measure(1000, []float64{1380, 1380, 1380})
+
+	// the surplus is not enough to start again
+	s.measure(-4000, []float64{0, 0, 0})
+
+	limit, ok = s.sut.Limit()
+	assert.True(s.T(), ok)
+	assert.Equal(s.T(), 0.0, limit)
+}
+
+func (s *ControllerSuite) Test_MinChargeTime() {
+	s.setController(NewController(s.mgcp, s.evcem, nil, s.opev, Config{MinChargeTime: time.Minute * 10}))
+	s.connect()
+
+	s.expectLimit(3, 6.5)
+	s.measure(-4500, []float64{0, 0, 0})
+
+	// without a measurement the EV is assumed to use its limit
+	s.now = s.now.Add(time.Minute * 5)
+	s.expectLimit(3, 6)
+	s.measure(1000, nil)
+
+	s.now = s.now.Add(time.Minute * 6)
+	s.expectLimit(3, 0)
+	s.measure(1000, nil)
+}
+
+func (s *ControllerSuite) Test_MinPV() {
+	s.setController(NewController(s.mgcp, s.evcem, nil, s.opev, Config{Mode: ModeMinPV}))
+	s.connect()
+
+	s.expectLimit(3, 6)
+	s.measure(500, []float64{0, 0, 0})
+
+	s.expectLimit(3, 8)
+	s.measure(-1380, []float64{1380, 1380, 1380})
+
+	// the charging stops without surplus in PV mode
+	s.sut.SetMode(ModePV)
+	s.expectLimit(3, 0)
+	s.measure(500, []float64{1380, 1380, 1380})
+}
+
+func (s *ControllerSuite) Test_Phases() {
+	s.opev.EXPECT().CurrentLimits(s.ev).Return([]float64{6, 6, 6}, []float64{32, 32, 32}, []float64{0, 0, 0}, nil)
+	s.evcem.EXPECT().PhasesConnected(s.ev).Return(1, nil).Once()
+	s.sut.EntityEventCB("evse", nil, s.ev, ucopev.DataUpdateCurrentLimits)
+
+	// the surplus is used on the connected phase, the other phases are reset
+	s.expectSinglePhase(13)
+	s.measure(-3000, []float64{0, 0, 0})
+
+	// all phases are used if the connected phases are unknown
+	s.evcem.EXPECT().PhasesConnected(s.ev).Return(0, errors.New("not available")).Once()
+	s.expectLimit(3, 0)
+	s.measure(-3000, []float64{0, 0, 0})
+}
+
+func (s *ControllerSuite) Test_PhaseSwitching() {
+	s.setController(NewController(s.mgcp, s.evcem, nil, s.opev, Config{Hysteresis: 690, PhaseSwitching: true}))
+	s.connect()
+
+	// 2000 W are not enough for 6 A on 3 phases, the EV charges with 1 phase
+	s.expectSinglePhase(8.6)
+	s.measure(-2000, []float64{0, 0, 0})
+
+	// 4000 W are still below the 4140 W needed for 3 phases
+	s.expectSinglePhase(16)
+	s.measure(-400, []float64{3600, 0, 0})
+
+	// the EV switches to 3 phases once the surplus reaches 4140 W
+	s.expectLimit(3, 6.5)
+	s.measure(-900, []float64{3600, 0, 0})
+
+	// the surplus drops within the hysteresis, the EV stays on 3 phases
+	s.expectLimit(3, 6)
+	s.measure(785, []float64{1495, 1495, 1495})
+
+	// the surplus drops below the hysteresis, the EV switches back to 1 phase
+	s.expectSinglePhase(13)
+	s.measure(1140, []float64{1380, 1380, 1380})
+
+	// without a measurement the EV is assumed to use its limit on phase a
+	s.measure(-10, nil)
+
+	// the charging stops without surplus
+	s.expectSinglePhase(0)
+	s.measure(2500, []float64{2990, 0, 0})
+
+	// EVs connected with 1 phase do not switch
+	s.evcem.EXPECT().PhasesConnected(s.ev).Unset()
+	s.evcem.EXPECT().PhasesConnected(s.ev).Return(1, nil)
+	s.expectSinglePhase(16)
+	s.measure(-5000, []float64{0, 0, 0})
+}
+
+func (s *ControllerSuite) Test_Battery() {
+	vabd := mocks.NewUCVABDInterface(s.T())
+	oscev := mocks.NewUCOSCEVInterface(s.T())
+	s.setController(NewController(s.mgcp, s.evcem, vabd, oscev, Config{}))
+
+	battery := s.entity("inverter")
+	s.sut.EntityEventCB("inverter", nil, battery, ucvabd.DataUpdatePower)
+
+	oscev.EXPECT().CurrentLimits(s.ev).Return([]float64{6, 6, 6}, []float64{16, 16, 16}, []float64{0, 0, 0}, nil)
+	s.evcem.EXPECT().PhasesConnected(s.ev).Return(3, nil)
+	s.sut.EntityEventCB("evse", nil, s.ev, ucoscev.DataUpdateCurrentLimits)
+
+	// the EV is charged before the battery
+	vabd.EXPECT().Power(battery).Return(4000, nil).Once()
+	oscev.EXPECT().WriteLoadControlLimits(s.ev, limits(3, 7.2)).Return(nil, nil).Once()
+	s.measure(-1000, []float64{0, 0, 0})
+
+	// the EV is not charged from the battery
+	vabd.EXPECT().Power(battery).Return(-2000, nil).Once()
+	oscev.EXPECT().WriteLoadControlLimits(s.ev, limits(3, 0)).Return(nil, nil).Once()
+	s.measure(0, []float64{1656, 1656, 1656})
+
+	// the battery of a disconnected device is ignored
+	s.sut.DeviceEventCB("inverter", nil, cem.DeviceDisconnected)
+	oscev.EXPECT().WriteLoadControlLimits(s.ev, limits(3, 7.2)).Return(nil, nil).Once()
+	s.measure(-5000, []float64{0, 0, 0})
+}
+
+func (s *ControllerSuite) Test_Disconnect() {
+	s.connect()
+
+	// failed writes are repeated with the next calculation
+	s.opev.EXPECT().WriteLoadControlLimits(s.ev, limits(3, 0)).Return(nil, errors.New("failed")).Once()
+	s.measure(0, []float64{0, 0, 0})
+	_, ok := s.sut.Limit()
+	assert.False(s.T(), ok)
+
+	s.expectLimit(3, 0)
+	s.measure(0, []float64{0, 0, 0})
+
+	// further EVs are ignored
+	other := s.entity("evse2")
+	s.sut.EntityEventCB("evse2", nil, other, ucopev.DataUpdateCurrentLimits)
+	s.sut.EntityEventCB("evse2", nil, other, ucevcc.EvDisconnected)
+
+	s.sut.EntityEventCB("evse", nil, s.ev, ucevcc.EvDisconnected)
+	_, ok = s.sut.Limit()
+	assert.False(s.T(), ok)
+
+	// a reconnected EV gets a new limit
+	s.mgcp.EXPECT().Power(s.grid).Return(0, nil).Once()
+	s.evcem.EXPECT().PowerPerPhase(s.ev).Return(nil, eebusapi.ErrDataNotAvailable).Once()
+	s.expectLimit(3, 0)
+	s.connect()
+
+	// without a grid connection point nothing is calculated
+	s.sut.DeviceEventCB("smgw", nil, cem.DeviceDisconnected)
+	s.sut.DeviceEventCB("evse", nil, cem.DeviceDisconnected)
+	s.connect()
+
+	s.mgcp.EXPECT().Power(s.grid).Return(0, errors.New("not available")).Once()
+	s.sut.EntityEventCB("smgw", nil, s.grid, ucmgcp.DataUpdatePower)
+}
@@ -134,6 +134,11 @@ func (e *UCCEVC) evTimeSeriesDescriptionDataUpdate(payload spineapi.EventPayload
 
 // the load control limit data of an EV was updated
 func (e *UCCEVC) evTimeSeriesDataUpdate(payload spineapi.EventPayload) {
+	// the demand is usually provided after the descriptions were received
+	if _, err := e.EnergyDemand(payload.Entity); err == nil {
+		e.eventCB(payload.Ski, payload.Device, payload.Entity, DataUpdateEnergyDemand)
+	}
+
 	if _, err := e.ChargePlan(payload.Entity); err == nil {
 		e.eventCB(payload.Ski, payload.Device, payload.Entity, DataUpdateChargePlan)
 	}
@@ -0,0 +1,83 @@
+package ucevccserver
+
+import (
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/spine-go/model"
+)
+
+//go:generate mockery
+
+// interface for the EV Commissioning and Configuration UseCase as an EVSE providing the EV data
+//
+// The EV entity only exists while an EV is connected. Other use cases providing
+// data on the EV entity have to be registered via AddEVUseCase.
+type UCEVCCServerInterface interface {
+	api.EVUseCaseInterface
+
+	// register a use case that provides data on the EV entity
+	//
+	// parameters:
+	//   - usecase: the use case, its features are added whenever an EV is connected
+	AddEVUseCase(usecase api.EVUseCaseInterface)
+
+	// Scenario 1 & 8
+
+	// set if an EV is plugged in
+	//
+	// parameters:
+	//   - connected: true creates the EV entity, false removes it
+	//
+	// possible errors:
+	//   - ErrNoLocalEntity if there is no local EVSE entity
+	SetEVConnected(connected bool) (resultErr error)
+
+	// return if an EV is plugged in
+	EVConnected() bool
+
+	// Scenario 2
+
+	// set the communication standard used between EVSE and EV
+	//
+	// parameters:
+	//   - value: the communication standard, e.g. iec61851 or iso15118-2ed1
+	SetCommunicationStandard(value model.DeviceConfigurationKeyValueStringType) (resultErr error)
+
+	// Scenario 3
+
+	// set if the EV supports asymmetric charging
+	SetAsymmetricChargingSupport(value bool) (resultErr error)
+
+	// Scenario 4
+
+	// set the identifications of the EV
+	SetIdentifications(values []api.IdentificationItem) (resultErr error)
+
+	// Scenario 5
+
+	// set the manufacturer data of the EV
+	//
+	// parameters:
+	//   - data: the manufacturer data, empty values are not provided
+	SetManufacturerData(data api.ManufacturerData) (resultErr error)
+
+	// Scenario 6
+
+	// set the minimum, maximum and standby charging power of the EV
+	//
+	// parameters:
+	//   - minimum: the minimum charging power in W
+	//   - maximum: the maximum charging power in W
+	//   - standby: the standby power in W
+	SetChargingPowerLimits(minimum, maximum, standby float64) (resultErr error)
+
+	// Scenario 7
+
+	// set the charge state of the EV
+	//
+	// parameters:
+	//   - state: the charge state, paused is reported as sleep mode
+	//
+	// possible errors:
+	//   - ErrNotSupported if the state can not be reported, e.g. unplugged
+	SetChargeState(state api.EVChargeStateType) (resultErr error)
+}
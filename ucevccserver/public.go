@@ -0,0 +1,123 @@
+package ucevccserver
+
+import (
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/util"
+	eebusapi "github.com/enbility/eebus-go/api"
+	eebusutil "github.com/enbility/eebus-go/util"
+	"github.com/enbility/spine-go/model"
+)
+
+// Scenario 1 & 8
+
+// set if an EV is plugged in
+func (e *UCEVCCServer) SetEVConnected(connected bool) error {
+	e.mux.Lock()
+	defer e.mux.Unlock()
+
+	localDevice := e.service.LocalDevice()
+	evEntity := localDevice.EntityForType(model.EntityTypeTypeEV)
+
+	if !connected {
+		if evEntity != nil {
+			localDevice.RemoveEntity(evEntity)
+		}
+		return nil
+	}
+
+	if evEntity != nil {
+		return nil
+	}
+
+	return e.connectEV()
+}
+
+// return if an EV is plugged in
+func (e *UCEVCCServer) EVConnected() bool {
+	return e.service.LocalDevice().EntityForType(model.EntityTypeTypeEV) != nil
+}
+
+// Scenario 2
+
+// set the communication standard used between EVSE and EV
+func (e *UCEVCCServer) SetCommunicationStandard(value model.DeviceConfigurationKeyValueStringType) error {
+	keyValue := model.DeviceConfigurationKeyValueValueType{
+		String: eebusutil.Ptr(value),
+	}
+
+	return e.setDeviceConfigurationValue(model.DeviceConfigurationKeyNameTypeCommunicationsStandard, keyValue)
+}
+
+// Scenario 3
+
+// set if the EV supports asymmetric charging
+func (e *UCEVCCServer) SetAsymmetricChargingSupport(value bool) error {
+	keyValue := model.DeviceConfigurationKeyValueValueType{
+		Boolean: eebusutil.Ptr(value),
+	}
+
+	return e.setDeviceConfigurationValue(model.DeviceConfigurationKeyNameTypeAsymmetricChargingSupported, keyValue)
+}
+
+// Scenario 4
+
+// set the identifications of the EV
+func (e *UCEVCCServer) SetIdentifications(values []api.IdentificationItem) error {
+	localEntity := e.service.LocalDevice().EntityForType(model.EntityTypeTypeEV)
+	if localEntity == nil {
+		return eebusapi.ErrDataNotAvailable
+	}
+
+	identification := localEntity.FeatureOfTypeAndRole(model.FeatureTypeTypeIdentification, model.RoleTypeServer)
+	if identification == nil {
+		return eebusapi.ErrDataNotAvailable
+	}
+
+	data := &model.IdentificationListDataType{}
+	for index, item := range values {
+		data.IdentificationData = append(data.IdentificationData, model.IdentificationDataType{
+			IdentificationId:    eebusutil.Ptr(model.IdentificationIdType(index)),
+			IdentificationType:  eebusutil.Ptr(item.ValueType),
+			IdentificationValue: eebusutil.Ptr(model.IdentificationValueType(item.Value)),
+		})
+	}
+	identification.SetData(model.FunctionTypeIdentificationListData, data)
+
+	return nil
+}
+
+// Scenario 5
+
+// set the manufacturer data of the EV
+func (e *UCEVCCServer) SetManufacturerData(data api.ManufacturerData) error {
+	return util.SetLocalManufacturerData(e.service, model.EntityTypeTypeEV, data)
+}
+
+// Scenario 6
+
+// set the minimum, maximum and standby charging power of the EV
+func (e *UCEVCCServer) SetChargingPowerLimits(minimum, maximum, standby float64) error {
+	return util.SetLocalEVPermittedValueSet(e.service, util.LocalEVElectricalConnectionParameterIdPowerTotal, minimum, maximum, standby)
+}
+
+// Scenario 7
+
+// set the charge state of the EV
+func (e *UCEVCCServer) SetChargeState(state api.EVChargeStateType) error {
+	var operatingState model.DeviceDiagnosisOperatingStateType
+
+	switch state {
+	case api.EVChargeStateTypeActive:
+		operatingState = model.DeviceDiagnosisOperatingStateTypeNormalOperation
+	case api.EVChargeStateTypePaused:
+		operatingState = model.DeviceDiagnosisOperatingStateTypeStandby
+	case api.EVChargeStateTypeError:
+		operatingState = model.DeviceDiagnosisOperatingStateTypeFailure
+	case api.EVChargeStateTypeFinished:
+		operatingState = model.DeviceDiagnosisOperatingStateTypeFinished
+	default:
+		return eebusapi.ErrNotSupported
+	}
+
+	return util.SetLocalDeviceDiagnosisState(e.service, model.EntityTypeTypeEV, operatingState, "")
+}
@@ -0,0 +1,128 @@
+package ucevccserver
+
+import (
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/util"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/stretchr/testify/assert"
+)
+
+// return the server feature of the connected EV
+func (s *UCEVCCServerSuite) evFeature(featureType model.FeatureTypeType) spineapi.FeatureLocalInterface {
+	evEntity := s.service.LocalDevice().EntityForType(model.EntityTypeTypeEV)
+	if evEntity == nil {
+		return nil
+	}
+
+	return evEntity.FeatureOfTypeAndRole(featureType, model.RoleTypeServer)
+}
+
+func (s *UCEVCCServerSuite) Test_NotConnected() {
+	err := s.sut.SetCommunicationStandard(model.DeviceConfigurationKeyValueStringTypeISO151182ED2)
+	assert.NotNil(s.T(), err)
+
+	err = s.sut.SetAsymmetricChargingSupport(true)
+	assert.NotNil(s.T(), err)
+
+	err = s.sut.SetIdentifications([]api.IdentificationItem{})
+	assert.NotNil(s.T(), err)
+
+	err = s.sut.SetManufacturerData(api.ManufacturerData{})
+	assert.NotNil(s.T(), err)
+
+	err = s.sut.SetChargingPowerLimits(1400, 11000, 0)
+	assert.NotNil(s.T(), err)
+
+	err = s.sut.SetChargeState(api.EVChargeStateTypeActive)
+	assert.NotNil(s.T(), err)
+}
+
+func (s *UCEVCCServerSuite) Test_DeviceConfiguration() {
+	err := s.sut.SetEVConnected(true)
+	assert.Nil(s.T(), err)
+
+	err = s.sut.SetCommunicationStandard(model.DeviceConfigurationKeyValueStringTypeIEC61851)
+	assert.Nil(s.T(), err)
+
+	err = s.sut.SetAsymmetricChargingSupport(true)
+	assert.Nil(s.T(), err)
+
+	err = s.sut.SetCommunicationStandard(model.DeviceConfigurationKeyValueStringTypeISO151182ED2)
+	assert.Nil(s.T(), err)
+
+	data, ok := s.evFeature(model.FeatureTypeTypeDeviceConfiguration).DataCopy(
+		model.FunctionTypeDeviceConfigurationKeyValueListData).(*model.DeviceConfigurationKeyValueListDataType)
+	assert.True(s.T(), ok)
+	assert.Equal(s.T(), 2, len(data.DeviceConfigurationKeyValueData))
+	assert.Equal(s.T(), model.DeviceConfigurationKeyValueStringTypeISO151182ED2, *data.DeviceConfigurationKeyValueData[0].Value.String)
+	assert.Equal(s.T(), true, *data.DeviceConfigurationKeyValueData[1].Value.Boolean)
+}
+
+func (s *UCEVCCServerSuite) Test_Identifications() {
+	err := s.sut.SetEVConnected(true)
+	assert.Nil(s.T(), err)
+
+	err = s.sut.SetIdentifications([]api.IdentificationItem{
+		{
+			Value:     "00:11:22:33:44:55",
+			ValueType: model.IdentificationTypeTypeEui48,
+		},
+	})
+	assert.Nil(s.T(), err)
+
+	data, ok := s.evFeature(model.FeatureTypeTypeIdentification).DataCopy(
+		model.FunctionTypeIdentificationListData).(*model.IdentificationListDataType)
+	assert.True(s.T(), ok)
+	assert.Equal(s.T(), 1, len(data.IdentificationData))
+	assert.Equal(s.T(), model.IdentificationValueType("00:11:22:33:44:55"), *data.IdentificationData[0].IdentificationValue)
+	assert.Equal(s.T(), model.IdentificationTypeTypeEui48, *data.IdentificationData[0].IdentificationType)
+}
+
+func (s *UCEVCCServerSuite) Test_ManufacturerData() {
+	err := s.sut.SetEVConnected(true)
+	assert.Nil(s.T(), err)
+
+	err = s.sut.SetManufacturerData(api.ManufacturerData{BrandName: "car"})
+	assert.Nil(s.T(), err)
+
+	data, ok := s.evFeature(model.FeatureTypeTypeDeviceClassification).DataCopy(
+		model.FunctionTypeDeviceClassificationManufacturerData).(*model.DeviceClassificationManufacturerDataType)
+	assert.True(s.T(), ok)
+	assert.Equal(s.T(), model.DeviceClassificationStringType("car"), *data.BrandName)
+}
+
+func (s *UCEVCCServerSuite) Test_ChargingPowerLimits() {
+	err := s.sut.SetEVConnected(true)
+	assert.Nil(s.T(), err)
+
+	err = s.sut.SetChargingPowerLimits(1400, 11000, 0)
+	assert.Nil(s.T(), err)
+
+	data, ok := s.evFeature(model.FeatureTypeTypeElectricalConnection).DataCopy(
+		model.FunctionTypeElectricalConnectionPermittedValueSetListData).(*model.ElectricalConnectionPermittedValueSetListDataType)
+	assert.True(s.T(), ok)
+	assert.Equal(s.T(), 1, len(data.ElectricalConnectionPermittedValueSetData))
+
+	set := data.ElectricalConnectionPermittedValueSetData[0]
+	assert.Equal(s.T(), util.LocalEVElectricalConnectionParameterIdPowerTotal, *set.ParameterId)
+	assert.Equal(s.T(), 1400.0, set.PermittedValueSet[0].Range[0].Min.GetValue())
+	assert.Equal(s.T(), 11000.0, set.PermittedValueSet[0].Range[0].Max.GetValue())
+	assert.Equal(s.T(), 0.0, set.PermittedValueSet[0].Value[0].GetValue())
+}
+
+func (s *UCEVCCServerSuite) Test_ChargeState() {
+	err := s.sut.SetEVConnected(true)
+	assert.Nil(s.T(), err)
+
+	err = s.sut.SetChargeState(api.EVChargeStateTypeUnplugged)
+	assert.NotNil(s.T(), err)
+
+	err = s.sut.SetChargeState(api.EVChargeStateTypePaused)
+	assert.Nil(s.T(), err)
+
+	data, ok := s.evFeature(model.FeatureTypeTypeDeviceDiagnosis).DataCopy(
+		model.FunctionTypeDeviceDiagnosisStateData).(*model.DeviceDiagnosisStateDataType)
+	assert.True(s.T(), ok)
+	assert.Equal(s.T(), model.DeviceDiagnosisOperatingStateTypeStandby, *data.OperatingState)
+}
@@ -0,0 +1,215 @@
+package ucevccserver
+
+import (
+	"slices"
+	"sync"
+
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/util"
+	eebusapi "github.com/enbility/eebus-go/api"
+	eebusutil "github.com/enbility/eebus-go/util"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/spine"
+)
+
+// the device configuration keys provided by this use case, the keyId of each key is its index
+var deviceConfigurationKeys = []struct {
+	keyName   model.DeviceConfigurationKeyNameType
+	valueType model.DeviceConfigurationKeyValueTypeType
+}{
+	{model.DeviceConfigurationKeyNameTypeCommunicationsStandard, model.DeviceConfigurationKeyValueTypeTypeString},
+	{model.DeviceConfigurationKeyNameTypeAsymmetricChargingSupported, model.DeviceConfigurationKeyValueTypeTypeBoolean},
+}
+
+type UCEVCCServer struct {
+	service eebusapi.ServiceInterface
+
+	eventCB api.EntityEventCallback
+
+	validEntityTypes []model.EntityTypeType
+
+	mux        sync.Mutex
+	evUseCases []api.EVUseCaseInterface
+}
+
+var _ UCEVCCServerInterface = (*UCEVCCServer)(nil)
+
+func NewUCEVCC(service eebusapi.ServiceInterface, eventCB api.EntityEventCallback) *UCEVCCServer {
+	uc := &UCEVCCServer{
+		service: service,
+		eventCB: eventCB,
+	}
+
+	uc.validEntityTypes = []model.EntityTypeType{
+		model.EntityTypeTypeCEM,
+	}
+
+	return uc
+}
+
+func (c *UCEVCCServer) UseCaseName() model.UseCaseNameType {
+	return model.UseCaseNameTypeEVCommissioningAndConfiguration
+}
+
+// the features are added to the EV entity when an EV is connected
+func (e *UCEVCCServer) AddFeatures() {}
+
+// the use case is added to the EV entity when an EV is connected
+func (e *UCEVCCServer) AddUseCase() {}
+
+func (e *UCEVCCServer) AddEVFeatures(entity spineapi.EntityLocalInterface) {
+	// server features
+	f := entity.GetOrAddFeature(model.FeatureTypeTypeDeviceConfiguration, model.RoleTypeServer)
+	f.AddFunctionType(model.FunctionTypeDeviceConfigurationKeyValueDescriptionListData, true, false)
+	f.AddFunctionType(model.FunctionTypeDeviceConfigurationKeyValueListData, true, false)
+
+	deviceConfigDesc := &model.DeviceConfigurationKeyValueDescriptionListDataType{}
+	for index, item := range deviceConfigurationKeys {
+		deviceConfigDesc.DeviceConfigurationKeyValueDescriptionData = append(deviceConfigDesc.DeviceConfigurationKeyValueDescriptionData,
+			model.DeviceConfigurationKeyValueDescriptionDataType{
+				KeyId:     eebusutil.Ptr(model.DeviceConfigurationKeyIdType(index)),
+				KeyName:   eebusutil.Ptr(item.keyName),
+				ValueType: eebusutil.Ptr(item.valueType),
+			})
+	}
+	f.SetData(model.FunctionTypeDeviceConfigurationKeyValueDescriptionListData, deviceConfigDesc)
+
+	f = entity.GetOrAddFeature(model.FeatureTypeTypeDeviceClassification, model.RoleTypeServer)
+	f.AddFunctionType(model.FunctionTypeDeviceClassificationManufacturerData, true, false)
+
+	f = entity.GetOrAddFeature(model.FeatureTypeTypeDeviceDiagnosis, model.RoleTypeServer)
+	f.AddFunctionType(model.FunctionTypeDeviceDiagnosisStateData, true, false)
+
+	f = entity.GetOrAddFeature(model.FeatureTypeTypeIdentification, model.RoleTypeServer)
+	f.AddFunctionType(model.FunctionTypeIdentificationListData, true, false)
+
+	util.AddLocalEVElectricalConnection(entity)
+
+	entity.AddUseCaseSupport(
+		model.UseCaseActorTypeEV,
+		e.UseCaseName(),
+		model.SpecificationVersionType("1.0.1"),
+		"release",
+		true,
+		[]model.UseCaseScenarioSupportType{1, 2, 3, 4, 5, 6, 7, 8})
+}
+
+func (e *UCEVCCServer) UpdateUseCaseAvailability(available bool) {
+	localEntity := e.service.LocalDevice().EntityForType(model.EntityTypeTypeEV)
+	if localEntity == nil {
+		return
+	}
+
+	localEntity.SetUseCaseAvailability(model.UseCaseActorTypeEV, e.UseCaseName(), available)
+}
+
+// returns if the entity supports the usecase
+//
+// possible errors:
+//   - ErrDataNotAvailable if that information is not (yet) available
+//   - and others
+func (e *UCEVCCServer) IsUseCaseSupported(entity spineapi.EntityRemoteInterface) (bool, error) {
+	if entity == nil || !util.IsCompatibleEntity(entity, e.validEntityTypes) {
+		return false, api.ErrNoCompatibleEntity
+	}
+
+	// check if the usecase and mandatory scenarios are supported
+	if !entity.Device().VerifyUseCaseScenariosAndFeaturesSupport(
+		model.UseCaseActorTypeCEM,
+		e.UseCaseName(),
+		[]model.UseCaseScenarioSupportType{1, 2, 3, 8},
+		[]model.FeatureTypeType{},
+	) {
+		return false, nil
+	}
+
+	return true, nil
+}
+
+func (e *UCEVCCServer) AddEVUseCase(usecase api.EVUseCaseInterface) {
+	e.mux.Lock()
+	defer e.mux.Unlock()
+
+	if slices.Contains(e.evUseCases, usecase) {
+		return
+	}
+
+	e.evUseCases = append(e.evUseCases, usecase)
+}
+
+// create the EV entity below the EVSE entity and announce it
+// with the features of all registered use cases
+func (e *UCEVCCServer) connectEV() error {
+	localDevice := e.service.LocalDevice()
+
+	evseEntity := localDevice.EntityForType(model.EntityTypeTypeEVSE)
+	if evseEntity == nil {
+		return util.ErrNoLocalEntity
+	}
+
+	address := slices.Clone(evseEntity.Address().Entity)
+	address = append(address, 1)
+	evEntity := spine.NewEntityLocal(localDevice, model.EntityTypeTypeEV, address)
+
+	e.AddEVFeatures(evEntity)
+	for _, usecase := range e.evUseCases {
+		usecase.AddEVFeatures(evEntity)
+	}
+
+	localDevice.AddEntity(evEntity)
+
+	return nil
+}
+
+// set the value of a device configuration key of the EV
+func (e *UCEVCCServer) setDeviceConfigurationValue(keyName model.DeviceConfigurationKeyNameType, value model.DeviceConfigurationKeyValueValueType) error {
+	localEntity := e.service.LocalDevice().EntityForType(model.EntityTypeTypeEV)
+	if localEntity == nil {
+		return eebusapi.ErrDataNotAvailable
+	}
+
+	deviceConfiguration := localEntity.FeatureOfTypeAndRole(model.FeatureTypeTypeDeviceConfiguration, model.RoleTypeServer)
+	if deviceConfiguration == nil {
+		return eebusapi.ErrDataNotAvailable
+	}
+
+	var keyId *model.DeviceConfigurationKeyIdType
+	for index, item := range deviceConfigurationKeys {
+		if item.keyName == keyName {
+			keyId = eebusutil.Ptr(model.DeviceConfigurationKeyIdType(index))
+			break
+		}
+	}
+	if keyId == nil {
+		return eebusapi.ErrDataNotAvailable
+	}
+
+	data, err := spine.LocalFeatureDataCopyOfType[*model.DeviceConfigurationKeyValueListDataType](
+		deviceConfiguration, model.FunctionTypeDeviceConfigurationKeyValueListData)
+	if err != nil || data == nil {
+		data = &model.DeviceConfigurationKeyValueListDataType{}
+	}
+
+	newItem := model.DeviceConfigurationKeyValueDataType{
+		KeyId:             keyId,
+		IsValueChangeable: eebusutil.Ptr(false),
+		Value:             eebusutil.Ptr(value),
+	}
+
+	found := false
+	for index, item := range data.DeviceConfigurationKeyValueData {
+		if item.KeyId != nil && *item.KeyId == *keyId {
+			data.DeviceConfigurationKeyValueData[index] = newItem
+			found = true
+			break
+		}
+	}
+	if !found {
+		data.DeviceConfigurationKeyValueData = append(data.DeviceConfigurationKeyValueData, newItem)
+	}
+
+	deviceConfiguration.SetData(model.FunctionTypeDeviceConfigurationKeyValueListData, data)
+
+	return nil
+}
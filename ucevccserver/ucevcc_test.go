@@ -0,0 +1,91 @@
+package ucevccserver
+
+import (
+	eebusutil "github.com/enbility/eebus-go/util"
+	"github.com/enbility/spine-go/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func (s *UCEVCCServerSuite) Test_UpdateUseCaseAvailability() {
+	s.sut.UpdateUseCaseAvailability(true)
+
+	err := s.sut.SetEVConnected(true)
+	assert.Nil(s.T(), err)
+
+	s.sut.UpdateUseCaseAvailability(false)
+}
+
+func (s *UCEVCCServerSuite) Test_IsUseCaseSupported() {
+	data, err := s.sut.IsUseCaseSupported(s.mockRemoteEntity)
+	assert.NotNil(s.T(), err)
+	assert.Equal(s.T(), false, data)
+
+	data, err = s.sut.IsUseCaseSupported(s.cemEntity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), false, data)
+
+	ucData := &model.NodeManagementUseCaseDataType{
+		UseCaseInformation: []model.UseCaseInformationDataType{
+			{
+				Actor: eebusutil.Ptr(model.UseCaseActorTypeCEM),
+				UseCaseSupport: []model.UseCaseSupportType{
+					{
+						UseCaseName:      eebusutil.Ptr(model.UseCaseNameTypeEVCommissioningAndConfiguration),
+						UseCaseAvailable: eebusutil.Ptr(true),
+						ScenarioSupport:  []model.UseCaseScenarioSupportType{1, 2, 3, 4, 5, 6, 7, 8},
+					},
+				},
+			},
+		},
+	}
+
+	nodemgmtEntity := s.remoteDevice.Entity([]model.AddressEntityType{0})
+	nodeFeature := s.remoteDevice.FeatureByEntityTypeAndRole(nodemgmtEntity, model.FeatureTypeTypeNodeManagement, model.RoleTypeSpecial)
+	fErr := nodeFeature.UpdateData(model.FunctionTypeNodeManagementUseCaseData, ucData, nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	data, err = s.sut.IsUseCaseSupported(s.cemEntity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), true, data)
+}
+
+func (s *UCEVCCServerSuite) Test_EVConnected() {
+	localDevice := s.service.LocalDevice()
+
+	assert.False(s.T(), s.sut.EVConnected())
+
+	err := s.sut.SetEVConnected(false)
+	assert.Nil(s.T(), err)
+
+	err = s.sut.SetEVConnected(true)
+	assert.Nil(s.T(), err)
+	assert.True(s.T(), s.sut.EVConnected())
+	s.evUseCase.AssertCalled(s.T(), "AddEVFeatures", mock.Anything)
+
+	evseEntity := localDevice.EntityForType(model.EntityTypeTypeEVSE)
+	evEntity := localDevice.EntityForType(model.EntityTypeTypeEV)
+	assert.NotNil(s.T(), evEntity)
+	assert.Equal(s.T(), append(evseEntity.Address().Entity, 1), evEntity.Address().Entity)
+	assert.True(s.T(), evEntity.HasUseCaseSupport(model.UseCaseActorTypeEV, s.sut.UseCaseName()))
+
+	for _, feature := range []model.FeatureTypeType{
+		model.FeatureTypeTypeDeviceConfiguration,
+		model.FeatureTypeTypeDeviceClassification,
+		model.FeatureTypeTypeDeviceDiagnosis,
+		model.FeatureTypeTypeElectricalConnection,
+		model.FeatureTypeTypeIdentification,
+	} {
+		assert.NotNil(s.T(), evEntity.FeatureOfTypeAndRole(feature, model.RoleTypeServer))
+	}
+
+	// connecting again keeps the entity
+	err = s.sut.SetEVConnected(true)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), evEntity, localDevice.EntityForType(model.EntityTypeTypeEV))
+
+	err = s.sut.SetEVConnected(false)
+	assert.Nil(s.T(), err)
+	assert.False(s.T(), s.sut.EVConnected())
+	assert.Nil(s.T(), localDevice.EntityForType(model.EntityTypeTypeEV))
+}
@@ -0,0 +1,39 @@
+package ucevcemserver
+
+import (
+	"github.com/enbility/cemd/api"
+)
+
+//go:generate mockery
+
+// interface for the Measurement of Electricity during EV Charging UseCase as an EVSE providing the EV data
+//
+// The data is only available while an EV is connected, the use case has to be
+// registered at the EV Commissioning and Configuration server use case
+type UCEVCEMServerInterface interface {
+	api.EVUseCaseInterface
+
+	// Scenario 1
+
+	// set the charging current per phase of the connected EV
+	//
+	// parameters:
+	//   - values: the current in A for up to 3 phases, in the order of phase A, B and C
+	SetCurrentPerPhase(values []float64) (resultErr error)
+
+	// Scenario 2
+
+	// set the charging power per phase of the connected EV
+	//
+	// parameters:
+	//   - values: the power in W for up to 3 phases, in the order of phase A, B and C
+	SetPowerPerPhase(values []float64) (resultErr error)
+
+	// Scenario 3
+
+	// set the charged energy of the connected EV
+	//
+	// parameters:
+	//   - value: the energy in Wh
+	SetEnergyCharged(value float64) (resultErr error)
+}
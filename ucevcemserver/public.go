@@ -0,0 +1,27 @@
+package ucevcemserver
+
+import (
+	"github.com/enbility/cemd/util"
+	"github.com/enbility/spine-go/model"
+)
+
+// Scenario 1
+
+// set the charging current per phase of the connected EV
+func (e *UCEVCEMServer) SetCurrentPerPhase(values []float64) error {
+	return e.setMeasurementValues(util.LocalEVMeasurementIdsCurrent, values)
+}
+
+// Scenario 2
+
+// set the charging power per phase of the connected EV
+func (e *UCEVCEMServer) SetPowerPerPhase(values []float64) error {
+	return e.setMeasurementValues(util.LocalEVMeasurementIdsPower, values)
+}
+
+// Scenario 3
+
+// set the charged energy of the connected EV
+func (e *UCEVCEMServer) SetEnergyCharged(value float64) error {
+	return e.setMeasurementValues([]model.MeasurementIdType{util.LocalEVMeasurementIdEnergyCharged}, []float64{value})
+}
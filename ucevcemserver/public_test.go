@@ -0,0 +1,93 @@
+package ucevcemserver
+
+import (
+	"github.com/enbility/cemd/util"
+	"github.com/enbility/spine-go/model"
+	"github.com/stretchr/testify/assert"
+)
+
+// return the measurement value of the connected EV for a measurementId
+func (s *UCEVCEMServerSuite) measurementValue(measurementId model.MeasurementIdType) (float64, bool) {
+	evEntity := s.service.LocalDevice().EntityForType(model.EntityTypeTypeEV)
+	if evEntity == nil {
+		return 0, false
+	}
+
+	measurement := evEntity.FeatureOfTypeAndRole(model.FeatureTypeTypeMeasurement, model.RoleTypeServer)
+	data, ok := measurement.DataCopy(model.FunctionTypeMeasurementListData).(*model.MeasurementListDataType)
+	if !ok || data == nil {
+		return 0, false
+	}
+
+	for _, item := range data.MeasurementData {
+		if item.MeasurementId != nil && *item.MeasurementId == measurementId && item.Value != nil {
+			return item.Value.GetValue(), true
+		}
+	}
+
+	return 0, false
+}
+
+func (s *UCEVCEMServerSuite) Test_CurrentPerPhase() {
+	err := s.sut.SetCurrentPerPhase([]float64{10, 10, 10})
+	assert.NotNil(s.T(), err)
+
+	err = s.evcc.SetEVConnected(true)
+	assert.Nil(s.T(), err)
+
+	err = s.sut.SetCurrentPerPhase([]float64{})
+	assert.NotNil(s.T(), err)
+
+	err = s.sut.SetCurrentPerPhase([]float64{10, 10, 10, 10})
+	assert.NotNil(s.T(), err)
+
+	err = s.sut.SetCurrentPerPhase([]float64{10, 11, 12})
+	assert.Nil(s.T(), err)
+
+	for index, id := range util.LocalEVMeasurementIdsCurrent {
+		value, ok := s.measurementValue(id)
+		assert.True(s.T(), ok)
+		assert.Equal(s.T(), 10.0+float64(index), value)
+	}
+}
+
+func (s *UCEVCEMServerSuite) Test_PowerPerPhase() {
+	err := s.sut.SetPowerPerPhase([]float64{2300, 2300, 2300})
+	assert.NotNil(s.T(), err)
+
+	err = s.evcc.SetEVConnected(true)
+	assert.Nil(s.T(), err)
+
+	err = s.sut.SetPowerPerPhase([]float64{2300})
+	assert.Nil(s.T(), err)
+
+	value, ok := s.measurementValue(util.LocalEVMeasurementIdsPower[0])
+	assert.True(s.T(), ok)
+	assert.Equal(s.T(), 2300.0, value)
+
+	_, ok = s.measurementValue(util.LocalEVMeasurementIdsPower[1])
+	assert.False(s.T(), ok)
+}
+
+func (s *UCEVCEMServerSuite) Test_EnergyCharged() {
+	err := s.sut.SetEnergyCharged(1000)
+	assert.NotNil(s.T(), err)
+
+	err = s.evcc.SetEVConnected(true)
+	assert.Nil(s.T(), err)
+
+	err = s.sut.SetCurrentPerPhase([]float64{10, 10, 10})
+	assert.Nil(s.T(), err)
+
+	err = s.sut.SetEnergyCharged(1000)
+	assert.Nil(s.T(), err)
+
+	value, ok := s.measurementValue(util.LocalEVMeasurementIdEnergyCharged)
+	assert.True(s.T(), ok)
+	assert.Equal(s.T(), 1000.0, value)
+
+	// other values are kept
+	value, ok = s.measurementValue(util.LocalEVMeasurementIdsCurrent[0])
+	assert.True(s.T(), ok)
+	assert.Equal(s.T(), 10.0, value)
+}
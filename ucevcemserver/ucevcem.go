@@ -0,0 +1,135 @@
+package ucevcemserver
+
+import (
+	"errors"
+	"time"
+
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/util"
+	eebusapi "github.com/enbility/eebus-go/api"
+	eebusutil "github.com/enbility/eebus-go/util"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+)
+
+type UCEVCEMServer struct {
+	service eebusapi.ServiceInterface
+
+	eventCB api.EntityEventCallback
+
+	validEntityTypes []model.EntityTypeType
+}
+
+var _ UCEVCEMServerInterface = (*UCEVCEMServer)(nil)
+
+func NewUCEVCEM(service eebusapi.ServiceInterface, eventCB api.EntityEventCallback) *UCEVCEMServer {
+	uc := &UCEVCEMServer{
+		service: service,
+		eventCB: eventCB,
+	}
+
+	uc.validEntityTypes = []model.EntityTypeType{
+		model.EntityTypeTypeCEM,
+	}
+
+	return uc
+}
+
+func (c *UCEVCEMServer) UseCaseName() model.UseCaseNameType {
+	return model.UseCaseNameTypeMeasurementOfElectricityDuringEVCharging
+}
+
+// the features are added to the EV entity when an EV is connected
+func (e *UCEVCEMServer) AddFeatures() {}
+
+// the use case is added to the EV entity when an EV is connected
+func (e *UCEVCEMServer) AddUseCase() {}
+
+func (e *UCEVCEMServer) AddEVFeatures(entity spineapi.EntityLocalInterface) {
+	var descriptions []model.MeasurementDescriptionDataType
+
+	for _, item := range []struct {
+		ids             []model.MeasurementIdType
+		measurementType model.MeasurementTypeType
+		unit            model.UnitOfMeasurementType
+		scope           model.ScopeTypeType
+	}{
+		{util.LocalEVMeasurementIdsCurrent, model.MeasurementTypeTypeCurrent, model.UnitOfMeasurementTypeA, model.ScopeTypeTypeACCurrent},
+		{util.LocalEVMeasurementIdsPower, model.MeasurementTypeTypePower, model.UnitOfMeasurementTypeW, model.ScopeTypeTypeACPower},
+		{[]model.MeasurementIdType{util.LocalEVMeasurementIdEnergyCharged}, model.MeasurementTypeTypeEnergy, model.UnitOfMeasurementTypeWh, model.ScopeTypeTypeCharge},
+	} {
+		for _, id := range item.ids {
+			descriptions = append(descriptions, model.MeasurementDescriptionDataType{
+				MeasurementId:   eebusutil.Ptr(id),
+				MeasurementType: eebusutil.Ptr(item.measurementType),
+				CommodityType:   eebusutil.Ptr(model.CommodityTypeTypeElectricity),
+				Unit:            eebusutil.Ptr(item.unit),
+				ScopeType:       eebusutil.Ptr(item.scope),
+			})
+		}
+	}
+
+	util.AddLocalEVMeasurementDescriptions(entity, descriptions)
+
+	entity.AddUseCaseSupport(
+		model.UseCaseActorTypeEV,
+		e.UseCaseName(),
+		model.SpecificationVersionType("1.0.1"),
+		"release",
+		true,
+		[]model.UseCaseScenarioSupportType{1, 2, 3})
+}
+
+func (e *UCEVCEMServer) UpdateUseCaseAvailability(available bool) {
+	localEntity := e.service.LocalDevice().EntityForType(model.EntityTypeTypeEV)
+	if localEntity == nil {
+		return
+	}
+
+	localEntity.SetUseCaseAvailability(model.UseCaseActorTypeEV, e.UseCaseName(), available)
+}
+
+// returns if the entity supports the usecase
+//
+// possible errors:
+//   - ErrDataNotAvailable if that information is not (yet) available
+//   - and others
+func (e *UCEVCEMServer) IsUseCaseSupported(entity spineapi.EntityRemoteInterface) (bool, error) {
+	if entity == nil || !util.IsCompatibleEntity(entity, e.validEntityTypes) {
+		return false, api.ErrNoCompatibleEntity
+	}
+
+	// check if the usecase is supported
+	if !entity.Device().VerifyUseCaseScenariosAndFeaturesSupport(
+		model.UseCaseActorTypeCEM,
+		e.UseCaseName(),
+		nil,
+		[]model.FeatureTypeType{},
+	) {
+		return false, nil
+	}
+
+	return true, nil
+}
+
+// set the values of the measurements with the given ids
+func (e *UCEVCEMServer) setMeasurementValues(ids []model.MeasurementIdType, values []float64) error {
+	if len(values) == 0 || len(values) > len(ids) {
+		return errors.New("invalid number of values")
+	}
+
+	now := time.Now()
+
+	var data []model.MeasurementDataType
+	for index, value := range values {
+		data = append(data, model.MeasurementDataType{
+			MeasurementId: eebusutil.Ptr(ids[index]),
+			ValueType:     eebusutil.Ptr(model.MeasurementValueTypeTypeValue),
+			Timestamp:     model.NewAbsoluteOrRelativeTimeTypeFromTime(now),
+			Value:         model.NewScaledNumberType(value),
+			ValueSource:   eebusutil.Ptr(model.MeasurementValueSourceTypeMeasuredValue),
+		})
+	}
+
+	return util.SetLocalMeasurementData(e.service, model.EntityTypeTypeEV, data)
+}
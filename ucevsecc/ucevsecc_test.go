@@ -39,6 +39,10 @@ func (s *UCEVSECCSuite) Test_IsUseCaseSupported() {
 	fErr := nodeFeature.UpdateData(model.FunctionTypeNodeManagementUseCaseData, ucData, nil, nil)
 	assert.Nil(s.T(), fErr)
 
+	// the use case on the EV actor is accepted without any manufacturer data being available
+	_, err = s.sut.ManufacturerData(s.evseEntity)
+	assert.NotNil(s.T(), err)
+
 	data, err = s.sut.IsUseCaseSupported(s.evseEntity)
 	assert.Nil(s.T(), err)
 	assert.Equal(s.T(), true, data)
@@ -0,0 +1,30 @@
+package ucevseccserver
+
+import (
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/spine-go/model"
+)
+
+//go:generate mockery
+
+// interface for the EVSE Commissioning and Configuration UseCase as an EVSE
+type UCEVSECCServerInterface interface {
+	api.UseCaseInterface
+
+	// Scenario 1
+
+	// set the manufacturer data of the EVSE
+	//
+	// parameters:
+	//   - data: the manufacturer data, empty values are not provided
+	SetManufacturerData(data api.ManufacturerData) (resultErr error)
+
+	// Scenario 2
+
+	// set the operating state of the EVSE
+	//
+	// parameters:
+	//   - operatingState: the current operating state
+	//   - lastErrorCode: the last error code, empty if there is none
+	SetOperatingState(operatingState model.DeviceDiagnosisOperatingStateType, lastErrorCode string) (resultErr error)
+}
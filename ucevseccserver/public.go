@@ -0,0 +1,21 @@
+package ucevseccserver
+
+import (
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/util"
+	"github.com/enbility/spine-go/model"
+)
+
+// Scenario 1
+
+// set the manufacturer data of the EVSE
+func (e *UCEVSECCServer) SetManufacturerData(data api.ManufacturerData) error {
+	return util.SetLocalManufacturerData(e.service, model.EntityTypeTypeEVSE, data)
+}
+
+// Scenario 2
+
+// set the operating state of the EVSE
+func (e *UCEVSECCServer) SetOperatingState(operatingState model.DeviceDiagnosisOperatingStateType, lastErrorCode string) error {
+	return util.SetLocalDeviceDiagnosisState(e.service, model.EntityTypeTypeEVSE, operatingState, lastErrorCode)
+}
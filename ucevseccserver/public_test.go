@@ -0,0 +1,40 @@
+package ucevseccserver
+
+import (
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *UCEVSECCServerSuite) Test_ManufacturerData() {
+	err := s.sut.SetManufacturerData(api.ManufacturerData{
+		DeviceName:   "wallbox",
+		SerialNumber: "1234",
+	})
+	assert.Nil(s.T(), err)
+
+	data, ok := s.deviceClassificationFeature.DataCopy(
+		model.FunctionTypeDeviceClassificationManufacturerData).(*model.DeviceClassificationManufacturerDataType)
+	assert.True(s.T(), ok)
+	assert.Equal(s.T(), model.DeviceClassificationStringType("wallbox"), *data.DeviceName)
+	assert.Equal(s.T(), model.DeviceClassificationStringType("1234"), *data.SerialNumber)
+	assert.Nil(s.T(), data.VendorName)
+}
+
+func (s *UCEVSECCServerSuite) Test_OperatingState() {
+	err := s.sut.SetOperatingState(model.DeviceDiagnosisOperatingStateTypeNormalOperation, "")
+	assert.Nil(s.T(), err)
+
+	data, ok := s.deviceDiagnosisFeature.DataCopy(model.FunctionTypeDeviceDiagnosisStateData).(*model.DeviceDiagnosisStateDataType)
+	assert.True(s.T(), ok)
+	assert.Equal(s.T(), model.DeviceDiagnosisOperatingStateTypeNormalOperation, *data.OperatingState)
+	assert.Nil(s.T(), data.LastErrorCode)
+
+	err = s.sut.SetOperatingState(model.DeviceDiagnosisOperatingStateTypeFailure, "E42")
+	assert.Nil(s.T(), err)
+
+	data, ok = s.deviceDiagnosisFeature.DataCopy(model.FunctionTypeDeviceDiagnosisStateData).(*model.DeviceDiagnosisStateDataType)
+	assert.True(s.T(), ok)
+	assert.Equal(s.T(), model.DeviceDiagnosisOperatingStateTypeFailure, *data.OperatingState)
+	assert.Equal(s.T(), model.LastErrorCodeType("E42"), *data.LastErrorCode)
+}
@@ -0,0 +1,88 @@
+package ucevseccserver
+
+import (
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/util"
+	eebusapi "github.com/enbility/eebus-go/api"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+)
+
+type UCEVSECCServer struct {
+	service eebusapi.ServiceInterface
+
+	eventCB api.EntityEventCallback
+
+	validEntityTypes []model.EntityTypeType
+}
+
+var _ UCEVSECCServerInterface = (*UCEVSECCServer)(nil)
+
+func NewUCEVSECC(service eebusapi.ServiceInterface, eventCB api.EntityEventCallback) *UCEVSECCServer {
+	uc := &UCEVSECCServer{
+		service: service,
+		eventCB: eventCB,
+	}
+
+	uc.validEntityTypes = []model.EntityTypeType{
+		model.EntityTypeTypeCEM,
+	}
+
+	return uc
+}
+
+func (c *UCEVSECCServer) UseCaseName() model.UseCaseNameType {
+	return model.UseCaseNameTypeEVSECommissioningAndConfiguration
+}
+
+func (e *UCEVSECCServer) AddFeatures() {
+	localEntity := e.service.LocalDevice().EntityForType(model.EntityTypeTypeEVSE)
+
+	// server features
+	f := localEntity.GetOrAddFeature(model.FeatureTypeTypeDeviceClassification, model.RoleTypeServer)
+	f.AddFunctionType(model.FunctionTypeDeviceClassificationManufacturerData, true, false)
+
+	f = localEntity.GetOrAddFeature(model.FeatureTypeTypeDeviceDiagnosis, model.RoleTypeServer)
+	f.AddFunctionType(model.FunctionTypeDeviceDiagnosisStateData, true, false)
+}
+
+func (e *UCEVSECCServer) AddUseCase() {
+	localEntity := e.service.LocalDevice().EntityForType(model.EntityTypeTypeEVSE)
+
+	localEntity.AddUseCaseSupport(
+		model.UseCaseActorTypeEVSE,
+		e.UseCaseName(),
+		model.SpecificationVersionType("1.0.1"),
+		"release",
+		true,
+		[]model.UseCaseScenarioSupportType{1, 2})
+}
+
+func (e *UCEVSECCServer) UpdateUseCaseAvailability(available bool) {
+	localEntity := e.service.LocalDevice().EntityForType(model.EntityTypeTypeEVSE)
+
+	localEntity.SetUseCaseAvailability(model.UseCaseActorTypeEVSE, e.UseCaseName(), available)
+}
+
+// returns if the entity supports the usecase
+//
+// possible errors:
+//   - ErrDataNotAvailable if that information is not (yet) available
+//   - and others
+func (e *UCEVSECCServer) IsUseCaseSupported(entity spineapi.EntityRemoteInterface) (bool, error) {
+	if entity == nil || !util.IsCompatibleEntity(entity, e.validEntityTypes) {
+		return false, api.ErrNoCompatibleEntity
+	}
+
+	// check if the usecase and mandatory scenarios are supported
+	if !entity.Device().VerifyUseCaseScenariosAndFeaturesSupport(
+		model.UseCaseActorTypeCEM,
+		e.UseCaseName(),
+		[]model.UseCaseScenarioSupportType{2},
+		[]model.FeatureTypeType{},
+	) {
+		return false, nil
+	}
+
+	return true, nil
+}
@@ -0,0 +1,23 @@
+package ucevsocserver
+
+import (
+	"github.com/enbility/cemd/api"
+)
+
+//go:generate mockery
+
+// interface for the EV State Of Charge UseCase as an EVSE providing the EV data
+//
+// The data is only available while an EV is connected, the use case has to be
+// registered at the EV Commissioning and Configuration server use case
+type UCEVSOCServerInterface interface {
+	api.EVUseCaseInterface
+
+	// Scenario 1
+
+	// set the current state of charge of the connected EV
+	//
+	// parameters:
+	//   - value: the state of charge in %
+	SetStateOfCharge(value float64) (resultErr error)
+}
@@ -0,0 +1,26 @@
+package ucevsocserver
+
+import (
+	"time"
+
+	"github.com/enbility/cemd/util"
+	eebusutil "github.com/enbility/eebus-go/util"
+	"github.com/enbility/spine-go/model"
+)
+
+// Scenario 1
+
+// set the current state of charge of the connected EV
+func (e *UCEVSOCServer) SetStateOfCharge(value float64) error {
+	data := []model.MeasurementDataType{
+		{
+			MeasurementId: eebusutil.Ptr(util.LocalEVMeasurementIdStateOfCharge),
+			ValueType:     eebusutil.Ptr(model.MeasurementValueTypeTypeValue),
+			Timestamp:     model.NewAbsoluteOrRelativeTimeTypeFromTime(time.Now()),
+			Value:         model.NewScaledNumberType(value),
+			ValueSource:   eebusutil.Ptr(model.MeasurementValueSourceTypeMeasuredValue),
+		},
+	}
+
+	return util.SetLocalMeasurementData(e.service, model.EntityTypeTypeEV, data)
+}
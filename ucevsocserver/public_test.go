@@ -0,0 +1,26 @@
+package ucevsocserver
+
+import (
+	"github.com/enbility/cemd/util"
+	"github.com/enbility/spine-go/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *UCEVSOCServerSuite) Test_StateOfCharge() {
+	err := s.sut.SetStateOfCharge(80)
+	assert.NotNil(s.T(), err)
+
+	err = s.evcc.SetEVConnected(true)
+	assert.Nil(s.T(), err)
+
+	err = s.sut.SetStateOfCharge(80)
+	assert.Nil(s.T(), err)
+
+	evEntity := s.service.LocalDevice().EntityForType(model.EntityTypeTypeEV)
+	measurement := evEntity.FeatureOfTypeAndRole(model.FeatureTypeTypeMeasurement, model.RoleTypeServer)
+	data, ok := measurement.DataCopy(model.FunctionTypeMeasurementListData).(*model.MeasurementListDataType)
+	assert.True(s.T(), ok)
+	assert.Equal(s.T(), 1, len(data.MeasurementData))
+	assert.Equal(s.T(), util.LocalEVMeasurementIdStateOfCharge, *data.MeasurementData[0].MeasurementId)
+	assert.Equal(s.T(), 80.0, data.MeasurementData[0].Value.GetValue())
+}
@@ -0,0 +1,95 @@
+package ucevsocserver
+
+import (
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/util"
+	eebusapi "github.com/enbility/eebus-go/api"
+	eebusutil "github.com/enbility/eebus-go/util"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+)
+
+type UCEVSOCServer struct {
+	service eebusapi.ServiceInterface
+
+	eventCB api.EntityEventCallback
+
+	validEntityTypes []model.EntityTypeType
+}
+
+var _ UCEVSOCServerInterface = (*UCEVSOCServer)(nil)
+
+func NewUCEVSOC(service eebusapi.ServiceInterface, eventCB api.EntityEventCallback) *UCEVSOCServer {
+	uc := &UCEVSOCServer{
+		service: service,
+		eventCB: eventCB,
+	}
+
+	uc.validEntityTypes = []model.EntityTypeType{
+		model.EntityTypeTypeCEM,
+	}
+
+	return uc
+}
+
+func (c *UCEVSOCServer) UseCaseName() model.UseCaseNameType {
+	return model.UseCaseNameTypeEVStateOfCharge
+}
+
+// the features are added to the EV entity when an EV is connected
+func (e *UCEVSOCServer) AddFeatures() {}
+
+// the use case is added to the EV entity when an EV is connected
+func (e *UCEVSOCServer) AddUseCase() {}
+
+func (e *UCEVSOCServer) AddEVFeatures(entity spineapi.EntityLocalInterface) {
+	util.AddLocalEVMeasurementDescriptions(entity, []model.MeasurementDescriptionDataType{
+		{
+			MeasurementId:   eebusutil.Ptr(util.LocalEVMeasurementIdStateOfCharge),
+			MeasurementType: eebusutil.Ptr(model.MeasurementTypeTypePercentage),
+			CommodityType:   eebusutil.Ptr(model.CommodityTypeTypeElectricity),
+			Unit:            eebusutil.Ptr(model.UnitOfMeasurementTypepct),
+			ScopeType:       eebusutil.Ptr(model.ScopeTypeTypeStateOfCharge),
+		},
+	})
+
+	entity.AddUseCaseSupport(
+		model.UseCaseActorTypeEV,
+		e.UseCaseName(),
+		model.SpecificationVersionType("1.0.0"),
+		"RC1",
+		true,
+		[]model.UseCaseScenarioSupportType{1})
+}
+
+func (e *UCEVSOCServer) UpdateUseCaseAvailability(available bool) {
+	localEntity := e.service.LocalDevice().EntityForType(model.EntityTypeTypeEV)
+	if localEntity == nil {
+		return
+	}
+
+	localEntity.SetUseCaseAvailability(model.UseCaseActorTypeEV, e.UseCaseName(), available)
+}
+
+// returns if the entity supports the usecase
+//
+// possible errors:
+//   - ErrDataNotAvailable if that information is not (yet) available
+//   - and others
+func (e *UCEVSOCServer) IsUseCaseSupported(entity spineapi.EntityRemoteInterface) (bool, error) {
+	if entity == nil || !util.IsCompatibleEntity(entity, e.validEntityTypes) {
+		return false, api.ErrNoCompatibleEntity
+	}
+
+	// check if the usecase and mandatory scenarios are supported
+	if !entity.Device().VerifyUseCaseScenariosAndFeaturesSupport(
+		model.UseCaseActorTypeCEM,
+		e.UseCaseName(),
+		[]model.UseCaseScenarioSupportType{1},
+		[]model.FeatureTypeType{},
+	) {
+		return false, nil
+	}
+
+	return true, nil
+}
@@ -0,0 +1,60 @@
+package ucevsocserver
+
+import (
+	eebusutil "github.com/enbility/eebus-go/util"
+	"github.com/enbility/spine-go/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *UCEVSOCServerSuite) Test_UpdateUseCaseAvailability() {
+	s.sut.UpdateUseCaseAvailability(true)
+
+	err := s.evcc.SetEVConnected(true)
+	assert.Nil(s.T(), err)
+
+	s.sut.UpdateUseCaseAvailability(false)
+}
+
+func (s *UCEVSOCServerSuite) Test_AddEVFeatures() {
+	err := s.evcc.SetEVConnected(true)
+	assert.Nil(s.T(), err)
+
+	evEntity := s.service.LocalDevice().EntityForType(model.EntityTypeTypeEV)
+	assert.NotNil(s.T(), evEntity)
+	assert.True(s.T(), evEntity.HasUseCaseSupport(model.UseCaseActorTypeEV, s.sut.UseCaseName()))
+	assert.NotNil(s.T(), evEntity.FeatureOfTypeAndRole(model.FeatureTypeTypeMeasurement, model.RoleTypeServer))
+}
+
+func (s *UCEVSOCServerSuite) Test_IsUseCaseSupported() {
+	data, err := s.sut.IsUseCaseSupported(s.mockRemoteEntity)
+	assert.NotNil(s.T(), err)
+	assert.Equal(s.T(), false, data)
+
+	data, err = s.sut.IsUseCaseSupported(s.cemEntity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), false, data)
+
+	ucData := &model.NodeManagementUseCaseDataType{
+		UseCaseInformation: []model.UseCaseInformationDataType{
+			{
+				Actor: eebusutil.Ptr(model.UseCaseActorTypeCEM),
+				UseCaseSupport: []model.UseCaseSupportType{
+					{
+						UseCaseName:      eebusutil.Ptr(model.UseCaseNameTypeEVStateOfCharge),
+						UseCaseAvailable: eebusutil.Ptr(true),
+						ScenarioSupport:  []model.UseCaseScenarioSupportType{1},
+					},
+				},
+			},
+		},
+	}
+
+	nodemgmtEntity := s.remoteDevice.Entity([]model.AddressEntityType{0})
+	nodeFeature := s.remoteDevice.FeatureByEntityTypeAndRole(nodemgmtEntity, model.FeatureTypeTypeNodeManagement, model.RoleTypeSpecial)
+	fErr := nodeFeature.UpdateData(model.FunctionTypeNodeManagementUseCaseData, ucData, nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	data, err = s.sut.IsUseCaseSupported(s.cemEntity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), true, data)
+}
@@ -114,7 +114,7 @@ func (e *UCLPC) WriteConsumptionLimit(
 		return nil, eebusapi.ErrDataNotAvailable
 	}
 
-	for index, item := range currentLimits {
+	for _, item := range currentLimits {
 		if item.LimitId == nil ||
 			*item.LimitId != *limitDesc.LimitId {
 			continue
@@ -137,7 +137,7 @@ func (e *UCLPC) WriteConsumptionLimit(
 			}
 		}
 
-		currentLimits[index] = newLimit
+		limitData = append(limitData, newLimit)
 		break
 	}
 
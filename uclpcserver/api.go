@@ -13,6 +13,17 @@ import (
 type UCLPCServerInterface interface {
 	api.UseCaseInterface
 
+	// set the store used to persist the limits and the failsafe and contractual values
+	//
+	// the stored values are restored when the use case is added to the service,
+	// so this has to be invoked before adding the use case.
+	// after a restart the Controllable System starts in "init" state
+	// with the restored failsafe values
+	//
+	// parameters:
+	//   - store: the store to persist the configuration in, nil to not persist it
+	SetStore(store api.StoreInterface)
+
 	// Scenario 1
 
 	// return the current consumption limit data
@@ -28,6 +39,16 @@ type UCLPCServerInterface interface {
 	// set the current loadcontrol limit data
 	SetConsumptionLimit(limit api.LoadLimit) (resultErr error)
 
+	// return the remaining duration of the current consumption limit
+	//
+	// an active limit with a duration is deactivated automatically
+	// once the duration expired, which is reported with a DataUpdateLimit event
+	//
+	// possible errors:
+	//   - ErrDataNotAvailable if no active limit with a duration is available
+	//   - and others
+	ConsumptionLimitRemainingDuration() (time.Duration, error)
+
 	// return the currently pending incoming consumption write limits
 	PendingConsumptionLimits() map[model.MsgCounterType]api.LoadLimit
 
@@ -39,6 +60,39 @@ type UCLPCServerInterface interface {
 	//  - reason: the reason why the approval is denied, otherwise an empty string
 	ApproveOrDenyConsumptionLimit(msgCounter model.MsgCounterType, approve bool, reason string)
 
+	// set the policy used to approve or deny incoming consumption write limits
+	//
+	// if the policy does not decide or no policy is set, a WriteApprovalRequired
+	// event is reported and the application has to invoke ApproveOrDenyConsumptionLimit
+	//
+	// parameters:
+	//   - policy: the approval policy, nil to approve or deny all limits manually
+	SetApprovalPolicy(policy api.ApprovalPolicyInterface)
+
+	// set the deadline for approving or denying incoming consumption write limits
+	//
+	// if the deadline passes, the pending limit is approved or denied automatically
+	// and an ApprovalTimeout event is reported
+	//
+	// SPINE supports only one write approval timeout for the load control server
+	// shared with the other limit use case, so this has to be invoked before adding
+	// the use case, ErrUseCaseAdded is returned otherwise
+	//
+	// parameters:
+	//   - timeout: the maximum duration a limit remains pending, has to be > 0, default is 10s
+	//   - approve: if pending limits are approved or denied after the timeout, default is deny
+	SetApprovalTimeout(timeout time.Duration, approve bool) (resultErr error)
+
+	// set the registry used to decide on workarounds for specific devices
+	//
+	// the local features depend on the registered quirks and the registry is used
+	// while handling SPINE events, so this has to be invoked before adding the
+	// use case, ErrUseCaseAdded is returned otherwise
+	//
+	// parameters:
+	//   - registry: the quirk registry, default contains quirks.DefaultProfiles, nil to not use any workarounds
+	SetQuirkRegistry(registry api.QuirkRegistryInterface) (resultErr error)
+
 	// Scenario 2
 
 	// return Failsafe limit for the consumed active (real) power of the
@@ -75,7 +129,28 @@ type UCLPCServerInterface interface {
 
 	// Scenario 3
 
-	// this is automatically covered by the SPINE implementation
+	// the heartbeat handling itself is automatically covered by the SPINE implementation
+
+	// return the current state of the Controllable System
+	//
+	// the state is derived from the heartbeat of the Energy Guard
+	// and the limits it writes
+	ControllableSystemState() api.ControllableSystemStateType
+
+	// return the consumption limit the Controllable System has to apply
+	// in its current state
+	//
+	// in "limited" state this is the active limit set by the Energy Guard,
+	// in "init" and "failsafe" state this is the failsafe limit
+	//
+	// return values:
+	//   - value: the power limit in W
+	//   - isLimited: true if the consumption has to be limited to value
+	//
+	// possible errors:
+	//   - ErrDataNotAvailable if the required limit is not (yet) available
+	//   - and others
+	EffectiveConsumptionLimit() (value float64, isLimited bool, resultErr error)
 
 	// Scenario 4
 
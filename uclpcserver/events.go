@@ -3,6 +3,7 @@ package uclpcserver
 import (
 	"slices"
 
+	"github.com/enbility/cemd/api"
 	"github.com/enbility/cemd/util"
 	"github.com/enbility/ship-go/logging"
 	spineapi "github.com/enbility/spine-go/api"
@@ -16,20 +17,35 @@ func (e *UCLPCServer) HandleEvent(payload spineapi.EventPayload) {
 		return
 	}
 
+	// did we receive the manufacturer data of any entity, which may require the heartbeat workaround?
+	if payload.EventType == spineapi.EventTypeDataChange &&
+		payload.ChangeType == spineapi.ElementChangeUpdate &&
+		payload.Function == model.FunctionTypeDeviceClassificationManufacturerData {
+		e.subscribeHeartbeatWorkaround()
+		return
+	}
+
 	if !util.IsCompatibleEntity(payload.Entity, e.validEntityTypes) {
 		return
 	}
 
 	localEntity := e.service.LocalDevice().EntityForType(model.EntityTypeTypeCEM)
 
-	// did we receive a binding to the loadControl server and the
-	// heartbeatWorkaround is required?
+	// did we receive a binding to the loadControl server?
 	if payload.EventType == spineapi.EventTypeBindingChange &&
 		payload.ChangeType == spineapi.ElementChangeAdd &&
 		payload.LocalFeature != nil &&
 		payload.LocalFeature.Type() == model.FeatureTypeTypeLoadControl &&
 		payload.LocalFeature.Role() == model.RoleTypeServer {
-		e.subscribeHeartbeatWorkaround(payload)
+		e.loadControlBindingAdded(payload)
+		return
+	}
+
+	// did we receive a heartbeat from the Energy Guard?
+	if payload.EventType == spineapi.EventTypeDataChange &&
+		payload.ChangeType == spineapi.ElementChangeUpdate &&
+		payload.Function == model.FunctionTypeDeviceDiagnosisHeartbeatData {
+		e.heartbeatReceived(payload.Entity)
 		return
 	}
 
@@ -98,6 +114,8 @@ func (e *UCLPCServer) deviceConnected(payload spineapi.EventPayload) {
 	// we only found one matching entity, as it should be, subscribe
 	if len(deviceDiagEntites) == 1 {
 		if localDeviceDiag, err := util.DeviceDiagnosis(e.service, deviceDiagEntites[0]); err == nil {
+			e.heartbeatSubscribed(deviceDiagEntites[0])
+
 			if _, err := localDeviceDiag.Subscribe(); err != nil {
 				logging.Log().Debug(err)
 			}
@@ -107,23 +125,70 @@ func (e *UCLPCServer) deviceConnected(payload spineapi.EventPayload) {
 			}
 		}
 
+		// some devices require the subscription on the binding entity nevertheless,
+		// which is decided by their manufacturer data
+		if e.quirks.HasQuirkForAnyDevice(api.QuirkTypeHeartbeatOnBindingEntity) {
+			if err := util.RequestDeviceManufacturerData(e.service, remoteDevice); err != nil {
+				logging.Log().Debug(err)
+			}
+		}
+
 		return
 	}
 
 	// we found more than one matching entity, this is not good
 	// according to KEO the subscription should be done on the entity that requests a binding to
 	// the local loadControlLimit server feature
-	e.heartbeatKeoWorkaround = true
+	e.heartbeatMux.Lock()
+	e.heartbeatEntityAmbiguous = true
+	e.heartbeatMux.Unlock()
 }
 
-// subscribe to the DeviceDiagnosis Server of the entity that created a binding
-func (e *UCLPCServer) subscribeHeartbeatWorkaround(payload spineapi.EventPayload) {
-	// the workaround is not needed, exit
-	if !e.heartbeatKeoWorkaround {
+// a remote entity created a binding to the load control server
+func (e *UCLPCServer) loadControlBindingAdded(payload spineapi.EventPayload) {
+	e.energyGuardIdentified(payload.Entity)
+
+	e.heartbeatMux.Lock()
+	e.heartbeatBindingEntity = payload.Entity
+	e.heartbeatMux.Unlock()
+
+	e.subscribeHeartbeatWorkaround()
+}
+
+// subscribe to the DeviceDiagnosis Server of the entity that created a binding,
+// if the heartbeat entity is ambiguous or the remote device requires it
+func (e *UCLPCServer) subscribeHeartbeatWorkaround() {
+	e.heartbeatMux.Lock()
+	entity := e.heartbeatBindingEntity
+	ambiguous := e.heartbeatEntityAmbiguous
+	e.heartbeatMux.Unlock()
+
+	// there is no binding yet, exit
+	if entity == nil {
+		return
+	}
+
+	// the workaround is always applied for ambiguous entities, otherwise only if a quirk requires it
+	// if the manufacturer data is not yet available, this is invoked again once it is
+	if !ambiguous {
+		data, err := util.DeviceManufacturerData(e.service, entity.Device())
+		if err != nil || !e.quirks.HasQuirk(data, api.QuirkTypeHeartbeatOnBindingEntity) {
+			return
+		}
+	}
+
+	// only subscribe once
+	e.heartbeatMux.Lock()
+	if e.heartbeatBindingEntity != entity {
+		e.heartbeatMux.Unlock()
 		return
 	}
+	e.heartbeatBindingEntity = nil
+	e.heartbeatMux.Unlock()
+
+	if localDeviceDiag, err := util.DeviceDiagnosis(e.service, entity); err == nil {
+		e.heartbeatSubscribed(entity)
 
-	if localDeviceDiag, err := util.DeviceDiagnosis(e.service, payload.Entity); err == nil {
 		if _, err := localDeviceDiag.Subscribe(); err != nil {
 			logging.Log().Debug(err)
 		}
@@ -143,6 +208,10 @@ func (e *UCLPCServer) loadControlLimitDataUpdate(payload spineapi.EventPayload)
 		model.EnergyDirectionTypeConsume,
 		model.ScopeTypeTypeActivePowerLimit) {
 		e.eventCB(payload.Ski, payload.Device, payload.Entity, DataUpdateLimit)
+
+		e.updateLimitExpiry()
+		e.storeConfiguration()
+		e.limitUpdated(payload.Entity)
 	}
 }
 
@@ -150,8 +219,10 @@ func (e *UCLPCServer) loadControlLimitDataUpdate(payload spineapi.EventPayload)
 func (e *UCLPCServer) configurationDataUpdate(payload spineapi.EventPayload) {
 	if util.DeviceConfigurationCheckDataPayloadForKeyName(true, e.service, payload, model.DeviceConfigurationKeyNameTypeFailsafeConsumptionActivePowerLimit) {
 		e.eventCB(payload.Ski, payload.Device, payload.Entity, DataUpdateFailsafeConsumptionActivePowerLimit)
+		e.storeConfiguration()
 	}
 	if util.DeviceConfigurationCheckDataPayloadForKeyName(true, e.service, payload, model.DeviceConfigurationKeyNameTypeFailsafeDurationMinimum) {
 		e.eventCB(payload.Ski, payload.Device, payload.Entity, DataUpdateFailsafeDurationMinimum)
+		e.storeConfiguration()
 	}
 }
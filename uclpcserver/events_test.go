@@ -3,10 +3,14 @@ package uclpcserver
 import (
 	"fmt"
 
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/quirks"
+	"github.com/enbility/cemd/util"
 	eebusutil "github.com/enbility/eebus-go/util"
 	spineapi "github.com/enbility/spine-go/api"
 	"github.com/enbility/spine-go/mocks"
 	"github.com/enbility/spine-go/model"
+	"github.com/stretchr/testify/assert"
 )
 
 func (s *UCLPCServerSuite) Test_Events() {
@@ -48,6 +52,11 @@ func (s *UCLPCServerSuite) Test_Events() {
 	payload.LocalFeature = s.deviceConfigurationFeature
 	s.sut.HandleEvent(payload)
 
+	payload.Function = model.FunctionTypeDeviceDiagnosisHeartbeatData
+	payload.CmdClassifier = eebusutil.Ptr(model.CmdClassifierTypeNotify)
+	payload.Data = eebusutil.Ptr(model.DeviceDiagnosisHeartbeatDataType{})
+	s.sut.HandleEvent(payload)
+
 	payload.EventType = spineapi.EventTypeBindingChange
 	payload.ChangeType = spineapi.ElementChangeAdd
 	payload.LocalFeature = s.loadControlFeature
@@ -71,7 +80,19 @@ func (s *UCLPCServerSuite) Test_deviceConnected() {
 	payload.Device = s.remoteDevice
 	s.sut.deviceConnected(payload)
 
-	s.sut.subscribeHeartbeatWorkaround(payload)
+	// the heartbeat entity is not ambiguous, so the binding entity is only used if a quirk requires it
+	payload.Entity = s.monitoredEntity
+	s.sut.loadControlBindingAdded(payload)
+	assert.False(s.T(), s.sut.heartbeatEntityAmbiguous)
+	assert.Equal(s.T(), s.monitoredEntity, s.sut.heartbeatBindingEntity)
+
+	// the manufacturer data is not yet available, so the quirk can not be decided on
+	s.sut.quirks = quirks.NewRegistry(api.QuirkProfile{
+		Name:   "all",
+		Quirks: []api.QuirkType{api.QuirkTypeHeartbeatOnBindingEntity},
+	})
+	s.sut.subscribeHeartbeatWorkaround()
+	assert.Equal(s.T(), s.monitoredEntity, s.sut.heartbeatBindingEntity)
 }
 
 func (s *UCLPCServerSuite) Test_multipleDeviceDiagServer() {
@@ -199,8 +220,16 @@ func (s *UCLPCServerSuite) Test_multipleDeviceDiagServer() {
 	s.remoteDevice.UpdateDevice(detailedData.DeviceInformation.Description)
 
 	s.sut.deviceConnected(payload)
+	assert.True(s.T(), s.sut.heartbeatEntityAmbiguous)
 
-	s.sut.subscribeHeartbeatWorkaround(payload)
+	// the workaround does not depend on the manufacturer data, which is not yet available
+	_, err = util.DeviceManufacturerData(s.service, s.remoteDevice)
+	assert.NotNil(s.T(), err)
+
+	payload.Entity = s.monitoredEntity
+	s.sut.loadControlBindingAdded(payload)
+	assert.Nil(s.T(), s.sut.heartbeatBindingEntity)
+	assert.True(s.T(), s.sut.isHeartbeatSource(s.monitoredEntity))
 }
 
 func (s *UCLPCServerSuite) Test_loadControlLimitDataUpdate() {
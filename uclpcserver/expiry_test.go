@@ -0,0 +1,69 @@
+package uclpcserver
+
+import (
+	"time"
+
+	"github.com/enbility/cemd/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *UCLPCServerSuite) Test_LimitExpiry() {
+	_, err := s.sut.ConsumptionLimitRemainingDuration()
+	assert.NotNil(s.T(), err)
+
+	err = s.sut.SetConsumptionLimit(api.LoadLimit{
+		Duration:     time.Hour,
+		IsActive:     true,
+		IsChangeable: true,
+		Value:        5000,
+	})
+	assert.Nil(s.T(), err)
+
+	remaining, err := s.sut.ConsumptionLimitRemainingDuration()
+	assert.Nil(s.T(), err)
+	assert.Greater(s.T(), remaining, time.Minute*59)
+	assert.LessOrEqual(s.T(), remaining, time.Hour)
+
+	// inactive limits do not expire
+	err = s.sut.SetConsumptionLimit(api.LoadLimit{
+		Duration:     time.Hour,
+		IsActive:     false,
+		IsChangeable: true,
+		Value:        5000,
+	})
+	assert.Nil(s.T(), err)
+
+	_, err = s.sut.ConsumptionLimitRemainingDuration()
+	assert.NotNil(s.T(), err)
+
+	s.sut.heartbeatReceived(s.monitoredEntity)
+	err = s.sut.SetConsumptionLimit(api.LoadLimit{
+		Duration:     time.Second,
+		IsActive:     true,
+		IsChangeable: true,
+		Value:        5000,
+	})
+	assert.Nil(s.T(), err)
+	s.sut.limitUpdated(s.monitoredEntity)
+	assert.Equal(s.T(), api.ControllableSystemStateTypeLimited, s.sut.ControllableSystemState())
+
+	// the limit was changed in the meantime
+	s.sut.limitExpired(time.Now())
+	limit, err := s.sut.ConsumptionLimit()
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), true, limit.IsActive)
+
+	assert.Eventually(s.T(), func() bool {
+		limit, err := s.sut.ConsumptionLimit()
+		return err == nil && !limit.IsActive
+	}, time.Second*3, time.Millisecond*50)
+
+	limit, err = s.sut.ConsumptionLimit()
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 5000.0, limit.Value)
+	assert.Equal(s.T(), time.Duration(0), limit.Duration)
+	assert.Equal(s.T(), api.ControllableSystemStateTypeUnlimitedControlled, s.sut.ControllableSystemState())
+
+	_, err = s.sut.ConsumptionLimitRemainingDuration()
+	assert.NotNil(s.T(), err)
+}
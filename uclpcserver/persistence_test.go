@@ -0,0 +1,104 @@
+package uclpcserver
+
+import (
+	"path/filepath"
+	"time"
+
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/store"
+	eebusutil "github.com/enbility/eebus-go/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *UCLPCServerSuite) Test_Persistence() {
+	storage := store.NewFileStore(filepath.Join(s.T().TempDir(), "store.json"))
+
+	// nothing stored yet
+	s.sut.SetStore(storage)
+	s.sut.loadConfiguration()
+
+	err := s.sut.SetFailsafeConsumptionActivePowerLimit(4200, true)
+	assert.Nil(s.T(), err)
+	err = s.sut.SetFailsafeDurationMinimum(time.Hour*3, false)
+	assert.Nil(s.T(), err)
+	err = s.sut.SetContractualConsumptionNominalMax(11000)
+	assert.Nil(s.T(), err)
+	err = s.sut.SetConsumptionLimit(api.LoadLimit{
+		Duration:     time.Hour,
+		IsActive:     true,
+		IsChangeable: true,
+		Value:        5000,
+	})
+	assert.Nil(s.T(), err)
+
+	var config api.ControllableSystemConfiguration
+	err = storage.Load(string(s.sut.UseCaseName()), &config)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 4200.0, *config.FailsafeLimit)
+	assert.Equal(s.T(), true, config.FailsafeLimitChangeable)
+	assert.Equal(s.T(), time.Hour*3, *config.FailsafeDurationMinimum)
+	assert.Equal(s.T(), false, config.FailsafeDurationChangeable)
+	assert.Equal(s.T(), 11000.0, *config.ContractualNominalMax)
+	assert.Equal(s.T(), 5000.0, config.Limit.Value)
+	assert.Equal(s.T(), true, config.Limit.IsActive)
+	assert.Equal(s.T(), false, config.LimitEndTime.IsZero())
+
+	config = api.ControllableSystemConfiguration{
+		Limit: &api.LoadLimit{
+			IsActive:     true,
+			IsChangeable: true,
+			Value:        6000,
+		},
+		LimitEndTime:               time.Now().Add(time.Hour * 2),
+		FailsafeLimit:              eebusutil.Ptr(3000.0),
+		FailsafeDurationMinimum:    eebusutil.Ptr(time.Hour * 4),
+		FailsafeDurationChangeable: true,
+		ContractualNominalMax:      eebusutil.Ptr(9000.0),
+	}
+	err = storage.Save(string(s.sut.UseCaseName()), config)
+	assert.Nil(s.T(), err)
+
+	s.sut.loadConfiguration()
+
+	value, changeable, err := s.sut.FailsafeConsumptionActivePowerLimit()
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 3000.0, value)
+	assert.Equal(s.T(), false, changeable)
+
+	duration, changeable, err := s.sut.FailsafeDurationMinimum()
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), time.Hour*4, duration)
+	assert.Equal(s.T(), true, changeable)
+
+	value, err = s.sut.ContractualConsumptionNominalMax()
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 9000.0, value)
+
+	limit, err := s.sut.ConsumptionLimit()
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 6000.0, limit.Value)
+	assert.Equal(s.T(), true, limit.IsActive)
+
+	remaining, err := s.sut.ConsumptionLimitRemainingDuration()
+	assert.Nil(s.T(), err)
+	assert.Greater(s.T(), remaining, time.Hour)
+
+	// the system restarts in "init" state with the failsafe limit
+	assert.Equal(s.T(), api.ControllableSystemStateTypeInit, s.sut.ControllableSystemState())
+	value, isLimited, err := s.sut.EffectiveConsumptionLimit()
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 3000.0, value)
+	assert.Equal(s.T(), true, isLimited)
+
+	// a timed limit that ended while the system was down is restored inactive
+	config.LimitEndTime = time.Now().Add(-time.Minute)
+	err = storage.Save(string(s.sut.UseCaseName()), config)
+	assert.Nil(s.T(), err)
+
+	s.sut.loadConfiguration()
+
+	limit, err = s.sut.ConsumptionLimit()
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 6000.0, limit.Value)
+	assert.Equal(s.T(), false, limit.IsActive)
+}
@@ -2,13 +2,17 @@ package uclpcserver
 
 import (
 	"errors"
+	"slices"
 	"time"
 
 	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/quirks"
 	"github.com/enbility/cemd/util"
 	eebusapi "github.com/enbility/eebus-go/api"
 	eebusutil "github.com/enbility/eebus-go/util"
+	spineapi "github.com/enbility/spine-go/api"
 	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/spine"
 )
 
 // Scenario 1
@@ -79,16 +83,51 @@ func (e *UCLPCServer) SetConsumptionLimit(limit api.LoadLimit) (resultErr error)
 			EndTime: model.NewAbsoluteOrRelativeTimeTypeFromDuration(limit.Duration),
 		}
 	}
-	// TODO: this overwrites LPP data as well
-	limits := &model.LoadControlLimitListDataType{
-		LoadControlLimitData: []model.LoadControlLimitDataType{limitData},
+	// keep the limits of other use cases
+	limits, err := spine.LocalFeatureDataCopyOfType[*model.LoadControlLimitListDataType](
+		loadControl, model.FunctionTypeLoadControlLimitListData)
+	if err != nil || limits == nil {
+		limits = &model.LoadControlLimitListDataType{}
+	}
+
+	index := slices.IndexFunc(limits.LoadControlLimitData, func(item model.LoadControlLimitDataType) bool {
+		return item.LimitId != nil && *item.LimitId == limidId
+	})
+	if index < 0 {
+		limits.LoadControlLimitData = append(limits.LoadControlLimitData, limitData)
+	} else {
+		limits.LoadControlLimitData[index] = limitData
 	}
 
 	loadControl.SetData(model.FunctionTypeLoadControlLimitListData, limits)
 
+	e.updateLimitExpiry()
+	e.storeConfiguration()
+
 	return nil
 }
 
+// return the remaining duration of the current consumption limit
+//
+// possible errors:
+//   - ErrDataNotAvailable if no active limit with a duration is available
+//   - and others
+func (e *UCLPCServer) ConsumptionLimitRemainingDuration() (time.Duration, error) {
+	e.expiryMux.Lock()
+	defer e.expiryMux.Unlock()
+
+	if e.limitEndTime.IsZero() {
+		return 0, eebusapi.ErrDataNotAvailable
+	}
+
+	remaining := time.Until(e.limitEndTime)
+	if remaining < 0 {
+		remaining = 0
+	}
+
+	return remaining, nil
+}
+
 // return the currently pending incoming consumption write limits
 func (e *UCLPCServer) PendingConsumptionLimits() map[model.MsgCounterType]api.LoadLimit {
 	result := make(map[model.MsgCounterType]api.LoadLimit)
@@ -114,23 +153,7 @@ func (e *UCLPCServer) PendingConsumptionLimits() map[model.MsgCounterType]api.Lo
 				continue
 			}
 
-			limit := api.LoadLimit{}
-
-			if item.TimePeriod != nil {
-				if duration, err := item.TimePeriod.GetDuration(); err == nil {
-					limit.Duration = duration
-				}
-			}
-
-			if item.IsLimitActive != nil {
-				limit.IsActive = *item.IsLimitActive
-			}
-
-			if item.Value != nil {
-				limit.Value = item.Value.GetValue()
-			}
-
-			result[key] = limit
+			result[key] = loadLimitFromData(item)
 		}
 	}
 
@@ -149,6 +172,64 @@ func (e *UCLPCServer) ApproveOrDenyConsumptionLimit(msgCounter model.MsgCounterT
 		return
 	}
 
+	delete(e.pendingLimits, msgCounter)
+	if timer, ok := e.pendingTimers[msgCounter]; ok {
+		timer.Stop()
+		delete(e.pendingTimers, msgCounter)
+	}
+
+	e.approveOrDenyConsumptionLimit(msg, approve, reason)
+}
+
+// set the policy used to approve or deny incoming consumption write limits
+func (e *UCLPCServer) SetApprovalPolicy(policy api.ApprovalPolicyInterface) {
+	e.pendingMux.Lock()
+	defer e.pendingMux.Unlock()
+
+	e.approvalPolicy = policy
+}
+
+// set the registry used to decide on workarounds for specific devices
+func (e *UCLPCServer) SetQuirkRegistry(registry api.QuirkRegistryInterface) error {
+	// the registry is read without locking while handling SPINE events
+	if e.featuresAdded.Load() {
+		return api.ErrUseCaseAdded
+	}
+
+	if registry == nil {
+		registry = quirks.NewRegistry()
+	}
+
+	e.quirks = registry
+
+	return nil
+}
+
+// set the deadline for approving or denying incoming consumption write limits
+//
+// parameters:
+//   - timeout: the maximum duration a limit remains pending, has to be > 0
+//   - approve: if pending limits are approved or denied after the timeout
+func (e *UCLPCServer) SetApprovalTimeout(timeout time.Duration, approve bool) error {
+	if timeout <= 0 {
+		return errors.New("timeout has to be greater than 0")
+	}
+
+	// the write approval timeout of the local feature is set when adding the features
+	if e.featuresAdded.Load() {
+		return api.ErrUseCaseAdded
+	}
+
+	e.pendingMux.Lock()
+	defer e.pendingMux.Unlock()
+
+	e.approvalTimeout = timeout
+	e.timeoutApprove = approve
+
+	return nil
+}
+
+func (e *UCLPCServer) approveOrDenyConsumptionLimit(msg *spineapi.Message, approve bool, reason string) {
 	localEntity := e.service.LocalDevice().EntityForType(model.EntityTypeTypeCEM)
 
 	f := localEntity.FeatureOfTypeAndRole(model.FeatureTypeTypeLoadControl, model.RoleTypeServer)
@@ -192,7 +273,13 @@ func (e *UCLPCServer) SetFailsafeConsumptionActivePowerLimit(value float64, chan
 		ScaledNumber: model.NewScaledNumberType(value),
 	}
 
-	return util.SetLocalDeviceConfigurationKeyValue(e.service, keyName, changeable, keyValue)
+	if err := util.SetLocalDeviceConfigurationKeyValue(e.service, keyName, changeable, keyValue); err != nil {
+		return err
+	}
+
+	e.storeConfiguration()
+
+	return nil
 }
 
 // return minimum time the Controllable System remains in "failsafe state" unless conditions
@@ -234,7 +321,54 @@ func (e *UCLPCServer) SetFailsafeDurationMinimum(duration time.Duration, changea
 		Duration: model.NewDurationType(duration),
 	}
 
-	return util.SetLocalDeviceConfigurationKeyValue(e.service, keyName, changeable, keyValue)
+	if err := util.SetLocalDeviceConfigurationKeyValue(e.service, keyName, changeable, keyValue); err != nil {
+		return err
+	}
+
+	e.storeConfiguration()
+
+	return nil
+}
+
+// Scenario 3
+
+// return the current state of the Controllable System
+func (e *UCLPCServer) ControllableSystemState() api.ControllableSystemStateType {
+	e.stateMux.Lock()
+	defer e.stateMux.Unlock()
+
+	return e.state
+}
+
+// return the consumption limit the Controllable System has to apply
+// in its current state
+//
+// return values:
+//   - value: the power limit in W
+//   - isLimited: true if the consumption has to be limited to value
+//
+// possible errors:
+//   - ErrDataNotAvailable if the required limit is not (yet) available
+//   - and others
+func (e *UCLPCServer) EffectiveConsumptionLimit() (value float64, isLimited bool, resultErr error) {
+	switch e.ControllableSystemState() {
+	case api.ControllableSystemStateTypeInit, api.ControllableSystemStateTypeFailsafe:
+		value, _, resultErr = e.FailsafeConsumptionActivePowerLimit()
+		if resultErr != nil {
+			return 0, false, resultErr
+		}
+
+		return value, true, nil
+	case api.ControllableSystemStateTypeLimited:
+		limit, err := e.ConsumptionLimit()
+		if err != nil {
+			return 0, false, err
+		}
+
+		return limit.Value, true, nil
+	}
+
+	return 0, false, nil
 }
 
 // Scenario 4
@@ -260,10 +394,16 @@ func (e *UCLPCServer) ContractualConsumptionNominalMax() (value float64, resultE
 // set nominal maximum active (real) power the Controllable System is
 // allowed to consume due to the customer's contract.
 func (e *UCLPCServer) SetContractualConsumptionNominalMax(value float64) error {
-	return util.SetLocalElectricalConnectionCharacteristicForContextType(
+	if err := util.SetLocalElectricalConnectionCharacteristicForContextType(
 		e.service,
 		model.ElectricalConnectionCharacteristicContextTypeEntity,
 		model.ElectricalConnectionCharacteristicTypeTypeContractualConsumptionNominalMax,
 		value,
-	)
+	); err != nil {
+		return err
+	}
+
+	e.storeConfiguration()
+
+	return nil
 }
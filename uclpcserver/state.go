@@ -0,0 +1,302 @@
+package uclpcserver
+
+import (
+	"time"
+
+	"github.com/enbility/cemd/api"
+	spineapi "github.com/enbility/spine-go/api"
+)
+
+// the connection to the Energy Guard is considered to be lost,
+// if no heartbeat was received within this duration
+const defaultHeartbeatTimeout = time.Second * 120
+
+// the failsafe duration used if no FailsafeDurationMinimum value is available
+const defaultFailsafeDurationMinimum = time.Hour * 2
+
+// enter "init" state and start supervising the Energy Guard
+//
+// if the Energy Guard does not take over control within the heartbeat timeout,
+// the Controllable System changes into "unlimited/autonomous" state
+func (e *UCLPCServer) startStateMachine() {
+	e.stateMux.Lock()
+	defer e.stateMux.Unlock()
+
+	e.state = api.ControllableSystemStateTypeInit
+	e.limitWritten = false
+	e.resetStateTimer(e.heartbeatTimeout, e.initTimedOut)
+}
+
+// the DeviceDiagnosis server of a remote entity was subscribed
+func (e *UCLPCServer) heartbeatSubscribed(entity spineapi.EntityRemoteInterface) {
+	if entity == nil || entity.Device() == nil {
+		return
+	}
+
+	e.stateMux.Lock()
+	defer e.stateMux.Unlock()
+
+	e.heartbeatSources[entity.Device().Ski()] = entity
+}
+
+// the Energy Guard bound to the load control server or wrote a limit
+func (e *UCLPCServer) energyGuardIdentified(entity spineapi.EntityRemoteInterface) {
+	if entity == nil {
+		return
+	}
+
+	e.stateMux.Lock()
+	defer e.stateMux.Unlock()
+
+	// a heartbeat of another device does not keep the Energy Guard alive
+	if !sameDevice(entity, e.heartbeatEntity) {
+		e.lastHeartbeat = time.Time{}
+	}
+
+	e.energyGuard = entity
+}
+
+// a heartbeat was received, which is ignored if it is not sent by the Energy Guard
+func (e *UCLPCServer) heartbeatReceived(entity spineapi.EntityRemoteInterface) {
+	e.stateMux.Lock()
+
+	if !e.isHeartbeatSource(entity) {
+		e.stateMux.Unlock()
+		return
+	}
+
+	e.heartbeatEntity = entity
+	e.lastHeartbeat = time.Now()
+	if e.heartbeatTimer != nil {
+		e.heartbeatTimer.Stop()
+	}
+	e.heartbeatTimer = time.AfterFunc(e.heartbeatTimeout, e.heartbeatTimedOut)
+
+	event := e.evaluateState()
+
+	e.stateMux.Unlock()
+
+	e.publishEvent(event)
+}
+
+// the Energy Guard wrote a new consumption limit
+func (e *UCLPCServer) limitUpdated(entity spineapi.EntityRemoteInterface) {
+	e.energyGuardIdentified(entity)
+
+	limit, err := e.ConsumptionLimit()
+
+	e.stateMux.Lock()
+
+	e.limitWritten = true
+	e.limitActive = err == nil && limit.IsActive
+
+	event := e.evaluateState()
+
+	e.stateMux.Unlock()
+
+	e.publishEvent(event)
+}
+
+// the active consumption limit expired
+func (e *UCLPCServer) limitDeactivated() {
+	e.stateMux.Lock()
+
+	e.limitActive = false
+
+	var event api.EventType
+	if e.state == api.ControllableSystemStateTypeLimited {
+		event = e.setState(api.ControllableSystemStateTypeUnlimitedControlled)
+	}
+
+	e.stateMux.Unlock()
+
+	e.publishEvent(event)
+}
+
+// no heartbeat of the Energy Guard was received within the heartbeat timeout
+func (e *UCLPCServer) heartbeatTimedOut() {
+	e.stateMux.Lock()
+
+	// a newer heartbeat was received in the meantime
+	if e.isHeartbeatAlive() {
+		e.stateMux.Unlock()
+		return
+	}
+
+	// a reconnected Energy Guard has to provide a new limit
+	e.limitWritten = false
+
+	var event api.EventType
+	if e.state == api.ControllableSystemStateTypeLimited ||
+		e.state == api.ControllableSystemStateTypeUnlimitedControlled {
+		event = e.setState(api.ControllableSystemStateTypeFailsafe)
+		e.failsafeSince = time.Now()
+		e.resetStateTimer(e.failsafeDuration(), e.failsafeTimedOut)
+	}
+
+	e.stateMux.Unlock()
+
+	e.publishEvent(event)
+}
+
+// the Energy Guard did not take over control after startup
+func (e *UCLPCServer) initTimedOut() {
+	e.stateMux.Lock()
+
+	var event api.EventType
+	if e.state == api.ControllableSystemStateTypeInit {
+		event = e.setState(api.ControllableSystemStateTypeUnlimitedAutonomous)
+	}
+
+	e.stateMux.Unlock()
+
+	e.publishEvent(event)
+}
+
+// the failsafe duration minimum has passed
+func (e *UCLPCServer) failsafeTimedOut() {
+	e.stateMux.Lock()
+
+	if e.state != api.ControllableSystemStateTypeFailsafe {
+		e.stateMux.Unlock()
+		return
+	}
+
+	// the failsafe duration may have been extended in the meantime
+	if remaining := e.failsafeDuration() - time.Since(e.failsafeSince); remaining > 0 {
+		e.resetStateTimer(remaining, e.failsafeTimedOut)
+		e.stateMux.Unlock()
+		return
+	}
+
+	newState := api.ControllableSystemStateTypeUnlimitedAutonomous
+	if e.isHeartbeatAlive() {
+		newState = api.ControllableSystemStateTypeUnlimitedControlled
+	}
+	event := e.setState(newState)
+
+	e.stateMux.Unlock()
+
+	e.publishEvent(event)
+}
+
+// switch into the state controlled by the Energy Guard, if it is connected
+// and provided a limit
+//
+// has to be invoked with stateMux being locked
+func (e *UCLPCServer) evaluateState() api.EventType {
+	if !e.isHeartbeatAlive() || !e.limitWritten {
+		return ""
+	}
+
+	newState := api.ControllableSystemStateTypeUnlimitedControlled
+	if e.limitActive {
+		newState = api.ControllableSystemStateTypeLimited
+	}
+
+	return e.setState(newState)
+}
+
+// set a new state and return the event for the state change,
+// returns an empty event if the state did not change
+//
+// has to be invoked with stateMux being locked
+func (e *UCLPCServer) setState(state api.ControllableSystemStateType) api.EventType {
+	if e.state == state {
+		return ""
+	}
+
+	e.state = state
+
+	// timers are only used in the "init" and "failsafe" state
+	if e.stateTimer != nil {
+		e.stateTimer.Stop()
+		e.stateTimer = nil
+	}
+
+	switch state {
+	case api.ControllableSystemStateTypeUnlimitedControlled:
+		return StateUnlimitedControlled
+	case api.ControllableSystemStateTypeLimited:
+		return StateLimited
+	case api.ControllableSystemStateTypeFailsafe:
+		return StateFailsafe
+	case api.ControllableSystemStateTypeUnlimitedAutonomous:
+		return StateUnlimitedAutonomous
+	}
+
+	return ""
+}
+
+// has to be invoked with stateMux being locked
+func (e *UCLPCServer) resetStateTimer(duration time.Duration, fn func()) {
+	if e.stateTimer != nil {
+		e.stateTimer.Stop()
+	}
+	e.stateTimer = time.AfterFunc(duration, fn)
+}
+
+// returns if heartbeats of the entity are accepted
+//
+// heartbeats are accepted from the Energy Guard itself and from a subscribed
+// DeviceDiagnosis server, as long as it belongs to the device of the Energy Guard
+//
+// has to be invoked with stateMux being locked
+func (e *UCLPCServer) isHeartbeatSource(entity spineapi.EntityRemoteInterface) bool {
+	if entity == nil || entity.Device() == nil {
+		return false
+	}
+
+	if entity == e.energyGuard {
+		return true
+	}
+
+	if e.heartbeatSources[entity.Device().Ski()] != entity {
+		return false
+	}
+
+	return e.energyGuard == nil || sameDevice(entity, e.energyGuard)
+}
+
+// has to be invoked with stateMux being locked
+func (e *UCLPCServer) isHeartbeatAlive() bool {
+	return !e.lastHeartbeat.IsZero() && time.Since(e.lastHeartbeat) < e.heartbeatTimeout
+}
+
+// return the minimum duration to remain in "failsafe" state
+func (e *UCLPCServer) failsafeDuration() time.Duration {
+	duration, _, err := e.FailsafeDurationMinimum()
+	if err != nil {
+		return defaultFailsafeDurationMinimum
+	}
+
+	return duration
+}
+
+func (e *UCLPCServer) publishEvent(event api.EventType) {
+	if event == "" {
+		return
+	}
+
+	e.stateMux.Lock()
+	entity := e.energyGuard
+	e.stateMux.Unlock()
+
+	var ski string
+	var device spineapi.DeviceRemoteInterface
+	if entity != nil && entity.Device() != nil {
+		device = entity.Device()
+		ski = device.Ski()
+	}
+
+	e.eventCB(ski, device, entity, event)
+}
+
+// returns if both entities belong to the same remote device
+func sameDevice(a, b spineapi.EntityRemoteInterface) bool {
+	if a == nil || b == nil || a.Device() == nil || b.Device() == nil {
+		return false
+	}
+
+	return a.Device().Ski() == b.Device().Ski()
+}
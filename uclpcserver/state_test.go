@@ -0,0 +1,150 @@
+package uclpcserver
+
+import (
+	"time"
+
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/spine-go/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *UCLPCServerSuite) Test_StateMachine() {
+	assert.Equal(s.T(), api.ControllableSystemStateTypeInit, s.sut.ControllableSystemState())
+
+	_, _, err := s.sut.EffectiveConsumptionLimit()
+	assert.NotNil(s.T(), err)
+
+	err = s.sut.SetFailsafeConsumptionActivePowerLimit(4200, true)
+	assert.Nil(s.T(), err)
+
+	value, isLimited, err := s.sut.EffectiveConsumptionLimit()
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), true, isLimited)
+	assert.Equal(s.T(), 4200.0, value)
+
+	// a limit without a heartbeat does not change the state
+	s.sut.limitUpdated(s.monitoredEntity)
+	assert.Equal(s.T(), api.ControllableSystemStateTypeInit, s.sut.ControllableSystemState())
+
+	s.sut.initTimedOut()
+	assert.Equal(s.T(), api.ControllableSystemStateTypeUnlimitedAutonomous, s.sut.ControllableSystemState())
+
+	value, isLimited, err = s.sut.EffectiveConsumptionLimit()
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), false, isLimited)
+	assert.Equal(s.T(), 0.0, value)
+
+	// the limit received earlier is applied once the heartbeat is available
+	s.sut.heartbeatReceived(s.monitoredEntity)
+	assert.Equal(s.T(), api.ControllableSystemStateTypeUnlimitedControlled, s.sut.ControllableSystemState())
+
+	err = s.sut.SetConsumptionLimit(api.LoadLimit{
+		IsActive:     true,
+		IsChangeable: true,
+		Value:        5000,
+	})
+	assert.Nil(s.T(), err)
+	s.sut.limitUpdated(s.monitoredEntity)
+	assert.Equal(s.T(), api.ControllableSystemStateTypeLimited, s.sut.ControllableSystemState())
+
+	value, isLimited, err = s.sut.EffectiveConsumptionLimit()
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), true, isLimited)
+	assert.Equal(s.T(), 5000.0, value)
+
+	err = s.sut.SetConsumptionLimit(api.LoadLimit{
+		IsActive:     false,
+		IsChangeable: true,
+		Value:        5000,
+	})
+	assert.Nil(s.T(), err)
+	s.sut.limitUpdated(s.monitoredEntity)
+	assert.Equal(s.T(), api.ControllableSystemStateTypeUnlimitedControlled, s.sut.ControllableSystemState())
+
+	// a newer heartbeat is available
+	s.sut.heartbeatTimedOut()
+	assert.Equal(s.T(), api.ControllableSystemStateTypeUnlimitedControlled, s.sut.ControllableSystemState())
+
+	s.sut.lastHeartbeat = time.Now().Add(-time.Hour)
+	s.sut.heartbeatTimedOut()
+	assert.Equal(s.T(), api.ControllableSystemStateTypeFailsafe, s.sut.ControllableSystemState())
+
+	value, isLimited, err = s.sut.EffectiveConsumptionLimit()
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), true, isLimited)
+	assert.Equal(s.T(), 4200.0, value)
+
+	// the failsafe duration minimum is not yet reached
+	s.sut.failsafeTimedOut()
+	assert.Equal(s.T(), api.ControllableSystemStateTypeFailsafe, s.sut.ControllableSystemState())
+
+	// a heartbeat without a new limit does not leave the failsafe state
+	s.sut.heartbeatReceived(s.monitoredEntity)
+	assert.Equal(s.T(), api.ControllableSystemStateTypeFailsafe, s.sut.ControllableSystemState())
+
+	s.sut.lastHeartbeat = time.Now().Add(-time.Hour)
+	s.sut.failsafeSince = time.Now().Add(-defaultFailsafeDurationMinimum)
+	s.sut.failsafeTimedOut()
+	assert.Equal(s.T(), api.ControllableSystemStateTypeUnlimitedAutonomous, s.sut.ControllableSystemState())
+
+	// the Energy Guard takes over control again
+	s.sut.heartbeatReceived(s.monitoredEntity)
+	s.sut.limitUpdated(s.monitoredEntity)
+	assert.Equal(s.T(), api.ControllableSystemStateTypeUnlimitedControlled, s.sut.ControllableSystemState())
+
+	s.sut.failsafeTimedOut()
+	assert.Equal(s.T(), api.ControllableSystemStateTypeUnlimitedControlled, s.sut.ControllableSystemState())
+}
+
+func (s *UCLPCServerSuite) Test_StateMachine_FailsafeRecovery() {
+	s.sut.heartbeatTimeout = time.Millisecond * 50
+
+	s.sut.heartbeatReceived(s.monitoredEntity)
+	err := s.sut.SetConsumptionLimit(api.LoadLimit{
+		IsActive:     true,
+		IsChangeable: true,
+		Value:        5000,
+	})
+	assert.Nil(s.T(), err)
+	s.sut.limitUpdated(s.monitoredEntity)
+	assert.Equal(s.T(), api.ControllableSystemStateTypeLimited, s.sut.ControllableSystemState())
+
+	assert.Eventually(s.T(), func() bool {
+		return s.sut.ControllableSystemState() == api.ControllableSystemStateTypeFailsafe
+	}, time.Second, time.Millisecond*10)
+
+	// leaving the failsafe state requires a heartbeat and a new limit
+	s.sut.heartbeatReceived(s.monitoredEntity)
+	assert.Equal(s.T(), api.ControllableSystemStateTypeFailsafe, s.sut.ControllableSystemState())
+
+	s.sut.limitUpdated(s.monitoredEntity)
+	assert.Equal(s.T(), api.ControllableSystemStateTypeLimited, s.sut.ControllableSystemState())
+}
+
+func (s *UCLPCServerSuite) Test_HeartbeatSources() {
+	otherDevice := mocks.NewDeviceRemoteInterface(s.T())
+	otherDevice.EXPECT().Ski().Return("otherski").Maybe()
+	otherEntity := mocks.NewEntityRemoteInterface(s.T())
+	otherEntity.EXPECT().Device().Return(otherDevice).Maybe()
+
+	// heartbeats of entities which are not subscribed are ignored
+	s.sut.heartbeatReceived(s.mockRemoteEntity)
+	s.sut.heartbeatReceived(nil)
+	assert.True(s.T(), s.sut.lastHeartbeat.IsZero())
+
+	// the heartbeat of another device does not count for the Energy Guard
+	s.sut.heartbeatSubscribed(otherEntity)
+	s.sut.heartbeatReceived(otherEntity)
+	assert.False(s.T(), s.sut.lastHeartbeat.IsZero())
+
+	s.sut.limitUpdated(s.monitoredEntity)
+	assert.True(s.T(), s.sut.lastHeartbeat.IsZero())
+	assert.Equal(s.T(), api.ControllableSystemStateTypeInit, s.sut.ControllableSystemState())
+
+	// once the Energy Guard is known, only its heartbeats are accepted
+	s.sut.heartbeatReceived(otherEntity)
+	assert.True(s.T(), s.sut.lastHeartbeat.IsZero())
+
+	s.sut.heartbeatReceived(s.monitoredEntity)
+	assert.Equal(s.T(), api.ControllableSystemStateTypeUnlimitedControlled, s.sut.ControllableSystemState())
+}
@@ -79,6 +79,9 @@ func (s *UCLPCServerSuite) BeforeTest(suiteName, testName string) {
 	s.deviceConfigurationFeature = localEntity.FeatureOfTypeAndRole(model.FeatureTypeTypeDeviceConfiguration, model.RoleTypeServer)
 
 	s.remoteDevice, s.monitoredEntity = setupDevices(s.service, s.T())
+
+	// the DeviceDiagnosis server of the remote entity is subscribed
+	s.sut.heartbeatSubscribed(s.monitoredEntity)
 }
 
 const remoteSki string = "testremoteski"
@@ -5,6 +5,8 @@ import "github.com/enbility/cemd/api"
 const (
 	// Load control obligation limit data update received
 	//
+	// Also reported if an active limit expired and was deactivated
+	//
 	// Use `ConsumptionLimit` to get the current data
 	//
 	// Use Case LPC, Scenario 1
@@ -18,6 +20,14 @@ const (
 	// Use Case LPC, Scenario 1
 	WriteApprovalRequired api.EventType = "uclpcserver-WriteApprovalRequired"
 
+	// An incoming load control obligation limit was not approved or denied in time
+	// and was answered automatically
+	//
+	// Use `SetApprovalTimeout` to configure the deadline and the automatic answer
+	//
+	// Use Case LPC, Scenario 1
+	ApprovalTimeout api.EventType = "uclpcserver-ApprovalTimeout"
+
 	// Failsafe limit for the consumed active (real) power of the
 	// Controllable System data update received
 	//
@@ -33,4 +43,34 @@ const (
 	//
 	// Use Case LPC, Scenario 2
 	DataUpdateFailsafeDurationMinimum api.EventType = "uclpcserver-DataUpdateFailsafeDurationMinimum"
+
+	// The Controllable System changed into "unlimited/controlled" state
+	//
+	// Use `ControllableSystemState` to get the current state
+	//
+	// Use Case LPC, Scenario 3
+	StateUnlimitedControlled api.EventType = "uclpcserver-StateUnlimitedControlled"
+
+	// The Controllable System changed into "limited" state
+	//
+	// Use `EffectiveConsumptionLimit` to get the limit to apply
+	//
+	// Use Case LPC, Scenario 3
+	StateLimited api.EventType = "uclpcserver-StateLimited"
+
+	// The Controllable System changed into "failsafe" state
+	//
+	// Use `EffectiveConsumptionLimit` to get the limit to apply
+	//
+	// Note: the event may be reported without a remote device and entity
+	//
+	// Use Case LPC, Scenario 3
+	StateFailsafe api.EventType = "uclpcserver-StateFailsafe"
+
+	// The Controllable System changed into "unlimited/autonomous" state
+	//
+	// Note: the event may be reported without a remote device and entity
+	//
+	// Use Case LPC, Scenario 3
+	StateUnlimitedAutonomous api.EventType = "uclpcserver-StateUnlimitedAutonomous"
 )
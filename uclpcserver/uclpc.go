@@ -3,8 +3,11 @@ package uclpcserver
 import (
 	"errors"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/quirks"
 	"github.com/enbility/cemd/util"
 	eebusapi "github.com/enbility/eebus-go/api"
 	eebusutil "github.com/enbility/eebus-go/util"
@@ -13,6 +16,13 @@ import (
 	"github.com/enbility/spine-go/spine"
 )
 
+// the default deadline for approving or denying incoming limits
+const defaultApprovalTimeout = time.Second * 10
+
+// SPINE denies pending writes itself after its write approval timeout,
+// which is extended by this margin so the use case always answers first
+const writeApprovalTimeoutMargin = time.Second
+
 type UCLPCServer struct {
 	service eebusapi.ServiceInterface
 
@@ -20,24 +30,60 @@ type UCLPCServer struct {
 
 	validEntityTypes []model.EntityTypeType
 
-	pendingMux    sync.Mutex
-	pendingLimits map[model.MsgCounterType]*spineapi.Message
-
-	heartbeatKeoWorkaround bool // required because KEO Stack uses multiple identical entities for the same functionality, and it is not clear which to use
+	pendingMux      sync.Mutex
+	pendingLimits   map[model.MsgCounterType]*spineapi.Message
+	pendingTimers   map[model.MsgCounterType]*time.Timer
+	approvalPolicy  api.ApprovalPolicyInterface
+	approvalTimeout time.Duration
+	timeoutApprove  bool // if limits are approved or denied after the approval timeout
+
+	quirks api.QuirkRegistryInterface
+
+	heartbeatMux             sync.Mutex
+	heartbeatEntityAmbiguous bool                           // the remote device provides a DeviceDiagnosis server on multiple entities
+	heartbeatBindingEntity   spineapi.EntityRemoteInterface // the entity that bound to the load control server, until the heartbeat is subscribed on it if required
+
+	stateMux         sync.Mutex
+	state            api.ControllableSystemStateType
+	energyGuard      spineapi.EntityRemoteInterface            // the entity that bound to the load control server or wrote the limit
+	heartbeatSources map[string]spineapi.EntityRemoteInterface // the subscribed DeviceDiagnosis server entities by SKI
+	heartbeatEntity  spineapi.EntityRemoteInterface            // the entity that provided the last heartbeat
+	lastHeartbeat    time.Time
+	heartbeatTimeout time.Duration
+	heartbeatTimer   *time.Timer
+	limitWritten     bool // if the Energy Guard wrote a limit since the connection was (re-)established
+	limitActive      bool
+	failsafeSince    time.Time
+	stateTimer       *time.Timer
+
+	expiryMux    sync.Mutex
+	limitEndTime time.Time // the end time of the active limit, zero if there is none
+	expiryTimer  *time.Timer
+
+	store     api.StoreInterface
+	restoring atomic.Bool // if the configuration is currently restored from the store
+
+	featuresAdded atomic.Bool // if the local features were added, which depend on the configuration
 }
 
 var _ UCLPCServerInterface = (*UCLPCServer)(nil)
 
 func NewUCLPC(service eebusapi.ServiceInterface, eventCB api.EntityEventCallback) *UCLPCServer {
 	uc := &UCLPCServer{
-		service:       service,
-		eventCB:       eventCB,
-		pendingLimits: make(map[model.MsgCounterType]*spineapi.Message),
+		service:          service,
+		eventCB:          eventCB,
+		pendingLimits:    make(map[model.MsgCounterType]*spineapi.Message),
+		pendingTimers:    make(map[model.MsgCounterType]*time.Timer),
+		approvalTimeout:  defaultApprovalTimeout,
+		state:            api.ControllableSystemStateTypeInit,
+		heartbeatTimeout: defaultHeartbeatTimeout,
+		heartbeatSources: make(map[string]spineapi.EntityRemoteInterface),
+		quirks:           quirks.NewDefaultRegistry(),
 	}
 
 	uc.validEntityTypes = []model.EntityTypeType{
 		model.EntityTypeTypeGridGuard,
-		model.EntityTypeTypeCEM, // KEO uses this entity type for an SMGW whysoever
+		model.EntityTypeTypeCEM, // some devices use this entity type for an SMGW
 	}
 
 	_ = spine.Events.Subscribe(uc)
@@ -77,9 +123,6 @@ func (e *UCLPCServer) loadControlLimitId() (limitid model.LoadControlLimitIdType
 // the implementation only considers write messages for this use case and
 // approves all others
 func (e *UCLPCServer) loadControlWriteCB(msg *spineapi.Message) {
-	e.pendingMux.Lock()
-	defer e.pendingMux.Unlock()
-
 	if msg.RequestHeader == nil || msg.RequestHeader.MsgCounter == nil ||
 		msg.Cmd.LoadControlLimitListData == nil {
 		return
@@ -105,21 +148,117 @@ func (e *UCLPCServer) loadControlWriteCB(msg *spineapi.Message) {
 			continue
 		}
 
-		if _, ok := e.pendingLimits[*msg.RequestHeader.MsgCounter]; !ok {
-			e.pendingLimits[*msg.RequestHeader.MsgCounter] = msg
+		msgCounter := *msg.RequestHeader.MsgCounter
+
+		e.pendingMux.Lock()
+		_, pending := e.pendingLimits[msgCounter]
+		policy := e.approvalPolicy
+		e.pendingMux.Unlock()
+
+		if pending {
+			continue
+		}
+
+		// the policy and the event callback are called without holding the lock,
+		// as they may approve or deny the limit or read the pending limits
+		decision := e.approvalDecision(policy, msg, item)
+
+		switch decision.Result {
+		case api.ApprovalResultTypeApprove:
+			e.approveOrDenyConsumptionLimit(msg, true, "")
+		case api.ApprovalResultTypeDeny:
+			e.approveOrDenyConsumptionLimit(msg, false, decision.Reason)
+		default:
+			e.pendingMux.Lock()
+			e.pendingLimits[msgCounter] = msg
+			e.pendingTimers[msgCounter] = time.AfterFunc(e.approvalTimeout, func() {
+				e.approvalTimedOut(msgCounter)
+			})
+			e.pendingMux.Unlock()
+
 			e.eventCB(msg.DeviceRemote.Ski(), msg.DeviceRemote, msg.EntityRemote, WriteApprovalRequired)
-			return
 		}
+		return
 	}
 
 	// approve, because this is no request for this usecase
 	go e.ApproveOrDenyConsumptionLimit(*msg.RequestHeader.MsgCounter, true, "")
 }
 
+// ask the approval policy for a decision on an incoming limit
+func (e *UCLPCServer) approvalDecision(policy api.ApprovalPolicyInterface, msg *spineapi.Message, item model.LoadControlLimitDataType) api.ApprovalDecision {
+	if policy == nil {
+		return api.ApprovalDecision{Result: api.ApprovalResultTypeManual}
+	}
+
+	request := api.LimitApprovalRequest{
+		MsgCounter: *msg.RequestHeader.MsgCounter,
+		UseCase:    e.UseCaseName(),
+		Limit:      loadLimitFromData(item),
+	}
+	if msg.DeviceRemote != nil {
+		request.Ski = msg.DeviceRemote.Ski()
+	}
+	if value, err := e.ContractualConsumptionNominalMax(); err == nil {
+		request.ContractualNominalMax = value
+	}
+
+	return policy.Decide(request)
+}
+
+// a pending limit was not approved or denied in time
+func (e *UCLPCServer) approvalTimedOut(msgCounter model.MsgCounterType) {
+	e.pendingMux.Lock()
+
+	msg, ok := e.pendingLimits[msgCounter]
+	if !ok {
+		e.pendingMux.Unlock()
+		return
+	}
+
+	delete(e.pendingLimits, msgCounter)
+	delete(e.pendingTimers, msgCounter)
+	approve := e.timeoutApprove
+
+	e.pendingMux.Unlock()
+
+	reason := ""
+	if !approve {
+		reason = "write not approved in time"
+	}
+	e.approveOrDenyConsumptionLimit(msg, approve, reason)
+
+	e.eventCB(msg.DeviceRemote.Ski(), msg.DeviceRemote, msg.EntityRemote, ApprovalTimeout)
+}
+
+// convert incoming limit data into a load limit
+func loadLimitFromData(item model.LoadControlLimitDataType) api.LoadLimit {
+	limit := api.LoadLimit{}
+
+	if item.TimePeriod != nil {
+		if duration, err := item.TimePeriod.GetDuration(); err == nil {
+			limit.Duration = duration
+		}
+	}
+
+	if item.IsLimitActive != nil {
+		limit.IsActive = *item.IsLimitActive
+	}
+
+	if item.Value != nil {
+		limit.Value = item.Value.GetValue()
+	}
+
+	return limit
+}
+
 func (e *UCLPCServer) AddFeatures() {
 	localEntity := e.service.LocalDevice().EntityForType(model.EntityTypeTypeCEM)
 
+	e.featuresAdded.Store(true)
+
 	// client features
+	_ = localEntity.GetOrAddFeature(model.FeatureTypeTypeDeviceClassification, model.RoleTypeClient)
 	_ = localEntity.GetOrAddFeature(model.FeatureTypeTypeDeviceDiagnosis, model.RoleTypeClient)
 
 	// server features
@@ -128,6 +267,11 @@ func (e *UCLPCServer) AddFeatures() {
 	f.AddFunctionType(model.FunctionTypeLoadControlLimitListData, true, true)
 	_ = f.AddWriteApprovalCallback(e.loadControlWriteCB)
 
+	e.pendingMux.Lock()
+	timeout := e.approvalTimeout
+	e.pendingMux.Unlock()
+	util.SetLocalWriteApprovalTimeout(f, timeout+writeApprovalTimeoutMargin)
+
 	var limitId model.LoadControlLimitIdType = 0
 	// get the highest limitId
 	desc, err := spine.LocalFeatureDataCopyOfType[*model.LoadControlLimitDescriptionListDataType](
@@ -151,10 +295,13 @@ func (e *UCLPCServer) AddFeatures() {
 		LimitType:      eebusutil.Ptr(model.LoadControlLimitTypeTypeSignDependentAbsValueLimit),
 		LimitCategory:  eebusutil.Ptr(model.LoadControlCategoryTypeObligation),
 		LimitDirection: eebusutil.Ptr(model.EnergyDirectionTypeConsume),
-		MeasurementId:  eebusutil.Ptr(model.MeasurementIdType(0)), // This is a fake Measurement ID, as there is no Electrical Connection server defined, it can't provide any meaningful. But KEO requires this to be set :(
 		Unit:           eebusutil.Ptr(model.UnitOfMeasurementTypeW),
 		ScopeType:      eebusutil.Ptr(model.ScopeTypeTypeActivePowerLimit),
 	}
+	if e.quirks.HasQuirkForAnyDevice(api.QuirkTypeLimitDescriptionMeasurementId) {
+		// a fake Measurement ID, as there is no Electrical Connection server defined, it can't provide any meaningful
+		newLimitDesc.MeasurementId = eebusutil.Ptr(model.MeasurementIdType(0))
+	}
 	desc.LoadControlLimitDescriptionData = append(desc.LoadControlLimitDescriptionData, newLimitDesc)
 	f.SetData(model.FunctionTypeLoadControlLimitDescriptionListData, desc)
 
@@ -241,11 +388,11 @@ func (e *UCLPCServer) AddFeatures() {
 		}
 	}
 
-	// ElectricalConnectionId and ParameterId should be identical to the ones used
-	// in a MPC Server role implementation, which is not done here (yet)
+	// ElectricalConnectionId and ParameterId are identical to the ones used
+	// in the MPC Server role implementation
 	newCharData := model.ElectricalConnectionCharacteristicDataType{
-		ElectricalConnectionId: eebusutil.Ptr(model.ElectricalConnectionIdType(0)),
-		ParameterId:            eebusutil.Ptr(model.ElectricalConnectionParameterIdType(0)),
+		ElectricalConnectionId: eebusutil.Ptr(util.LocalElectricalConnectionId),
+		ParameterId:            eebusutil.Ptr(util.LocalElectricalConnectionParameterIdPowerTotal),
 		CharacteristicId:       eebusutil.Ptr(elCharId),
 		CharacteristicContext:  eebusutil.Ptr(model.ElectricalConnectionCharacteristicContextTypeEntity),
 		CharacteristicType:     eebusutil.Ptr(model.ElectricalConnectionCharacteristicTypeTypeContractualConsumptionNominalMax),
@@ -253,6 +400,8 @@ func (e *UCLPCServer) AddFeatures() {
 	}
 	elCharData.ElectricalConnectionCharacteristicData = append(elCharData.ElectricalConnectionCharacteristicData, newCharData)
 	f.SetData(model.FunctionTypeElectricalConnectionCharacteristicListData, elCharData)
+
+	e.loadConfiguration()
 }
 
 func (e *UCLPCServer) AddUseCase() {
@@ -265,6 +414,8 @@ func (e *UCLPCServer) AddUseCase() {
 		"release",
 		true,
 		[]model.UseCaseScenarioSupportType{1, 2, 3, 4})
+
+	e.startStateMachine()
 }
 
 func (e *UCLPCServer) UpdateUseCaseAvailability(available bool) {
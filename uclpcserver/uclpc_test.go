@@ -3,10 +3,16 @@ package uclpcserver
 import (
 	"time"
 
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/mocks"
+	"github.com/enbility/cemd/quirks"
+	"github.com/enbility/cemd/util"
 	eebusutil "github.com/enbility/eebus-go/util"
 	spineapi "github.com/enbility/spine-go/api"
 	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/spine"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func (s *UCLPCServerSuite) Test_loadControlWriteCB() {
@@ -61,6 +67,222 @@ func (s *UCLPCServerSuite) Test_loadControlWriteCB() {
 	s.sut.loadControlWriteCB(msg)
 }
 
+func (s *UCLPCServerSuite) Test_ApprovalPolicy() {
+	msgCounter := model.MsgCounterType(500)
+
+	msg := &spineapi.Message{
+		RequestHeader: &model.HeaderType{
+			MsgCounter: eebusutil.Ptr(msgCounter),
+		},
+		Cmd: model.CmdType{
+			LoadControlLimitListData: &model.LoadControlLimitListDataType{
+				LoadControlLimitData: []model.LoadControlLimitDataType{
+					{
+						LimitId:       eebusutil.Ptr(model.LoadControlLimitIdType(0)),
+						IsLimitActive: eebusutil.Ptr(true),
+						Value:         model.NewScaledNumberType(12000),
+					},
+				},
+			},
+		},
+		DeviceRemote: s.remoteDevice,
+		EntityRemote: s.monitoredEntity,
+	}
+
+	err := s.sut.SetContractualConsumptionNominalMax(11000)
+	assert.Nil(s.T(), err)
+
+	policy := mocks.NewApprovalPolicyInterface(s.T())
+	policy.EXPECT().Decide(mock.Anything).RunAndReturn(func(request api.LimitApprovalRequest) api.ApprovalDecision {
+		assert.Equal(s.T(), remoteSki, request.Ski)
+		assert.Equal(s.T(), msgCounter, request.MsgCounter)
+		assert.Equal(s.T(), 12000.0, request.Limit.Value)
+		assert.Equal(s.T(), 11000.0, request.ContractualNominalMax)
+
+		return api.ApprovalDecision{Result: api.ApprovalResultTypeDeny, Reason: "too high"}
+	}).Once()
+	s.sut.SetApprovalPolicy(policy)
+
+	s.sut.loadControlWriteCB(msg)
+	data := s.sut.PendingConsumptionLimits()
+	assert.Equal(s.T(), 0, len(data))
+
+	policy.EXPECT().Decide(mock.Anything).Return(api.ApprovalDecision{Result: api.ApprovalResultTypeApprove}).Once()
+	s.sut.loadControlWriteCB(msg)
+	data = s.sut.PendingConsumptionLimits()
+	assert.Equal(s.T(), 0, len(data))
+
+	policy.EXPECT().Decide(mock.Anything).Return(api.ApprovalDecision{Result: api.ApprovalResultTypeNone}).Once()
+	s.sut.loadControlWriteCB(msg)
+	data = s.sut.PendingConsumptionLimits()
+	assert.Equal(s.T(), 1, len(data))
+
+	s.sut.ApproveOrDenyConsumptionLimit(msgCounter, true, "")
+	data = s.sut.PendingConsumptionLimits()
+	assert.Equal(s.T(), 0, len(data))
+
+	s.sut.SetApprovalPolicy(nil)
+	s.sut.loadControlWriteCB(msg)
+	data = s.sut.PendingConsumptionLimits()
+	assert.Equal(s.T(), 1, len(data))
+}
+
+func (s *UCLPCServerSuite) Test_ApprovalCallbacks() {
+	msgCounter := model.MsgCounterType(500)
+
+	msg := &spineapi.Message{
+		RequestHeader: &model.HeaderType{
+			MsgCounter: eebusutil.Ptr(msgCounter),
+		},
+		Cmd: model.CmdType{
+			LoadControlLimitListData: &model.LoadControlLimitListDataType{
+				LoadControlLimitData: []model.LoadControlLimitDataType{
+					{
+						LimitId:       eebusutil.Ptr(model.LoadControlLimitIdType(0)),
+						IsLimitActive: eebusutil.Ptr(true),
+						Value:         model.NewScaledNumberType(1000),
+					},
+				},
+			},
+		},
+		DeviceRemote: s.remoteDevice,
+		EntityRemote: s.monitoredEntity,
+	}
+
+	// the event callback approves the pending limit
+	var sut *UCLPCServer
+	sut = NewUCLPC(s.service, func(ski string, device spineapi.DeviceRemoteInterface, entity spineapi.EntityRemoteInterface, event api.EventType) {
+		if event != WriteApprovalRequired {
+			return
+		}
+		for key := range sut.PendingConsumptionLimits() {
+			sut.ApproveOrDenyConsumptionLimit(key, true, "")
+		}
+	})
+	defer func() { _ = spine.Events.Unsubscribe(sut) }()
+
+	// the policy reads the pending limits
+	policy := mocks.NewApprovalPolicyInterface(s.T())
+	policy.EXPECT().Decide(mock.Anything).RunAndReturn(func(request api.LimitApprovalRequest) api.ApprovalDecision {
+		assert.Equal(s.T(), 0, len(sut.PendingConsumptionLimits()))
+		return api.ApprovalDecision{Result: api.ApprovalResultTypeManual}
+	}).Once()
+	sut.SetApprovalPolicy(policy)
+
+	done := make(chan struct{})
+	go func() {
+		sut.loadControlWriteCB(msg)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		s.T().Fatal("the write callback did not return")
+	}
+
+	assert.Equal(s.T(), 0, len(sut.PendingConsumptionLimits()))
+}
+
+func (s *UCLPCServerSuite) Test_ApprovalTimeout() {
+	msgCounter := model.MsgCounterType(500)
+
+	msg := &spineapi.Message{
+		RequestHeader: &model.HeaderType{
+			MsgCounter: eebusutil.Ptr(msgCounter),
+		},
+		Cmd: model.CmdType{
+			LoadControlLimitListData: &model.LoadControlLimitListDataType{
+				LoadControlLimitData: []model.LoadControlLimitDataType{
+					{
+						LimitId:       eebusutil.Ptr(model.LoadControlLimitIdType(0)),
+						IsLimitActive: eebusutil.Ptr(true),
+						Value:         model.NewScaledNumberType(1000),
+					},
+				},
+			},
+		},
+		DeviceRemote: s.remoteDevice,
+		EntityRemote: s.monitoredEntity,
+	}
+
+	// the timeout can only be set before the use case is added
+	err := s.sut.SetApprovalTimeout(time.Millisecond*50, false)
+	assert.Equal(s.T(), api.ErrUseCaseAdded, err)
+
+	sut := NewUCLPC(s.service, s.Event)
+	defer func() { _ = spine.Events.Unsubscribe(sut) }()
+
+	err = sut.SetApprovalTimeout(0, false)
+	assert.NotNil(s.T(), err)
+
+	err = sut.SetApprovalTimeout(time.Millisecond*50, false)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), time.Millisecond*50, sut.approvalTimeout)
+
+	s.sut.approvalTimeout = time.Millisecond * 50
+
+	s.sut.loadControlWriteCB(msg)
+	data := s.sut.PendingConsumptionLimits()
+	assert.Equal(s.T(), 1, len(data))
+
+	assert.Eventually(s.T(), func() bool {
+		return len(s.sut.PendingConsumptionLimits()) == 0
+	}, time.Second, time.Millisecond*10)
+
+	s.sut.timeoutApprove = true
+
+	s.sut.loadControlWriteCB(msg)
+	data = s.sut.PendingConsumptionLimits()
+	assert.Equal(s.T(), 1, len(data))
+
+	// answered before the timeout
+	s.sut.ApproveOrDenyConsumptionLimit(msgCounter, true, "")
+	s.sut.approvalTimedOut(msgCounter)
+	data = s.sut.PendingConsumptionLimits()
+	assert.Equal(s.T(), 0, len(data))
+}
+
+func (s *UCLPCServerSuite) Test_QuirkLimitDescriptionMeasurementId() {
+	descriptions := util.GetLocalLimitDescriptionsForTypeCategoryDirectionScope(
+		s.service,
+		model.LoadControlLimitTypeTypeSignDependentAbsValueLimit,
+		model.LoadControlCategoryTypeObligation,
+		model.EnergyDirectionTypeConsume,
+		model.ScopeTypeTypeActivePowerLimit,
+	)
+	if assert.Equal(s.T(), 1, len(descriptions)) {
+		assert.NotNil(s.T(), descriptions[0].MeasurementId)
+	}
+
+	sut := NewUCLPC(s.service, s.Event)
+	defer func() { _ = spine.Events.Unsubscribe(sut) }()
+
+	// without any quirks no fake measurement id is provided
+	err := sut.SetQuirkRegistry(nil)
+	assert.Nil(s.T(), err)
+	sut.AddFeatures()
+
+	// the registry can not be replaced afterwards
+	err = sut.SetQuirkRegistry(quirks.NewRegistry(api.QuirkProfile{
+		Name:   "all",
+		Quirks: []api.QuirkType{api.QuirkTypeHeartbeatOnBindingEntity},
+	}))
+	assert.Equal(s.T(), api.ErrUseCaseAdded, err)
+	assert.False(s.T(), sut.quirks.HasQuirkForAnyDevice(api.QuirkTypeHeartbeatOnBindingEntity))
+
+	descriptions = util.GetLocalLimitDescriptionsForTypeCategoryDirectionScope(
+		s.service,
+		model.LoadControlLimitTypeTypeSignDependentAbsValueLimit,
+		model.LoadControlCategoryTypeObligation,
+		model.EnergyDirectionTypeConsume,
+		model.ScopeTypeTypeActivePowerLimit,
+	)
+	if assert.Equal(s.T(), 2, len(descriptions)) {
+		assert.Nil(s.T(), descriptions[1].MeasurementId)
+	}
+}
+
 func (s *UCLPCServerSuite) Test_UpdateUseCaseAvailability() {
 	s.sut.UpdateUseCaseAvailability(true)
 }
@@ -43,12 +43,33 @@ func (e *UCLPP) connected(entity spineapi.EntityRemoteInterface) {
 			logging.Log().Debug(err)
 		}
 
+		// writing limits requires a binding
+		if _, err := loadControl.Bind(); err != nil {
+			logging.Log().Debug(err)
+		}
+
 		// get descriptions
 		if _, err := loadControl.RequestLimitDescriptions(); err != nil {
 			logging.Log().Debug(err)
 		}
 	}
 
+	if deviceConfiguration, err := util.DeviceConfiguration(e.service, entity); err == nil {
+		if _, err := deviceConfiguration.Subscribe(); err != nil {
+			logging.Log().Debug(err)
+		}
+
+		// writing failsafe values requires a binding
+		if _, err := deviceConfiguration.Bind(); err != nil {
+			logging.Log().Debug(err)
+		}
+
+		// get descriptions
+		if _, err := deviceConfiguration.RequestDescriptions(); err != nil {
+			logging.Log().Debug(err)
+		}
+	}
+
 	if localDeviceDiag, err := util.DeviceDiagnosis(e.service, entity); err == nil {
 		if _, err := localDeviceDiag.Subscribe(); err != nil {
 			logging.Log().Debug(err)
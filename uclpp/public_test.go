@@ -114,9 +114,16 @@ func (s *UCLPPSuite) Test_WriteLoadControlLimit() {
 	assert.Nil(s.T(), fErr)
 
 	_, err = s.sut.WriteProductionLimit(s.monitoredEntity, limit)
-	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), err)
 
 	limit.Duration = time.Duration(time.Hour * 2)
+	_, err = s.sut.WriteProductionLimit(s.monitoredEntity, limit)
+	assert.Nil(s.T(), err)
+
+	limitData.LoadControlLimitData[0].IsLimitChangeable = eebusutil.Ptr(false)
+	fErr = rFeature.UpdateData(model.FunctionTypeLoadControlLimitListData, limitData, nil, nil)
+	assert.Nil(s.T(), fErr)
+
 	_, err = s.sut.WriteProductionLimit(s.monitoredEntity, limit)
 	assert.NotNil(s.T(), err)
 }
@@ -26,6 +26,7 @@ func NewUCLPP(service eebusapi.ServiceInterface, eventCB api.EntityEventCallback
 	}
 
 	uc.validEntityTypes = []model.EntityTypeType{
+		model.EntityTypeTypeCEM, // e.g. an energy management system controlling its own appliances
 		model.EntityTypeTypeEVSE,
 		model.EntityTypeTypeInverter,
 		model.EntityTypeTypeSmartEnergyAppliance,
@@ -91,7 +92,7 @@ func (e *UCLPP) IsUseCaseSupported(entity spineapi.EntityRemoteInterface) (bool,
 	// check if the usecase and mandatory scenarios are supported and
 	// if the required server features are available
 	if !entity.Device().VerifyUseCaseScenariosAndFeaturesSupport(
-		model.UseCaseActorTypeEnergyGuard,
+		model.UseCaseActorTypeControllableSystem,
 		e.UseCaseName(),
 		[]model.UseCaseScenarioSupportType{1, 2, 3, 4},
 		[]model.FeatureTypeType{
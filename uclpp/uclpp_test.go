@@ -22,7 +22,7 @@ func (s *UCLPPSuite) Test_IsUseCaseSupported() {
 	ucData := &model.NodeManagementUseCaseDataType{
 		UseCaseInformation: []model.UseCaseInformationDataType{
 			{
-				Actor: eebusutil.Ptr(model.UseCaseActorTypeEnergyGuard),
+				Actor: eebusutil.Ptr(model.UseCaseActorTypeControllableSystem),
 				UseCaseSupport: []model.UseCaseSupportType{
 					{
 						UseCaseName:      eebusutil.Ptr(model.UseCaseNameTypeLimitationOfPowerProduction),
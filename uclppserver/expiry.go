@@ -0,0 +1,75 @@
+package uclppserver
+
+import (
+	"time"
+
+	"github.com/enbility/cemd/util"
+	"github.com/enbility/ship-go/logging"
+)
+
+// track the end time of the current production limit
+//
+// an active limit with a time period is deactivated automatically
+// once its end time is reached
+func (e *UCLPPServer) updateLimitExpiry() {
+	var endTime time.Time
+
+	if limitId, err := e.loadControlLimitId(); err == nil {
+		value := util.GetLocalLimitValueForLimitId(e.service, limitId)
+		if value.IsLimitActive != nil && *value.IsLimitActive &&
+			value.TimePeriod != nil && value.TimePeriod.EndTime != nil {
+			if limitEndTime, err := value.TimePeriod.EndTime.GetTime(); err == nil {
+				endTime = limitEndTime
+			}
+		}
+	}
+
+	e.expiryMux.Lock()
+	defer e.expiryMux.Unlock()
+
+	if e.expiryTimer != nil {
+		e.expiryTimer.Stop()
+		e.expiryTimer = nil
+	}
+
+	e.limitEndTime = endTime
+	if endTime.IsZero() {
+		return
+	}
+
+	e.expiryTimer = time.AfterFunc(time.Until(endTime), func() {
+		e.limitExpired(endTime)
+	})
+}
+
+// the end time of the current production limit was reached
+func (e *UCLPPServer) limitExpired(endTime time.Time) {
+	e.expiryMux.Lock()
+
+	// the limit was changed in the meantime
+	if !e.limitEndTime.Equal(endTime) {
+		e.expiryMux.Unlock()
+		return
+	}
+
+	e.limitEndTime = time.Time{}
+	e.expiryTimer = nil
+
+	e.expiryMux.Unlock()
+
+	limit, err := e.ProductionLimit()
+	if err != nil {
+		return
+	}
+
+	limit.IsActive = false
+	limit.Duration = 0
+	if err := e.SetProductionLimit(limit); err != nil {
+		logging.Log().Debug(err)
+		return
+	}
+
+	e.limitDeactivated()
+
+	e.publishEvent(DataUpdateLimit)
+}
@@ -0,0 +1,104 @@
+package uclppserver
+
+import (
+	"errors"
+	"time"
+
+	"github.com/enbility/cemd/api"
+	eebusapi "github.com/enbility/eebus-go/api"
+	"github.com/enbility/ship-go/logging"
+)
+
+// set the store used to persist the limits and the failsafe and contractual values
+func (e *UCLPPServer) SetStore(store api.StoreInterface) {
+	e.store = store
+}
+
+// restore the configuration from the store
+func (e *UCLPPServer) loadConfiguration() {
+	if e.store == nil {
+		return
+	}
+
+	var config api.ControllableSystemConfiguration
+	if err := e.store.Load(string(e.UseCaseName()), &config); err != nil {
+		if !errors.Is(err, eebusapi.ErrDataNotAvailable) {
+			logging.Log().Error(err)
+		}
+		return
+	}
+
+	// the restored values are already stored
+	e.restoring.Store(true)
+	defer e.restoring.Store(false)
+
+	if config.FailsafeLimit != nil {
+		if err := e.SetFailsafeProductionActivePowerLimit(*config.FailsafeLimit, config.FailsafeLimitChangeable); err != nil {
+			logging.Log().Debug(err)
+		}
+	}
+
+	if config.FailsafeDurationMinimum != nil {
+		if err := e.SetFailsafeDurationMinimum(*config.FailsafeDurationMinimum, config.FailsafeDurationChangeable); err != nil {
+			logging.Log().Debug(err)
+		}
+	}
+
+	if config.ContractualNominalMax != nil {
+		if err := e.SetContractualProductionNominalMax(*config.ContractualNominalMax); err != nil {
+			logging.Log().Debug(err)
+		}
+	}
+
+	if config.Limit != nil {
+		limit := *config.Limit
+
+		// the duration of a timed limit continues to elapse while the system is down
+		if !config.LimitEndTime.IsZero() {
+			limit.Duration = time.Until(config.LimitEndTime)
+			if limit.Duration <= 0 {
+				limit.IsActive = false
+				limit.Duration = 0
+			}
+		}
+
+		if err := e.SetProductionLimit(limit); err != nil {
+			logging.Log().Debug(err)
+		}
+	}
+}
+
+// write the current configuration to the store
+func (e *UCLPPServer) storeConfiguration() {
+	if e.store == nil || e.restoring.Load() {
+		return
+	}
+
+	var config api.ControllableSystemConfiguration
+
+	if limit, err := e.ProductionLimit(); err == nil {
+		config.Limit = &limit
+
+		e.expiryMux.Lock()
+		config.LimitEndTime = e.limitEndTime
+		e.expiryMux.Unlock()
+	}
+
+	if value, changeable, err := e.FailsafeProductionActivePowerLimit(); err == nil {
+		config.FailsafeLimit = &value
+		config.FailsafeLimitChangeable = changeable
+	}
+
+	if duration, changeable, err := e.FailsafeDurationMinimum(); err == nil {
+		config.FailsafeDurationMinimum = &duration
+		config.FailsafeDurationChangeable = changeable
+	}
+
+	if value, err := e.ContractualProductionNominalMax(); err == nil {
+		config.ContractualNominalMax = &value
+	}
+
+	if err := e.store.Save(string(e.UseCaseName()), config); err != nil {
+		logging.Log().Error(err)
+	}
+}
@@ -27,6 +27,27 @@ func (s *UCLPPServerSuite) Test_LoadControlLimit() {
 	limit, err = s.sut.ProductionLimit()
 	assert.Equal(s.T(), 16.0, limit.Value)
 	assert.Nil(s.T(), err)
+
+	// the limit of the consumption use case on the same feature is kept
+	localEntity := s.service.LocalDevice().EntityForType(model.EntityTypeTypeCEM)
+	loadControl := localEntity.FeatureOfTypeAndRole(model.FeatureTypeTypeLoadControl, model.RoleTypeServer)
+	limits := loadControl.DataCopy(model.FunctionTypeLoadControlLimitListData).(*model.LoadControlLimitListDataType)
+	limits.LoadControlLimitData = append(limits.LoadControlLimitData, model.LoadControlLimitDataType{
+		LimitId: eebusutil.Ptr(model.LoadControlLimitIdType(99)),
+		Value:   model.NewScaledNumberType(8),
+	})
+	loadControl.SetData(model.FunctionTypeLoadControlLimitListData, limits)
+
+	newLimit.Value = 12
+	err = s.sut.SetProductionLimit(newLimit)
+	assert.Nil(s.T(), err)
+
+	limit, err = s.sut.ProductionLimit()
+	assert.Equal(s.T(), 12.0, limit.Value)
+	assert.Nil(s.T(), err)
+
+	limits = loadControl.DataCopy(model.FunctionTypeLoadControlLimitListData).(*model.LoadControlLimitListDataType)
+	assert.Equal(s.T(), 2, len(limits.LoadControlLimitData))
 }
 
 func (s *UCLPPServerSuite) Test_PendingProductionLimits() {
@@ -100,7 +100,7 @@ func (e *UCMGCP) IsUseCaseSupported(entity spineapi.EntityRemoteInterface) (bool
 		return false, eebusapi.ErrFunctionNotSupported
 	}
 
-	_, err1 := measurement.GetDescriptionsForScope(model.ScopeTypeTypeACPower)
+	_, err1 := measurement.GetDescriptionsForScope(model.ScopeTypeTypeACPowerTotal)
 	_, err2 := measurement.GetDescriptionsForScope(model.ScopeTypeTypeGridFeedIn)
 	_, err3 := measurement.GetDescriptionsForScope(model.ScopeTypeTypeGridConsumption)
 	if err1 != nil || err2 != nil || err3 != nil {
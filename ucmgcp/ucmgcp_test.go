@@ -47,7 +47,7 @@ func (s *UCMGCPSuite) Test_IsUseCaseSupported() {
 		MeasurementDescriptionData: []model.MeasurementDescriptionDataType{
 			{
 				MeasurementId: eebusutil.Ptr(model.MeasurementIdType(0)),
-				ScopeType:     eebusutil.Ptr(model.ScopeTypeTypeACPower),
+				ScopeType:     eebusutil.Ptr(model.ScopeTypeTypeACPowerTotal),
 			},
 			{
 				MeasurementId: eebusutil.Ptr(model.MeasurementIdType(1)),
@@ -0,0 +1,74 @@
+package ucmgcpserver
+
+import (
+	"github.com/enbility/cemd/api"
+)
+
+//go:generate mockery
+
+// interface for the Monitoring of Grid Connection Point UseCase as a Grid Connection Point
+type UCMGCPServerInterface interface {
+	api.UseCaseInterface
+
+	// Scenario 1
+
+	// set the current power limitation factor
+	//
+	// parameters:
+	//   - value: the power limitation factor in %
+	SetPowerLimitationFactor(value float64) (resultErr error)
+
+	// Scenario 2
+
+	// set the momentary power consumption or production at the grid connection point
+	//
+	// parameters:
+	//   - value: the power in W
+	//
+	//   - positive values are used for consumption
+	//   - negative values are used for production
+	SetPower(value float64) (resultErr error)
+
+	// Scenario 3
+
+	// set the total feed in energy at the grid connection point
+	//
+	// parameters:
+	//   - value: the energy in Wh
+	SetEnergyFeedIn(value float64) (resultErr error)
+
+	// Scenario 4
+
+	// set the total consumption energy at the grid connection point
+	//
+	// parameters:
+	//   - value: the energy in Wh
+	SetEnergyConsumed(value float64) (resultErr error)
+
+	// Scenario 5
+
+	// set the momentary current consumption or production at the grid connection point
+	//
+	// parameters:
+	//   - values: the current in A for up to 3 phases, in the order of phase A, B and C
+	//
+	//   - positive values are used for consumption
+	//   - negative values are used for production
+	SetCurrentPerPhase(values []float64) (resultErr error)
+
+	// Scenario 6
+
+	// set the voltage phase details at the grid connection point
+	//
+	// parameters:
+	//   - values: the voltage in V for up to 3 phases, in the order of phase A, B and C
+	SetVoltagePerPhase(values []float64) (resultErr error)
+
+	// Scenario 7
+
+	// set frequency at the grid connection point
+	//
+	// parameters:
+	//   - value: the frequency in Hz
+	SetFrequency(value float64) (resultErr error)
+}
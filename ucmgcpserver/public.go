@@ -0,0 +1,69 @@
+package ucmgcpserver
+
+import (
+	"github.com/enbility/cemd/util"
+	"github.com/enbility/spine-go/model"
+)
+
+// the phase name used for measurements over all phases
+var totalPhases = []model.ElectricalConnectionPhaseNameType{model.ElectricalConnectionPhaseNameTypeAbc}
+
+// Scenario 1
+
+// set the current power limitation factor
+func (e *UCMGCPServer) SetPowerLimitationFactor(value float64) error {
+	keyName := model.DeviceConfigurationKeyNameTypePvCurtailmentLimitFactor
+	keyValue := model.DeviceConfigurationKeyValueValueType{
+		ScaledNumber: model.NewScaledNumberType(value),
+	}
+
+	return util.SetLocalDeviceConfigurationKeyValue(e.service, keyName, false, keyValue)
+}
+
+// Scenario 2
+
+// set the momentary power consumption or production at the grid connection point
+//
+//   - positive values are used for consumption
+//   - negative values are used for production
+func (e *UCMGCPServer) SetPower(value float64) error {
+	return e.setMeasurementValues(model.ScopeTypeTypeACPowerTotal, totalPhases, []float64{value})
+}
+
+// Scenario 3
+
+// set the total feed in energy at the grid connection point
+func (e *UCMGCPServer) SetEnergyFeedIn(value float64) error {
+	return e.setMeasurementValues(model.ScopeTypeTypeGridFeedIn, totalPhases, []float64{value})
+}
+
+// Scenario 4
+
+// set the total consumption energy at the grid connection point
+func (e *UCMGCPServer) SetEnergyConsumed(value float64) error {
+	return e.setMeasurementValues(model.ScopeTypeTypeGridConsumption, totalPhases, []float64{value})
+}
+
+// Scenario 5
+
+// set the momentary current consumption or production at the grid connection point
+//
+//   - positive values are used for consumption
+//   - negative values are used for production
+func (e *UCMGCPServer) SetCurrentPerPhase(values []float64) error {
+	return e.setMeasurementValues(model.ScopeTypeTypeACCurrent, util.PhaseNameMapping, values)
+}
+
+// Scenario 6
+
+// set the voltage phase details at the grid connection point
+func (e *UCMGCPServer) SetVoltagePerPhase(values []float64) error {
+	return e.setMeasurementValues(model.ScopeTypeTypeACVoltage, util.PhaseNameMapping, values)
+}
+
+// Scenario 7
+
+// set frequency at the grid connection point
+func (e *UCMGCPServer) SetFrequency(value float64) error {
+	return e.setMeasurementValues(model.ScopeTypeTypeACFrequency, []model.ElectricalConnectionPhaseNameType{""}, []float64{value})
+}
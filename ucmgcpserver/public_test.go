@@ -0,0 +1,89 @@
+package ucmgcpserver
+
+import (
+	"github.com/enbility/cemd/util"
+	"github.com/enbility/spine-go/model"
+	"github.com/stretchr/testify/assert"
+)
+
+// return the local measurement values for a scope
+func (s *UCMGCPServerSuite) measurementValues(scope model.ScopeTypeType) []float64 {
+	var result []float64
+
+	data, ok := s.measurementFeature.DataCopy(model.FunctionTypeMeasurementListData).(*model.MeasurementListDataType)
+	if !ok || data == nil {
+		return result
+	}
+
+	for _, item := range measurements {
+		if item.scope != scope {
+			continue
+		}
+
+		id := s.sut.measurementIds[measurementKey{scope: item.scope, phase: item.phase}]
+		for _, value := range data.MeasurementData {
+			if value.MeasurementId != nil && *value.MeasurementId == id && value.Value != nil {
+				result = append(result, value.Value.GetValue())
+			}
+		}
+	}
+
+	return result
+}
+
+func (s *UCMGCPServerSuite) Test_PowerLimitationFactor() {
+	err := s.sut.SetPowerLimitationFactor(70)
+	assert.Nil(s.T(), err)
+
+	keyData := util.GetLocalDeviceConfigurationKeyValueForKeyName(s.service, model.DeviceConfigurationKeyNameTypePvCurtailmentLimitFactor)
+	assert.NotNil(s.T(), keyData.Value)
+	assert.Equal(s.T(), 70.0, keyData.Value.ScaledNumber.GetValue())
+	assert.Equal(s.T(), false, *keyData.IsValueChangeable)
+}
+
+func (s *UCMGCPServerSuite) Test_Power() {
+	err := s.sut.SetPower(-4200)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []float64{-4200}, s.measurementValues(model.ScopeTypeTypeACPowerTotal))
+}
+
+func (s *UCMGCPServerSuite) Test_Energy() {
+	err := s.sut.SetEnergyFeedIn(500)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []float64{500}, s.measurementValues(model.ScopeTypeTypeGridFeedIn))
+
+	err = s.sut.SetEnergyConsumed(1000)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []float64{1000}, s.measurementValues(model.ScopeTypeTypeGridConsumption))
+
+	// other values are kept
+	assert.Equal(s.T(), []float64{500}, s.measurementValues(model.ScopeTypeTypeGridFeedIn))
+}
+
+func (s *UCMGCPServerSuite) Test_CurrentPerPhase() {
+	err := s.sut.SetCurrentPerPhase(nil)
+	assert.NotNil(s.T(), err)
+
+	err = s.sut.SetCurrentPerPhase([]float64{10, 12, 14, 16})
+	assert.NotNil(s.T(), err)
+
+	err = s.sut.SetCurrentPerPhase([]float64{10, 12, 14})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []float64{10, 12, 14}, s.measurementValues(model.ScopeTypeTypeACCurrent))
+}
+
+func (s *UCMGCPServerSuite) Test_VoltagePerPhase() {
+	err := s.sut.SetVoltagePerPhase([]float64{230, 231, 232})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []float64{230, 231, 232}, s.measurementValues(model.ScopeTypeTypeACVoltage))
+}
+
+func (s *UCMGCPServerSuite) Test_Frequency() {
+	err := s.sut.SetFrequency(50)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []float64{50}, s.measurementValues(model.ScopeTypeTypeACFrequency))
+
+	sut := NewUCMGCP(s.service, s.Event)
+	err = sut.SetFrequency(50)
+	assert.NotNil(s.T(), err)
+}
@@ -0,0 +1,266 @@
+package ucmgcpserver
+
+import (
+	"errors"
+	"time"
+
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/util"
+	eebusapi "github.com/enbility/eebus-go/api"
+	eebusutil "github.com/enbility/eebus-go/util"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/spine"
+)
+
+// identifies a measurement provided by this use case
+type measurementKey struct {
+	scope model.ScopeTypeType
+	phase model.ElectricalConnectionPhaseNameType
+}
+
+// the measurements provided by this use case, the parameterId of each measurement is its index
+var measurements = []struct {
+	measurementType model.MeasurementTypeType
+	scope           model.ScopeTypeType
+	unit            model.UnitOfMeasurementType
+	phase           model.ElectricalConnectionPhaseNameType
+	referenceTo     model.ElectricalConnectionPhaseNameType
+	acType          model.ElectricalConnectionAcMeasurementTypeType
+}{
+	{model.MeasurementTypeTypePower, model.ScopeTypeTypeACPowerTotal, model.UnitOfMeasurementTypeW,
+		model.ElectricalConnectionPhaseNameTypeAbc, "", model.ElectricalConnectionAcMeasurementTypeTypeReal},
+	{model.MeasurementTypeTypeEnergy, model.ScopeTypeTypeGridFeedIn, model.UnitOfMeasurementTypeWh,
+		model.ElectricalConnectionPhaseNameTypeAbc, "", model.ElectricalConnectionAcMeasurementTypeTypeReal},
+	{model.MeasurementTypeTypeEnergy, model.ScopeTypeTypeGridConsumption, model.UnitOfMeasurementTypeWh,
+		model.ElectricalConnectionPhaseNameTypeAbc, "", model.ElectricalConnectionAcMeasurementTypeTypeReal},
+	{model.MeasurementTypeTypeCurrent, model.ScopeTypeTypeACCurrent, model.UnitOfMeasurementTypeA,
+		model.ElectricalConnectionPhaseNameTypeA, "", model.ElectricalConnectionAcMeasurementTypeTypeReal},
+	{model.MeasurementTypeTypeCurrent, model.ScopeTypeTypeACCurrent, model.UnitOfMeasurementTypeA,
+		model.ElectricalConnectionPhaseNameTypeB, "", model.ElectricalConnectionAcMeasurementTypeTypeReal},
+	{model.MeasurementTypeTypeCurrent, model.ScopeTypeTypeACCurrent, model.UnitOfMeasurementTypeA,
+		model.ElectricalConnectionPhaseNameTypeC, "", model.ElectricalConnectionAcMeasurementTypeTypeReal},
+	{model.MeasurementTypeTypeVoltage, model.ScopeTypeTypeACVoltage, model.UnitOfMeasurementTypeV,
+		model.ElectricalConnectionPhaseNameTypeA, model.ElectricalConnectionPhaseNameTypeNeutral, model.ElectricalConnectionAcMeasurementTypeTypeApparent},
+	{model.MeasurementTypeTypeVoltage, model.ScopeTypeTypeACVoltage, model.UnitOfMeasurementTypeV,
+		model.ElectricalConnectionPhaseNameTypeB, model.ElectricalConnectionPhaseNameTypeNeutral, model.ElectricalConnectionAcMeasurementTypeTypeApparent},
+	{model.MeasurementTypeTypeVoltage, model.ScopeTypeTypeACVoltage, model.UnitOfMeasurementTypeV,
+		model.ElectricalConnectionPhaseNameTypeC, model.ElectricalConnectionPhaseNameTypeNeutral, model.ElectricalConnectionAcMeasurementTypeTypeApparent},
+	{model.MeasurementTypeTypeFrequency, model.ScopeTypeTypeACFrequency, model.UnitOfMeasurementTypeHz,
+		"", "", ""},
+}
+
+type UCMGCPServer struct {
+	service eebusapi.ServiceInterface
+
+	eventCB api.EntityEventCallback
+
+	validEntityTypes []model.EntityTypeType
+
+	measurementIds map[measurementKey]model.MeasurementIdType
+}
+
+var _ UCMGCPServerInterface = (*UCMGCPServer)(nil)
+
+func NewUCMGCP(service eebusapi.ServiceInterface, eventCB api.EntityEventCallback) *UCMGCPServer {
+	uc := &UCMGCPServer{
+		service:        service,
+		eventCB:        eventCB,
+		measurementIds: make(map[measurementKey]model.MeasurementIdType),
+	}
+
+	uc.validEntityTypes = []model.EntityTypeType{
+		model.EntityTypeTypeCEM,
+	}
+
+	return uc
+}
+
+func (c *UCMGCPServer) UseCaseName() model.UseCaseNameType {
+	return model.UseCaseNameTypeMonitoringOfGridConnectionPoint
+}
+
+func (e *UCMGCPServer) AddFeatures() {
+	localEntity := e.service.LocalDevice().EntityForType(model.EntityTypeTypeCEM)
+
+	// server features
+	f := localEntity.GetOrAddFeature(model.FeatureTypeTypeMeasurement, model.RoleTypeServer)
+	f.AddFunctionType(model.FunctionTypeMeasurementDescriptionListData, true, false)
+	f.AddFunctionType(model.FunctionTypeMeasurementListData, true, false)
+
+	var measurementId model.MeasurementIdType = 0
+	// get the highest MeasurementId
+	for _, desc := range util.GetLocalMeasurementDescriptionsForTypeCommodityScope(e.service, model.EntityTypeTypeCEM, "", "", "") {
+		if desc.MeasurementId != nil && *desc.MeasurementId >= measurementId {
+			measurementId = *desc.MeasurementId + 1
+		}
+	}
+
+	measurementDesc, err := spine.LocalFeatureDataCopyOfType[*model.MeasurementDescriptionListDataType](
+		f, model.FunctionTypeMeasurementDescriptionListData)
+	if err != nil || measurementDesc == nil {
+		measurementDesc = &model.MeasurementDescriptionListDataType{}
+	}
+
+	var paramDescs []model.ElectricalConnectionParameterDescriptionDataType
+
+	for index, item := range measurements {
+		id := measurementId + model.MeasurementIdType(index)
+		e.measurementIds[measurementKey{scope: item.scope, phase: item.phase}] = id
+
+		measurementDesc.MeasurementDescriptionData = append(measurementDesc.MeasurementDescriptionData,
+			model.MeasurementDescriptionDataType{
+				MeasurementId:   eebusutil.Ptr(id),
+				MeasurementType: eebusutil.Ptr(item.measurementType),
+				CommodityType:   eebusutil.Ptr(model.CommodityTypeTypeElectricity),
+				Unit:            eebusutil.Ptr(item.unit),
+				ScopeType:       eebusutil.Ptr(item.scope),
+			})
+
+		paramDesc := model.ElectricalConnectionParameterDescriptionDataType{
+			ElectricalConnectionId: eebusutil.Ptr(util.LocalGridElectricalConnectionId),
+			ParameterId:            eebusutil.Ptr(model.ElectricalConnectionParameterIdType(index)),
+			MeasurementId:          eebusutil.Ptr(id),
+			VoltageType:            eebusutil.Ptr(model.ElectricalConnectionVoltageTypeTypeAc),
+			AcMeasurementVariant:   eebusutil.Ptr(model.ElectricalConnectionMeasurandVariantTypeRms),
+		}
+		if item.phase != "" {
+			paramDesc.AcMeasuredPhases = eebusutil.Ptr(item.phase)
+		}
+		if item.referenceTo != "" {
+			paramDesc.AcMeasuredInReferenceTo = eebusutil.Ptr(item.referenceTo)
+		}
+		if item.acType != "" {
+			paramDesc.AcMeasurementType = eebusutil.Ptr(item.acType)
+		}
+		paramDescs = append(paramDescs, paramDesc)
+	}
+	f.SetData(model.FunctionTypeMeasurementDescriptionListData, measurementDesc)
+
+	f = localEntity.GetOrAddFeature(model.FeatureTypeTypeElectricalConnection, model.RoleTypeServer)
+	f.AddFunctionType(model.FunctionTypeElectricalConnectionDescriptionListData, true, false)
+	f.AddFunctionType(model.FunctionTypeElectricalConnectionParameterDescriptionListData, true, false)
+
+	elDesc, err := spine.LocalFeatureDataCopyOfType[*model.ElectricalConnectionDescriptionListDataType](
+		f, model.FunctionTypeElectricalConnectionDescriptionListData)
+	if err != nil || elDesc == nil {
+		elDesc = &model.ElectricalConnectionDescriptionListDataType{}
+	}
+
+	elDesc.ElectricalConnectionDescriptionData = append(elDesc.ElectricalConnectionDescriptionData,
+		model.ElectricalConnectionDescriptionDataType{
+			ElectricalConnectionId:  eebusutil.Ptr(util.LocalGridElectricalConnectionId),
+			PowerSupplyType:         eebusutil.Ptr(model.ElectricalConnectionVoltageTypeTypeAc),
+			AcConnectedPhases:       eebusutil.Ptr(uint(len(util.PhaseNameMapping))),
+			PositiveEnergyDirection: eebusutil.Ptr(model.EnergyDirectionTypeConsume),
+		})
+	f.SetData(model.FunctionTypeElectricalConnectionDescriptionListData, elDesc)
+
+	elParamDesc, err := spine.LocalFeatureDataCopyOfType[*model.ElectricalConnectionParameterDescriptionListDataType](
+		f, model.FunctionTypeElectricalConnectionParameterDescriptionListData)
+	if err != nil || elParamDesc == nil {
+		elParamDesc = &model.ElectricalConnectionParameterDescriptionListDataType{}
+	}
+
+	elParamDesc.ElectricalConnectionParameterDescriptionData = append(elParamDesc.ElectricalConnectionParameterDescriptionData, paramDescs...)
+	f.SetData(model.FunctionTypeElectricalConnectionParameterDescriptionListData, elParamDesc)
+
+	// the key values are writeable, as the feature is shared with the LPC and LPP server use cases
+	f = localEntity.GetOrAddFeature(model.FeatureTypeTypeDeviceConfiguration, model.RoleTypeServer)
+	f.AddFunctionType(model.FunctionTypeDeviceConfigurationKeyValueDescriptionListData, true, false)
+	f.AddFunctionType(model.FunctionTypeDeviceConfigurationKeyValueListData, true, true)
+
+	var configId model.DeviceConfigurationKeyIdType = 0
+	// get the highest keyId
+	deviceConfigDesc, err := spine.LocalFeatureDataCopyOfType[*model.DeviceConfigurationKeyValueDescriptionListDataType](
+		f, model.FunctionTypeDeviceConfigurationKeyValueDescriptionListData)
+	if err != nil || deviceConfigDesc == nil {
+		deviceConfigDesc = &model.DeviceConfigurationKeyValueDescriptionListDataType{}
+	}
+	for _, desc := range deviceConfigDesc.DeviceConfigurationKeyValueDescriptionData {
+		if desc.KeyId != nil && *desc.KeyId >= configId {
+			configId = *desc.KeyId + 1
+		}
+	}
+
+	deviceConfigDesc.DeviceConfigurationKeyValueDescriptionData = append(deviceConfigDesc.DeviceConfigurationKeyValueDescriptionData,
+		model.DeviceConfigurationKeyValueDescriptionDataType{
+			KeyId:     eebusutil.Ptr(configId),
+			KeyName:   eebusutil.Ptr(model.DeviceConfigurationKeyNameTypePvCurtailmentLimitFactor),
+			ValueType: eebusutil.Ptr(model.DeviceConfigurationKeyValueTypeTypeScaledNumber),
+			Unit:      eebusutil.Ptr(model.UnitOfMeasurementTypepct),
+		})
+	f.SetData(model.FunctionTypeDeviceConfigurationKeyValueDescriptionListData, deviceConfigDesc)
+}
+
+func (e *UCMGCPServer) AddUseCase() {
+	localEntity := e.service.LocalDevice().EntityForType(model.EntityTypeTypeCEM)
+
+	localEntity.AddUseCaseSupport(
+		model.UseCaseActorTypeGridConnectionPoint,
+		e.UseCaseName(),
+		model.SpecificationVersionType("1.0.0"),
+		"release",
+		true,
+		[]model.UseCaseScenarioSupportType{1, 2, 3, 4, 5, 6, 7})
+}
+
+func (e *UCMGCPServer) UpdateUseCaseAvailability(available bool) {
+	localEntity := e.service.LocalDevice().EntityForType(model.EntityTypeTypeCEM)
+
+	localEntity.SetUseCaseAvailability(model.UseCaseActorTypeGridConnectionPoint, e.UseCaseName(), available)
+}
+
+// returns if the entity supports the usecase
+//
+// possible errors:
+//   - ErrDataNotAvailable if that information is not (yet) available
+//   - and others
+func (e *UCMGCPServer) IsUseCaseSupported(entity spineapi.EntityRemoteInterface) (bool, error) {
+	if !util.IsCompatibleEntity(entity, e.validEntityTypes) {
+		return false, api.ErrNoCompatibleEntity
+	}
+
+	// check if the usecase and mandatory scenarios are supported
+	if !entity.Device().VerifyUseCaseScenariosAndFeaturesSupport(
+		model.UseCaseActorTypeMonitoringAppliance,
+		e.UseCaseName(),
+		[]model.UseCaseScenarioSupportType{2, 3, 4},
+		[]model.FeatureTypeType{},
+	) {
+		return false, nil
+	}
+
+	return true, nil
+}
+
+// set the values of the measurements for a scope and the given phases
+func (e *UCMGCPServer) setMeasurementValues(
+	scope model.ScopeTypeType,
+	phases []model.ElectricalConnectionPhaseNameType,
+	values []float64,
+) error {
+	if len(values) == 0 || len(values) > len(phases) {
+		return errors.New("invalid number of values")
+	}
+
+	timestamp := time.Now()
+
+	var data []model.MeasurementDataType
+	for index, value := range values {
+		measurementId, ok := e.measurementIds[measurementKey{scope: scope, phase: phases[index]}]
+		if !ok {
+			return eebusapi.ErrDataNotAvailable
+		}
+
+		data = append(data, model.MeasurementDataType{
+			MeasurementId: eebusutil.Ptr(measurementId),
+			ValueType:     eebusutil.Ptr(model.MeasurementValueTypeTypeValue),
+			Timestamp:     model.NewAbsoluteOrRelativeTimeTypeFromTime(timestamp),
+			Value:         model.NewScaledNumberType(value),
+			ValueSource:   eebusutil.Ptr(model.MeasurementValueSourceTypeMeasuredValue),
+		})
+	}
+
+	return util.SetLocalMeasurementData(e.service, model.EntityTypeTypeCEM, data)
+}
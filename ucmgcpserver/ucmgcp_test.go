@@ -0,0 +1,80 @@
+package ucmgcpserver
+
+import (
+	"github.com/enbility/cemd/util"
+	eebusutil "github.com/enbility/eebus-go/util"
+	"github.com/enbility/spine-go/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *UCMGCPServerSuite) Test_AddFeatures() {
+	descs := util.GetLocalMeasurementDescriptionsForTypeCommodityScope(s.service, model.EntityTypeTypeCEM, "", "", "")
+	assert.Equal(s.T(), len(measurements), len(descs))
+
+	elParamDesc, ok := s.electricalConnectionFeature.DataCopy(
+		model.FunctionTypeElectricalConnectionParameterDescriptionListData).(*model.ElectricalConnectionParameterDescriptionListDataType)
+	assert.True(s.T(), ok)
+	assert.Equal(s.T(), len(measurements), len(elParamDesc.ElectricalConnectionParameterDescriptionData))
+	assert.Equal(s.T(), util.LocalGridElectricalConnectionId, *elParamDesc.ElectricalConnectionParameterDescriptionData[0].ElectricalConnectionId)
+
+	elDesc, ok := s.electricalConnectionFeature.DataCopy(
+		model.FunctionTypeElectricalConnectionDescriptionListData).(*model.ElectricalConnectionDescriptionListDataType)
+	assert.True(s.T(), ok)
+	assert.Equal(s.T(), 1, len(elDesc.ElectricalConnectionDescriptionData))
+	assert.Equal(s.T(), model.EnergyDirectionTypeConsume, *elDesc.ElectricalConnectionDescriptionData[0].PositiveEnergyDirection)
+
+	desc := util.GetLocalDeviceConfigurationDescriptionForKeyName(s.service, model.DeviceConfigurationKeyNameTypePvCurtailmentLimitFactor)
+	assert.NotNil(s.T(), desc.KeyId)
+	assert.Equal(s.T(), model.DeviceConfigurationKeyIdType(0), *desc.KeyId)
+
+	// existing descriptions are kept
+	sut := NewUCMGCP(s.service, s.Event)
+	sut.AddFeatures()
+
+	descs = util.GetLocalMeasurementDescriptionsForTypeCommodityScope(s.service, model.EntityTypeTypeCEM, "", "", "")
+	assert.Equal(s.T(), 2*len(measurements), len(descs))
+
+	deviceConfigDesc, ok := s.deviceConfigurationFeature.DataCopy(
+		model.FunctionTypeDeviceConfigurationKeyValueDescriptionListData).(*model.DeviceConfigurationKeyValueDescriptionListDataType)
+	assert.True(s.T(), ok)
+	assert.Equal(s.T(), 2, len(deviceConfigDesc.DeviceConfigurationKeyValueDescriptionData))
+	assert.Equal(s.T(), model.DeviceConfigurationKeyIdType(1), *deviceConfigDesc.DeviceConfigurationKeyValueDescriptionData[1].KeyId)
+}
+
+func (s *UCMGCPServerSuite) Test_UpdateUseCaseAvailability() {
+	s.sut.UpdateUseCaseAvailability(true)
+}
+
+func (s *UCMGCPServerSuite) Test_IsUseCaseSupported() {
+	data, err := s.sut.IsUseCaseSupported(s.mockRemoteEntity)
+	assert.NotNil(s.T(), err)
+	assert.Equal(s.T(), false, data)
+
+	data, err = s.sut.IsUseCaseSupported(s.monitoredEntity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), false, data)
+
+	ucData := &model.NodeManagementUseCaseDataType{
+		UseCaseInformation: []model.UseCaseInformationDataType{
+			{
+				Actor: eebusutil.Ptr(model.UseCaseActorTypeMonitoringAppliance),
+				UseCaseSupport: []model.UseCaseSupportType{
+					{
+						UseCaseName:      eebusutil.Ptr(model.UseCaseNameTypeMonitoringOfGridConnectionPoint),
+						UseCaseAvailable: eebusutil.Ptr(true),
+						ScenarioSupport:  []model.UseCaseScenarioSupportType{1, 2, 3, 4, 5, 6, 7},
+					},
+				},
+			},
+		},
+	}
+
+	nodemgmtEntity := s.remoteDevice.Entity([]model.AddressEntityType{0})
+	nodeFeature := s.remoteDevice.FeatureByEntityTypeAndRole(nodemgmtEntity, model.FeatureTypeTypeNodeManagement, model.RoleTypeSpecial)
+	fErr := nodeFeature.UpdateData(model.FunctionTypeNodeManagementUseCaseData, ucData, nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	data, err = s.sut.IsUseCaseSupported(s.monitoredEntity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), true, data)
+}
@@ -0,0 +1,73 @@
+package ucmpcserver
+
+import (
+	"github.com/enbility/cemd/api"
+)
+
+//go:generate mockery
+
+// interface for the Monitoring of Power Consumption UseCase as a Monitored Unit
+type UCMPCServerInterface interface {
+	api.UseCaseInterface
+
+	// Scenario 1
+
+	// set the momentary active power consumption or production
+	//
+	// parameters:
+	//   - value: the power in W
+	//
+	//   - positive values are used for consumption
+	//   - negative values are used for production
+	SetPower(value float64) (resultErr error)
+
+	// set the momentary active phase specific power consumption or production per phase
+	//
+	// parameters:
+	//   - values: the power in W for up to 3 phases, in the order of phase A, B and C
+	//
+	//   - positive values are used for consumption
+	//   - negative values are used for production
+	SetPowerPerPhase(values []float64) (resultErr error)
+
+	// Scenario 2
+
+	// set the total consumption energy
+	//
+	// parameters:
+	//   - value: the energy in Wh
+	SetEnergyConsumed(value float64) (resultErr error)
+
+	// set the total feed in energy
+	//
+	// parameters:
+	//   - value: the energy in Wh
+	SetEnergyProduced(value float64) (resultErr error)
+
+	// Scenario 3
+
+	// set the momentary phase specific current consumption or production
+	//
+	// parameters:
+	//   - values: the current in A for up to 3 phases, in the order of phase A, B and C
+	//
+	//   - positive values are used for consumption
+	//   - negative values are used for production
+	SetCurrentPerPhase(values []float64) (resultErr error)
+
+	// Scenario 4
+
+	// set the phase specific voltage details
+	//
+	// parameters:
+	//   - values: the voltage in V for up to 3 phases, in the order of phase A, B and C
+	SetVoltagePerPhase(values []float64) (resultErr error)
+
+	// Scenario 5
+
+	// set frequency
+	//
+	// parameters:
+	//   - value: the frequency in Hz
+	SetFrequency(value float64) (resultErr error)
+}
@@ -0,0 +1,63 @@
+package ucmpcserver
+
+import (
+	"github.com/enbility/cemd/util"
+	"github.com/enbility/spine-go/model"
+)
+
+// the phase name used for measurements over all phases
+var totalPhases = []model.ElectricalConnectionPhaseNameType{model.ElectricalConnectionPhaseNameTypeAbc}
+
+// Scenario 1
+
+// set the momentary active power consumption or production
+//
+//   - positive values are used for consumption
+//   - negative values are used for production
+func (e *UCMPCServer) SetPower(value float64) error {
+	return e.setMeasurementValues(model.ScopeTypeTypeACPowerTotal, totalPhases, []float64{value})
+}
+
+// set the momentary active phase specific power consumption or production per phase
+//
+//   - positive values are used for consumption
+//   - negative values are used for production
+func (e *UCMPCServer) SetPowerPerPhase(values []float64) error {
+	return e.setMeasurementValues(model.ScopeTypeTypeACPower, util.PhaseNameMapping, values)
+}
+
+// Scenario 2
+
+// set the total consumption energy
+func (e *UCMPCServer) SetEnergyConsumed(value float64) error {
+	return e.setMeasurementValues(model.ScopeTypeTypeACEnergyConsumed, totalPhases, []float64{value})
+}
+
+// set the total feed in energy
+func (e *UCMPCServer) SetEnergyProduced(value float64) error {
+	return e.setMeasurementValues(model.ScopeTypeTypeACEnergyProduced, totalPhases, []float64{value})
+}
+
+// Scenario 3
+
+// set the momentary phase specific current consumption or production
+//
+//   - positive values are used for consumption
+//   - negative values are used for production
+func (e *UCMPCServer) SetCurrentPerPhase(values []float64) error {
+	return e.setMeasurementValues(model.ScopeTypeTypeACCurrent, util.PhaseNameMapping, values)
+}
+
+// Scenario 4
+
+// set the phase specific voltage details
+func (e *UCMPCServer) SetVoltagePerPhase(values []float64) error {
+	return e.setMeasurementValues(model.ScopeTypeTypeACVoltage, util.PhaseNameMapping, values)
+}
+
+// Scenario 5
+
+// set frequency
+func (e *UCMPCServer) SetFrequency(value float64) error {
+	return e.setMeasurementValues(model.ScopeTypeTypeACFrequency, []model.ElectricalConnectionPhaseNameType{""}, []float64{value})
+}
@@ -0,0 +1,86 @@
+package ucmpcserver
+
+import (
+	"github.com/enbility/spine-go/model"
+	"github.com/stretchr/testify/assert"
+)
+
+// return the local measurement values for a scope
+func (s *UCMPCServerSuite) measurementValues(scope model.ScopeTypeType) []float64 {
+	var result []float64
+
+	data, ok := s.measurementFeature.DataCopy(model.FunctionTypeMeasurementListData).(*model.MeasurementListDataType)
+	if !ok || data == nil {
+		return result
+	}
+
+	for _, item := range measurements {
+		if item.scope != scope {
+			continue
+		}
+
+		id := s.sut.measurementIds[measurementKey{scope: item.scope, phase: item.phase}]
+		for _, value := range data.MeasurementData {
+			if value.MeasurementId != nil && *value.MeasurementId == id && value.Value != nil {
+				result = append(result, value.Value.GetValue())
+			}
+		}
+	}
+
+	return result
+}
+
+func (s *UCMPCServerSuite) Test_Power() {
+	err := s.sut.SetPower(4200)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []float64{4200}, s.measurementValues(model.ScopeTypeTypeACPowerTotal))
+
+	err = s.sut.SetPowerPerPhase(nil)
+	assert.NotNil(s.T(), err)
+
+	err = s.sut.SetPowerPerPhase([]float64{1000, 1200, 1400, 1600})
+	assert.NotNil(s.T(), err)
+
+	err = s.sut.SetPowerPerPhase([]float64{1000})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []float64{1000}, s.measurementValues(model.ScopeTypeTypeACPower))
+
+	err = s.sut.SetPowerPerPhase([]float64{1000, 1200, 1400})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []float64{1000, 1200, 1400}, s.measurementValues(model.ScopeTypeTypeACPower))
+
+	// other values are kept
+	assert.Equal(s.T(), []float64{4200}, s.measurementValues(model.ScopeTypeTypeACPowerTotal))
+}
+
+func (s *UCMPCServerSuite) Test_Energy() {
+	err := s.sut.SetEnergyConsumed(1000)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []float64{1000}, s.measurementValues(model.ScopeTypeTypeACEnergyConsumed))
+
+	err = s.sut.SetEnergyProduced(500)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []float64{500}, s.measurementValues(model.ScopeTypeTypeACEnergyProduced))
+}
+
+func (s *UCMPCServerSuite) Test_CurrentPerPhase() {
+	err := s.sut.SetCurrentPerPhase([]float64{10, 12, 14})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []float64{10, 12, 14}, s.measurementValues(model.ScopeTypeTypeACCurrent))
+}
+
+func (s *UCMPCServerSuite) Test_VoltagePerPhase() {
+	err := s.sut.SetVoltagePerPhase([]float64{230, 231, 232})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []float64{230, 231, 232}, s.measurementValues(model.ScopeTypeTypeACVoltage))
+}
+
+func (s *UCMPCServerSuite) Test_Frequency() {
+	err := s.sut.SetFrequency(50)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []float64{50}, s.measurementValues(model.ScopeTypeTypeACFrequency))
+
+	sut := NewUCMPC(s.service, s.Event)
+	err = sut.SetFrequency(50)
+	assert.NotNil(s.T(), err)
+}
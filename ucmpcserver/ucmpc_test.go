@@ -0,0 +1,82 @@
+package ucmpcserver
+
+import (
+	"github.com/enbility/cemd/util"
+	eebusutil "github.com/enbility/eebus-go/util"
+	"github.com/enbility/spine-go/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *UCMPCServerSuite) Test_AddFeatures() {
+	descs := util.GetLocalMeasurementDescriptionsForTypeCommodityScope(s.service, model.EntityTypeTypeCEM, "", "", "")
+	assert.Equal(s.T(), len(measurements), len(descs))
+
+	elParamDesc, ok := s.electricalConnectionFeature.DataCopy(
+		model.FunctionTypeElectricalConnectionParameterDescriptionListData).(*model.ElectricalConnectionParameterDescriptionListDataType)
+	assert.True(s.T(), ok)
+	assert.Equal(s.T(), len(measurements), len(elParamDesc.ElectricalConnectionParameterDescriptionData))
+
+	// the total power parameter is shared with the LPC and LPP server use cases
+	powerDescs := util.GetLocalMeasurementDescriptionsForTypeCommodityScope(
+		s.service, model.EntityTypeTypeCEM, model.MeasurementTypeTypePower, model.CommodityTypeTypeElectricity, model.ScopeTypeTypeACPowerTotal)
+	assert.Equal(s.T(), 1, len(powerDescs))
+	param := elParamDesc.ElectricalConnectionParameterDescriptionData[0]
+	assert.Equal(s.T(), util.LocalElectricalConnectionId, *param.ElectricalConnectionId)
+	assert.Equal(s.T(), util.LocalElectricalConnectionParameterIdPowerTotal, *param.ParameterId)
+	assert.Equal(s.T(), *powerDescs[0].MeasurementId, *param.MeasurementId)
+
+	elDesc, ok := s.electricalConnectionFeature.DataCopy(
+		model.FunctionTypeElectricalConnectionDescriptionListData).(*model.ElectricalConnectionDescriptionListDataType)
+	assert.True(s.T(), ok)
+	assert.Equal(s.T(), 1, len(elDesc.ElectricalConnectionDescriptionData))
+	assert.Equal(s.T(), model.EnergyDirectionTypeConsume, *elDesc.ElectricalConnectionDescriptionData[0].PositiveEnergyDirection)
+
+	// existing measurement descriptions are kept
+	sut := NewUCMPC(s.service, s.Event)
+	sut.AddFeatures()
+
+	descs = util.GetLocalMeasurementDescriptionsForTypeCommodityScope(s.service, model.EntityTypeTypeCEM, "", "", "")
+	assert.Equal(s.T(), 2*len(measurements), len(descs))
+	assert.Equal(s.T(), model.MeasurementIdType(len(measurements)), sut.measurementIds[measurementKey{
+		scope: model.ScopeTypeTypeACPowerTotal,
+		phase: model.ElectricalConnectionPhaseNameTypeAbc,
+	}])
+}
+
+func (s *UCMPCServerSuite) Test_UpdateUseCaseAvailability() {
+	s.sut.UpdateUseCaseAvailability(true)
+}
+
+func (s *UCMPCServerSuite) Test_IsUseCaseSupported() {
+	data, err := s.sut.IsUseCaseSupported(s.mockRemoteEntity)
+	assert.NotNil(s.T(), err)
+	assert.Equal(s.T(), false, data)
+
+	data, err = s.sut.IsUseCaseSupported(s.monitoredEntity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), false, data)
+
+	ucData := &model.NodeManagementUseCaseDataType{
+		UseCaseInformation: []model.UseCaseInformationDataType{
+			{
+				Actor: eebusutil.Ptr(model.UseCaseActorTypeMonitoringAppliance),
+				UseCaseSupport: []model.UseCaseSupportType{
+					{
+						UseCaseName:      eebusutil.Ptr(model.UseCaseNameTypeMonitoringOfPowerConsumption),
+						UseCaseAvailable: eebusutil.Ptr(true),
+						ScenarioSupport:  []model.UseCaseScenarioSupportType{1, 2, 3, 4, 5},
+					},
+				},
+			},
+		},
+	}
+
+	nodemgmtEntity := s.remoteDevice.Entity([]model.AddressEntityType{0})
+	nodeFeature := s.remoteDevice.FeatureByEntityTypeAndRole(nodemgmtEntity, model.FeatureTypeTypeNodeManagement, model.RoleTypeSpecial)
+	fErr := nodeFeature.UpdateData(model.FunctionTypeNodeManagementUseCaseData, ucData, nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	data, err = s.sut.IsUseCaseSupported(s.monitoredEntity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), true, data)
+}
@@ -0,0 +1,34 @@
+package ucopevserver
+
+import (
+	"github.com/enbility/cemd/api"
+)
+
+//go:generate mockery
+
+// interface for the Overload Protection by EV Charging Current Curtailment UseCase as an EVSE providing the EV data
+//
+// The data is only available while an EV is connected, the use case has to be
+// registered at the EV Commissioning and Configuration server use case
+type UCOPEVServerInterface interface {
+	api.EVUseCaseInterface
+
+	// Scenario 1
+
+	// set the minimum, maximum and default current limits per phase of the connected EV
+	//
+	// parameters:
+	//   - minimum: the minimum current in A for up to 3 phases, in the order of phase A, B and C
+	//   - maximum: the maximum current in A for up to 3 phases, in the order of phase A, B and C
+	//   - defaults: the default current in A for up to 3 phases, in the order of phase A, B and C
+	SetCurrentLimits(minimum, maximum, defaults []float64) (resultErr error)
+
+	// return the current loadcontrol obligation limits of the connected EV
+	//
+	// return values:
+	//   - limits: per phase data
+	//
+	// possible errors:
+	//   - ErrDataNotAvailable if no EV is connected
+	LoadControlLimits() (limits []api.LoadLimitsPhase, resultErr error)
+}
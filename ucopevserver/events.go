@@ -0,0 +1,20 @@
+package ucopevserver
+
+import (
+	"github.com/enbility/cemd/util"
+	spineapi "github.com/enbility/spine-go/api"
+)
+
+// handle SPINE events
+func (e *UCOPEVServer) HandleEvent(payload spineapi.EventPayload) {
+	// only about writes to the obligation limits of the EV
+	e.mux.Lock()
+	limitIds := e.limitIds
+	e.mux.Unlock()
+
+	if !util.LocalEVLoadControlLimitsWritten(payload, limitIds) {
+		return
+	}
+
+	e.eventCB(payload.Ski, payload.Device, payload.Entity, DataUpdateLimit)
+}
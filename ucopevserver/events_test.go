@@ -0,0 +1,60 @@
+package ucopevserver
+
+import (
+	eebusutil "github.com/enbility/eebus-go/util"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *UCOPEVServerSuite) Test_Events() {
+	payload := spineapi.EventPayload{
+		EventType: spineapi.EventTypeDataChange,
+	}
+	s.sut.HandleEvent(payload)
+	assert.Equal(s.T(), "", string(s.eventCalled))
+
+	err := s.evcc.SetEVConnected(true)
+	assert.Nil(s.T(), err)
+
+	evEntity := s.service.LocalDevice().EntityForType(model.EntityTypeTypeEV)
+	payload.LocalFeature = evEntity.FeatureOfTypeAndRole(model.FeatureTypeTypeLoadControl, model.RoleTypeServer)
+	payload.Data = &model.LoadControlLimitListDataType{
+		LoadControlLimitData: []model.LoadControlLimitDataType{
+			{
+				LimitId:       eebusutil.Ptr(model.LoadControlLimitIdType(0)),
+				IsLimitActive: eebusutil.Ptr(true),
+				Value:         model.NewScaledNumberType(16),
+			},
+		},
+	}
+	s.sut.HandleEvent(payload)
+	assert.Equal(s.T(), "", string(s.eventCalled))
+
+	payload.CmdClassifier = eebusutil.Ptr(model.CmdClassifierTypeNotify)
+	s.sut.HandleEvent(payload)
+	assert.Equal(s.T(), "", string(s.eventCalled))
+
+	payload.CmdClassifier = eebusutil.Ptr(model.CmdClassifierTypeWrite)
+	payload.Data = &model.LoadControlLimitListDataType{
+		LoadControlLimitData: []model.LoadControlLimitDataType{
+			{
+				LimitId: eebusutil.Ptr(model.LoadControlLimitIdType(10)),
+			},
+		},
+	}
+	s.sut.HandleEvent(payload)
+	assert.Equal(s.T(), "", string(s.eventCalled))
+
+	payload.Data = &model.LoadControlLimitListDataType{
+		LoadControlLimitData: []model.LoadControlLimitDataType{
+			{
+				LimitId:       eebusutil.Ptr(model.LoadControlLimitIdType(0)),
+				IsLimitActive: eebusutil.Ptr(true),
+				Value:         model.NewScaledNumberType(16),
+			},
+		},
+	}
+	s.sut.HandleEvent(payload)
+	assert.Equal(s.T(), DataUpdateLimit, s.eventCalled)
+}
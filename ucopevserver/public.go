@@ -0,0 +1,37 @@
+package ucopevserver
+
+import (
+	"errors"
+
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/util"
+	"github.com/enbility/spine-go/model"
+)
+
+// Scenario 1
+
+// set the minimum, maximum and default current limits per phase of the connected EV
+func (e *UCOPEVServer) SetCurrentLimits(minimum, maximum, defaults []float64) error {
+	if len(minimum) == 0 || len(minimum) > len(util.LocalEVMeasurementIdsCurrent) ||
+		len(maximum) != len(minimum) || len(defaults) != len(minimum) {
+		return errors.New("invalid number of values")
+	}
+
+	for index := range minimum {
+		parameterId := model.ElectricalConnectionParameterIdType(util.LocalEVMeasurementIdsCurrent[index])
+		if err := util.SetLocalEVPermittedValueSet(e.service, parameterId, minimum[index], maximum[index], defaults[index]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// return the current loadcontrol obligation limits of the connected EV
+func (e *UCOPEVServer) LoadControlLimits() ([]api.LoadLimitsPhase, error) {
+	e.mux.Lock()
+	limitIds := e.limitIds
+	e.mux.Unlock()
+
+	return util.LocalEVLoadControlLimits(e.service, limitIds)
+}
@@ -0,0 +1,78 @@
+package ucopevserver
+
+import (
+	"github.com/enbility/cemd/util"
+	eebusutil "github.com/enbility/eebus-go/util"
+	"github.com/enbility/spine-go/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *UCOPEVServerSuite) Test_CurrentLimits() {
+	err := s.sut.SetCurrentLimits([]float64{6, 6, 6}, []float64{16, 16, 16}, []float64{16, 16, 16})
+	assert.NotNil(s.T(), err)
+
+	err = s.evcc.SetEVConnected(true)
+	assert.Nil(s.T(), err)
+
+	err = s.sut.SetCurrentLimits([]float64{}, []float64{}, []float64{})
+	assert.NotNil(s.T(), err)
+
+	err = s.sut.SetCurrentLimits([]float64{6, 6, 6}, []float64{16, 16}, []float64{16, 16, 16})
+	assert.NotNil(s.T(), err)
+
+	err = s.sut.SetCurrentLimits([]float64{6, 6, 6}, []float64{16, 16, 16}, []float64{0, 0, 0})
+	assert.Nil(s.T(), err)
+
+	evEntity := s.service.LocalDevice().EntityForType(model.EntityTypeTypeEV)
+	feature := evEntity.FeatureOfTypeAndRole(model.FeatureTypeTypeElectricalConnection, model.RoleTypeServer)
+	data, ok := feature.DataCopy(model.FunctionTypeElectricalConnectionPermittedValueSetListData).(*model.ElectricalConnectionPermittedValueSetListDataType)
+	assert.True(s.T(), ok)
+	assert.Equal(s.T(), 3, len(data.ElectricalConnectionPermittedValueSetData))
+
+	for index, item := range data.ElectricalConnectionPermittedValueSetData {
+		assert.Equal(s.T(), model.ElectricalConnectionParameterIdType(util.LocalEVMeasurementIdsCurrent[index]), *item.ParameterId)
+		assert.Equal(s.T(), 6.0, item.PermittedValueSet[0].Range[0].Min.GetValue())
+		assert.Equal(s.T(), 16.0, item.PermittedValueSet[0].Range[0].Max.GetValue())
+	}
+}
+
+func (s *UCOPEVServerSuite) Test_LoadControlLimits() {
+	data, err := s.sut.LoadControlLimits()
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), data)
+
+	err = s.evcc.SetEVConnected(true)
+	assert.Nil(s.T(), err)
+
+	data, err = s.sut.LoadControlLimits()
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 3, len(data))
+	assert.Equal(s.T(), model.ElectricalConnectionPhaseNameTypeA, data[0].Phase)
+	assert.Equal(s.T(), true, data[0].IsChangeable)
+	assert.Equal(s.T(), false, data[0].IsActive)
+
+	evEntity := s.service.LocalDevice().EntityForType(model.EntityTypeTypeEV)
+	feature := evEntity.FeatureOfTypeAndRole(model.FeatureTypeTypeLoadControl, model.RoleTypeServer)
+	desc, ok := feature.DataCopy(model.FunctionTypeLoadControlLimitDescriptionListData).(*model.LoadControlLimitDescriptionListDataType)
+	assert.True(s.T(), ok)
+	assert.Equal(s.T(), 3, len(desc.LoadControlLimitDescriptionData))
+	assert.Equal(s.T(), model.LoadControlCategoryTypeObligation, *desc.LoadControlLimitDescriptionData[0].LimitCategory)
+
+	limits := &model.LoadControlLimitListDataType{
+		LoadControlLimitData: []model.LoadControlLimitDataType{
+			{
+				LimitId:           eebusutil.Ptr(model.LoadControlLimitIdType(0)),
+				IsLimitChangeable: eebusutil.Ptr(true),
+				IsLimitActive:     eebusutil.Ptr(true),
+				Value:             model.NewScaledNumberType(10),
+			},
+		},
+	}
+	feature.SetData(model.FunctionTypeLoadControlLimitListData, limits)
+
+	data, err = s.sut.LoadControlLimits()
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 1, len(data))
+	assert.Equal(s.T(), true, data[0].IsActive)
+	assert.Equal(s.T(), 10.0, data[0].Value)
+}
@@ -0,0 +1,10 @@
+package ucopevserver
+
+import "github.com/enbility/cemd/api"
+
+const (
+	// The CEM wrote new obligation limits for the connected EV
+	//
+	// Use Case OPEV, Scenario 1
+	DataUpdateLimit api.EventType = "ucopevserver-DataUpdateLimit"
+)
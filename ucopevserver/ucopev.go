@@ -0,0 +1,101 @@
+package ucopevserver
+
+import (
+	"sync"
+
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/util"
+	eebusapi "github.com/enbility/eebus-go/api"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/spine"
+)
+
+type UCOPEVServer struct {
+	service eebusapi.ServiceInterface
+
+	eventCB api.EntityEventCallback
+
+	validEntityTypes []model.EntityTypeType
+
+	mux      sync.Mutex
+	limitIds []model.LoadControlLimitIdType // the limits of the connected EV, in the order of phase A, B and C
+}
+
+var _ UCOPEVServerInterface = (*UCOPEVServer)(nil)
+
+func NewUCOPEV(service eebusapi.ServiceInterface, eventCB api.EntityEventCallback) *UCOPEVServer {
+	uc := &UCOPEVServer{
+		service: service,
+		eventCB: eventCB,
+	}
+
+	uc.validEntityTypes = []model.EntityTypeType{
+		model.EntityTypeTypeCEM,
+	}
+
+	_ = spine.Events.Subscribe(uc)
+
+	return uc
+}
+
+func (c *UCOPEVServer) UseCaseName() model.UseCaseNameType {
+	return model.UseCaseNameTypeOverloadProtectionByEVChargingCurrentCurtailment
+}
+
+// the features are added to the EV entity when an EV is connected
+func (e *UCOPEVServer) AddFeatures() {}
+
+// the use case is added to the EV entity when an EV is connected
+func (e *UCOPEVServer) AddUseCase() {}
+
+func (e *UCOPEVServer) AddEVFeatures(entity spineapi.EntityLocalInterface) {
+	limitIds := util.AddLocalEVLoadControlLimits(
+		entity,
+		model.LoadControlCategoryTypeObligation,
+		model.ScopeTypeTypeOverloadProtection)
+
+	e.mux.Lock()
+	e.limitIds = limitIds
+	e.mux.Unlock()
+
+	entity.AddUseCaseSupport(
+		model.UseCaseActorTypeEV,
+		e.UseCaseName(),
+		model.SpecificationVersionType("1.0.1"),
+		"release",
+		true,
+		[]model.UseCaseScenarioSupportType{1, 2, 3})
+}
+
+func (e *UCOPEVServer) UpdateUseCaseAvailability(available bool) {
+	localEntity := e.service.LocalDevice().EntityForType(model.EntityTypeTypeEV)
+	if localEntity == nil {
+		return
+	}
+
+	localEntity.SetUseCaseAvailability(model.UseCaseActorTypeEV, e.UseCaseName(), available)
+}
+
+// returns if the entity supports the usecase
+//
+// possible errors:
+//   - ErrDataNotAvailable if that information is not (yet) available
+//   - and others
+func (e *UCOPEVServer) IsUseCaseSupported(entity spineapi.EntityRemoteInterface) (bool, error) {
+	if entity == nil || !util.IsCompatibleEntity(entity, e.validEntityTypes) {
+		return false, api.ErrNoCompatibleEntity
+	}
+
+	// check if the usecase and mandatory scenarios are supported
+	if !entity.Device().VerifyUseCaseScenariosAndFeaturesSupport(
+		model.UseCaseActorTypeCEM,
+		e.UseCaseName(),
+		[]model.UseCaseScenarioSupportType{1, 2, 3},
+		[]model.FeatureTypeType{},
+	) {
+		return false, nil
+	}
+
+	return true, nil
+}
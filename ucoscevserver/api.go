@@ -0,0 +1,28 @@
+package ucoscevserver
+
+import (
+	"github.com/enbility/cemd/api"
+)
+
+//go:generate mockery
+
+// interface for the Optimization of Self Consumption During EV Charging UseCase as an EVSE providing the EV data
+//
+// The data is only available while an EV is connected, the use case has to be
+// registered at the EV Commissioning and Configuration server use case.
+// The current limits of the EV are provided by the Overload Protection
+// by EV Charging Current Curtailment server use case
+type UCOSCEVServerInterface interface {
+	api.EVUseCaseInterface
+
+	// Scenario 1
+
+	// return the current loadcontrol recommendation limits of the connected EV
+	//
+	// return values:
+	//   - limits: per phase data
+	//
+	// possible errors:
+	//   - ErrDataNotAvailable if no EV is connected
+	LoadControlLimits() (limits []api.LoadLimitsPhase, resultErr error)
+}
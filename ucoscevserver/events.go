@@ -0,0 +1,20 @@
+package ucoscevserver
+
+import (
+	"github.com/enbility/cemd/util"
+	spineapi "github.com/enbility/spine-go/api"
+)
+
+// handle SPINE events
+func (e *UCOSCEVServer) HandleEvent(payload spineapi.EventPayload) {
+	// only about writes to the recommendation limits of the EV
+	e.mux.Lock()
+	limitIds := e.limitIds
+	e.mux.Unlock()
+
+	if !util.LocalEVLoadControlLimitsWritten(payload, limitIds) {
+		return
+	}
+
+	e.eventCB(payload.Ski, payload.Device, payload.Entity, DataUpdateLimit)
+}
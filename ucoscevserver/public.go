@@ -0,0 +1,17 @@
+package ucoscevserver
+
+import (
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/util"
+)
+
+// Scenario 1
+
+// return the current loadcontrol recommendation limits of the connected EV
+func (e *UCOSCEVServer) LoadControlLimits() ([]api.LoadLimitsPhase, error) {
+	e.mux.Lock()
+	limitIds := e.limitIds
+	e.mux.Unlock()
+
+	return util.LocalEVLoadControlLimits(e.service, limitIds)
+}
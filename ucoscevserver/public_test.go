@@ -0,0 +1,50 @@
+package ucoscevserver
+
+import (
+	"github.com/enbility/cemd/ucopevserver"
+	"github.com/enbility/spine-go/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *UCOSCEVServerSuite) Test_LoadControlLimits() {
+	data, err := s.sut.LoadControlLimits()
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), data)
+
+	err = s.evcc.SetEVConnected(true)
+	assert.Nil(s.T(), err)
+
+	data, err = s.sut.LoadControlLimits()
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 3, len(data))
+	assert.Equal(s.T(), model.ElectricalConnectionPhaseNameTypeA, data[0].Phase)
+	assert.Equal(s.T(), false, data[0].IsActive)
+}
+
+func (s *UCOSCEVServerSuite) Test_LimitIds() {
+	opev := ucopevserver.NewUCOPEV(s.service, s.Event)
+	s.evcc.AddEVUseCase(opev)
+
+	err := s.evcc.SetEVConnected(true)
+	assert.Nil(s.T(), err)
+
+	evEntity := s.service.LocalDevice().EntityForType(model.EntityTypeTypeEV)
+	feature := evEntity.FeatureOfTypeAndRole(model.FeatureTypeTypeLoadControl, model.RoleTypeServer)
+	desc, ok := feature.DataCopy(model.FunctionTypeLoadControlLimitDescriptionListData).(*model.LoadControlLimitDescriptionListDataType)
+	assert.True(s.T(), ok)
+	assert.Equal(s.T(), 6, len(desc.LoadControlLimitDescriptionData))
+
+	// OSCEV was registered first, so the obligation limits follow the recommendation limits
+	for index, item := range desc.LoadControlLimitDescriptionData {
+		assert.Equal(s.T(), model.LoadControlLimitIdType(index), *item.LimitId)
+		category := model.LoadControlCategoryTypeRecommendation
+		if index >= 3 {
+			category = model.LoadControlCategoryTypeObligation
+		}
+		assert.Equal(s.T(), category, *item.LimitCategory)
+	}
+
+	data, err := s.sut.LoadControlLimits()
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 3, len(data))
+}
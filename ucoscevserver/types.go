@@ -0,0 +1,10 @@
+package ucoscevserver
+
+import "github.com/enbility/cemd/api"
+
+const (
+	// The CEM wrote new recommendation limits for the connected EV
+	//
+	// Use Case OSCEV, Scenario 1
+	DataUpdateLimit api.EventType = "ucoscevserver-DataUpdateLimit"
+)
@@ -0,0 +1,101 @@
+package ucoscevserver
+
+import (
+	"sync"
+
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/util"
+	eebusapi "github.com/enbility/eebus-go/api"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/spine"
+)
+
+type UCOSCEVServer struct {
+	service eebusapi.ServiceInterface
+
+	eventCB api.EntityEventCallback
+
+	validEntityTypes []model.EntityTypeType
+
+	mux      sync.Mutex
+	limitIds []model.LoadControlLimitIdType // the limits of the connected EV, in the order of phase A, B and C
+}
+
+var _ UCOSCEVServerInterface = (*UCOSCEVServer)(nil)
+
+func NewUCOSCEV(service eebusapi.ServiceInterface, eventCB api.EntityEventCallback) *UCOSCEVServer {
+	uc := &UCOSCEVServer{
+		service: service,
+		eventCB: eventCB,
+	}
+
+	uc.validEntityTypes = []model.EntityTypeType{
+		model.EntityTypeTypeCEM,
+	}
+
+	_ = spine.Events.Subscribe(uc)
+
+	return uc
+}
+
+func (c *UCOSCEVServer) UseCaseName() model.UseCaseNameType {
+	return model.UseCaseNameTypeOptimizationOfSelfConsumptionDuringEVCharging
+}
+
+// the features are added to the EV entity when an EV is connected
+func (e *UCOSCEVServer) AddFeatures() {}
+
+// the use case is added to the EV entity when an EV is connected
+func (e *UCOSCEVServer) AddUseCase() {}
+
+func (e *UCOSCEVServer) AddEVFeatures(entity spineapi.EntityLocalInterface) {
+	limitIds := util.AddLocalEVLoadControlLimits(
+		entity,
+		model.LoadControlCategoryTypeRecommendation,
+		model.ScopeTypeTypeSelfConsumption)
+
+	e.mux.Lock()
+	e.limitIds = limitIds
+	e.mux.Unlock()
+
+	entity.AddUseCaseSupport(
+		model.UseCaseActorTypeEV,
+		e.UseCaseName(),
+		model.SpecificationVersionType("1.0.1"),
+		"release",
+		true,
+		[]model.UseCaseScenarioSupportType{1, 2, 3})
+}
+
+func (e *UCOSCEVServer) UpdateUseCaseAvailability(available bool) {
+	localEntity := e.service.LocalDevice().EntityForType(model.EntityTypeTypeEV)
+	if localEntity == nil {
+		return
+	}
+
+	localEntity.SetUseCaseAvailability(model.UseCaseActorTypeEV, e.UseCaseName(), available)
+}
+
+// returns if the entity supports the usecase
+//
+// possible errors:
+//   - ErrDataNotAvailable if that information is not (yet) available
+//   - and others
+func (e *UCOSCEVServer) IsUseCaseSupported(entity spineapi.EntityRemoteInterface) (bool, error) {
+	if entity == nil || !util.IsCompatibleEntity(entity, e.validEntityTypes) {
+		return false, api.ErrNoCompatibleEntity
+	}
+
+	// check if the usecase and mandatory scenarios are supported
+	if !entity.Device().VerifyUseCaseScenariosAndFeaturesSupport(
+		model.UseCaseActorTypeCEM,
+		e.UseCaseName(),
+		[]model.UseCaseScenarioSupportType{1, 2, 3},
+		[]model.FeatureTypeType{},
+	) {
+		return false, nil
+	}
+
+	return true, nil
+}
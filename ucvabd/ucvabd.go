@@ -83,7 +83,7 @@ func (e *UCVABD) IsUseCaseSupported(entity spineapi.EntityRemoteInterface) (bool
 	// check if the usecase and mandatory scenarios are supported and
 	// if the required server features are available
 	if !entity.Device().VerifyUseCaseScenariosAndFeaturesSupport(
-		model.UseCaseActorTypePVSystem,
+		model.UseCaseActorTypeBatterySystem,
 		e.UseCaseName(),
 		[]model.UseCaseScenarioSupportType{1, 4},
 		[]model.FeatureTypeType{
@@ -22,7 +22,7 @@ func (s *UCVABDSuite) Test_IsUseCaseSupported() {
 	ucData := &model.NodeManagementUseCaseDataType{
 		UseCaseInformation: []model.UseCaseInformationDataType{
 			{
-				Actor: eebusutil.Ptr(model.UseCaseActorTypePVSystem),
+				Actor: eebusutil.Ptr(model.UseCaseActorTypeBatterySystem),
 				UseCaseSupport: []model.UseCaseSupportType{
 					{
 						UseCaseName:      eebusutil.Ptr(model.UseCaseNameTypeVisualizationOfAggregatedBatteryData),
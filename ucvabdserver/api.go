@@ -0,0 +1,47 @@
+package ucvabdserver
+
+import (
+	"github.com/enbility/cemd/api"
+)
+
+//go:generate mockery
+
+// interface for the Visualization of Aggregated Battery Data UseCase as a Battery System
+type UCVABDServerInterface interface {
+	api.UseCaseInterface
+
+	// Scenario 1
+
+	// set the current (dis)charging power
+	//
+	// parameters:
+	//   - value: the power in W
+	//
+	//   - positive values are used for charging
+	//   - negative values are used for discharging
+	SetPower(value float64) (resultErr error)
+
+	// Scenario 2
+
+	// set the cumulated battery system charge energy
+	//
+	// parameters:
+	//   - value: the energy in Wh
+	SetEnergyCharged(value float64) (resultErr error)
+
+	// Scenario 3
+
+	// set the cumulated battery system discharge energy
+	//
+	// parameters:
+	//   - value: the energy in Wh
+	SetEnergyDischarged(value float64) (resultErr error)
+
+	// Scenario 4
+
+	// set the current state of charge of the battery system
+	//
+	// parameters:
+	//   - value: the state of charge in %
+	SetStateOfCharge(value float64) (resultErr error)
+}
@@ -0,0 +1,36 @@
+package ucvabdserver
+
+import (
+	"github.com/enbility/spine-go/model"
+)
+
+// Scenario 1
+
+// set the current (dis)charging power
+//
+//   - positive values are used for charging
+//   - negative values are used for discharging
+func (e *UCVABDServer) SetPower(value float64) error {
+	return e.setMeasurementValue(model.ScopeTypeTypeACPowerTotal, value)
+}
+
+// Scenario 2
+
+// set the cumulated battery system charge energy
+func (e *UCVABDServer) SetEnergyCharged(value float64) error {
+	return e.setMeasurementValue(model.ScopeTypeTypeCharge, value)
+}
+
+// Scenario 3
+
+// set the cumulated battery system discharge energy
+func (e *UCVABDServer) SetEnergyDischarged(value float64) error {
+	return e.setMeasurementValue(model.ScopeTypeTypeDischarge, value)
+}
+
+// Scenario 4
+
+// set the current state of charge of the battery system
+func (e *UCVABDServer) SetStateOfCharge(value float64) error {
+	return e.setMeasurementValue(model.ScopeTypeTypeStateOfCharge, value)
+}
@@ -0,0 +1,58 @@
+package ucvabdserver
+
+import (
+	"github.com/enbility/spine-go/model"
+	"github.com/stretchr/testify/assert"
+)
+
+// return the local measurement value for a scope
+func (s *UCVABDServerSuite) measurementValue(scope model.ScopeTypeType) *float64 {
+	data, ok := s.measurementFeature.DataCopy(model.FunctionTypeMeasurementListData).(*model.MeasurementListDataType)
+	if !ok || data == nil {
+		return nil
+	}
+
+	id := s.sut.measurementIds[scope]
+	for _, item := range data.MeasurementData {
+		if item.MeasurementId != nil && *item.MeasurementId == id && item.Value != nil {
+			value := item.Value.GetValue()
+			return &value
+		}
+	}
+
+	return nil
+}
+
+func (s *UCVABDServerSuite) Test_Power() {
+	err := s.sut.SetPower(-2500)
+	assert.Nil(s.T(), err)
+
+	value := s.measurementValue(model.ScopeTypeTypeACPowerTotal)
+	assert.NotNil(s.T(), value)
+	assert.Equal(s.T(), -2500.0, *value)
+}
+
+func (s *UCVABDServerSuite) Test_Energy() {
+	err := s.sut.SetEnergyCharged(1000)
+	assert.Nil(s.T(), err)
+
+	err = s.sut.SetEnergyDischarged(800)
+	assert.Nil(s.T(), err)
+
+	value := s.measurementValue(model.ScopeTypeTypeCharge)
+	assert.NotNil(s.T(), value)
+	assert.Equal(s.T(), 1000.0, *value)
+
+	value = s.measurementValue(model.ScopeTypeTypeDischarge)
+	assert.NotNil(s.T(), value)
+	assert.Equal(s.T(), 800.0, *value)
+}
+
+func (s *UCVABDServerSuite) Test_StateOfCharge() {
+	err := s.sut.SetStateOfCharge(75)
+	assert.Nil(s.T(), err)
+
+	value := s.measurementValue(model.ScopeTypeTypeStateOfCharge)
+	assert.NotNil(s.T(), value)
+	assert.Equal(s.T(), 75.0, *value)
+}
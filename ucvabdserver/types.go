@@ -0,0 +1,15 @@
+package ucvabdserver
+
+import "github.com/enbility/cemd/api"
+
+const (
+	// A Visualization Appliance subscribed to the Battery System data
+	//
+	// Use Case VABD, Scenario 1-4
+	VisualizationApplianceSubscribed api.EventType = "ucvabdserver-VisualizationApplianceSubscribed"
+
+	// A Visualization Appliance unsubscribed from the Battery System data
+	//
+	// Use Case VABD, Scenario 1-4
+	VisualizationApplianceUnsubscribed api.EventType = "ucvabdserver-VisualizationApplianceUnsubscribed"
+)
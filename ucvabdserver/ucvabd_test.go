@@ -0,0 +1,80 @@
+package ucvabdserver
+
+import (
+	"github.com/enbility/cemd/util"
+	eebusutil "github.com/enbility/eebus-go/util"
+	"github.com/enbility/spine-go/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *UCVABDServerSuite) Test_AddFeatures() {
+	descs := util.GetLocalMeasurementDescriptionsForTypeCommodityScope(s.service, model.EntityTypeTypeElectricityStorageSystem, "", "", "")
+	assert.Equal(s.T(), len(measurements), len(descs))
+
+	// nothing is added to the CEM entity
+	descs = util.GetLocalMeasurementDescriptionsForTypeCommodityScope(s.service, model.EntityTypeTypeCEM, "", "", "")
+	assert.Equal(s.T(), 0, len(descs))
+
+	elParamDesc, ok := s.electricalConnectionFeature.DataCopy(
+		model.FunctionTypeElectricalConnectionParameterDescriptionListData).(*model.ElectricalConnectionParameterDescriptionListDataType)
+	assert.True(s.T(), ok)
+	// the state of charge is not related to the electrical connection
+	assert.Equal(s.T(), len(measurements)-1, len(elParamDesc.ElectricalConnectionParameterDescriptionData))
+
+	elDesc, ok := s.electricalConnectionFeature.DataCopy(
+		model.FunctionTypeElectricalConnectionDescriptionListData).(*model.ElectricalConnectionDescriptionListDataType)
+	assert.True(s.T(), ok)
+	assert.Equal(s.T(), 1, len(elDesc.ElectricalConnectionDescriptionData))
+	assert.Equal(s.T(), model.EnergyDirectionTypeConsume, *elDesc.ElectricalConnectionDescriptionData[0].PositiveEnergyDirection)
+
+}
+
+func (s *UCVABDServerSuite) Test_MissingStorageSystemEntity() {
+	sut := NewUCVABD(s.newService(model.EntityTypeTypeCEM), nil)
+
+	// nothing is added without the entity
+	sut.AddFeatures()
+	sut.AddUseCase()
+	sut.UpdateUseCaseAvailability(true)
+
+	err := sut.SetStateOfCharge(100)
+	assert.NotNil(s.T(), err)
+}
+
+func (s *UCVABDServerSuite) Test_UpdateUseCaseAvailability() {
+	s.sut.UpdateUseCaseAvailability(true)
+}
+
+func (s *UCVABDServerSuite) Test_IsUseCaseSupported() {
+	data, err := s.sut.IsUseCaseSupported(s.mockRemoteEntity)
+	assert.NotNil(s.T(), err)
+	assert.Equal(s.T(), false, data)
+
+	data, err = s.sut.IsUseCaseSupported(s.visualizationEntity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), false, data)
+
+	ucData := &model.NodeManagementUseCaseDataType{
+		UseCaseInformation: []model.UseCaseInformationDataType{
+			{
+				Actor: eebusutil.Ptr(model.UseCaseActorTypeVisualizationAppliance),
+				UseCaseSupport: []model.UseCaseSupportType{
+					{
+						UseCaseName:      eebusutil.Ptr(model.UseCaseNameTypeVisualizationOfAggregatedBatteryData),
+						UseCaseAvailable: eebusutil.Ptr(true),
+						ScenarioSupport:  []model.UseCaseScenarioSupportType{1, 2, 3, 4},
+					},
+				},
+			},
+		},
+	}
+
+	nodemgmtEntity := s.remoteDevice.Entity([]model.AddressEntityType{0})
+	nodeFeature := s.remoteDevice.FeatureByEntityTypeAndRole(nodemgmtEntity, model.FeatureTypeTypeNodeManagement, model.RoleTypeSpecial)
+	fErr := nodeFeature.UpdateData(model.FunctionTypeNodeManagementUseCaseData, ucData, nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	data, err = s.sut.IsUseCaseSupported(s.visualizationEntity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), true, data)
+}
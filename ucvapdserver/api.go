@@ -0,0 +1,36 @@
+package ucvapdserver
+
+import (
+	"github.com/enbility/cemd/api"
+)
+
+//go:generate mockery
+
+// interface for the Visualization of Aggregated Photovoltaic Data UseCase as a PV System
+type UCVAPDServerInterface interface {
+	api.UseCaseInterface
+
+	// Scenario 1
+
+	// set the current production power
+	//
+	// parameters:
+	//   - value: the power in W
+	SetPower(value float64) (resultErr error)
+
+	// Scenario 2
+
+	// set the nominal peak power
+	//
+	// parameters:
+	//   - value: the nominal peak power in W
+	SetPowerNominalPeak(value float64) (resultErr error)
+
+	// Scenario 3
+
+	// set total PV yield
+	//
+	// parameters:
+	//   - value: the total yield in Wh
+	SetPVYieldTotal(value float64) (resultErr error)
+}
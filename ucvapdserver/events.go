@@ -0,0 +1,28 @@
+package ucvapdserver
+
+import (
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+)
+
+// handle SPINE events
+func (e *UCVAPDServer) HandleEvent(payload spineapi.EventPayload) {
+	// only about subscriptions to the measurement data of the PV System
+	if payload.EventType != spineapi.EventTypeSubscriptionChange ||
+		payload.LocalFeature == nil {
+		return
+	}
+
+	localEntity := e.service.LocalDevice().EntityForType(model.EntityTypeTypePVSystem)
+	if localEntity == nil ||
+		payload.LocalFeature != localEntity.FeatureOfTypeAndRole(model.FeatureTypeTypeMeasurement, model.RoleTypeServer) {
+		return
+	}
+
+	switch payload.ChangeType {
+	case spineapi.ElementChangeAdd:
+		e.eventCB(payload.Ski, payload.Device, payload.Entity, VisualizationApplianceSubscribed)
+	case spineapi.ElementChangeRemove:
+		e.eventCB(payload.Ski, payload.Device, payload.Entity, VisualizationApplianceUnsubscribed)
+	}
+}
@@ -0,0 +1,32 @@
+package ucvapdserver
+
+import (
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *UCVAPDServerSuite) Test_Events() {
+	payload := spineapi.EventPayload{
+		Ski:          remoteSki,
+		Device:       s.remoteDevice,
+		Entity:       s.visualizationEntity,
+		EventType:    spineapi.EventTypeDataChange,
+		ChangeType:   spineapi.ElementChangeAdd,
+		LocalFeature: s.measurementFeature,
+	}
+	s.sut.HandleEvent(payload)
+	assert.Equal(s.T(), "", string(s.eventCalled))
+
+	payload.EventType = spineapi.EventTypeSubscriptionChange
+	payload.LocalFeature = s.deviceConfigurationFeature
+	s.sut.HandleEvent(payload)
+	assert.Equal(s.T(), "", string(s.eventCalled))
+
+	payload.LocalFeature = s.measurementFeature
+	s.sut.HandleEvent(payload)
+	assert.Equal(s.T(), VisualizationApplianceSubscribed, s.eventCalled)
+
+	payload.ChangeType = spineapi.ElementChangeRemove
+	s.sut.HandleEvent(payload)
+	assert.Equal(s.T(), VisualizationApplianceUnsubscribed, s.eventCalled)
+}
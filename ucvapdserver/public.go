@@ -0,0 +1,61 @@
+package ucvapdserver
+
+import (
+	eebusapi "github.com/enbility/eebus-go/api"
+	eebusutil "github.com/enbility/eebus-go/util"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/spine"
+)
+
+// Scenario 1
+
+// set the current production power
+func (e *UCVAPDServer) SetPower(value float64) error {
+	return e.setMeasurementValue(model.ScopeTypeTypeACPowerTotal, value)
+}
+
+// Scenario 2
+
+// set the nominal peak power
+func (e *UCVAPDServer) SetPowerNominalPeak(value float64) error {
+	localEntity := e.service.LocalDevice().EntityForType(model.EntityTypeTypePVSystem)
+	if localEntity == nil {
+		return eebusapi.ErrDataNotAvailable
+	}
+
+	deviceConfiguration := localEntity.FeatureOfTypeAndRole(model.FeatureTypeTypeDeviceConfiguration, model.RoleTypeServer)
+	if deviceConfiguration == nil {
+		return eebusapi.ErrDataNotAvailable
+	}
+
+	if _, err := spine.LocalFeatureDataCopyOfType[*model.DeviceConfigurationKeyValueDescriptionListDataType](
+		deviceConfiguration, model.FunctionTypeDeviceConfigurationKeyValueDescriptionListData); err != nil {
+		return eebusapi.ErrDataNotAvailable
+	}
+
+	// the peak power is the only key on the PV System entity
+	keyId := model.DeviceConfigurationKeyIdType(0)
+
+	data := &model.DeviceConfigurationKeyValueListDataType{
+		DeviceConfigurationKeyValueData: []model.DeviceConfigurationKeyValueDataType{
+			{
+				KeyId:             eebusutil.Ptr(keyId),
+				IsValueChangeable: eebusutil.Ptr(false),
+				Value: &model.DeviceConfigurationKeyValueValueType{
+					ScaledNumber: model.NewScaledNumberType(value),
+				},
+			},
+		},
+	}
+
+	deviceConfiguration.SetData(model.FunctionTypeDeviceConfigurationKeyValueListData, data)
+
+	return nil
+}
+
+// Scenario 3
+
+// set total PV yield
+func (e *UCVAPDServer) SetPVYieldTotal(value float64) error {
+	return e.setMeasurementValue(model.ScopeTypeTypeACYieldTotal, value)
+}
@@ -0,0 +1,67 @@
+package ucvapdserver
+
+import (
+	"github.com/enbility/spine-go/model"
+	"github.com/stretchr/testify/assert"
+)
+
+// return the local measurement value for a scope
+func (s *UCVAPDServerSuite) measurementValue(scope model.ScopeTypeType) *float64 {
+	data, ok := s.measurementFeature.DataCopy(model.FunctionTypeMeasurementListData).(*model.MeasurementListDataType)
+	if !ok || data == nil {
+		return nil
+	}
+
+	id := s.sut.measurementIds[scope]
+	for _, item := range data.MeasurementData {
+		if item.MeasurementId != nil && *item.MeasurementId == id && item.Value != nil {
+			value := item.Value.GetValue()
+			return &value
+		}
+	}
+
+	return nil
+}
+
+func (s *UCVAPDServerSuite) Test_Power() {
+	err := s.sut.SetPower(5000)
+	assert.Nil(s.T(), err)
+
+	value := s.measurementValue(model.ScopeTypeTypeACPowerTotal)
+	assert.NotNil(s.T(), value)
+	assert.Equal(s.T(), 5000.0, *value)
+}
+
+func (s *UCVAPDServerSuite) Test_PowerNominalPeak() {
+	err := s.sut.SetPowerNominalPeak(10000)
+	assert.Nil(s.T(), err)
+
+	data, ok := s.deviceConfigurationFeature.DataCopy(
+		model.FunctionTypeDeviceConfigurationKeyValueListData).(*model.DeviceConfigurationKeyValueListDataType)
+	assert.True(s.T(), ok)
+	assert.Equal(s.T(), 1, len(data.DeviceConfigurationKeyValueData))
+	assert.Equal(s.T(), 10000.0, data.DeviceConfigurationKeyValueData[0].Value.ScaledNumber.GetValue())
+	assert.Equal(s.T(), false, *data.DeviceConfigurationKeyValueData[0].IsValueChangeable)
+
+	err = s.sut.SetPowerNominalPeak(12000)
+	assert.Nil(s.T(), err)
+
+	data, ok = s.deviceConfigurationFeature.DataCopy(
+		model.FunctionTypeDeviceConfigurationKeyValueListData).(*model.DeviceConfigurationKeyValueListDataType)
+	assert.True(s.T(), ok)
+	assert.Equal(s.T(), 1, len(data.DeviceConfigurationKeyValueData))
+	assert.Equal(s.T(), 12000.0, data.DeviceConfigurationKeyValueData[0].Value.ScaledNumber.GetValue())
+}
+
+func (s *UCVAPDServerSuite) Test_PVYieldTotal() {
+	err := s.sut.SetPVYieldTotal(1500)
+	assert.Nil(s.T(), err)
+
+	err = s.sut.SetPower(3000)
+	assert.Nil(s.T(), err)
+
+	// other values are kept
+	value := s.measurementValue(model.ScopeTypeTypeACYieldTotal)
+	assert.NotNil(s.T(), value)
+	assert.Equal(s.T(), 1500.0, *value)
+}
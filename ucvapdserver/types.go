@@ -0,0 +1,15 @@
+package ucvapdserver
+
+import "github.com/enbility/cemd/api"
+
+const (
+	// A Visualization Appliance subscribed to the PV System data
+	//
+	// Use Case VAPD, Scenario 1-3
+	VisualizationApplianceSubscribed api.EventType = "ucvapdserver-VisualizationApplianceSubscribed"
+
+	// A Visualization Appliance unsubscribed from the PV System data
+	//
+	// Use Case VAPD, Scenario 1-3
+	VisualizationApplianceUnsubscribed api.EventType = "ucvapdserver-VisualizationApplianceUnsubscribed"
+)
@@ -0,0 +1,220 @@
+package ucvapdserver
+
+import (
+	"time"
+
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/util"
+	eebusapi "github.com/enbility/eebus-go/api"
+	eebusutil "github.com/enbility/eebus-go/util"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/spine"
+)
+
+// the measurements provided by this use case, the parameterId of each measurement is its index
+var measurements = []struct {
+	measurementType model.MeasurementTypeType
+	scope           model.ScopeTypeType
+	unit            model.UnitOfMeasurementType
+}{
+	{model.MeasurementTypeTypePower, model.ScopeTypeTypeACPowerTotal, model.UnitOfMeasurementTypeW},
+	{model.MeasurementTypeTypeEnergy, model.ScopeTypeTypeACYieldTotal, model.UnitOfMeasurementTypeWh},
+}
+
+type UCVAPDServer struct {
+	service eebusapi.ServiceInterface
+
+	eventCB api.EntityEventCallback
+
+	validEntityTypes []model.EntityTypeType
+
+	measurementIds map[model.ScopeTypeType]model.MeasurementIdType
+}
+
+var _ UCVAPDServerInterface = (*UCVAPDServer)(nil)
+
+// the local device requires an entity of type PVSystem, which is configured via
+// the entity types of the service configuration
+//
+// without this entity the features and the use case are not added and
+// the values can not be set
+func NewUCVAPD(service eebusapi.ServiceInterface, eventCB api.EntityEventCallback) *UCVAPDServer {
+	uc := &UCVAPDServer{
+		service:        service,
+		eventCB:        eventCB,
+		measurementIds: make(map[model.ScopeTypeType]model.MeasurementIdType),
+	}
+
+	uc.validEntityTypes = []model.EntityTypeType{
+		model.EntityTypeTypeCEM,
+	}
+	_ = spine.Events.Subscribe(uc)
+
+	return uc
+}
+
+func (c *UCVAPDServer) UseCaseName() model.UseCaseNameType {
+	return model.UseCaseNameTypeVisualizationOfAggregatedPhotovoltaicData
+}
+
+func (e *UCVAPDServer) AddFeatures() {
+	localEntity := e.service.LocalDevice().EntityForType(model.EntityTypeTypePVSystem)
+	if localEntity == nil {
+		return
+	}
+
+	// server features
+	f := localEntity.GetOrAddFeature(model.FeatureTypeTypeMeasurement, model.RoleTypeServer)
+	f.AddFunctionType(model.FunctionTypeMeasurementDescriptionListData, true, false)
+	f.AddFunctionType(model.FunctionTypeMeasurementListData, true, false)
+
+	var measurementId model.MeasurementIdType = 0
+	// get the highest MeasurementId
+	for _, desc := range util.GetLocalMeasurementDescriptionsForTypeCommodityScope(e.service, model.EntityTypeTypePVSystem, "", "", "") {
+		if desc.MeasurementId != nil && *desc.MeasurementId >= measurementId {
+			measurementId = *desc.MeasurementId + 1
+		}
+	}
+
+	measurementDesc, err := spine.LocalFeatureDataCopyOfType[*model.MeasurementDescriptionListDataType](
+		f, model.FunctionTypeMeasurementDescriptionListData)
+	if err != nil || measurementDesc == nil {
+		measurementDesc = &model.MeasurementDescriptionListDataType{}
+	}
+
+	var paramDescs []model.ElectricalConnectionParameterDescriptionDataType
+
+	for index, item := range measurements {
+		id := measurementId + model.MeasurementIdType(index)
+		e.measurementIds[item.scope] = id
+
+		measurementDesc.MeasurementDescriptionData = append(measurementDesc.MeasurementDescriptionData,
+			model.MeasurementDescriptionDataType{
+				MeasurementId:   eebusutil.Ptr(id),
+				MeasurementType: eebusutil.Ptr(item.measurementType),
+				CommodityType:   eebusutil.Ptr(model.CommodityTypeTypeElectricity),
+				Unit:            eebusutil.Ptr(item.unit),
+				ScopeType:       eebusutil.Ptr(item.scope),
+			})
+
+		paramDescs = append(paramDescs, model.ElectricalConnectionParameterDescriptionDataType{
+			ElectricalConnectionId: eebusutil.Ptr(util.LocalElectricalConnectionId),
+			ParameterId:            eebusutil.Ptr(model.ElectricalConnectionParameterIdType(index)),
+			MeasurementId:          eebusutil.Ptr(id),
+			VoltageType:            eebusutil.Ptr(model.ElectricalConnectionVoltageTypeTypeAc),
+			AcMeasuredPhases:       eebusutil.Ptr(model.ElectricalConnectionPhaseNameTypeAbc),
+			AcMeasurementType:      eebusutil.Ptr(model.ElectricalConnectionAcMeasurementTypeTypeReal),
+			AcMeasurementVariant:   eebusutil.Ptr(model.ElectricalConnectionMeasurandVariantTypeRms),
+		})
+	}
+	f.SetData(model.FunctionTypeMeasurementDescriptionListData, measurementDesc)
+
+	f = localEntity.GetOrAddFeature(model.FeatureTypeTypeElectricalConnection, model.RoleTypeServer)
+	f.AddFunctionType(model.FunctionTypeElectricalConnectionDescriptionListData, true, false)
+	f.AddFunctionType(model.FunctionTypeElectricalConnectionParameterDescriptionListData, true, false)
+
+	elDesc := &model.ElectricalConnectionDescriptionListDataType{
+		ElectricalConnectionDescriptionData: []model.ElectricalConnectionDescriptionDataType{
+			{
+				ElectricalConnectionId:  eebusutil.Ptr(util.LocalElectricalConnectionId),
+				PowerSupplyType:         eebusutil.Ptr(model.ElectricalConnectionVoltageTypeTypeAc),
+				PositiveEnergyDirection: eebusutil.Ptr(model.EnergyDirectionTypeProduce),
+			},
+		},
+	}
+	f.SetData(model.FunctionTypeElectricalConnectionDescriptionListData, elDesc)
+
+	elParamDesc := &model.ElectricalConnectionParameterDescriptionListDataType{
+		ElectricalConnectionParameterDescriptionData: paramDescs,
+	}
+	f.SetData(model.FunctionTypeElectricalConnectionParameterDescriptionListData, elParamDesc)
+
+	f = localEntity.GetOrAddFeature(model.FeatureTypeTypeDeviceConfiguration, model.RoleTypeServer)
+	f.AddFunctionType(model.FunctionTypeDeviceConfigurationKeyValueDescriptionListData, true, false)
+	f.AddFunctionType(model.FunctionTypeDeviceConfigurationKeyValueListData, true, false)
+
+	deviceConfigDesc := &model.DeviceConfigurationKeyValueDescriptionListDataType{
+		DeviceConfigurationKeyValueDescriptionData: []model.DeviceConfigurationKeyValueDescriptionDataType{
+			{
+				KeyId:     eebusutil.Ptr(model.DeviceConfigurationKeyIdType(0)),
+				KeyName:   eebusutil.Ptr(model.DeviceConfigurationKeyNameTypePeakPowerOfPVSystem),
+				ValueType: eebusutil.Ptr(model.DeviceConfigurationKeyValueTypeTypeScaledNumber),
+				Unit:      eebusutil.Ptr(model.UnitOfMeasurementTypeW),
+			},
+		},
+	}
+	f.SetData(model.FunctionTypeDeviceConfigurationKeyValueDescriptionListData, deviceConfigDesc)
+}
+
+func (e *UCVAPDServer) AddUseCase() {
+	localEntity := e.service.LocalDevice().EntityForType(model.EntityTypeTypePVSystem)
+	if localEntity == nil {
+		return
+	}
+
+	localEntity.AddUseCaseSupport(
+		model.UseCaseActorTypePVSystem,
+		e.UseCaseName(),
+		model.SpecificationVersionType("1.0.1"),
+		"RC1",
+		true,
+		[]model.UseCaseScenarioSupportType{1, 2, 3})
+}
+
+func (e *UCVAPDServer) UpdateUseCaseAvailability(available bool) {
+	localEntity := e.service.LocalDevice().EntityForType(model.EntityTypeTypePVSystem)
+	if localEntity == nil {
+		return
+	}
+
+	localEntity.SetUseCaseAvailability(model.UseCaseActorTypePVSystem, e.UseCaseName(), available)
+}
+
+// returns if the entity supports the usecase
+//
+// possible errors:
+//   - ErrDataNotAvailable if that information is not (yet) available
+//   - and others
+func (e *UCVAPDServer) IsUseCaseSupported(entity spineapi.EntityRemoteInterface) (bool, error) {
+	if !util.IsCompatibleEntity(entity, e.validEntityTypes) {
+		return false, api.ErrNoCompatibleEntity
+	}
+
+	// the visualization appliance may be announced as a CEM
+	for _, actor := range []model.UseCaseActorType{
+		model.UseCaseActorTypeVisualizationAppliance,
+		model.UseCaseActorTypeCEM,
+	} {
+		if entity.Device().VerifyUseCaseScenariosAndFeaturesSupport(
+			actor,
+			e.UseCaseName(),
+			[]model.UseCaseScenarioSupportType{1},
+			[]model.FeatureTypeType{},
+		) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// set the value of the measurement for a scope
+func (e *UCVAPDServer) setMeasurementValue(scope model.ScopeTypeType, value float64) error {
+	measurementId, ok := e.measurementIds[scope]
+	if !ok {
+		return eebusapi.ErrDataNotAvailable
+	}
+
+	data := []model.MeasurementDataType{
+		{
+			MeasurementId: eebusutil.Ptr(measurementId),
+			ValueType:     eebusutil.Ptr(model.MeasurementValueTypeTypeValue),
+			Timestamp:     model.NewAbsoluteOrRelativeTimeTypeFromTime(time.Now()),
+			Value:         model.NewScaledNumberType(value),
+			ValueSource:   eebusutil.Ptr(model.MeasurementValueSourceTypeMeasuredValue),
+		},
+	}
+
+	return util.SetLocalMeasurementData(e.service, model.EntityTypeTypePVSystem, data)
+}
@@ -0,0 +1,84 @@
+package ucvapdserver
+
+import (
+	"github.com/enbility/cemd/util"
+	eebusutil "github.com/enbility/eebus-go/util"
+	"github.com/enbility/spine-go/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *UCVAPDServerSuite) Test_AddFeatures() {
+	descs := util.GetLocalMeasurementDescriptionsForTypeCommodityScope(s.service, model.EntityTypeTypePVSystem, "", "", "")
+	assert.Equal(s.T(), len(measurements), len(descs))
+
+	// nothing is added to the CEM entity
+	descs = util.GetLocalMeasurementDescriptionsForTypeCommodityScope(s.service, model.EntityTypeTypeCEM, "", "", "")
+	assert.Equal(s.T(), 0, len(descs))
+
+	elParamDesc, ok := s.electricalConnectionFeature.DataCopy(
+		model.FunctionTypeElectricalConnectionParameterDescriptionListData).(*model.ElectricalConnectionParameterDescriptionListDataType)
+	assert.True(s.T(), ok)
+	assert.Equal(s.T(), len(measurements), len(elParamDesc.ElectricalConnectionParameterDescriptionData))
+
+	elDesc, ok := s.electricalConnectionFeature.DataCopy(
+		model.FunctionTypeElectricalConnectionDescriptionListData).(*model.ElectricalConnectionDescriptionListDataType)
+	assert.True(s.T(), ok)
+	assert.Equal(s.T(), 1, len(elDesc.ElectricalConnectionDescriptionData))
+	assert.Equal(s.T(), model.EnergyDirectionTypeProduce, *elDesc.ElectricalConnectionDescriptionData[0].PositiveEnergyDirection)
+
+	deviceConfigDesc, ok := s.deviceConfigurationFeature.DataCopy(
+		model.FunctionTypeDeviceConfigurationKeyValueDescriptionListData).(*model.DeviceConfigurationKeyValueDescriptionListDataType)
+	assert.True(s.T(), ok)
+	assert.Equal(s.T(), 1, len(deviceConfigDesc.DeviceConfigurationKeyValueDescriptionData))
+	assert.Equal(s.T(), model.DeviceConfigurationKeyNameTypePeakPowerOfPVSystem, *deviceConfigDesc.DeviceConfigurationKeyValueDescriptionData[0].KeyName)
+}
+
+func (s *UCVAPDServerSuite) Test_MissingPVSystemEntity() {
+	sut := NewUCVAPD(s.newService(model.EntityTypeTypeCEM), nil)
+
+	// nothing is added without the entity
+	sut.AddFeatures()
+	sut.AddUseCase()
+	sut.UpdateUseCaseAvailability(true)
+
+	err := sut.SetPVYieldTotal(100)
+	assert.NotNil(s.T(), err)
+}
+
+func (s *UCVAPDServerSuite) Test_UpdateUseCaseAvailability() {
+	s.sut.UpdateUseCaseAvailability(true)
+}
+
+func (s *UCVAPDServerSuite) Test_IsUseCaseSupported() {
+	data, err := s.sut.IsUseCaseSupported(s.mockRemoteEntity)
+	assert.NotNil(s.T(), err)
+	assert.Equal(s.T(), false, data)
+
+	data, err = s.sut.IsUseCaseSupported(s.visualizationEntity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), false, data)
+
+	ucData := &model.NodeManagementUseCaseDataType{
+		UseCaseInformation: []model.UseCaseInformationDataType{
+			{
+				Actor: eebusutil.Ptr(model.UseCaseActorTypeVisualizationAppliance),
+				UseCaseSupport: []model.UseCaseSupportType{
+					{
+						UseCaseName:      eebusutil.Ptr(model.UseCaseNameTypeVisualizationOfAggregatedPhotovoltaicData),
+						UseCaseAvailable: eebusutil.Ptr(true),
+						ScenarioSupport:  []model.UseCaseScenarioSupportType{1, 2, 3},
+					},
+				},
+			},
+		},
+	}
+
+	nodemgmtEntity := s.remoteDevice.Entity([]model.AddressEntityType{0})
+	nodeFeature := s.remoteDevice.FeatureByEntityTypeAndRole(nodemgmtEntity, model.FeatureTypeTypeNodeManagement, model.RoleTypeSpecial)
+	fErr := nodeFeature.UpdateData(model.FunctionTypeNodeManagementUseCaseData, ucData, nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	data, err = s.sut.IsUseCaseSupported(s.visualizationEntity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), true, data)
+}
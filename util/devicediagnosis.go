@@ -0,0 +1,39 @@
+package util
+
+import (
+	eebusapi "github.com/enbility/eebus-go/api"
+	eebusutil "github.com/enbility/eebus-go/util"
+	"github.com/enbility/spine-go/model"
+)
+
+// set the operating state of a local entity
+//
+// parameters:
+//   - lastErrorCode: the last error code, empty if there is none
+//
+// possible errors:
+//   - ErrDataNotAvailable if the entity or the device diagnosis server feature is not available
+func SetLocalDeviceDiagnosisState(
+	service eebusapi.ServiceInterface,
+	entityType model.EntityTypeType,
+	operatingState model.DeviceDiagnosisOperatingStateType,
+	lastErrorCode string,
+) error {
+	localEntity := service.LocalDevice().EntityForType(entityType)
+	if localEntity == nil {
+		return eebusapi.ErrDataNotAvailable
+	}
+
+	deviceDiagnosis := localEntity.FeatureOfTypeAndRole(model.FeatureTypeTypeDeviceDiagnosis, model.RoleTypeServer)
+	if deviceDiagnosis == nil {
+		return eebusapi.ErrDataNotAvailable
+	}
+
+	data := &model.DeviceDiagnosisStateDataType{
+		OperatingState: eebusutil.Ptr(operatingState),
+		LastErrorCode:  Ref[model.LastErrorCodeType](lastErrorCode),
+	}
+	deviceDiagnosis.SetData(model.FunctionTypeDeviceDiagnosisStateData, data)
+
+	return nil
+}
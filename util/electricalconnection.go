@@ -1,6 +1,8 @@
 package util
 
 import (
+	"slices"
+
 	"github.com/enbility/cemd/api"
 	eebusapi "github.com/enbility/eebus-go/api"
 	spineapi "github.com/enbility/spine-go/api"
@@ -8,6 +10,18 @@ import (
 	"github.com/enbility/spine-go/spine"
 )
 
+// The local electrical connection used by the server use case implementations,
+// so that MPC measurements and LPC/LPP characteristics refer to the same connection
+const (
+	LocalElectricalConnectionId model.ElectricalConnectionIdType = 0
+
+	// the parameter of the total active power measurement
+	LocalElectricalConnectionParameterIdPowerTotal model.ElectricalConnectionParameterIdType = 0
+
+	// the grid connection point provided by the MGCP server use case
+	LocalGridElectricalConnectionId model.ElectricalConnectionIdType = 1
+)
+
 func GetPhaseCurrentLimits(
 	service eebusapi.ServiceInterface,
 	entity spineapi.EntityRemoteInterface,
@@ -104,9 +118,21 @@ func SetLocalElectricalConnectionCharacteristicForContextType(
 	}
 	function := model.FunctionTypeElectricalConnectionCharacteristicListData
 
-	listData := &model.ElectricalConnectionCharacteristicListDataType{
-		ElectricalConnectionCharacteristicData: []model.ElectricalConnectionCharacteristicDataType{charData},
+	// keep the characteristics of other use cases
+	listData, err := spine.LocalFeatureDataCopyOfType[*model.ElectricalConnectionCharacteristicListDataType](
+		electricalConnection, function)
+	if err != nil || listData == nil {
+		return
 	}
+
+	index := slices.IndexFunc(listData.ElectricalConnectionCharacteristicData, func(item model.ElectricalConnectionCharacteristicDataType) bool {
+		return item.CharacteristicId != nil && *item.CharacteristicId == *charData.CharacteristicId
+	})
+	if index < 0 {
+		return
+	}
+	listData.ElectricalConnectionCharacteristicData[index] = charData
+
 	electricalConnection.SetData(function, listData)
 
 	return nil
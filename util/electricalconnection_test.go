@@ -213,6 +213,14 @@ func (s *UtilSuite) Test_SetLocalElectricalConnectionCharacteristicForContextTyp
 				CharacteristicContext:  eebusutil.Ptr(context),
 				CharacteristicType:     eebusutil.Ptr(charType),
 			},
+			{
+				ElectricalConnectionId: eebusutil.Ptr(model.ElectricalConnectionIdType(0)),
+				ParameterId:            eebusutil.Ptr(model.ElectricalConnectionParameterIdType(0)),
+				CharacteristicId:       eebusutil.Ptr(model.ElectricalConnectionCharacteristicIdType(1)),
+				CharacteristicContext:  eebusutil.Ptr(context),
+				CharacteristicType:     eebusutil.Ptr(model.ElectricalConnectionCharacteristicTypeTypeContractualProductionNominalMax),
+				Value:                  model.NewScaledNumberType(-7000),
+			},
 		},
 	}
 	feature.SetData(model.FunctionTypeElectricalConnectionCharacteristicListData, charData)
@@ -220,7 +228,13 @@ func (s *UtilSuite) Test_SetLocalElectricalConnectionCharacteristicForContextTyp
 	err = SetLocalElectricalConnectionCharacteristicForContextType(s.service, context, charType, value)
 	assert.Nil(s.T(), err)
 
-	data := GetLocalElectricalConnectionCharacteristicForContextType(s.service, context, charType)
+	// other characteristics are kept
+	data := GetLocalElectricalConnectionCharacteristicForContextType(
+		s.service, context, model.ElectricalConnectionCharacteristicTypeTypeContractualProductionNominalMax)
+	assert.NotNil(s.T(), data.Value)
+	assert.Equal(s.T(), -7000.0, data.Value.GetValue())
+
+	data = GetLocalElectricalConnectionCharacteristicForContextType(s.service, context, charType)
 	assert.NotNil(s.T(), data.CharacteristicId)
 	assert.Equal(s.T(), uint(0), uint(*data.CharacteristicId))
 	assert.NotNil(s.T(), data.Value)
@@ -3,3 +3,5 @@ package util
 import "errors"
 
 var ErrDeviceDisconnected = errors.New("device is disconnected")
+
+var ErrNoLocalEntity = errors.New("local entity is not available")
@@ -0,0 +1,310 @@
+package util
+
+import (
+	"github.com/enbility/cemd/api"
+	eebusapi "github.com/enbility/eebus-go/api"
+	eebusutil "github.com/enbility/eebus-go/util"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/spine"
+)
+
+// The layout of the electrical connection and the measurements of an EV entity
+// provided by the EVSE server use case implementations, so that EVCC power limits,
+// EVCEM and EVSOC measurements and OPEV/OSCEV current limits refer to the same data.
+//
+// The parameterIds of the phase specific parameters are identical to their measurementIds
+const (
+	LocalEVElectricalConnectionId model.ElectricalConnectionIdType = 0
+
+	// the parameter of the charging power limits
+	LocalEVElectricalConnectionParameterIdPowerTotal model.ElectricalConnectionParameterIdType = 6
+
+	// the measurement of the charged energy
+	LocalEVMeasurementIdEnergyCharged model.MeasurementIdType = 6
+
+	// the measurement of the state of charge
+	LocalEVMeasurementIdStateOfCharge model.MeasurementIdType = 7
+)
+
+// the measurements of the phase specific currents, in the order of PhaseNameMapping
+var LocalEVMeasurementIdsCurrent = []model.MeasurementIdType{0, 1, 2}
+
+// the measurements of the phase specific powers, in the order of PhaseNameMapping
+var LocalEVMeasurementIdsPower = []model.MeasurementIdType{3, 4, 5}
+
+// add the electrical connection server feature to a new EV entity
+func AddLocalEVElectricalConnection(entity spineapi.EntityLocalInterface) {
+	f := entity.GetOrAddFeature(model.FeatureTypeTypeElectricalConnection, model.RoleTypeServer)
+	f.AddFunctionType(model.FunctionTypeElectricalConnectionDescriptionListData, true, false)
+	f.AddFunctionType(model.FunctionTypeElectricalConnectionParameterDescriptionListData, true, false)
+	f.AddFunctionType(model.FunctionTypeElectricalConnectionPermittedValueSetListData, true, false)
+
+	elDesc := &model.ElectricalConnectionDescriptionListDataType{
+		ElectricalConnectionDescriptionData: []model.ElectricalConnectionDescriptionDataType{
+			{
+				ElectricalConnectionId:  eebusutil.Ptr(LocalEVElectricalConnectionId),
+				PowerSupplyType:         eebusutil.Ptr(model.ElectricalConnectionVoltageTypeTypeAc),
+				AcConnectedPhases:       eebusutil.Ptr(uint(len(PhaseNameMapping))),
+				PositiveEnergyDirection: eebusutil.Ptr(model.EnergyDirectionTypeConsume),
+			},
+		},
+	}
+	f.SetData(model.FunctionTypeElectricalConnectionDescriptionListData, elDesc)
+
+	var paramDescs []model.ElectricalConnectionParameterDescriptionDataType
+
+	// the current parameters have to be listed first, as the phase specific
+	// current limits are looked up by the measured phase
+	for _, ids := range [][]model.MeasurementIdType{LocalEVMeasurementIdsCurrent, LocalEVMeasurementIdsPower} {
+		for index, id := range ids {
+			paramDescs = append(paramDescs, model.ElectricalConnectionParameterDescriptionDataType{
+				ElectricalConnectionId:  eebusutil.Ptr(LocalEVElectricalConnectionId),
+				ParameterId:             eebusutil.Ptr(model.ElectricalConnectionParameterIdType(id)),
+				MeasurementId:           eebusutil.Ptr(id),
+				VoltageType:             eebusutil.Ptr(model.ElectricalConnectionVoltageTypeTypeAc),
+				AcMeasuredPhases:        eebusutil.Ptr(PhaseNameMapping[index]),
+				AcMeasuredInReferenceTo: eebusutil.Ptr(model.ElectricalConnectionPhaseNameTypeNeutral),
+				AcMeasurementType:       eebusutil.Ptr(model.ElectricalConnectionAcMeasurementTypeTypeReal),
+				AcMeasurementVariant:    eebusutil.Ptr(model.ElectricalConnectionMeasurandVariantTypeRms),
+			})
+		}
+	}
+
+	paramDescs = append(paramDescs, model.ElectricalConnectionParameterDescriptionDataType{
+		ElectricalConnectionId: eebusutil.Ptr(LocalEVElectricalConnectionId),
+		ParameterId:            eebusutil.Ptr(LocalEVElectricalConnectionParameterIdPowerTotal),
+		VoltageType:            eebusutil.Ptr(model.ElectricalConnectionVoltageTypeTypeAc),
+		AcMeasuredPhases:       eebusutil.Ptr(model.ElectricalConnectionPhaseNameTypeAbc),
+		ScopeType:              eebusutil.Ptr(model.ScopeTypeTypeACPowerTotal),
+	})
+
+	elParamDesc := &model.ElectricalConnectionParameterDescriptionListDataType{
+		ElectricalConnectionParameterDescriptionData: paramDescs,
+	}
+	f.SetData(model.FunctionTypeElectricalConnectionParameterDescriptionListData, elParamDesc)
+}
+
+// set the permitted minimum, maximum and default or standby value of a parameter
+// of the electrical connection of the connected EV
+//
+// possible errors:
+//   - ErrDataNotAvailable if no EV is connected
+func SetLocalEVPermittedValueSet(
+	service eebusapi.ServiceInterface,
+	parameterId model.ElectricalConnectionParameterIdType,
+	minValue, maxValue, value float64,
+) error {
+	localEntity := service.LocalDevice().EntityForType(model.EntityTypeTypeEV)
+	if localEntity == nil {
+		return eebusapi.ErrDataNotAvailable
+	}
+
+	electricalConnection := localEntity.FeatureOfTypeAndRole(model.FeatureTypeTypeElectricalConnection, model.RoleTypeServer)
+	if electricalConnection == nil {
+		return eebusapi.ErrDataNotAvailable
+	}
+
+	function := model.FunctionTypeElectricalConnectionPermittedValueSetListData
+	data, err := spine.LocalFeatureDataCopyOfType[*model.ElectricalConnectionPermittedValueSetListDataType](electricalConnection, function)
+	if err != nil || data == nil {
+		data = &model.ElectricalConnectionPermittedValueSetListDataType{}
+	}
+
+	newSet := model.ElectricalConnectionPermittedValueSetDataType{
+		ElectricalConnectionId: eebusutil.Ptr(LocalEVElectricalConnectionId),
+		ParameterId:            eebusutil.Ptr(parameterId),
+		PermittedValueSet: []model.ScaledNumberSetType{
+			{
+				Value: []model.ScaledNumberType{*model.NewScaledNumberType(value)},
+				Range: []model.ScaledNumberRangeType{
+					{
+						Min: model.NewScaledNumberType(minValue),
+						Max: model.NewScaledNumberType(maxValue),
+					},
+				},
+			},
+		},
+	}
+
+	found := false
+	for index, item := range data.ElectricalConnectionPermittedValueSetData {
+		if item.ParameterId != nil && *item.ParameterId == parameterId {
+			data.ElectricalConnectionPermittedValueSetData[index] = newSet
+			found = true
+			break
+		}
+	}
+	if !found {
+		data.ElectricalConnectionPermittedValueSetData = append(data.ElectricalConnectionPermittedValueSetData, newSet)
+	}
+
+	electricalConnection.SetData(function, data)
+
+	return nil
+}
+
+// add the measurement descriptions of a use case to the measurement server feature of a new EV entity
+func AddLocalEVMeasurementDescriptions(entity spineapi.EntityLocalInterface, descriptions []model.MeasurementDescriptionDataType) {
+	f := entity.GetOrAddFeature(model.FeatureTypeTypeMeasurement, model.RoleTypeServer)
+	f.AddFunctionType(model.FunctionTypeMeasurementDescriptionListData, true, false)
+	f.AddFunctionType(model.FunctionTypeMeasurementListData, true, false)
+
+	data, err := spine.LocalFeatureDataCopyOfType[*model.MeasurementDescriptionListDataType](
+		f, model.FunctionTypeMeasurementDescriptionListData)
+	if err != nil || data == nil {
+		data = &model.MeasurementDescriptionListDataType{}
+	}
+
+	data.MeasurementDescriptionData = append(data.MeasurementDescriptionData, descriptions...)
+	f.SetData(model.FunctionTypeMeasurementDescriptionListData, data)
+}
+
+// add phase specific current limits to the load control server feature of a new EV entity
+//
+// returns the limitIds in the order of PhaseNameMapping
+func AddLocalEVLoadControlLimits(
+	entity spineapi.EntityLocalInterface,
+	category model.LoadControlCategoryType,
+	scope model.ScopeTypeType,
+) []model.LoadControlLimitIdType {
+	f := entity.GetOrAddFeature(model.FeatureTypeTypeLoadControl, model.RoleTypeServer)
+	f.AddFunctionType(model.FunctionTypeLoadControlLimitDescriptionListData, true, false)
+	f.AddFunctionType(model.FunctionTypeLoadControlLimitListData, true, true)
+
+	desc, err := spine.LocalFeatureDataCopyOfType[*model.LoadControlLimitDescriptionListDataType](
+		f, model.FunctionTypeLoadControlLimitDescriptionListData)
+	if err != nil || desc == nil {
+		desc = &model.LoadControlLimitDescriptionListDataType{}
+	}
+
+	var limitId model.LoadControlLimitIdType = 0
+	// get the highest limitId
+	for _, item := range desc.LoadControlLimitDescriptionData {
+		if item.LimitId != nil && *item.LimitId >= limitId {
+			limitId = *item.LimitId + 1
+		}
+	}
+
+	values, err := spine.LocalFeatureDataCopyOfType[*model.LoadControlLimitListDataType](
+		f, model.FunctionTypeLoadControlLimitListData)
+	if err != nil || values == nil {
+		values = &model.LoadControlLimitListDataType{}
+	}
+
+	var limitIds []model.LoadControlLimitIdType
+	for index, measurementId := range LocalEVMeasurementIdsCurrent {
+		id := limitId + model.LoadControlLimitIdType(index)
+		limitIds = append(limitIds, id)
+
+		desc.LoadControlLimitDescriptionData = append(desc.LoadControlLimitDescriptionData,
+			model.LoadControlLimitDescriptionDataType{
+				LimitId:        eebusutil.Ptr(id),
+				LimitType:      eebusutil.Ptr(model.LoadControlLimitTypeTypeMaxValueLimit),
+				LimitCategory:  eebusutil.Ptr(category),
+				LimitDirection: eebusutil.Ptr(model.EnergyDirectionTypeConsume),
+				MeasurementId:  eebusutil.Ptr(measurementId),
+				Unit:           eebusutil.Ptr(model.UnitOfMeasurementTypeA),
+				ScopeType:      eebusutil.Ptr(scope),
+			})
+
+		values.LoadControlLimitData = append(values.LoadControlLimitData, model.LoadControlLimitDataType{
+			LimitId:           eebusutil.Ptr(id),
+			IsLimitChangeable: eebusutil.Ptr(true),
+			IsLimitActive:     eebusutil.Ptr(false),
+		})
+	}
+
+	f.SetData(model.FunctionTypeLoadControlLimitDescriptionListData, desc)
+	f.SetData(model.FunctionTypeLoadControlLimitListData, values)
+
+	return limitIds
+}
+
+// return the current phase specific limits of the connected EV
+//
+// parameters:
+//   - limitIds: the limitIds in the order of PhaseNameMapping
+//
+// possible errors:
+//   - ErrDataNotAvailable if no EV is connected
+func LocalEVLoadControlLimits(
+	service eebusapi.ServiceInterface,
+	limitIds []model.LoadControlLimitIdType,
+) ([]api.LoadLimitsPhase, error) {
+	localEntity := service.LocalDevice().EntityForType(model.EntityTypeTypeEV)
+	if localEntity == nil || len(limitIds) == 0 {
+		return nil, eebusapi.ErrDataNotAvailable
+	}
+
+	loadControl := localEntity.FeatureOfTypeAndRole(model.FeatureTypeTypeLoadControl, model.RoleTypeServer)
+	if loadControl == nil {
+		return nil, eebusapi.ErrDataNotAvailable
+	}
+
+	values, err := spine.LocalFeatureDataCopyOfType[*model.LoadControlLimitListDataType](
+		loadControl, model.FunctionTypeLoadControlLimitListData)
+	if err != nil || values == nil {
+		return nil, eebusapi.ErrDataNotAvailable
+	}
+
+	var result []api.LoadLimitsPhase
+	for index, limitId := range limitIds {
+		for _, item := range values.LoadControlLimitData {
+			if item.LimitId == nil || *item.LimitId != limitId {
+				continue
+			}
+
+			limit := api.LoadLimitsPhase{
+				Phase:        PhaseNameMapping[index],
+				IsChangeable: item.IsLimitChangeable != nil && *item.IsLimitChangeable,
+				IsActive:     item.IsLimitActive != nil && *item.IsLimitActive,
+			}
+			if item.Value != nil {
+				limit.Value = item.Value.GetValue()
+			}
+
+			result = append(result, limit)
+			break
+		}
+	}
+
+	if len(result) == 0 {
+		return nil, eebusapi.ErrDataNotAvailable
+	}
+
+	return result, nil
+}
+
+// check if the payload of a write to the load control server feature of the
+// connected EV contains any of the limitIds
+func LocalEVLoadControlLimitsWritten(payload spineapi.EventPayload, limitIds []model.LoadControlLimitIdType) bool {
+	if payload.EventType != spineapi.EventTypeDataChange ||
+		payload.LocalFeature == nil ||
+		payload.LocalFeature.Type() != model.FeatureTypeTypeLoadControl ||
+		payload.LocalFeature.Role() != model.RoleTypeServer ||
+		payload.LocalFeature.Entity().EntityType() != model.EntityTypeTypeEV ||
+		payload.CmdClassifier == nil ||
+		*payload.CmdClassifier != model.CmdClassifierTypeWrite {
+		return false
+	}
+
+	data, ok := payload.Data.(*model.LoadControlLimitListDataType)
+	if !ok || data == nil {
+		return false
+	}
+
+	for _, item := range data.LoadControlLimitData {
+		if item.LimitId == nil {
+			continue
+		}
+
+		for _, limitId := range limitIds {
+			if *item.LimitId == limitId {
+				return true
+			}
+		}
+	}
+
+	return false
+}
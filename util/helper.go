@@ -55,3 +55,12 @@ func Deref(v *string) string {
 	}
 	return ""
 }
+
+// return a pointer to the value, or nil for an empty string
+func Ref[T ~string](v string) *T {
+	if v == "" {
+		return nil
+	}
+	value := T(v)
+	return &value
+}
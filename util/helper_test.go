@@ -83,3 +83,11 @@ func (s *UtilSuite) Test_IsEntityDisconnected() {
 	result = IsEntityDisconnected(payload)
 	assert.Equal(s.T(), true, result)
 }
+
+func (s *UtilSuite) Test_Ref() {
+	result := Ref[model.DeviceClassificationStringType]("")
+	assert.Nil(s.T(), result)
+
+	result = Ref[model.DeviceClassificationStringType]("test")
+	assert.Equal(s.T(), model.DeviceClassificationStringType("test"), *result)
+}
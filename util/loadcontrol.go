@@ -1,6 +1,9 @@
 package util
 
 import (
+	"sync"
+	"time"
+
 	"github.com/enbility/cemd/api"
 	eebusapi "github.com/enbility/eebus-go/api"
 	eebusutil "github.com/enbility/eebus-go/util"
@@ -312,3 +315,29 @@ func GetLocalLimitValueForLimitId(
 
 	return
 }
+
+// the write approval timeouts of the local server features shared by multiple use cases
+var (
+	writeApprovalMux      sync.Mutex
+	writeApprovalTimeouts = make(map[spineapi.FeatureLocalInterface]time.Duration)
+)
+
+// set the write approval timeout of a local server feature shared by multiple use cases
+//
+// SPINE supports only one timeout per feature, after which it denies pending writes
+// itself, so the feature uses the longest timeout requested by any use case.
+// Each use case has to answer pending writes within its own timeout.
+//
+// SPINE reads the timeout without synchronization, so this may only be invoked
+// while the local features are set up, before the service is started
+func SetLocalWriteApprovalTimeout(feature spineapi.FeatureLocalInterface, timeout time.Duration) {
+	writeApprovalMux.Lock()
+	defer writeApprovalMux.Unlock()
+
+	if timeout <= writeApprovalTimeouts[feature] {
+		return
+	}
+
+	writeApprovalTimeouts[feature] = timeout
+	feature.SetWriteApprovalTimeout(timeout)
+}
@@ -2,6 +2,7 @@ package util
 
 import (
 	"testing"
+	"time"
 
 	"github.com/enbility/cemd/api"
 	eebusutil "github.com/enbility/eebus-go/util"
@@ -644,3 +645,18 @@ func (s *UtilSuite) Test_GetLocalLimitValueForLimitId() {
 	data = GetLocalLimitValueForLimitId(s.service, limitId)
 	assert.NotNil(s.T(), data.LimitId)
 }
+
+func (s *UtilSuite) Test_SetLocalWriteApprovalTimeout() {
+	localEntity := s.service.LocalDevice().EntityForType(model.EntityTypeTypeCEM)
+	feature := localEntity.GetOrAddFeature(model.FeatureTypeTypeLoadControl, model.RoleTypeServer)
+
+	SetLocalWriteApprovalTimeout(feature, time.Second*61)
+	assert.Equal(s.T(), time.Second*61, writeApprovalTimeouts[feature])
+
+	// the longest timeout of all use cases is kept
+	SetLocalWriteApprovalTimeout(feature, time.Second*11)
+	assert.Equal(s.T(), time.Second*61, writeApprovalTimeouts[feature])
+
+	SetLocalWriteApprovalTimeout(feature, time.Second*121)
+	assert.Equal(s.T(), time.Second*121, writeApprovalTimeouts[feature])
+}
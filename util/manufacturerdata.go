@@ -44,3 +44,94 @@ func ManufacturerData(service eebusapi.ServiceInterface, entity spineapi.EntityR
 
 	return ret, nil
 }
+
+// return the manufacturer data of a remote device
+//
+// the data is provided by the first entity with a DeviceClassification server
+//
+// possible errors:
+//   - ErrDataNotAvailable if no manufacturer data is (yet) available
+func DeviceManufacturerData(service eebusapi.ServiceInterface, device spineapi.DeviceRemoteInterface) (api.ManufacturerData, error) {
+	entity := deviceClassificationEntity(device)
+	if entity == nil {
+		return api.ManufacturerData{}, eebusapi.ErrDataNotAvailable
+	}
+
+	data, err := ManufacturerData(service, entity, []model.EntityTypeType{entity.EntityType()})
+	if err != nil {
+		return api.ManufacturerData{}, eebusapi.ErrDataNotAvailable
+	}
+
+	return data, nil
+}
+
+// request the manufacturer data of a remote device
+//
+// the data is requested from the first entity with a DeviceClassification server
+//
+// possible errors:
+//   - ErrDataNotAvailable if the device has no DeviceClassification server
+//   - and others
+func RequestDeviceManufacturerData(service eebusapi.ServiceInterface, device spineapi.DeviceRemoteInterface) error {
+	entity := deviceClassificationEntity(device)
+	if entity == nil {
+		return eebusapi.ErrDataNotAvailable
+	}
+
+	deviceClassification, err := DeviceClassification(service, entity)
+	if err != nil {
+		return err
+	}
+
+	_, err = deviceClassification.RequestManufacturerDetails()
+	return err
+}
+
+// return the first entity of a remote device with a DeviceClassification server
+func deviceClassificationEntity(device spineapi.DeviceRemoteInterface) spineapi.EntityRemoteInterface {
+	if device == nil {
+		return nil
+	}
+
+	for _, entity := range device.Entities() {
+		if entity.FeatureOfTypeAndRole(model.FeatureTypeTypeDeviceClassification, model.RoleTypeServer) != nil {
+			return entity
+		}
+	}
+
+	return nil
+}
+
+// set the manufacturer data of a local entity
+//
+// possible errors:
+//   - ErrDataNotAvailable if the entity or the device classification server feature is not available
+func SetLocalManufacturerData(service eebusapi.ServiceInterface, entityType model.EntityTypeType, data api.ManufacturerData) error {
+	localEntity := service.LocalDevice().EntityForType(entityType)
+	if localEntity == nil {
+		return eebusapi.ErrDataNotAvailable
+	}
+
+	deviceClassification := localEntity.FeatureOfTypeAndRole(model.FeatureTypeTypeDeviceClassification, model.RoleTypeServer)
+	if deviceClassification == nil {
+		return eebusapi.ErrDataNotAvailable
+	}
+
+	value := &model.DeviceClassificationManufacturerDataType{
+		DeviceName:                     Ref[model.DeviceClassificationStringType](data.DeviceName),
+		DeviceCode:                     Ref[model.DeviceClassificationStringType](data.DeviceCode),
+		SerialNumber:                   Ref[model.DeviceClassificationStringType](data.SerialNumber),
+		SoftwareRevision:               Ref[model.DeviceClassificationStringType](data.SoftwareRevision),
+		HardwareRevision:               Ref[model.DeviceClassificationStringType](data.HardwareRevision),
+		VendorName:                     Ref[model.DeviceClassificationStringType](data.VendorName),
+		VendorCode:                     Ref[model.DeviceClassificationStringType](data.VendorCode),
+		BrandName:                      Ref[model.DeviceClassificationStringType](data.BrandName),
+		PowerSource:                    Ref[model.PowerSourceType](data.PowerSource),
+		ManufacturerNodeIdentification: Ref[model.DeviceClassificationStringType](data.ManufacturerNodeIdentification),
+		ManufacturerLabel:              Ref[model.LabelType](data.ManufacturerLabel),
+		ManufacturerDescription:        Ref[model.DescriptionType](data.ManufacturerDescription),
+	}
+	deviceClassification.SetData(model.FunctionTypeDeviceClassificationManufacturerData, value)
+
+	return nil
+}
@@ -34,3 +34,31 @@ func (s *UtilSuite) Test_ManufacturerData() {
 	assert.Equal(s.T(), "serialNumber", data.SerialNumber)
 	assert.Equal(s.T(), "", data.SoftwareRevision)
 }
+
+func (s *UtilSuite) Test_DeviceManufacturerData() {
+	_, err := DeviceManufacturerData(s.service, nil)
+	assert.NotNil(s.T(), err)
+
+	err = RequestDeviceManufacturerData(s.service, nil)
+	assert.NotNil(s.T(), err)
+
+	_, err = DeviceManufacturerData(s.service, s.remoteDevice)
+	assert.NotNil(s.T(), err)
+
+	err = RequestDeviceManufacturerData(s.service, s.remoteDevice)
+	assert.Nil(s.T(), err)
+
+	descData := &model.DeviceClassificationManufacturerDataType{
+		VendorName: util.Ptr(model.DeviceClassificationStringType("vendorName")),
+		BrandName:  util.Ptr(model.DeviceClassificationStringType("brandName")),
+	}
+
+	rFeature := s.remoteDevice.FeatureByEntityTypeAndRole(s.monitoredEntity, model.FeatureTypeTypeDeviceClassification, model.RoleTypeServer)
+	fErr := rFeature.UpdateData(model.FunctionTypeDeviceClassificationManufacturerData, descData, nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	data, err := DeviceManufacturerData(s.service, s.remoteDevice)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "vendorName", data.VendorName)
+	assert.Equal(s.T(), "brandName", data.BrandName)
+}
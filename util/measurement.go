@@ -6,6 +6,7 @@ import (
 	eebusapi "github.com/enbility/eebus-go/api"
 	spineapi "github.com/enbility/spine-go/api"
 	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/spine"
 )
 
 // Check the payload data if it contains measurementId values for a given scope
@@ -108,3 +109,89 @@ func GetValuesForTypeCommodityScope(
 
 	return measurementFeature.GetValuesForTypeCommodityScope(measurement, commodity, scope)
 }
+
+// return the measurement descriptions of the local measurement server feature
+// of an entity for a given type, commodity and scope
+func GetLocalMeasurementDescriptionsForTypeCommodityScope(
+	service eebusapi.ServiceInterface,
+	entityType model.EntityTypeType,
+	measurementType model.MeasurementTypeType,
+	commodityType model.CommodityTypeType,
+	scopeType model.ScopeTypeType,
+) (descriptions []model.MeasurementDescriptionDataType) {
+	descriptions = []model.MeasurementDescriptionDataType{}
+
+	localEntity := service.LocalDevice().EntityForType(entityType)
+	if localEntity == nil {
+		return
+	}
+
+	measurement := localEntity.FeatureOfTypeAndRole(model.FeatureTypeTypeMeasurement, model.RoleTypeServer)
+	if measurement == nil {
+		return
+	}
+
+	data, err := spine.LocalFeatureDataCopyOfType[*model.MeasurementDescriptionListDataType](
+		measurement, model.FunctionTypeMeasurementDescriptionListData)
+	if err != nil || data == nil || data.MeasurementDescriptionData == nil {
+		return
+	}
+
+	for _, desc := range data.MeasurementDescriptionData {
+		if desc.MeasurementId != nil &&
+			(measurementType == "" || (desc.MeasurementType != nil && *desc.MeasurementType == measurementType)) &&
+			(commodityType == "" || (desc.CommodityType != nil && *desc.CommodityType == commodityType)) &&
+			(scopeType == "" || (desc.ScopeType != nil && *desc.ScopeType == scopeType)) {
+			descriptions = append(descriptions, desc)
+		}
+	}
+
+	return descriptions
+}
+
+// set measurement values of the local measurement server feature of an entity
+//
+// existing values of other measurementIds are kept
+func SetLocalMeasurementData(
+	service eebusapi.ServiceInterface,
+	entityType model.EntityTypeType,
+	data []model.MeasurementDataType,
+) (resultErr error) {
+	resultErr = eebusapi.ErrDataNotAvailable
+
+	localEntity := service.LocalDevice().EntityForType(entityType)
+	if localEntity == nil {
+		return
+	}
+
+	measurement := localEntity.FeatureOfTypeAndRole(model.FeatureTypeTypeMeasurement, model.RoleTypeServer)
+	if measurement == nil {
+		return
+	}
+
+	listData, err := spine.LocalFeatureDataCopyOfType[*model.MeasurementListDataType](
+		measurement, model.FunctionTypeMeasurementListData)
+	if err != nil || listData == nil {
+		listData = &model.MeasurementListDataType{}
+	}
+
+	for _, item := range data {
+		if item.MeasurementId == nil {
+			continue
+		}
+
+		index := slices.IndexFunc(listData.MeasurementData, func(existing model.MeasurementDataType) bool {
+			return existing.MeasurementId != nil && *existing.MeasurementId == *item.MeasurementId
+		})
+		if index < 0 {
+			listData.MeasurementData = append(listData.MeasurementData, item)
+			continue
+		}
+
+		listData.MeasurementData[index] = item
+	}
+
+	measurement.SetData(model.FunctionTypeMeasurementListData, listData)
+
+	return nil
+}
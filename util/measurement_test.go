@@ -216,3 +216,80 @@ func (s *UtilSuite) Test_MeasurementValuesForTypeCommodityScope() {
 	assert.Nil(s.T(), err)
 	assert.Equal(s.T(), []float64{10, 10, 10}, data)
 }
+
+func (s *UtilSuite) Test_GetLocalMeasurementDescriptionsForTypeCommodityScope() {
+	measurementType := model.MeasurementTypeTypePower
+	commodityType := model.CommodityTypeTypeElectricity
+	scopeType := model.ScopeTypeTypeACPowerTotal
+
+	data := GetLocalMeasurementDescriptionsForTypeCommodityScope(s.service, model.EntityTypeTypeCEM, measurementType, commodityType, scopeType)
+	assert.Equal(s.T(), 0, len(data))
+
+	data = GetLocalMeasurementDescriptionsForTypeCommodityScope(s.service, model.EntityTypeTypePVSystem, measurementType, commodityType, scopeType)
+	assert.Equal(s.T(), 0, len(data))
+
+	entity := s.service.LocalDevice().EntityForType(model.EntityTypeTypeCEM)
+	feature := entity.FeatureOfTypeAndRole(model.FeatureTypeTypeMeasurement, model.RoleTypeServer)
+
+	descData := &model.MeasurementDescriptionListDataType{
+		MeasurementDescriptionData: []model.MeasurementDescriptionDataType{
+			{
+				MeasurementId:   eebusutil.Ptr(model.MeasurementIdType(0)),
+				MeasurementType: eebusutil.Ptr(measurementType),
+				CommodityType:   eebusutil.Ptr(commodityType),
+				ScopeType:       eebusutil.Ptr(scopeType),
+			},
+			{
+				MeasurementId:   eebusutil.Ptr(model.MeasurementIdType(1)),
+				MeasurementType: eebusutil.Ptr(measurementType),
+				CommodityType:   eebusutil.Ptr(commodityType),
+				ScopeType:       eebusutil.Ptr(model.ScopeTypeTypeACPower),
+			},
+		},
+	}
+	feature.SetData(model.FunctionTypeMeasurementDescriptionListData, descData)
+
+	data = GetLocalMeasurementDescriptionsForTypeCommodityScope(s.service, model.EntityTypeTypeCEM, measurementType, commodityType, scopeType)
+	assert.Equal(s.T(), 1, len(data))
+	assert.Equal(s.T(), model.MeasurementIdType(0), *data[0].MeasurementId)
+
+	data = GetLocalMeasurementDescriptionsForTypeCommodityScope(s.service, model.EntityTypeTypeCEM, measurementType, commodityType, "")
+	assert.Equal(s.T(), 2, len(data))
+}
+
+func (s *UtilSuite) Test_SetLocalMeasurementData() {
+	err := SetLocalMeasurementData(s.service, model.EntityTypeTypePVSystem, []model.MeasurementDataType{})
+	assert.NotNil(s.T(), err)
+
+	err = SetLocalMeasurementData(s.service, model.EntityTypeTypeCEM, []model.MeasurementDataType{
+		{
+			MeasurementId: eebusutil.Ptr(model.MeasurementIdType(0)),
+			Value:         model.NewScaledNumberType(10),
+		},
+		{
+			MeasurementId: eebusutil.Ptr(model.MeasurementIdType(1)),
+			Value:         model.NewScaledNumberType(20),
+		},
+	})
+	assert.Nil(s.T(), err)
+
+	err = SetLocalMeasurementData(s.service, model.EntityTypeTypeCEM, []model.MeasurementDataType{
+		{
+			Value: model.NewScaledNumberType(5),
+		},
+		{
+			MeasurementId: eebusutil.Ptr(model.MeasurementIdType(1)),
+			Value:         model.NewScaledNumberType(30),
+		},
+	})
+	assert.Nil(s.T(), err)
+
+	entity := s.service.LocalDevice().EntityForType(model.EntityTypeTypeCEM)
+	feature := entity.FeatureOfTypeAndRole(model.FeatureTypeTypeMeasurement, model.RoleTypeServer)
+
+	data, ok := feature.DataCopy(model.FunctionTypeMeasurementListData).(*model.MeasurementListDataType)
+	assert.True(s.T(), ok)
+	assert.Equal(s.T(), 2, len(data.MeasurementData))
+	assert.Equal(s.T(), 10.0, data.MeasurementData[0].Value.GetValue())
+	assert.Equal(s.T(), 30.0, data.MeasurementData[1].Value.GetValue())
+}
@@ -0,0 +1,316 @@
+// Package writetracker tracks the results of the writes to remote devices
+//
+// The write operations of the use cases only return the message counter of the
+// sent SPINE message. The remote device answers a write with a result message
+// referencing this message counter, which either accepts the write or rejects it
+// with an error number.
+//
+// The Tracker records the writes and matches the result messages received by
+// the local client features. The result of a write can be awaited via
+// Write.Await or received via the ResultCallback of the tracker. Writes without
+// a result within the timeout are sent again, if retries are configured.
+package writetracker
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/enbility/ship-go/logging"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+)
+
+// Tracks the results of the writes to remote devices
+type Tracker struct {
+	config   Config
+	resultCB ResultCallback
+
+	mux sync.Mutex
+	// the writes waiting for their result
+	pending map[key]*Write
+	// the results received before their write was tracked
+	unmatched map[key]unmatchedResult
+}
+
+// identifies a write by the remote device and the message counter
+type key struct {
+	ski        string
+	msgCounter model.MsgCounterType
+}
+
+type unmatchedResult struct {
+	data     *model.ResultDataType
+	received time.Time
+}
+
+// A tracked write
+type Write struct {
+	tracker *Tracker
+	entity  spineapi.EntityRemoteInterface
+
+	// sends the write, nil if the write can not be retried
+	write func() (*model.MsgCounterType, error)
+
+	// guarded by the mutex of the tracker
+	result Result
+	timer  *time.Timer
+
+	// closed once the result is final
+	done chan struct{}
+}
+
+// create a new tracker
+//
+// parameters:
+//   - config: the configuration
+//   - resultCB: invoked with the final result of each write, may be nil
+//
+// the result messages have to be passed to HandleResponse, see Register
+func NewTracker(config Config, resultCB ResultCallback) *Tracker {
+	if config.Timeout <= 0 {
+		config.Timeout = defaultTimeout
+	}
+
+	return &Tracker{
+		config:    config,
+		resultCB:  resultCB,
+		pending:   make(map[key]*Write),
+		unmatched: make(map[key]unmatchedResult),
+	}
+}
+
+// pass the result messages of the client features of the local entity to the tracker
+//
+// has to be invoked after all use cases are added, as these add the features
+func (t *Tracker) Register(entity spineapi.EntityLocalInterface) {
+	for _, feature := range entity.Features() {
+		if feature.Role() == model.RoleTypeClient {
+			feature.AddResultCallback(t.HandleResponse)
+		}
+	}
+}
+
+// send a write and track its result
+//
+// parameters:
+//   - entity: the remote entity the write is sent to
+//   - write: sends the write, e.g. a closure invoking a use case write operation,
+//     it is invoked again for each retry
+//
+// possible errors:
+//   - ErrNoMsgCounter if the write did not return a message counter
+//   - and the errors of the write
+func (t *Tracker) Write(entity spineapi.EntityRemoteInterface, write func() (*model.MsgCounterType, error)) (*Write, error) {
+	item := t.newWrite(entity, write)
+
+	if err := t.send(item); err != nil {
+		return nil, err
+	}
+
+	return item, nil
+}
+
+// track the result of a write which was already sent
+//
+// the write is not retried
+func (t *Tracker) Track(entity spineapi.EntityRemoteInterface, msgCounter model.MsgCounterType) *Write {
+	item := t.newWrite(entity, nil)
+	t.track(item, msgCounter)
+
+	return item
+}
+
+// track the writes reported by the APIs
+//
+// has the signature of datapoints.WriteCallback
+func (t *Tracker) WriteCB(entity spineapi.EntityRemoteInterface, usecase, operation string, msgCounter *model.MsgCounterType, err error) {
+	if err != nil || msgCounter == nil {
+		return
+	}
+
+	t.Track(entity, *msgCounter)
+}
+
+// match a result message with the tracked writes
+//
+// has the signature of the result callbacks of the local features
+func (t *Tracker) HandleResponse(msg spineapi.ResponseMessage) {
+	data, ok := msg.Data.(*model.ResultDataType)
+	if !ok || data == nil || msg.DeviceRemote == nil {
+		return
+	}
+
+	k := key{ski: msg.DeviceRemote.Ski(), msgCounter: msg.MsgCounterReference}
+
+	t.mux.Lock()
+
+	item, ok := t.pending[k]
+	if !ok {
+		// the write may not be tracked yet
+		t.pruneUnmatched()
+		t.unmatched[k] = unmatchedResult{data: data, received: time.Now()}
+		t.mux.Unlock()
+		return
+	}
+
+	delete(t.pending, k)
+	item.timer.Stop()
+	item.resolve(data)
+
+	t.mux.Unlock()
+
+	t.notify(item)
+}
+
+func (t *Tracker) newWrite(entity spineapi.EntityRemoteInterface, write func() (*model.MsgCounterType, error)) *Write {
+	return &Write{
+		tracker: t,
+		entity:  entity,
+		write:   write,
+		result:  Result{Status: StatusPending},
+		done:    make(chan struct{}),
+	}
+}
+
+// send an attempt of the write and track it
+func (t *Tracker) send(item *Write) error {
+	msgCounter, err := item.write()
+	if err != nil {
+		return err
+	}
+	if msgCounter == nil {
+		return ErrNoMsgCounter
+	}
+
+	t.track(item, *msgCounter)
+
+	return nil
+}
+
+// track an attempt of the write
+func (t *Tracker) track(item *Write, msgCounter model.MsgCounterType) {
+	k := key{ski: ski(item.entity), msgCounter: msgCounter}
+
+	t.mux.Lock()
+
+	item.result.MsgCounter = msgCounter
+	item.result.Attempts++
+
+	if result, ok := t.unmatched[k]; ok {
+		delete(t.unmatched, k)
+		item.resolve(result.data)
+		t.mux.Unlock()
+
+		t.notify(item)
+		return
+	}
+
+	t.pending[k] = item
+	item.timer = time.AfterFunc(t.config.Timeout, func() {
+		t.timedOut(item, k)
+	})
+
+	t.mux.Unlock()
+}
+
+// no result was received for an attempt of the write
+func (t *Tracker) timedOut(item *Write, k key) {
+	t.mux.Lock()
+
+	if t.pending[k] != item {
+		t.mux.Unlock()
+		return
+	}
+	delete(t.pending, k)
+
+	retry := item.write != nil && item.result.Attempts <= t.config.Retries
+
+	t.mux.Unlock()
+
+	if retry {
+		err := t.send(item)
+		if err == nil {
+			return
+		}
+		logging.Log().Error("write tracker", ski(item.entity), err)
+	}
+
+	t.mux.Lock()
+	item.result.Status = StatusTimedOut
+	close(item.done)
+	t.mux.Unlock()
+
+	t.notify(item)
+}
+
+// remove the unmatched results which can no longer be matched
+//
+// has to be invoked with the mutex locked
+func (t *Tracker) pruneUnmatched() {
+	deadline := time.Now().Add(-t.config.Timeout)
+
+	for k, result := range t.unmatched {
+		if result.received.Before(deadline) {
+			delete(t.unmatched, k)
+		}
+	}
+}
+
+func (t *Tracker) notify(item *Write) {
+	if t.resultCB != nil {
+		t.resultCB(item.entity, item.Result())
+	}
+}
+
+// returns the SKI of the device of an entity
+func ski(entity spineapi.EntityRemoteInterface) string {
+	if entity == nil || entity.Device() == nil {
+		return ""
+	}
+
+	return entity.Device().Ski()
+}
+
+// returns the remote entity the write is sent to
+func (w *Write) Entity() spineapi.EntityRemoteInterface {
+	return w.entity
+}
+
+// returns the current result of the write
+func (w *Write) Result() Result {
+	w.tracker.mux.Lock()
+	defer w.tracker.mux.Unlock()
+
+	return w.result
+}
+
+// wait for the final result of the write
+//
+// returns the current result and the error of the context, if the context is
+// done before the result is final
+func (w *Write) Await(ctx context.Context) (Result, error) {
+	select {
+	case <-w.done:
+		return w.Result(), nil
+	case <-ctx.Done():
+		return w.Result(), ctx.Err()
+	}
+}
+
+// set the final result from the result data
+//
+// has to be invoked with the mutex of the tracker locked
+func (w *Write) resolve(data *model.ResultDataType) {
+	w.result.Status = StatusAccepted
+
+	if data.ErrorNumber != nil && *data.ErrorNumber != model.ErrorNumberTypeNoError {
+		w.result.Status = StatusRejected
+		w.result.ErrorNumber = *data.ErrorNumber
+		if data.Description != nil {
+			w.result.Description = string(*data.Description)
+		}
+	}
+
+	close(w.done)
+}
@@ -0,0 +1,176 @@
+package writetracker
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/enbility/cemd/api"
+	"github.com/enbility/cemd/approval"
+	"github.com/enbility/cemd/loopback"
+	"github.com/enbility/cemd/uclpc"
+	"github.com/enbility/cemd/uclpcserver"
+	spineapi "github.com/enbility/spine-go/api"
+	spinemocks "github.com/enbility/spine-go/mocks"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestTrackerSuite(t *testing.T) {
+	suite.Run(t, new(TrackerSuite))
+}
+
+type TrackerSuite struct {
+	suite.Suite
+
+	network *loopback.Network
+
+	guard  *loopback.Device
+	lpc    *uclpc.UCLPC
+	system *loopback.Device
+	server *uclpcserver.UCLPCServer
+
+	// the remote entity of the controllable system
+	entity spineapi.EntityRemoteInterface
+
+	results chan Result
+	sut     *Tracker
+}
+
+func (s *TrackerSuite) BeforeTest(suiteName, testName string) {
+	s.network = loopback.NewNetwork()
+
+	var err error
+	s.guard, err = s.network.NewCEM("guard", nil)
+	assert.Nil(s.T(), err)
+
+	s.lpc = uclpc.NewUCLPC(s.guard.Service(), loopback.NewEventRecorder().EntityEventCB)
+	s.guard.AddUseCase(s.lpc)
+
+	s.system, err = s.network.NewCEM("system", nil)
+	assert.Nil(s.T(), err)
+
+	s.server = uclpcserver.NewUCLPC(s.system.Service(), loopback.NewEventRecorder().EntityEventCB)
+	s.system.AddUseCase(s.server)
+
+	err = s.server.SetConsumptionLimit(api.LoadLimit{IsChangeable: true})
+	assert.Nil(s.T(), err)
+
+	_, err = s.network.Connect(s.guard, s.system)
+	assert.Nil(s.T(), err)
+
+	err = s.network.WaitIdle(time.Second * 5)
+	assert.Nil(s.T(), err)
+
+	s.entity = s.guard.RemoteEntity(s.system, model.EntityTypeTypeCEM)
+	assert.NotNil(s.T(), s.entity)
+
+	s.results = make(chan Result, 10)
+	s.sut = NewTracker(Config{}, func(entity spineapi.EntityRemoteInterface, result Result) {
+		s.results <- result
+	})
+	s.sut.Register(s.guard.LocalDevice().EntityForType(model.EntityTypeTypeCEM))
+}
+
+func (s *TrackerSuite) AfterTest(suiteName, testName string) {
+	s.network.Close()
+}
+
+// writes an active consumption limit
+func (s *TrackerSuite) write() (*model.MsgCounterType, error) {
+	return s.lpc.WriteConsumptionLimit(s.entity, api.LoadLimit{IsActive: true, Value: 4200})
+}
+
+func (s *TrackerSuite) await(item *Write) Result {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	result, err := item.Await(ctx)
+	assert.Nil(s.T(), err)
+
+	return result
+}
+
+func (s *TrackerSuite) Test_Accepted() {
+	s.server.SetApprovalPolicy(approval.NewAutoApprovePolicy())
+
+	item, err := s.sut.Write(s.entity, s.write)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), s.entity, item.Entity())
+
+	result := s.await(item)
+	assert.Equal(s.T(), StatusAccepted, result.Status)
+	assert.Equal(s.T(), 1, result.Attempts)
+
+	assert.Equal(s.T(), result, <-s.results)
+}
+
+func (s *TrackerSuite) Test_Rejected() {
+	s.server.SetApprovalPolicy(approval.NewSKIAllowListPolicy("other"))
+
+	// writes sent by the APIs are tracked via the write callback
+	msgCounter, err := s.write()
+	assert.Nil(s.T(), err)
+	s.sut.WriteCB(s.entity, "lpc", "consumptionLimit", msgCounter, nil)
+
+	result := <-s.results
+	assert.Equal(s.T(), StatusRejected, result.Status)
+	assert.Equal(s.T(), *msgCounter, result.MsgCounter)
+	assert.NotEqual(s.T(), model.ErrorNumberTypeNoError, result.ErrorNumber)
+
+	// failed writes are not tracked
+	s.sut.WriteCB(s.entity, "lpc", "consumptionLimit", nil, errors.New("failed"))
+	assert.Empty(s.T(), s.results)
+}
+
+func (s *TrackerSuite) Test_TimedOut() {
+	s.sut = NewTracker(Config{Timeout: time.Millisecond * 50, Retries: 1}, nil)
+
+	var msgCounter model.MsgCounterType
+	item, err := s.sut.Write(s.entity, func() (*model.MsgCounterType, error) {
+		msgCounter++
+		return util.Ptr(msgCounter), nil
+	})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), StatusPending, item.Result().Status)
+
+	result := s.await(item)
+	assert.Equal(s.T(), StatusTimedOut, result.Status)
+	assert.Equal(s.T(), 2, result.Attempts)
+	assert.Equal(s.T(), model.MsgCounterType(2), result.MsgCounter)
+
+	// writes without a message counter can not be tracked
+	_, err = s.sut.Write(s.entity, func() (*model.MsgCounterType, error) {
+		return nil, nil
+	})
+	assert.Equal(s.T(), ErrNoMsgCounter, err)
+}
+
+func (s *TrackerSuite) Test_Unmatched() {
+	device := spinemocks.NewDeviceRemoteInterface(s.T())
+	device.EXPECT().Ski().Return(s.system.SKI())
+
+	// the result is received before the write is tracked
+	s.sut.HandleResponse(spineapi.ResponseMessage{
+		MsgCounterReference: 1000,
+		Data:                &model.ResultDataType{ErrorNumber: util.Ptr(model.ErrorNumberTypeNoError)},
+		DeviceRemote:        device,
+	})
+
+	item := s.sut.Track(s.entity, 1000)
+	assert.Equal(s.T(), StatusAccepted, s.await(item).Status)
+	assert.Equal(s.T(), StatusAccepted, (<-s.results).Status)
+
+	// the context ends before the result is received
+	item = s.sut.Track(s.entity, 1001)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	result, err := item.Await(ctx)
+	assert.Equal(s.T(), context.Canceled, err)
+	assert.Equal(s.T(), StatusPending, result.Status)
+}
@@ -0,0 +1,63 @@
+package writetracker
+
+import (
+	"errors"
+	"time"
+
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+)
+
+// returned if a write did not provide a message counter to track
+var ErrNoMsgCounter = errors.New("write without message counter")
+
+// the timeout used if no timeout is configured
+const defaultTimeout = time.Second * 10
+
+// The status of a tracked write
+type Status string
+
+const (
+	// No result was received yet
+	StatusPending Status = "pending"
+
+	// The remote device accepted the write
+	StatusAccepted Status = "accepted"
+
+	// The remote device rejected the write
+	//
+	// The error number and description are provided in the result
+	StatusRejected Status = "rejected"
+
+	// No result was received within the timeout, after all retries
+	StatusTimedOut Status = "timedOut"
+)
+
+// The result of a tracked write
+type Result struct {
+	Status Status
+
+	// the message counter of the last attempt
+	MsgCounter model.MsgCounterType
+
+	// the number of attempts, including the retries
+	Attempts int
+
+	// the error number and description of a rejected write
+	ErrorNumber model.ErrorNumberType
+	Description string
+}
+
+// Invoked once a tracked write is accepted, rejected or timed out
+type ResultCallback func(entity spineapi.EntityRemoteInterface, result Result)
+
+// Configuration of the tracker
+type Config struct {
+	// the duration to wait for the result of each attempt, 10s if 0
+	Timeout time.Duration
+
+	// the number of times a timed out write is sent again
+	//
+	// only writes sent via Tracker.Write are retried, rejected writes are never retried
+	Retries int
+}